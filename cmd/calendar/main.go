@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"golv2-learning-app/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const usage = `使い方: calendar <サブコマンド> [オプション]
+
+サブコマンド:
+  export  予定のワークアウトをiCalendar（.ics）ファイルに出力
+
+各サブコマンドのオプションは calendar <サブコマンド> -h で確認できます`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	switch os.Args[1] {
+	case "export":
+		runExport(os.Args[2:])
+	case "-h", "--help", "help":
+		fmt.Println(usage)
+	default:
+		fmt.Fprintf(os.Stderr, "不明なサブコマンド: %s\n\n%s\n", os.Args[1], usage)
+		os.Exit(2)
+	}
+}
+
+// runExport exportサブコマンド: ExportICalendarを呼び出して.icsファイルに保存
+func runExport(args []string) {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var (
+		addr        = fs.String("addr", "localhost:50051", "gRPCサーバーのアドレス (例: localhost:50051)")
+		timeout     = fs.Duration("timeout", 10*time.Second, "リクエストのタイムアウト時間")
+		from        = fs.String("from", "", "開始日 YYYY-MM-DD（省略時は今日）")
+		to          = fs.String("to", "", "終了日 YYYY-MM-DD（その日を含む、省略時は開始日の4週間後）")
+		timezone    = fs.String("timezone", "", "日付の解釈に使用するタイムゾーン（省略時はサーバーのユーザー設定）")
+		language    = fs.String("lang", "ja", "種目名・説明の言語（ja または en）")
+		includeDone = fs.Bool("include-done", false, "完了・スキップ済みの予定も含める")
+		duration    = fs.Int("duration", 60, "時刻指定の予定の長さ（分）")
+		output      = fs.String("o", "", "出力ファイル（省略時はサーバーが提案するファイル名、- で標準出力）")
+	)
+	_ = fs.Parse(args)
+
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("接続に失敗: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewWorkoutServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := client.ExportICalendar(ctx, &proto.ExportICalendarRequest{
+		DateFrom:        *from,
+		DateTo:          *to,
+		Timezone:        *timezone,
+		Language:        *language,
+		IncludeDone:     *includeDone,
+		DurationMinutes: int32(*duration),
+	})
+	if err != nil {
+		log.Fatalf("❌ iCalendarの出力に失敗: %v", err)
+	}
+
+	if *output == "-" {
+		if _, err := os.Stdout.Write(resp.Ics); err != nil {
+			log.Fatalf("❌ 標準出力への書き込みに失敗: %v", err)
+		}
+		return
+	}
+
+	path := *output
+	if path == "" {
+		path = resp.Filename
+	}
+	if err := os.WriteFile(path, resp.Ics, 0o644); err != nil {
+		log.Fatalf("❌ %s への書き込みに失敗: %v", path, err)
+	}
+	fmt.Printf("%s → %s\n", resp.Message, path)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golv2-learning-app/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+func main() {
+	// コマンドライン引数の定義
+	var (
+		addr        = flag.String("addr", "localhost:50051", "gRPCサーバーのアドレス (例: localhost:50051)")
+		timeout     = flag.Duration("timeout", 10*time.Minute, "エクスポート全体のタイムアウト時間")
+		format      = flag.String("format", "", "ファイル形式: jsonl, csv, proto（省略時は出力ファイルの拡張子から判定、判定できなければ jsonl）")
+		output      = flag.String("o", "", "出力ファイル（省略時は workouts.<拡張子>、- で標準出力）")
+		status      = flag.String("status", "", "ステータスで絞り込む（planned, in_progress, completed, skipped）")
+		difficulty  = flag.String("difficulty", "", "難易度で絞り込む（beginner, intermediate, advanced, beast）")
+		muscleGroup = flag.String("muscle-group", "", "筋肉群で絞り込む（例: chest, full_body）")
+		minWeight   = flag.Float64("min-weight", -1, "重量(kg)の下限（負の値は指定なし）")
+		maxWeight   = flag.Float64("max-weight", -1, "重量(kg)の上限（負の値は指定なし）")
+		from        = flag.String("from", "", "実施日の開始 YYYY-MM-DD")
+		to          = flag.String("to", "", "実施日の終了 YYYY-MM-DD（その日を含む）")
+		timezone    = flag.String("timezone", "", "日付の解釈に使用するタイムゾーン（省略時はサーバーのユーザー設定）")
+		batchSize   = flag.Int("batch-size", 0, "1メッセージあたりの件数（省略時はサーバーのデフォルト）")
+	)
+	flag.Parse()
+
+	exportFormat, err := resolveFormat(*format, *output)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	req := &proto.ExportWorkoutsRequest{
+		DateFrom:  *from,
+		DateTo:    *to,
+		Timezone:  *timezone,
+		Format:    exportFormat,
+		BatchSize: int32(*batchSize),
+	}
+	if err := applyEnumFilters(req, *status, *difficulty, *muscleGroup); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *minWeight >= 0 {
+		req.MinWeight = minWeight
+	}
+	if *maxWeight >= 0 {
+		req.MaxWeight = maxWeight
+	}
+
+	path := *output
+	if path == "" {
+		path = "workouts" + formatExtension(exportFormat)
+	}
+	var file io.WriteCloser = os.Stdout
+	if path != "-" {
+		f, err := os.Create(path)
+		if err != nil {
+			log.Fatalf("❌ ファイルを作成できません: %v", err)
+		}
+		file = f
+	}
+	defer file.Close()
+	writer := bufio.NewWriter(file)
+
+	log.Printf("🔌 gRPCサーバーに接続: %s", *addr)
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("接続に失敗: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewWorkoutServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	stream, err := client.ExportWorkouts(ctx, req)
+	if err != nil {
+		log.Fatalf("❌ エクスポートを開始できません: %v", err)
+	}
+
+	exported := int32(0)
+	for {
+		resp, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.Fatalf("❌ エクスポートに失敗（%d件まで受信）: %v", exported, err)
+		}
+		if _, err := writer.Write(resp.Chunk); err != nil {
+			log.Fatalf("❌ 書き込みに失敗: %v", err)
+		}
+		// PROTO形式は長さ付きのバイナリを連結して保存する（protodelimで1件ずつ読み込める）
+		for _, workout := range resp.Workouts {
+			if _, err := protodelim.MarshalTo(writer, workout); err != nil {
+				log.Fatalf("❌ 書き込みに失敗: %v", err)
+			}
+		}
+		exported = resp.ExportedCount
+	}
+	if err := writer.Flush(); err != nil {
+		log.Fatalf("❌ 書き込みに失敗: %v", err)
+	}
+
+	if path != "-" {
+		log.Printf("📤 %d件のワークアウトをエクスポートしました → %s", exported, path)
+	}
+}
+
+// resolveFormat -formatの指定、なければ出力ファイルの拡張子からエクスポート形式を決める
+func resolveFormat(format, path string) (proto.ExportFormat, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".pb", ".bin":
+			format = "proto"
+		default:
+			format = "jsonl"
+		}
+	}
+	switch strings.ToLower(format) {
+	case "jsonl", "ndjson":
+		return proto.ExportFormat_EXPORT_FORMAT_JSONL, nil
+	case "csv":
+		return proto.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "proto":
+		return proto.ExportFormat_EXPORT_FORMAT_PROTO, nil
+	default:
+		return proto.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, fmt.Errorf("不明なファイル形式: %q（-format jsonl, csv, proto のいずれかを指定してください）", format)
+	}
+}
+
+// formatExtension エクスポート形式に対応する拡張子
+func formatExtension(format proto.ExportFormat) string {
+	switch format {
+	case proto.ExportFormat_EXPORT_FORMAT_CSV:
+		return ".csv"
+	case proto.ExportFormat_EXPORT_FORMAT_PROTO:
+		return ".pb"
+	default:
+		return ".jsonl"
+	}
+}
+
+// applyEnumFilters キー（例: "completed"）で指定した絞り込み条件をprotoの列挙値に変換する
+func applyEnumFilters(req *proto.ExportWorkoutsRequest, status, difficulty, muscleGroup string) error {
+	if status != "" {
+		v, ok := proto.WorkoutStatus_value["WORKOUT_STATUS_"+strings.ToUpper(status)]
+		if !ok {
+			return fmt.Errorf("不明なステータス: %q", status)
+		}
+		req.StatusFilter = proto.WorkoutStatus(v)
+	}
+	if difficulty != "" {
+		v, ok := proto.Difficulty_value["DIFFICULTY_"+strings.ToUpper(difficulty)]
+		if !ok {
+			return fmt.Errorf("不明な難易度: %q", difficulty)
+		}
+		req.DifficultyFilter = proto.Difficulty(v)
+	}
+	if muscleGroup != "" {
+		v, ok := proto.MuscleGroup_value[strings.ToUpper(muscleGroup)]
+		if !ok || v == int32(proto.MuscleGroup_UNSPECIFIED) {
+			return fmt.Errorf("不明な筋肉群: %q", muscleGroup)
+		}
+		req.MuscleGroupFilter = proto.MuscleGroup(v)
+	}
+	return nil
+}
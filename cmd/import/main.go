@@ -0,0 +1,162 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golv2-learning-app/proto"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// chunkSize 1メッセージで送るファイルの大きさ
+const chunkSize = 64 * 1024
+
+func main() {
+	// コマンドライン引数の定義
+	var (
+		addr     = flag.String("addr", "localhost:50051", "gRPCサーバーのアドレス (例: localhost:50051)")
+		timeout  = flag.Duration("timeout", 5*time.Minute, "インポート全体のタイムアウト時間")
+		format   = flag.String("format", "", "ファイル形式: csv, jsonl, strong, hevy, fitnotes（省略時は拡張子から判定）")
+		mapping  = flag.String("map", "", "CSVの列の対応 フィールド名=列名 をカンマ区切りで指定（例: exercise_type=種目,weight=重量）")
+		timezone = flag.String("timezone", "", "タイムゾーンのない日時の解釈に使用（省略時はサーバーのユーザー設定）")
+		dryRun   = flag.Bool("dry-run", false, "検証のみ行い保存しない")
+	)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "使い方: import [オプション] <ファイル>\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	path := flag.Arg(0)
+
+	importFormat, err := resolveFormat(*format, path)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	columnMapping, err := parseMapping(*mapping)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatalf("❌ ファイルを開けません: %v", err)
+	}
+	defer file.Close()
+
+	log.Printf("🔌 gRPCサーバーに接続: %s", *addr)
+	conn, err := grpc.Dial(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("接続に失敗: %v", err)
+	}
+	defer conn.Close()
+
+	client := proto.NewWorkoutServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	resp, err := upload(ctx, client, file, &proto.ImportOptions{
+		Format:        importFormat,
+		ColumnMapping: columnMapping,
+		Timezone:      *timezone,
+		DryRun:        *dryRun,
+	})
+	if err != nil {
+		log.Fatalf("❌ インポートに失敗: %v", err)
+	}
+
+	for _, e := range resp.Errors {
+		fmt.Printf("  %d行目: %s\n", e.Line, e.Message)
+	}
+	for _, e := range resp.Skipped {
+		fmt.Printf("  ⏭️ %d行目: %s\n", e.Line, e.Message)
+	}
+	fmt.Println(resp.Message)
+	if len(resp.Errors) > 0 || (!resp.Committed && !resp.DryRun) {
+		os.Exit(1)
+	}
+}
+
+// upload オプションを送ってからファイルの内容を分割して送信する
+func upload(ctx context.Context, client proto.WorkoutServiceClient, r io.Reader, options *proto.ImportOptions) (*proto.ImportWorkoutsResponse, error) {
+	stream, err := client.ImportWorkouts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&proto.ImportWorkoutsRequest{Payload: &proto.ImportWorkoutsRequest_Options{Options: options}}); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, readErr := r.Read(buf)
+		if n > 0 {
+			err := stream.Send(&proto.ImportWorkoutsRequest{Payload: &proto.ImportWorkoutsRequest_Chunk{Chunk: buf[:n]}})
+			// サーバーが途中で応答を返した場合はio.EOFになるため、CloseAndRecvで結果を受け取る
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			return nil, fmt.Errorf("ファイルの読み込みに失敗: %w", readErr)
+		}
+	}
+	return stream.CloseAndRecv()
+}
+
+// resolveFormat 形式の指定（なければ拡張子）からImportFormatを決める
+func resolveFormat(format, path string) (proto.ImportFormat, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch format {
+	case "csv":
+		return proto.ImportFormat_IMPORT_FORMAT_CSV, nil
+	case "jsonl", "ndjson":
+		return proto.ImportFormat_IMPORT_FORMAT_JSONL, nil
+	case "strong":
+		return proto.ImportFormat_IMPORT_FORMAT_STRONG, nil
+	case "hevy":
+		return proto.ImportFormat_IMPORT_FORMAT_HEVY, nil
+	case "fitnotes":
+		return proto.ImportFormat_IMPORT_FORMAT_FITNOTES, nil
+	default:
+		return proto.ImportFormat_IMPORT_FORMAT_UNSPECIFIED, fmt.Errorf("不明なファイル形式: %q（-format csv, jsonl, strong, hevy, fitnotes のいずれかを指定してください）", format)
+	}
+}
+
+// parseMapping "フィールド名=列名,..." を解析する
+func parseMapping(value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	if value == "" {
+		return mapping, nil
+	}
+	for _, pair := range strings.Split(value, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(field) == "" || strings.TrimSpace(column) == "" {
+			return nil, fmt.Errorf("列の対応の形式が不正です: %q（フィールド名=列名）", pair)
+		}
+		mapping[strings.TrimSpace(field)] = strings.TrimSpace(column)
+	}
+	return mapping, nil
+}
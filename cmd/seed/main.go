@@ -10,6 +10,7 @@ import (
 	"golv2-learning-app/proto"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -58,38 +59,43 @@ func main() {
 		{proto.ExerciseType_EXERCISE_PULL_UP, "加重懸垂でパワーアップ", "重りつけて挑戦！", proto.MuscleGroup_BACK, proto.Difficulty_DIFFICULTY_BEAST, 4, 6, 10.0},
 	}
 
-	fmt.Printf("🚀 %d個のワークアウトを作成開始！\n", len(workouts))
+	fmt.Printf("🚀 %d個のワークアウトを一括作成開始！\n", len(workouts))
 
-	successCount := 0
-	for i, workout := range workouts {
-		exerciseName := getExerciseTypeName(workout.exerciseType)
-		fmt.Printf("📝 [%d/%d] %s を作成中...\n", i+1, len(workouts), exerciseName)
-
-		req := &proto.CreateWorkoutRequest{
+	reqs := make([]*proto.CreateWorkoutRequest, 0, len(workouts))
+	for _, workout := range workouts {
+		sets, reps := workout.sets, workout.reps
+		reqs = append(reqs, &proto.CreateWorkoutRequest{
 			ExerciseType: workout.exerciseType,
 			Description:  workout.description,
 			Notes:        workout.notes,
 			MuscleGroup:  workout.muscleGroup,
 			Difficulty:   workout.difficulty,
-			Sets:         workout.sets,
-			Reps:         workout.reps,
+			Sets:         &sets,
+			Reps:         &reps,
 			Weight:       workout.weight,
-		}
+		})
+	}
 
-		ctx, cancel := context.WithTimeout(context.Background(), *timeout)
-		resp, err := client.CreateWorkout(ctx, req)
-		cancel()
+	// 1回のリクエストでまとめて作成（失敗した項目があっても他の項目は作成する）
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	resp, err := client.BatchCreateWorkouts(ctx, &proto.BatchCreateWorkoutsRequest{
+		Workouts: reqs,
+		Mode:     proto.BatchMode_BATCH_MODE_PER_ITEM,
+	})
+	if err != nil {
+		log.Fatalf("❌ 一括作成に失敗: %v", err)
+	}
 
-		if err != nil {
-			fmt.Printf("❌ %s の作成に失敗: %v\n", exerciseName, err)
+	successCount := 0
+	for _, result := range resp.Results {
+		exerciseName := getExerciseTypeName(workouts[result.Index].exerciseType)
+		if result.Code != int32(codes.OK) {
+			fmt.Printf("❌ [%d/%d] %s の作成に失敗 (%s): %s\n", result.Index+1, len(workouts), exerciseName, codes.Code(result.Code), result.Message)
 			continue
 		}
-
 		successCount++
-		fmt.Printf("✅ %s (ID: %d) を作成しました\n", getExerciseTypeName(resp.Workout.ExerciseType), resp.Workout.Id)
-
-		// API負荷軽減の待機
-		time.Sleep(200 * time.Millisecond)
+		fmt.Printf("✅ [%d/%d] %s (ID: %d) を作成しました\n", result.Index+1, len(workouts), exerciseName, result.Id)
 	}
 
 	fmt.Printf("\n🎉 完了！ %d/%d個のワークアウトを作成しました！\n", successCount, len(workouts))
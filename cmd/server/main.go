@@ -1,32 +1,50 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strconv"
+	"strings"
 	"time"
+	_ "time/tzdata" // 最小構成のコンテナでもタイムゾーンを読み込めるように埋め込む
 
+	"golv2-learning-app/config"
+	"golv2-learning-app/domain"
 	repository "golv2-learning-app/infra"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/metrics"
 	"golv2-learning-app/server"
+	"golv2-learning-app/tracing"
 	"golv2-learning-app/usecase"
+	"golv2-learning-app/usecase/plates"
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
-	"gorm.io/gorm/logger"
+	gormlogger "gorm.io/gorm/logger"
 )
 
+// logger アプリケーション全体のロガー（設定ファイルのlogging.level/logging.formatで作成）
+var logger = slog.New(slog.NewTextHandler(os.Stdout, nil))
+
+// fatal エラーをログに出力して終了
+func fatal(msg string, args ...any) {
+	logger.Error(msg, args...)
+	os.Exit(1)
+}
+
 // getEnv 環境変数を取得（必須）
 func getEnv(key string, hideValue bool) string {
 	value := os.Getenv(key)
 	if value == "" {
-		log.Fatalf("❌ 環境変数 %s が設定されていません", key)
+		fatal("環境変数が設定されていません", slog.String("key", key))
 	}
 	if hideValue {
-		log.Printf("✅ %s: [HIDDEN]", key)
+		logger.Debug("環境変数を読み込みました", slog.String("key", key), slog.String("value", "[HIDDEN]"))
 	} else {
-		log.Printf("✅ %s: %s", key, value)
+		logger.Debug("環境変数を読み込みました", slog.String("key", key), slog.String("value", value))
 	}
 	return value
 }
@@ -35,22 +53,80 @@ func getEnv(key string, hideValue bool) string {
 func getEnvWithDefault(key, defaultValue string) string {
 	value := os.Getenv(key)
 	if value == "" {
-		log.Printf("⚠️  %s not set, using default: %s", key, defaultValue)
+		logger.Debug("環境変数が未設定のためデフォルト値を使用します", slog.String("key", key), slog.String("value", defaultValue))
 		return defaultValue
 	}
-	log.Printf("✅ %s: %s", key, value)
+	logger.Debug("環境変数を読み込みました", slog.String("key", key), slog.String("value", value))
 	return value
 }
 
+// gormLogLevel アプリケーションのログレベルに合わせたGORMのログレベル（debugの場合のみSQLを出力）
+func gormLogLevel() gormlogger.LogLevel {
+	if logger.Enabled(context.Background(), slog.LevelDebug) {
+		return gormlogger.Info
+	}
+	return gormlogger.Warn
+}
+
+// allowedOrigins CORSで許可するオリジンを取得（環境変数CORS_ALLOWED_ORIGINS > 設定ファイルの優先順位）
+func allowedOrigins(cfg *config.Config) []string {
+	if value := os.Getenv("CORS_ALLOWED_ORIGINS"); value != "" {
+		var origins []string
+		for _, origin := range strings.Split(value, ",") {
+			if origin = strings.TrimSpace(origin); origin != "" {
+				origins = append(origins, origin)
+			}
+		}
+		return origins
+	}
+	if cfg == nil {
+		return nil
+	}
+	return cfg.Server.CORS.AllowedOrigins
+}
+
+// tracingConfig トレースの設定を取得（出力先は環境変数TRACING_EXPORTER > 設定ファイルの優先順位）
+func tracingConfig(cfg *config.Config) tracing.Config {
+	tc := tracing.Config{Exporter: tracing.ExporterNone, SampleRatio: 1.0}
+	if cfg != nil {
+		tc = tracing.Config{
+			Exporter:    cfg.Tracing.Exporter,
+			Endpoint:    cfg.Tracing.Endpoint,
+			Insecure:    cfg.Tracing.Insecure,
+			SampleRatio: cfg.Tracing.SampleRatio,
+			Version:     cfg.App.Version,
+		}
+	}
+	if value := os.Getenv("TRACING_EXPORTER"); value != "" {
+		tc.Exporter = value
+	}
+	return tc
+}
+
 func main() {
 	// コマンドライン引数の定義
 	var (
-		port = flag.Int("port", 0, "gRPCサーバーのポート番号 (デフォルト: 環境変数GRPC_PORTまたは50051)")
+		port        = flag.Int("port", 0, "gRPCサーバーのポート番号 (デフォルト: 環境変数GRPC_PORTまたは50051)")
+		httpPort    = flag.Int("http-port", -1, "REST/JSON・gRPC-Webのポート番号、0で無効 (デフォルト: 環境変数HTTP_PORTまたは8080)")
+		metricsPort = flag.Int("metrics-port", -1, "メトリクス（/metrics）のポート番号、0で無効 (デフォルト: 環境変数METRICS_PORTまたは9090)")
+		configPath  = flag.String("config", "config.yaml", "設定ファイルのパス")
 	)
 	flag.Parse()
 
-	log.Printf("💪 筋トレアプリを起動中...")
-	log.Printf("🔥 環境変数を読み込み中...")
+	// 設定ファイルを読み込み、ログの設定を反映（読み込めない場合はデフォルト設定）
+	cfg, cfgErr := config.Load(*configPath)
+	if cfgErr == nil {
+		configured, err := logging.New(os.Stdout, cfg.Logging.Level, cfg.Logging.Format)
+		if err != nil {
+			fatal("ログの設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		logger = configured
+	}
+
+	logger.Info("筋トレアプリを起動中...")
+	if cfgErr != nil {
+		logger.Warn("設定ファイルを読み込めませんでした。デフォルト設定を使用します", slog.String(logging.KeyError, cfgErr.Error()))
+	}
 
 	dbHost := getEnv("DB_HOST", false)
 	dbName := getEnv("DB_NAME", false)
@@ -58,36 +134,51 @@ func main() {
 	dbPass := getEnv("DB_PASSWORD", true)
 
 	var err error
-	var dbPort, serverPort int
+	var dbPort, serverPort, gatewayPort, promPort int
 
 	dbPortStr := getEnvWithDefault("DB_PORT", "3306")
 	dbPort, err = strconv.Atoi(dbPortStr)
 	if err != nil {
-		log.Fatalf("❌ DB_PORT is invalid: %s", dbPortStr)
+		fatal("DB_PORTが不正です", slog.String("value", dbPortStr))
 	}
 
 	// ポート番号の取得（コマンドライン引数 > 環境変数 > デフォルト値の優先順位）
 	if *port > 0 {
 		serverPort = *port
-		log.Printf("✅ コマンドライン引数からポートを取得: %d", serverPort)
 	} else {
 		serverPortStr := getEnvWithDefault("GRPC_PORT", "50051")
 		serverPort, err = strconv.Atoi(serverPortStr)
 		if err != nil {
-			log.Fatalf("❌ GRPC_PORT is invalid: %s", serverPortStr)
+			fatal("GRPC_PORTが不正です", slog.String("value", serverPortStr))
+		}
+	}
+	if *httpPort >= 0 {
+		gatewayPort = *httpPort
+	} else {
+		gatewayPortStr := getEnvWithDefault("HTTP_PORT", "8080")
+		gatewayPort, err = strconv.Atoi(gatewayPortStr)
+		if err != nil || gatewayPort < 0 {
+			fatal("HTTP_PORTが不正です", slog.String("value", gatewayPortStr))
+		}
+	}
+	if *metricsPort >= 0 {
+		promPort = *metricsPort
+	} else {
+		promPortStr := getEnvWithDefault("METRICS_PORT", "9090")
+		promPort, err = strconv.Atoi(promPortStr)
+		if err != nil || promPort < 0 {
+			fatal("METRICS_PORTが不正です", slog.String("value", promPortStr))
 		}
-		log.Printf("✅ 環境変数からポートを取得: %d", serverPort)
 	}
 
 	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?parseTime=true&charset=utf8mb4&collation=utf8mb4_unicode_ci&loc=Local",
 		dbUser, dbPass, dbHost, dbPort, dbName)
 
-	log.Printf("🏋️ Database connection info: Host=%s, Port=%d, Database=%s, User=%s",
-		dbHost, dbPort, dbName, dbUser)
+	dbAttrs := []any{slog.String("host", dbHost), slog.Int("port", dbPort), slog.String("database", dbName), slog.String("user", dbUser)}
 
-	// GORM設定（SQL実行ログを表示）
-	config := &gorm.Config{
-		Logger: logger.Default.LogMode(logger.Info),
+	// GORM設定（ログレベルがdebugの場合のみSQL実行ログを表示）
+	gormConfig := &gorm.Config{
+		Logger: gormlogger.Default.LogMode(gormLogLevel()),
 	}
 
 	// DB接続のリトライ処理
@@ -96,38 +187,166 @@ func main() {
 	retryDelay := 2 * time.Second
 
 	for i := 0; i < maxRetries; i++ {
-		log.Printf("💪 MySQLにGORMで接続中... (試行 %d/%d)", i+1, maxRetries)
-		db, err = gorm.Open(mysql.Open(dsn), config)
+		logger.Info("MySQLに接続中...", append(dbAttrs, slog.Int("attempt", i+1), slog.Int("max_attempts", maxRetries))...)
+		db, err = gorm.Open(mysql.Open(dsn), gormConfig)
 		if err == nil {
 			break
 		}
-		log.Printf("MySQL接続に失敗: %v", err)
+		logger.Warn("MySQL接続に失敗しました", slog.String(logging.KeyError, err.Error()), slog.Duration("retry_delay", retryDelay))
 		if i < maxRetries-1 {
-			log.Printf("🔄 %v後に再試行...", retryDelay)
 			time.Sleep(retryDelay)
 		}
 	}
 
 	if err != nil {
-		log.Fatalf("%d回の試行後もMySQL接続に失敗: %v", maxRetries, err)
+		fatal("MySQL接続に失敗しました", slog.Int("attempts", maxRetries), slog.String(logging.KeyError, err.Error()))
+	}
+
+	logger.Info("MySQLデータベースに接続しました", dbAttrs...)
+
+	// メトリクス（RPC・クエリの処理時間、コネクションプール、ワークアウトの件数）
+	appMetrics := metrics.New()
+	if err := repository.RegisterQueryMetrics(db, appMetrics); err != nil {
+		fatal("クエリのメトリクスを設定できませんでした", slog.String(logging.KeyError, err.Error()))
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		fatal("DB接続を取得できませんでした", slog.String(logging.KeyError, err.Error()))
+	}
+	if err := appMetrics.RegisterDBStats(sqlDB, dbName); err != nil {
+		fatal("コネクションプールのメトリクスを設定できませんでした", slog.String(logging.KeyError, err.Error()))
 	}
 
-	log.Printf("✅ MySQLデータベースに接続しました: %s:%d/%s", dbHost, dbPort, dbName)
+	// トレース（RPC・ユースケース・DBクエリのスパン）
+	tc := tracingConfig(cfg)
+	tracerProvider, shutdownTracing, err := tracing.New(context.Background(), tc, os.Stdout)
+	if err != nil {
+		fatal("トレースの設定が不正です", slog.String(logging.KeyError, err.Error()))
+	}
+	defer shutdownTracing(context.Background())
+	if err := repository.RegisterTracing(db, tracerProvider); err != nil {
+		fatal("クエリのトレースを設定できませんでした", slog.String(logging.KeyError, err.Error()))
+	}
+	logger.Info("トレースを設定しました", slog.String("exporter", tc.Exporter), slog.Float64("sample_ratio", tc.SampleRatio))
 
 	// リポジトリを作成（接続済みのDBを注入）
 	repo := repository.NewGORMRepository(db)
+	repo.SetLogger(logger)
 
 	// ワークアウトマネージャーを作成（MySQLリポジトリを使用）
 	workoutManager := usecase.NewWorkoutManagerWithRepository(repo)
+	workoutManager.SetLogger(logger)
+	workoutManager.SetMetrics(appMetrics)
+	workoutManager.SetTracerProvider(tracerProvider)
+	workoutManager.SetProgramRepository(repository.NewGORMProgramRepository(db))
+	workoutManager.SetBodyMeasurementRepository(repository.NewGORMBodyMeasurementRepository(db))
+
+	// 設定ファイルから高強度判定ルールなどを読み込み（読み込めない場合はデフォルト設定）
+	if cfgErr == nil {
+		if len(cfg.Intensity.Rules) > 0 {
+			rules, err := cfg.Intensity.DomainRules()
+			if err != nil {
+				fatal("高強度判定ルールの設定が不正です", slog.String(logging.KeyError, err.Error()))
+			}
+			workoutManager.SetIntensityRules(rules, cfg.Intensity.Bodyweight)
+			logger.Info("高強度判定ルールを読み込みました", slog.Int("rules", len(rules)))
+		}
+
+		// ボリュームバランスの目標セット数（未設定の場合はデフォルト値）
+		targets := usecase.DefaultVolumeTargets()
+		if len(cfg.VolumeBalance.Targets) > 0 {
+			targets, err = cfg.VolumeBalance.DomainTargets()
+			if err != nil {
+				fatal("ボリュームバランスの設定が不正です", slog.String(logging.KeyError, err.Error()))
+			}
+		}
+		secondaryWeight := domain.DefaultSecondaryMuscleWeight
+		if cfg.VolumeBalance.SecondaryWeight != nil {
+			secondaryWeight = *cfg.VolumeBalance.SecondaryWeight
+		}
+		workoutManager.SetVolumeTargets(targets, secondaryWeight)
+
+		loc, err := cfg.User.Location()
+		if err != nil {
+			fatal("タイムゾーンの設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		workoutManager.SetLocation(loc)
+		logger.Info("タイムゾーンを設定しました", slog.String("timezone", loc.String()))
+
+		weightUnit, err := cfg.User.DomainWeightUnit()
+		if err != nil {
+			fatal("重量の単位の設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		workoutManager.SetWeightUnit(weightUnit)
+
+		plateInventory, err := cfg.User.DomainPlateInventory(weightUnit)
+		if err == nil {
+			err = plates.ValidateInventory(plateInventory)
+		}
+		if err != nil {
+			fatal("プレートの在庫の設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		workoutManager.SetPlateInventory(plateInventory)
+
+		progressionRules, err := cfg.Progression.DomainRules()
+		if err != nil {
+			fatal("漸進的過負荷の設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		workoutManager.SetProgressionRules(progressionRules, cfg.Progression.AutoCreateNext)
+
+		importAliases, err := cfg.Import.DomainAliases()
+		if err != nil {
+			fatal("インポートの別名の設定が不正です", slog.String(logging.KeyError, err.Error()))
+		}
+		workoutManager.SetImportAliases(importAliases)
+
+		// 予定日を過ぎた予定を定期的にスキップ（未実施）にする
+		if cfg.Schedule.AutoSkipMissed {
+			if cfg.Schedule.CheckInterval <= 0 || cfg.Schedule.MissedGracePeriod < 0 {
+				fatal("予定の設定が不正です",
+					slog.Duration("check_interval", cfg.Schedule.CheckInterval),
+					slog.Duration("missed_grace_period", cfg.Schedule.MissedGracePeriod))
+			}
+			workoutManager.SetMissedGracePeriod(cfg.Schedule.MissedGracePeriod)
+			jobCtx, cancelJob := context.WithCancel(context.Background())
+			defer cancelJob()
+			go workoutManager.RunMissedWorkoutJob(jobCtx, cfg.Schedule.CheckInterval)
+			logger.Info("未実施の予定を定期的にチェックします",
+				slog.Duration("check_interval", cfg.Schedule.CheckInterval),
+				slog.Duration("missed_grace_period", cfg.Schedule.MissedGracePeriod))
+		}
+	}
 
 	// gRPCサーバーの作成と起動
 	grpcServer := server.NewGRPCServer(workoutManager)
+	grpcServer.SetLogger(logger)
+	grpcServer.SetMetrics(appMetrics)
+	grpcServer.SetTracerProvider(tracerProvider)
+
+	if promPort > 0 {
+		go func() {
+			logger.Info("メトリクスを公開しました", slog.Int("port", promPort), slog.String("path", metrics.Path))
+			if err := appMetrics.Start(promPort); err != nil {
+				fatal("メトリクスサーバーの起動に失敗しました", slog.String(logging.KeyError, err.Error()))
+			}
+		}()
+	} else {
+		logger.Info("メトリクスサーバーは無効です")
+	}
 
-	log.Printf("🚀 ポート %d でgRPCサーバーを起動中...", serverPort)
-	log.Printf("🎯 Evansで接続: evans -r repl -p %d", serverPort)
-	log.Printf("💪 今日も筋肉を鍛えましょう！")
+	// REST/JSONはgRPCサーバーに中継し、gRPC-Webは同じポートで受け付ける
+	if gatewayPort > 0 {
+		httpServer := server.NewHTTPServer(fmt.Sprintf("localhost:%d", serverPort), grpcServer, allowedOrigins(cfg))
+		go func() {
+			if err := httpServer.Start(context.Background(), gatewayPort); err != nil {
+				fatal("REST/JSON・gRPC-Webサーバーの起動に失敗しました", slog.String(logging.KeyError, err.Error()))
+			}
+		}()
+	} else {
+		logger.Info("REST/JSON・gRPC-Webサーバーは無効です")
+	}
 
 	if err := grpcServer.Start(serverPort); err != nil {
-		log.Fatalf("💥 gRPCサーバーの起動に失敗: %v", err)
+		fatal("gRPCサーバーの起動に失敗しました", slog.String(logging.KeyError, err.Error()))
 	}
 }
@@ -0,0 +1,310 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"github.com/spf13/viper"
+)
+
+// Config アプリケーション設定（config.yaml）
+type Config struct {
+	App           AppConfig           `mapstructure:"app"`
+	Logging       LoggingConfig       `mapstructure:"logging"`
+	Tracing       TracingConfig       `mapstructure:"tracing"`
+	Server        ServerConfig        `mapstructure:"server"`
+	User          UserConfig          `mapstructure:"user"`
+	Intensity     IntensityConfig     `mapstructure:"intensity"`
+	VolumeBalance VolumeBalanceConfig `mapstructure:"volume_balance"`
+	Progression   ProgressionConfig   `mapstructure:"progression"`
+	Schedule      ScheduleConfig      `mapstructure:"schedule"`
+	Import        ImportConfig        `mapstructure:"import"`
+}
+
+// AppConfig アプリケーション情報
+type AppConfig struct {
+	Name    string `mapstructure:"name"`
+	Version string `mapstructure:"version"`
+}
+
+// LoggingConfig ログ設定
+type LoggingConfig struct {
+	Level  string `mapstructure:"level"`
+	Format string `mapstructure:"format"`
+}
+
+// TracingConfig OpenTelemetryのトレース設定
+type TracingConfig struct {
+	Exporter    string  `mapstructure:"exporter"`     // 出力先（otlp / stdout / none）
+	Endpoint    string  `mapstructure:"endpoint"`     // OTLPの送信先（例: "localhost:4317"、未指定の場合はOTEL_EXPORTER_OTLP_ENDPOINT）
+	Insecure    bool    `mapstructure:"insecure"`     // OTLPをTLSなしで送信する
+	SampleRatio float64 `mapstructure:"sample_ratio"` // 記録するトレースの割合（0.0〜1.0）
+}
+
+// ServerConfig サーバー設定
+type ServerConfig struct {
+	CORS CORSConfig `mapstructure:"cors"`
+}
+
+// CORSConfig ブラウザから他のオリジンのREST/JSON・gRPC-Webを呼び出すための設定
+type CORSConfig struct {
+	AllowedOrigins []string `mapstructure:"allowed_origins"` // 許可するオリジン（例: "http://localhost:3000"、"*" は全て許可）
+}
+
+// UserConfig ユーザー設定
+type UserConfig struct {
+	Timezone   string               `mapstructure:"timezone"`    // IANAタイムゾーン名（例: "Asia/Tokyo"）。日付の区切りに使用
+	WeightUnit string               `mapstructure:"weight_unit"` // 重量の単位（"kg" / "lb"）。入力・表示のデフォルト
+	Plates     PlateInventoryConfig `mapstructure:"plates"`      // プレートの組み合わせ計算に使用するバーとプレート
+}
+
+// PlateInventoryConfig バーとプレートの在庫の設定（未設定の場合は在庫の単位の一般的なジムの在庫）
+type PlateInventoryConfig struct {
+	Unit      string        `mapstructure:"unit"` // 在庫の単位（"kg" / "lb"）。未設定の場合はweight_unit
+	BarWeight *float64      `mapstructure:"bar_weight"`
+	Plates    []PlateConfig `mapstructure:"plates"`
+}
+
+// PlateConfig プレートの設定（countは両側合わせた枚数）
+type PlateConfig struct {
+	Weight float64 `mapstructure:"weight"`
+	Count  int     `mapstructure:"count"`
+}
+
+// Location 設定のタイムゾーンを読み込む（未設定の場合はサーバーのローカルタイム）
+func (c UserConfig) Location() (*time.Location, error) {
+	if c.Timezone == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", c.Timezone, err)
+	}
+	return loc, nil
+}
+
+// DomainWeightUnit 設定の重量の単位を読み込む（未設定の場合はkg）
+func (c UserConfig) DomainWeightUnit() (domain.WeightUnit, error) {
+	if c.WeightUnit == "" {
+		return domain.WeightUnitKilogram, nil
+	}
+	return domain.ParseWeightUnit(c.WeightUnit)
+}
+
+// DomainPlateInventory 設定のバーとプレートの在庫を読み込む（未設定の項目は在庫の単位の一般的なジムの在庫）
+// 在庫の単位が未設定の場合はdefaultUnit（ユーザーの重量の単位）
+func (c UserConfig) DomainPlateInventory(defaultUnit domain.WeightUnit) (domain.PlateInventory, error) {
+	unit := defaultUnit
+	if c.Plates.Unit != "" {
+		parsed, err := domain.ParseWeightUnit(c.Plates.Unit)
+		if err != nil {
+			return domain.PlateInventory{}, fmt.Errorf("invalid plates unit: %w", err)
+		}
+		unit = parsed
+	}
+	inventory := domain.DefaultPlateInventory(unit)
+	if c.Plates.BarWeight != nil {
+		inventory.BarWeight = *c.Plates.BarWeight
+	}
+	if len(c.Plates.Plates) > 0 {
+		inventory.Plates = make([]domain.Plate, 0, len(c.Plates.Plates))
+		for _, p := range c.Plates.Plates {
+			inventory.Plates = append(inventory.Plates, domain.Plate{Weight: p.Weight, Count: p.Count})
+		}
+	}
+	return inventory, nil
+}
+
+// IntensityConfig 高強度判定の設定
+type IntensityConfig struct {
+	Bodyweight float64               `mapstructure:"bodyweight"` // 体重比ルールで使用する体重(kg)
+	Rules      []IntensityRuleConfig `mapstructure:"rules"`
+}
+
+// IntensityRuleConfig 高強度判定ルールの設定
+// 種目・難易度はキー（例: "bench_press", "advanced"）で指定する
+type IntensityRuleConfig struct {
+	Name                  string   `mapstructure:"name"`
+	ExerciseType          string   `mapstructure:"exercise_type"`
+	MinDifficulty         string   `mapstructure:"min_difficulty"`
+	MinWeight             *float64 `mapstructure:"min_weight"`
+	MinBodyweightRatio    *float64 `mapstructure:"min_bodyweight_ratio"`
+	MinPercentOfOneRepMax *float64 `mapstructure:"min_percent_of_one_rep_max"`
+	MinVolume             *float64 `mapstructure:"min_volume"`
+	MinSets               *int     `mapstructure:"min_sets"`
+}
+
+// VolumeBalanceConfig ボリュームバランスレポートの設定
+// 目標セット数は筋肉群のキー（例: "chest", "back"）で指定する
+type VolumeBalanceConfig struct {
+	SecondaryWeight *float64                      `mapstructure:"secondary_weight"` // 協働筋の重み（0.0〜1.0）
+	Targets         map[string]VolumeTargetConfig `mapstructure:"targets"`
+}
+
+// VolumeTargetConfig 週あたりの目標セット数の設定
+type VolumeTargetConfig struct {
+	Min float64 `mapstructure:"min"`
+	Max float64 `mapstructure:"max"` // 0の場合は上限なし
+}
+
+// ProgressionConfig 漸進的過負荷の設定
+type ProgressionConfig struct {
+	AutoCreateNext bool                    `mapstructure:"auto_create_next"` // 完了時に次回の予定を自動作成する
+	Rules          []ProgressionRuleConfig `mapstructure:"rules"`
+}
+
+// ProgressionRuleConfig 種目ごとの漸進的過負荷ルールの設定
+// 種目・進め方はキー（例: "bench_press", "double"）で指定する
+type ProgressionRuleConfig struct {
+	ExerciseType  string   `mapstructure:"exercise_type"`
+	ProgramID     int      `mapstructure:"program_id"` // 0の場合は全てのワークアウトに適用
+	Scheme        string   `mapstructure:"scheme"`     // 未指定の場合は "linear"
+	Increment     *float64 `mapstructure:"increment"`
+	TargetReps    int      `mapstructure:"target_reps"`
+	MinReps       int      `mapstructure:"min_reps"`
+	MaxReps       int      `mapstructure:"max_reps"`
+	DeloadAfter   *int     `mapstructure:"deload_after"`
+	DeloadPercent *float64 `mapstructure:"deload_percent"`
+}
+
+// ScheduleConfig 予定のワークアウトの設定
+type ScheduleConfig struct {
+	AutoSkipMissed    bool          `mapstructure:"auto_skip_missed"`    // 予定日を過ぎた予定を自動でスキップにする
+	MissedGracePeriod time.Duration `mapstructure:"missed_grace_period"` // 予定日時からこの時間が過ぎたら未実施とみなす（例: "24h"）
+	CheckInterval     time.Duration `mapstructure:"check_interval"`      // 未実施の予定を検出する間隔（例: "1h"）
+}
+
+// ImportConfig 他のアプリからのインポートの設定
+type ImportConfig struct {
+	// Aliases 種目キー → アプリでの種目名（標準の別名にない種目名を追加する）
+	// 設定ファイルのキーは小文字に変換されるため、種目名は値に書く
+	Aliases map[string][]string `mapstructure:"aliases"`
+}
+
+// Load 設定ファイルを読み込む
+func Load(path string) (*Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	v.SetDefault("logging.level", "info")
+	v.SetDefault("logging.format", "text")
+	v.SetDefault("tracing.exporter", "none")
+	v.SetDefault("tracing.sample_ratio", 1.0)
+	v.SetDefault("schedule.missed_grace_period", "24h")
+	v.SetDefault("schedule.check_interval", "1h")
+
+	if err := v.ReadInConfig(); err != nil {
+		return nil, fmt.Errorf("failed to read config (path=%s): %w", path, err)
+	}
+
+	var cfg Config
+	if err := v.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config (path=%s): %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// DomainRules 設定のルールをドメインのIntensityRuleに変換する
+func (c IntensityConfig) DomainRules() ([]domain.IntensityRule, error) {
+	rules := make([]domain.IntensityRule, 0, len(c.Rules))
+	for _, rc := range c.Rules {
+		rule := domain.IntensityRule{
+			Name:                  rc.Name,
+			MinWeight:             rc.MinWeight,
+			MinBodyweightRatio:    rc.MinBodyweightRatio,
+			MinPercentOfOneRepMax: rc.MinPercentOfOneRepMax,
+			MinVolume:             rc.MinVolume,
+			MinSets:               rc.MinSets,
+		}
+		if rc.ExerciseType != "" {
+			exerciseType, err := domain.ParseExerciseType(rc.ExerciseType)
+			if err != nil {
+				return nil, fmt.Errorf("intensity rule %q: %w", rc.Name, err)
+			}
+			rule.ExerciseType = &exerciseType
+		}
+		if rc.MinDifficulty != "" {
+			difficulty, err := domain.ParseDifficulty(rc.MinDifficulty)
+			if err != nil {
+				return nil, fmt.Errorf("intensity rule %q: %w", rc.Name, err)
+			}
+			rule.MinDifficulty = &difficulty
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// DomainTargets 設定の目標セット数をドメインのVolumeTargetに変換する
+func (c VolumeBalanceConfig) DomainTargets() (map[domain.MuscleGroup]domain.VolumeTarget, error) {
+	targets := make(map[domain.MuscleGroup]domain.VolumeTarget, len(c.Targets))
+	for key, tc := range c.Targets {
+		muscleGroup, err := domain.ParseMuscleGroup(key)
+		if err != nil {
+			return nil, fmt.Errorf("volume target: %w", err)
+		}
+		if tc.Min < 0 || (tc.Max > 0 && tc.Max < tc.Min) {
+			return nil, fmt.Errorf("volume target %q: invalid range %.1f-%.1f", key, tc.Min, tc.Max)
+		}
+		targets[muscleGroup] = domain.VolumeTarget{MinWeeklySets: tc.Min, MaxWeeklySets: tc.Max}
+	}
+	return targets, nil
+}
+
+// DomainRules 設定のルールをドメインのProgressionRuleに変換する（未指定の値はデフォルト値）
+func (c ProgressionConfig) DomainRules() ([]domain.ProgressionRule, error) {
+	rules := make([]domain.ProgressionRule, 0, len(c.Rules))
+	for _, rc := range c.Rules {
+		exerciseType, err := domain.ParseExerciseType(rc.ExerciseType)
+		if err != nil {
+			return nil, fmt.Errorf("progression rule: %w", err)
+		}
+		rule := domain.ProgressionRule{
+			ExerciseType:  exerciseType,
+			Scheme:        domain.ProgressionLinear,
+			Increment:     domain.DefaultProgressionIncrement,
+			TargetReps:    rc.TargetReps,
+			MinReps:       rc.MinReps,
+			MaxReps:       rc.MaxReps,
+			DeloadAfter:   domain.DefaultProgressionDeloadAfter,
+			DeloadPercent: domain.DefaultProgressionDeloadPercent,
+		}
+		if rc.Scheme != "" {
+			rule.Scheme, err = domain.ParseProgressionScheme(rc.Scheme)
+			if err != nil {
+				return nil, fmt.Errorf("progression rule %q: %w", rc.ExerciseType, err)
+			}
+		}
+		if rc.ProgramID > 0 {
+			programID := domain.ProgramID(rc.ProgramID)
+			rule.ProgramID = &programID
+		}
+		if rc.Increment != nil {
+			rule.Increment = *rc.Increment
+		}
+		if rc.DeloadAfter != nil {
+			rule.DeloadAfter = *rc.DeloadAfter
+		}
+		if rc.DeloadPercent != nil {
+			rule.DeloadPercent = *rc.DeloadPercent
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// DomainAliases 設定の別名を種目名 → ドメインのExerciseTypeに変換する
+func (c ImportConfig) DomainAliases() (map[string]domain.ExerciseType, error) {
+	aliases := make(map[string]domain.ExerciseType, len(c.Aliases))
+	for key, names := range c.Aliases {
+		exerciseType, err := domain.ParseExerciseType(key)
+		if err != nil {
+			return nil, fmt.Errorf("import alias: %w", err)
+		}
+		for _, name := range names {
+			aliases[name] = exerciseType
+		}
+	}
+	return aliases, nil
+}
@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"sort"
+	"time"
+)
+
+// BodyMeasurementID 体重・身体測定の記録IDの型定義
+type BodyMeasurementID int64
+
+// 身体測定の値の上限（body_measurementsテーブルのCHECK制約と同じ値）
+const (
+	MaxBodyweight     = 500.0 // 体重(kg)
+	MaxBodyFatPercent = 100.0 // 体脂肪率(%)
+	MaxCircumference  = 300.0 // 周囲径(cm)
+)
+
+// BodyMeasurement 体重・身体測定の記録
+// 測定しなかった項目は0（少なくとも1項目は測定していること）
+type BodyMeasurement struct {
+	ID             BodyMeasurementID
+	Date           time.Time // 測定日（ユーザーのタイムゾーンの0時）
+	Bodyweight     float64   // 体重(kg)
+	BodyFatPercent float64   // 体脂肪率(%)
+	Waist          float64   // ウエスト(cm)
+	Chest          float64   // 胸囲(cm)
+	Arm            float64   // 上腕囲(cm)
+	Notes          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// HasValues いずれかの項目を測定しているか
+func (m *BodyMeasurement) HasValues() bool {
+	return m.Bodyweight > 0 || m.BodyFatPercent > 0 || m.Waist > 0 || m.Chest > 0 || m.Arm > 0
+}
+
+// DailyBodyweight 1日分の体重（同じ日に複数回測定した場合は平均）
+type DailyBodyweight struct {
+	Date       time.Time // その日の0時
+	Bodyweight float64
+}
+
+// DailyBodyweights 体重を測定した日ごとに平均し、日付の昇順で返す（体重を測定していない記録は除く）
+// 日付はlocのタイムゾーンで区切る
+func DailyBodyweights(measurements []*BodyMeasurement, loc *time.Location) []DailyBodyweight {
+	var days []DailyBodyweight
+	var sum float64
+	var count int
+	for _, m := range sortedByDate(measurements, loc) {
+		if m.Bodyweight <= 0 {
+			continue
+		}
+		day := StatsPeriodDay.BucketStart(m.Date.In(loc))
+		if len(days) > 0 && days[len(days)-1].Date.Equal(day) {
+			sum += m.Bodyweight
+			count++
+			days[len(days)-1].Bodyweight = sum / float64(count)
+			continue
+		}
+		sum, count = m.Bodyweight, 1
+		days = append(days, DailyBodyweight{Date: day, Bodyweight: m.Bodyweight})
+	}
+	return days
+}
+
+// sortedByDate 測定日の昇順に並べたコピー（同じ日は元の順序を保つ）
+func sortedByDate(measurements []*BodyMeasurement, loc *time.Location) []*BodyMeasurement {
+	sorted := make([]*BodyMeasurement, len(measurements))
+	copy(sorted, measurements)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return StatsPeriodDay.BucketStart(sorted[i].Date.In(loc)).Before(StatsPeriodDay.BucketStart(sorted[j].Date.In(loc)))
+	})
+	return sorted
+}
+
+// BodyweightTrendPoint 体重の推移の1日分
+type BodyweightTrendPoint struct {
+	Date          time.Time // その日の0時
+	Bodyweight    float64   // その日の体重（複数回測定した場合は平均）
+	MovingAverage float64   // その日までの移動平均
+	Samples       int       // 移動平均の計算に使用した日数（期間内に体重を測定した日数）
+}
+
+// BodyweightTrend 体重を測定した日ごとに、その日を含む直近windowDays日の移動平均を計算する
+// 測定しなかった日は平均に含めないため、毎日測定していなくても推移を追える
+func BodyweightTrend(days []DailyBodyweight, windowDays int) []*BodyweightTrendPoint {
+	if windowDays < 1 {
+		windowDays = 1
+	}
+	points := make([]*BodyweightTrendPoint, 0, len(days))
+	start := 0
+	var sum float64
+	for i, day := range days {
+		sum += day.Bodyweight
+		windowStart := day.Date.AddDate(0, 0, -(windowDays - 1))
+		for days[start].Date.Before(windowStart) {
+			sum -= days[start].Bodyweight
+			start++
+		}
+		samples := i - start + 1
+		points = append(points, &BodyweightTrendPoint{
+			Date:          day.Date,
+			Bodyweight:    day.Bodyweight,
+			MovingAverage: sum / float64(samples),
+			Samples:       samples,
+		})
+	}
+	return points
+}
+
+// RelativeStrength 体重に対する挙上重量の比（同じ日に体重を測定したワークアウトのみ）
+type RelativeStrength struct {
+	Workout        *Workout
+	Bodyweight     float64 // 実施日の体重(kg)
+	WeightRatio    float64 // 重量 ÷ 体重
+	OneRepMax      float64 // 推定1RM(kg)
+	OneRepMaxRatio float64 // 推定1RM ÷ 体重
+}
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+// CalendarDay カレンダーの1日分
+type CalendarDay struct {
+	Date     time.Time  // その日の0時（ユーザーのタイムゾーン）
+	Workouts []*Workout // 実施日時（Workout.ActivityAt）の昇順
+}
+
+// CountByStatus 指定したステータスのワークアウト数
+func (d *CalendarDay) CountByStatus(status WorkoutStatus) int {
+	count := 0
+	for _, w := range d.Workouts {
+		if w.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Calendar 期間内のワークアウトを日ごとにまとめたもの
+type Calendar struct {
+	Location *time.Location
+	Days     []*CalendarDay // 期間内の全日（ワークアウトのない日を含む）
+}
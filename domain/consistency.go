@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// Streak 連続してトレーニングした期間
+type Streak struct {
+	Length int       // 連続した期間の数（日数または週数）
+	Start  time.Time // 最初の期間の開始日
+	End    time.Time // 最後の期間の開始日
+}
+
+// HeatmapDay カレンダーヒートマップの1日分
+type HeatmapDay struct {
+	Date           time.Time // ユーザーのタイムゾーンでの日付（0時）
+	CompletedCount int
+	TotalVolume    float64
+}
+
+// SkipReasonBucket 集計期間ごとのスキップ理由の件数
+type SkipReasonBucket struct {
+	PeriodStart time.Time
+	Counts      map[SkipReason]int
+}
+
+// ConsistencyReport 継続状況（ストリーク・予定の実施率・スキップ理由・ヒートマップ）
+type ConsistencyReport struct {
+	Location       *time.Location
+	StreakUnit     StatsPeriod
+	CurrentStreak  Streak
+	LongestStreak  Streak
+	DueCount       int // 実施予定日を過ぎたワークアウト数（完了 + スキップ + 未実施の予定）
+	CompletedCount int
+	SkippedCount   int
+	MissedCount    int // 予定のまま実施日を過ぎたワークアウト数
+	SkipReasons    []*SkipReasonBucket
+	Heatmap        []*HeatmapDay // 期間内の全日（完了0件の日を含む）
+}
+
+// AdherenceRate 予定の実施率（完了数 / 実施予定日を過ぎたワークアウト数）
+func (r *ConsistencyReport) AdherenceRate() float64 {
+	if r.DueCount == 0 {
+		return 0
+	}
+	return float64(r.CompletedCount) / float64(r.DueCount)
+}
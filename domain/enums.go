@@ -1,5 +1,7 @@
 package domain
 
+import "fmt"
+
 // WorkoutStatus ワークアウトステータス
 type WorkoutStatus int
 
@@ -52,6 +54,22 @@ const (
 	HighPull                                // ハイプル
 )
 
+// SkipReason ワークアウトをスキップした理由
+type SkipReason int
+
+const (
+	SkipReasonUnspecified SkipReason = iota // 未指定
+	SkipReasonSore                          // 筋肉痛
+	SkipReasonNoTime                        // 時間がない
+	SkipReasonSick                          // 体調不良
+	SkipReasonInjury                        // 怪我
+	SkipReasonTravel                        // 出張・旅行
+	SkipReasonWeather                       // 天候
+	SkipReasonMotivation                    // やる気が出ない
+	SkipReasonOther                         // その他
+	SkipReasonMissed                        // 予定日を過ぎたため自動でスキップ
+)
+
 // Japanese （日本語表示のため）
 func (mg MuscleGroup) Japanese() string {
 	switch mg {
@@ -103,3 +121,188 @@ func (et ExerciseType) Japanese() string {
 		return "未指定"
 	}
 }
+
+// English （英語表示のため）
+func (et ExerciseType) English() string {
+	switch et {
+	case BenchPress:
+		return "Bench Press"
+	case Squat:
+		return "Squat"
+	case Deadlift:
+		return "Deadlift"
+	case DumbbellShoulder:
+		return "Dumbbell Shoulder Press"
+	case PullUp:
+		return "Pull-Up"
+	case SideRaise:
+		return "Side Raise"
+	case OneHandRow:
+		return "One-Hand Row"
+	case HighPull:
+		return "High Pull"
+	default:
+		return "Unspecified"
+	}
+}
+
+// Japanese （日本語表示のため）
+func (sr SkipReason) Japanese() string {
+	switch sr {
+	case SkipReasonSore:
+		return "筋肉痛"
+	case SkipReasonNoTime:
+		return "時間がない"
+	case SkipReasonSick:
+		return "体調不良"
+	case SkipReasonInjury:
+		return "怪我"
+	case SkipReasonTravel:
+		return "出張・旅行"
+	case SkipReasonWeather:
+		return "天候"
+	case SkipReasonMotivation:
+		return "やる気が出ない"
+	case SkipReasonOther:
+		return "その他"
+	case SkipReasonMissed:
+		return "未実施（自動スキップ）"
+	default:
+		return "未指定"
+	}
+}
+
+// exerciseTypeKeys 設定ファイル等で使用する種目キー
+var exerciseTypeKeys = map[string]ExerciseType{
+	"bench_press":       BenchPress,
+	"squat":             Squat,
+	"deadlift":          Deadlift,
+	"dumbbell_shoulder": DumbbellShoulder,
+	"pull_up":           PullUp,
+	"side_raise":        SideRaise,
+	"one_hand_row":      OneHandRow,
+	"high_pull":         HighPull,
+}
+
+// workoutStatusKeys 設定ファイル・インポート等で使用するステータスキー
+var workoutStatusKeys = map[string]WorkoutStatus{
+	"planned":     WorkoutStatusPlanned,
+	"in_progress": WorkoutStatusInProgress,
+	"completed":   WorkoutStatusCompleted,
+	"skipped":     WorkoutStatusSkipped,
+}
+
+// skipReasonKeys 設定ファイル・インポート等で使用するスキップ理由キー
+var skipReasonKeys = map[string]SkipReason{
+	"sore":       SkipReasonSore,
+	"no_time":    SkipReasonNoTime,
+	"sick":       SkipReasonSick,
+	"injury":     SkipReasonInjury,
+	"travel":     SkipReasonTravel,
+	"weather":    SkipReasonWeather,
+	"motivation": SkipReasonMotivation,
+	"other":      SkipReasonOther,
+	"missed":     SkipReasonMissed,
+}
+
+// difficultyKeys 設定ファイル等で使用する難易度キー
+var difficultyKeys = map[string]Difficulty{
+	"beginner":     DifficultyBeginner,
+	"intermediate": DifficultyIntermediate,
+	"advanced":     DifficultyAdvanced,
+	"beast":        DifficultyBeast,
+}
+
+// muscleGroupKeys 設定ファイル等で使用する筋肉群キー
+var muscleGroupKeys = map[string]MuscleGroup{
+	"chest":     Chest,
+	"back":      Back,
+	"legs":      Legs,
+	"shoulders": Shoulders,
+	"arms":      Arms,
+	"abs":       Abs,
+	"core":      Core,
+	"glutes":    Glutes,
+	"cardio":    Cardio,
+	"full_body": FullBody,
+}
+
+// ParseExerciseType 種目キー（例: "bench_press"）からExerciseTypeを取得
+func ParseExerciseType(key string) (ExerciseType, error) {
+	et, ok := exerciseTypeKeys[key]
+	if !ok {
+		return ExerciseUnspecified, fmt.Errorf("unknown exercise type: %q", key)
+	}
+	return et, nil
+}
+
+// ParseWorkoutStatus ステータスキー（例: "completed"）からWorkoutStatusを取得
+func ParseWorkoutStatus(key string) (WorkoutStatus, error) {
+	status, ok := workoutStatusKeys[key]
+	if !ok {
+		return WorkoutStatusPlanned, fmt.Errorf("unknown workout status: %q", key)
+	}
+	return status, nil
+}
+
+// ParseSkipReason スキップ理由キー（例: "sore"）からSkipReasonを取得
+func ParseSkipReason(key string) (SkipReason, error) {
+	reason, ok := skipReasonKeys[key]
+	if !ok {
+		return SkipReasonUnspecified, fmt.Errorf("unknown skip reason: %q", key)
+	}
+	return reason, nil
+}
+
+// ParseDifficulty 難易度キー（例: "advanced"）からDifficultyを取得
+func ParseDifficulty(key string) (Difficulty, error) {
+	d, ok := difficultyKeys[key]
+	if !ok {
+		return DifficultyBeginner, fmt.Errorf("unknown difficulty: %q", key)
+	}
+	return d, nil
+}
+
+// ParseMuscleGroup 筋肉群キー（例: "chest"）からMuscleGroupを取得
+func ParseMuscleGroup(key string) (MuscleGroup, error) {
+	mg, ok := muscleGroupKeys[key]
+	if !ok {
+		return Unspecified, fmt.Errorf("unknown muscle group: %q", key)
+	}
+	return mg, nil
+}
+
+// keyOf キーの表から値に対応するキーを探す（見つからなければ空）
+func keyOf[T comparable](keys map[string]T, value T) string {
+	for key, v := range keys {
+		if v == value {
+			return key
+		}
+	}
+	return ""
+}
+
+// Key 種目キー（例: "bench_press"、未指定の場合は空）
+func (et ExerciseType) Key() string {
+	return keyOf(exerciseTypeKeys, et)
+}
+
+// Key ステータスキー（例: "completed"）
+func (s WorkoutStatus) Key() string {
+	return keyOf(workoutStatusKeys, s)
+}
+
+// Key スキップ理由キー（例: "sore"、未指定の場合は空）
+func (sr SkipReason) Key() string {
+	return keyOf(skipReasonKeys, sr)
+}
+
+// Key 難易度キー（例: "advanced"）
+func (d Difficulty) Key() string {
+	return keyOf(difficultyKeys, d)
+}
+
+// Key 筋肉群キー（例: "chest"、未指定の場合は空）
+func (mg MuscleGroup) Key() string {
+	return keyOf(muscleGroupKeys, mg)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+// リポジトリ・ユースケースが返すエラーの種類
+// 呼び出し元は errors.Is で判定し、gRPCのステータスコード・HTTPステータスに変換する
+var (
+	ErrNotFound        = errors.New("not found")        // 対象のデータが存在しない（リポジトリはこのエラーをラップして返す）
+	ErrInvalidArgument = errors.New("invalid argument") // 入力値が不正
+	ErrAlreadyExists   = errors.New("already exists")   // 一意であるべきデータが既に存在する（同時に取り込んだ記録など）
+)
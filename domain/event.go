@@ -0,0 +1,78 @@
+package domain
+
+import "time"
+
+// WorkoutEventType ワークアウトの変更イベントの種類
+type WorkoutEventType int
+
+const (
+	WorkoutEventUnspecified   WorkoutEventType = iota // 未指定
+	WorkoutEventCreated                               // 作成
+	WorkoutEventUpdated                               // 更新（ステータス以外の変更）
+	WorkoutEventDeleted                               // 削除
+	WorkoutEventStatusChanged                         // ステータスの変更
+)
+
+// Japanese （日本語表示のため）
+func (t WorkoutEventType) Japanese() string {
+	switch t {
+	case WorkoutEventCreated:
+		return "作成"
+	case WorkoutEventUpdated:
+		return "更新"
+	case WorkoutEventDeleted:
+		return "削除"
+	case WorkoutEventStatusChanged:
+		return "ステータス変更"
+	default:
+		return "未指定"
+	}
+}
+
+// WorkoutEvent ワークアウトの変更イベント
+// Workoutは変更後のスナップショット（削除の場合は削除前）で、購読者間で共有するため変更しないこと
+type WorkoutEvent struct {
+	Sequence       uint64           // イベントバスが採番する通し番号（再開トークンの元）
+	Type           WorkoutEventType // イベントの種類
+	Workout        *Workout         // 変更後のワークアウト
+	PreviousStatus *WorkoutStatus   // ステータス変更の場合のみ、変更前のステータス
+	OccurredAt     time.Time        // 発生日時
+}
+
+// WorkoutEventFilter 購読するイベントの条件（空のスライスは絞り込まない）
+type WorkoutEventFilter struct {
+	Types         []WorkoutEventType
+	WorkoutIDs    []WorkoutID
+	ExerciseTypes []ExerciseType
+	Statuses      []WorkoutStatus // 変更後のステータス
+}
+
+// Matches イベントが条件に一致するか判定する
+func (f WorkoutEventFilter) Matches(event WorkoutEvent) bool {
+	if len(f.Types) > 0 && !containsValue(f.Types, event.Type) {
+		return false
+	}
+	if event.Workout == nil {
+		return len(f.WorkoutIDs) == 0 && len(f.ExerciseTypes) == 0 && len(f.Statuses) == 0
+	}
+	if len(f.WorkoutIDs) > 0 && !containsValue(f.WorkoutIDs, event.Workout.ID) {
+		return false
+	}
+	if len(f.ExerciseTypes) > 0 && !containsValue(f.ExerciseTypes, event.Workout.ExerciseType) {
+		return false
+	}
+	if len(f.Statuses) > 0 && !containsValue(f.Statuses, event.Workout.Status) {
+		return false
+	}
+	return true
+}
+
+// containsValue スライスに値が含まれるか判定する
+func containsValue[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package domain
+
+// ExerciseInfo 種目カタログの情報（主働筋と協働筋）
+type ExerciseInfo struct {
+	ExerciseType ExerciseType
+	Primary      MuscleGroup   // 主働筋
+	Secondary    []MuscleGroup // 協働筋（ボリューム集計では重み付きで加算する）
+	Barbell      bool          // バーベル種目（プレートの組み合わせを計算できる）
+}
+
+// exerciseCatalog 種目カタログ
+var exerciseCatalog = map[ExerciseType]ExerciseInfo{
+	BenchPress:       {ExerciseType: BenchPress, Primary: Chest, Secondary: []MuscleGroup{Shoulders, Arms}, Barbell: true},
+	Squat:            {ExerciseType: Squat, Primary: Legs, Secondary: []MuscleGroup{Glutes, Core}, Barbell: true},
+	Deadlift:         {ExerciseType: Deadlift, Primary: Back, Secondary: []MuscleGroup{Legs, Glutes}, Barbell: true},
+	DumbbellShoulder: {ExerciseType: DumbbellShoulder, Primary: Shoulders, Secondary: []MuscleGroup{Arms}},
+	PullUp:           {ExerciseType: PullUp, Primary: Back, Secondary: []MuscleGroup{Arms}},
+	SideRaise:        {ExerciseType: SideRaise, Primary: Shoulders},
+	OneHandRow:       {ExerciseType: OneHandRow, Primary: Back, Secondary: []MuscleGroup{Arms}},
+	HighPull:         {ExerciseType: HighPull, Primary: Shoulders, Secondary: []MuscleGroup{Back}},
+}
+
+// LookupExercise 種目カタログから種目の情報を取得
+func LookupExercise(et ExerciseType) (ExerciseInfo, bool) {
+	info, ok := exerciseCatalog[et]
+	return info, ok
+}
@@ -0,0 +1,63 @@
+package domain
+
+// IntensityRule 高強度判定ルール
+// 指定された条件（nilでないフィールド）を全て満たすワークアウトがルールに一致する
+type IntensityRule struct {
+	Name                  string        `json:"name"`
+	ExerciseType          *ExerciseType `json:"exercise_type,omitempty"`              // nilの場合は全種目
+	MinDifficulty         *Difficulty   `json:"min_difficulty,omitempty"`             // 難易度の下限
+	MinWeight             *float64      `json:"min_weight,omitempty"`                 // 絶対重量(kg)の下限
+	MinBodyweightRatio    *float64      `json:"min_bodyweight_ratio,omitempty"`       // 体重比（重量 ÷ 体重）の下限
+	MinPercentOfOneRepMax *float64      `json:"min_percent_of_one_rep_max,omitempty"` // 種目ごとのベストe1RM比の下限（0.0〜1.0）
+	MinVolume             *float64      `json:"min_volume,omitempty"`                 // Sets × Reps × Weight の下限
+	MinSets               *int          `json:"min_sets,omitempty"`                   // セット数の下限
+}
+
+// HasCriteria 判定条件が1つ以上指定されているか
+func (r IntensityRule) HasCriteria() bool {
+	return r.ExerciseType != nil || r.MinDifficulty != nil || r.MinWeight != nil ||
+		r.MinBodyweightRatio != nil || r.MinPercentOfOneRepMax != nil ||
+		r.MinVolume != nil || r.MinSets != nil
+}
+
+// Matches ワークアウトがルールに一致するか判定する
+// bestOneRepMaxは同じ種目のベストe1RM、bodyweightは体重比ルールで使用する体重(kg)
+func (r IntensityRule) Matches(w *Workout, bestOneRepMax, bodyweight float64) bool {
+	if !r.HasCriteria() {
+		return false
+	}
+	if r.ExerciseType != nil && w.ExerciseType != *r.ExerciseType {
+		return false
+	}
+	if r.MinDifficulty != nil && w.Difficulty < *r.MinDifficulty {
+		return false
+	}
+	if r.MinWeight != nil && w.Weight < *r.MinWeight {
+		return false
+	}
+	if r.MinBodyweightRatio != nil && (bodyweight <= 0 || w.Weight < *r.MinBodyweightRatio*bodyweight) {
+		return false
+	}
+	if r.MinPercentOfOneRepMax != nil && (bestOneRepMax <= 0 || w.Weight <= 0 || w.Weight < *r.MinPercentOfOneRepMax*bestOneRepMax) {
+		return false
+	}
+	if r.MinVolume != nil && w.Volume() < *r.MinVolume {
+		return false
+	}
+	if r.MinSets != nil && w.Sets < *r.MinSets {
+		return false
+	}
+	return true
+}
+
+// IntensityQuery 高強度ワークアウトの検索条件
+type IntensityQuery struct {
+	Rules      []IntensityRule // 評価順に並べたルール（最初に一致したルールを報告する）
+	Bodyweight float64         // 体重比ルールで使用する体重(kg)
+}
+
+// IntensityMatch 高強度ルールに一致したワークアウト
+type IntensityMatch struct {
+	Workout     *Workout
+	MatchedRule string // 最初に一致したルール名
+}
@@ -0,0 +1,78 @@
+package domain
+
+import "math"
+
+// プレートの組み合わせ計算の上限（proto/workout.protoの制約と同じ）
+const (
+	MaxPlateTypes     = 20    // 在庫に指定できるプレートの種類
+	MaxPlateCount     = 100   // 1種類あたりの枚数
+	MaxPlateWeight    = 100.0 // プレート1枚の重量
+	MaxBarWeight      = 100.0 // バーの重量
+	MaxWarmupSets     = 10    // ウォームアップセットの数
+	DefaultPlateCount = 20    // デフォルトの在庫の1種類あたりの枚数
+)
+
+// Plate プレートの在庫（Countは両側合わせた枚数、片側に付けられるのはCount/2枚）
+type Plate struct {
+	Weight float64 `json:"weight"`
+	Count  int     `json:"count"`
+}
+
+// PlateInventory ユーザーが使用できるバーとプレート
+// 重量はすべてUnitの単位（kgのジムではkgプレート、lbのジムではlbプレート）
+type PlateInventory struct {
+	Unit      WeightUnit `json:"unit"`
+	BarWeight float64    `json:"bar_weight"`
+	Plates    []Plate    `json:"plates"`
+}
+
+// DefaultPlateInventory 単位ごとの一般的なジムのバーとプレート
+func DefaultPlateInventory(unit WeightUnit) PlateInventory {
+	if unit == WeightUnitPound {
+		return PlateInventory{
+			Unit:      WeightUnitPound,
+			BarWeight: 45,
+			Plates:    defaultPlates(45, 35, 25, 10, 5, 2.5),
+		}
+	}
+	return PlateInventory{
+		Unit:      WeightUnitKilogram,
+		BarWeight: 20,
+		Plates:    defaultPlates(25, 20, 15, 10, 5, 2.5, 1.25),
+	}
+}
+
+// defaultPlates 指定した重量のプレートをデフォルトの枚数ずつ用意する
+func defaultPlates(weights ...float64) []Plate {
+	plates := make([]Plate, 0, len(weights))
+	for _, w := range weights {
+		plates = append(plates, Plate{Weight: w, Count: DefaultPlateCount})
+	}
+	return plates
+}
+
+// PlateLoad 片側に付けるプレート（重い順）
+type PlateLoad struct {
+	Weight float64 `json:"weight"`
+	Count  int     `json:"count"`
+}
+
+// PlateLoading 1セット分のプレートの組み合わせ
+type PlateLoading struct {
+	Percent  float64     `json:"percent"`  // 目標重量に対する割合（メインセットは1.0）
+	Target   float64     `json:"target"`   // このセットの目標重量
+	Achieved float64     `json:"achieved"` // 実際に組める重量（バー + 両側のプレート）
+	PerSide  []PlateLoad `json:"per_side"` // 片側に付けるプレート（空の場合はバーのみ）
+}
+
+// Exact 目標重量をちょうど組めるか（0.01未満の差は同じ重量とみなす）
+func (l PlateLoading) Exact() bool {
+	return math.Abs(l.Achieved-l.Target) < 0.005
+}
+
+// PlateCalculation ウォームアップセットとメインセットのプレートの組み合わせ
+type PlateCalculation struct {
+	Unit      WeightUnit      `json:"unit"`       // 重量の単位（在庫の単位）
+	BarWeight float64         `json:"bar_weight"` // 使用するバーの重量
+	Sets      []*PlateLoading `json:"sets"`       // ウォームアップセット（軽い順）→ メインセット
+}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+// ProgramID トレーニングプログラムIDの型定義
+type ProgramID int64
+
+// TemplateID ワークアウトテンプレートIDの型定義
+type TemplateID int64
+
+// SetScheme セット・レップの構成
+// PercentOfTrainingMax が0より大きい場合は、トレーニングマックス × 割合 を重量とする（5/3/1など）
+type SetScheme struct {
+	Sets                 int     `json:"sets"`
+	Reps                 int     `json:"reps"`
+	Weight               float64 `json:"weight,omitempty"`                  // 固定重量（kg）
+	PercentOfTrainingMax float64 `json:"percent_of_training_max,omitempty"` // 例: 0.85
+}
+
+// TemplateExercise テンプレート内の種目
+type TemplateExercise struct {
+	ExerciseType ExerciseType  `json:"exercise_type"`
+	MuscleGroup  MuscleGroup   `json:"muscle_group,omitempty"` // 未指定の場合は種目カタログの主働筋
+	Difficulty   Difficulty    `json:"difficulty"`
+	Notes        string        `json:"notes,omitempty"`
+	Weeks        [][]SetScheme `json:"weeks"` // 週ごとのセット構成（プログラムの週番号で循環）
+}
+
+// SchemeForWeek プログラムの週番号（0始まり）に対応するセット構成
+func (e TemplateExercise) SchemeForWeek(week int) []SetScheme {
+	if len(e.Weeks) == 0 {
+		return nil
+	}
+	return e.Weeks[week%len(e.Weeks)]
+}
+
+// WorkoutTemplate 1日分のワークアウトテンプレート（例: PPLのPush日）
+// テンプレートはプログラムのバージョンごとに保存され、過去のバージョンは変更しない
+type WorkoutTemplate struct {
+	ID        TemplateID
+	ProgramID ProgramID
+	Version   int // 所属するプログラムのバージョン
+	Position  int // プログラム内での並び順
+	Name      string
+	DayOfWeek time.Weekday // 実施する曜日
+	Exercises []TemplateExercise
+}
+
+// Program トレーニングプログラム（例: 5/3/1、PPL）
+type Program struct {
+	ID          ProgramID
+	Name        string
+	Description string
+	Version     int                // テンプレートを編集するたびに1つ増える
+	Templates   []*WorkoutTemplate // Version のテンプレート
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,111 @@
+package domain
+
+import "fmt"
+
+// ProgressionScheme 漸進的過負荷の進め方
+type ProgressionScheme int
+
+const (
+	ProgressionLinear ProgressionScheme = iota // リニア: 目標回数を達成したら重量を上げる
+	ProgressionDouble                          // ダブルプログレッション: 回数を上限まで伸ばしてから重量を上げる
+)
+
+// Japanese 進め方の日本語名を返す
+func (s ProgressionScheme) Japanese() string {
+	switch s {
+	case ProgressionLinear:
+		return "リニア"
+	case ProgressionDouble:
+		return "ダブルプログレッション"
+	default:
+		return "不明"
+	}
+}
+
+// progressionSchemeKeys 設定ファイル等で使用する進め方のキー
+var progressionSchemeKeys = map[string]ProgressionScheme{
+	"linear": ProgressionLinear,
+	"double": ProgressionDouble,
+}
+
+// ParseProgressionScheme 進め方のキー（例: "linear"）からProgressionSchemeを取得
+func ParseProgressionScheme(key string) (ProgressionScheme, error) {
+	s, ok := progressionSchemeKeys[key]
+	if !ok {
+		return ProgressionLinear, fmt.Errorf("unknown progression scheme: %q", key)
+	}
+	return s, nil
+}
+
+// ProgressionAction 次回のワークアウトに対する提案内容
+type ProgressionAction int
+
+const (
+	ProgressionActionBaseline       ProgressionAction = iota // 履歴なし・初回: 前回と同じ内容
+	ProgressionActionIncreaseWeight                          // 重量を上げる
+	ProgressionActionIncreaseReps                            // 同じ重量で回数を上げる
+	ProgressionActionRepeat                                  // 失敗したため同じ内容を再挑戦
+	ProgressionActionDeload                                  // 連続で失敗したため重量を下げる
+)
+
+// Japanese 提案内容の日本語名を返す
+func (a ProgressionAction) Japanese() string {
+	switch a {
+	case ProgressionActionBaseline:
+		return "前回と同じ"
+	case ProgressionActionIncreaseWeight:
+		return "重量アップ"
+	case ProgressionActionIncreaseReps:
+		return "回数アップ"
+	case ProgressionActionRepeat:
+		return "再挑戦"
+	case ProgressionActionDeload:
+		return "ディロード"
+	default:
+		return "不明"
+	}
+}
+
+// 漸進的過負荷のデフォルト値
+const (
+	DefaultProgressionIncrement     = 2.5 // 成功時に上げる重量(kg)
+	DefaultProgressionDeloadAfter   = 3   // この回数連続で失敗したらディロード
+	DefaultProgressionDeloadPercent = 0.1 // ディロード時に下げる割合
+)
+
+// ProgressionRule 種目（およびプログラム）ごとの漸進的過負荷ルール
+type ProgressionRule struct {
+	ExerciseType  ExerciseType      `json:"exercise_type"`
+	ProgramID     *ProgramID        `json:"program_id,omitempty"` // nilの場合は全てのワークアウトに適用
+	Scheme        ProgressionScheme `json:"scheme"`
+	Increment     float64           `json:"increment"`      // 成功時に上げる重量(kg)
+	TargetReps    int               `json:"target_reps"`    // リニア: 成功とみなす回数（0の場合は前回の回数）
+	MinReps       int               `json:"min_reps"`       // ダブルプログレッション: 重量を上げた後の回数
+	MaxReps       int               `json:"max_reps"`       // ダブルプログレッション: 重量を上げる回数
+	DeloadAfter   int               `json:"deload_after"`   // この回数連続で失敗したらディロード（0の場合はディロードしない）
+	DeloadPercent float64           `json:"deload_percent"` // ディロード時に下げる割合（0.0〜1.0）
+}
+
+// AppliesTo ルールが種目・プログラムに適用されるか判定する
+func (r ProgressionRule) AppliesTo(exerciseType ExerciseType, programID *ProgramID) bool {
+	if r.ExerciseType != exerciseType {
+		return false
+	}
+	if r.ProgramID == nil {
+		return true
+	}
+	return programID != nil && *programID == *r.ProgramID
+}
+
+// ProgressionSuggestion 次回のワークアウトの提案
+type ProgressionSuggestion struct {
+	ExerciseType        ExerciseType
+	ProgramID           *ProgramID
+	Rule                ProgressionRule
+	Action              ProgressionAction
+	Sets                int
+	Reps                int
+	Weight              float64
+	ConsecutiveFailures int      // 現在の連続失敗回数（ディロード後は0）
+	LastWorkout         *Workout // 提案の基になった直近の完了ワークアウト（履歴がない場合はnil）
+}
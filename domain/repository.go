@@ -1,15 +1,108 @@
 package domain
 
+import "time"
+
 type WorkoutRepository interface {
 	CreateWorkout(workout *Workout) error
 
+	// CreateWorkouts 複数のワークアウトを1トランザクションで作成（すべて成功するか、すべて失敗する）
+	CreateWorkouts(workouts []*Workout) error
+
+	// BatchCreateWorkouts batchSize件ずつまとめてINSERTし、全体を1トランザクションで作成
+	BatchCreateWorkouts(workouts []*Workout, batchSize int) error
+
 	GetWorkout(id WorkoutID) (*Workout, error)
 
+	// GetWorkouts 指定したIDのワークアウトを取得（存在しないIDは結果に含めない）
+	GetWorkouts(ids []WorkoutID) ([]*Workout, error)
+
 	UpdateWorkout(workout *Workout) error
 
+	// BatchUpdateWorkouts 複数のワークアウトを1トランザクションで更新（すべて成功するか、すべて失敗する）
+	BatchUpdateWorkouts(workouts []*Workout) error
+
 	DeleteWorkout(id WorkoutID) error
 
+	// BatchDeleteWorkouts 指定したIDのワークアウトを1トランザクションで削除し、削除した件数を返す
+	BatchDeleteWorkouts(ids []WorkoutID) (int, error)
+
 	ListWorkouts(statusFilter *int, difficultyFilter *int, muscleGroupFilter *int) ([]*Workout, error)
 
 	GetWorkoutCount() (int, error)
+
+	// FindHighIntensityWorkouts 高強度ルールに一致するワークアウトを取得（ルール評価はクエリ側で行う）
+	FindHighIntensityWorkouts(query IntensityQuery) ([]*IntensityMatch, error)
+
+	// GetTrainingStats 集計期間ごとのトレーニング統計を取得（期間の昇順）
+	GetTrainingStats(query TrainingStatsQuery) ([]*TrainingStatsBucket, error)
+
+	// GetCompletedSetsByExercise 期間内に完了したセット数を種目・筋肉群ごとに集計
+	GetCompletedSetsByExercise(dateFrom, dateTo *time.Time) ([]*ExerciseSets, error)
+
+	// GetCompletionTimes 完了したワークアウトの完了日時を取得（古い順、ストリーク計算用）
+	GetCompletionTimes(dateFrom, dateTo *time.Time) ([]time.Time, error)
+
+	// ListWorkoutsByActivity 実施日時（Workout.ActivityAt）が期間内のワークアウトを取得
+	ListWorkoutsByActivity(dateFrom, dateTo *time.Time) ([]*Workout, error)
+
+	// GetExerciseHistory 種目（programIDがnilでなければプログラムも）が一致する完了済みワークアウトを直近limit件取得（古い順）
+	GetExerciseHistory(exerciseType ExerciseType, programID *ProgramID, limit int) ([]*Workout, error)
+
+	// MarkMissedWorkouts 実施予定日時がscheduledBeforeより前の予定をスキップ（未実施）に変更し、実際に変更したワークアウトを返す
+	MarkMissedWorkouts(scheduledBefore time.Time) ([]*Workout, error)
+
+	// ListScheduledWorkouts 実施予定日時が期間内のワークアウトを取得（予定日時の昇順、statusesが空なら全ステータス）
+	ListScheduledWorkouts(dateFrom, dateTo time.Time, statuses []WorkoutStatus) ([]*Workout, error)
+
+	// FindImportKeys 指定した取り込み識別子のうち、既に保存されているものを返す
+	FindImportKeys(keys []string) ([]string, error)
+
+	// StreamWorkouts フィルタに一致するワークアウトをID順にbatchSize件ずつfnに渡す（全件をメモリに載せない）
+	// fnがエラーを返した場合は中断してそのエラーを返す。fnに渡したスライスは呼び出し後に再利用される
+	StreamWorkouts(filter WorkoutFilter, batchSize int, fn func(batch []*Workout) error) error
+
+	// CreateWorkoutSet 実施中のワークアウトで記録したセットを1件保存
+	CreateWorkoutSet(set *WorkoutSet) error
+
+	// ListWorkoutSets ワークアウトで記録したセットを取得（セット番号の昇順）
+	ListWorkoutSets(workoutID WorkoutID) ([]*WorkoutSet, error)
+
+	// GetExerciseBest 完了済みワークアウトと記録したセットから種目の自己ベストを取得
+	GetExerciseBest(exerciseType ExerciseType) (*ExerciseBest, error)
+}
+
+// ProgramRepository トレーニングプログラムの永続化
+// テンプレートはバージョンごとに保存し、過去のバージョンも取得できる
+type ProgramRepository interface {
+	// CreateProgram プログラムとバージョン1のテンプレートを作成
+	CreateProgram(program *Program) error
+
+	// GetProgram 指定バージョンのテンプレートを含めてプログラムを取得（version が0の場合は最新）
+	GetProgram(id ProgramID, version int) (*Program, error)
+
+	// ListPrograms プログラム一覧を取得（テンプレートは含めない）
+	ListPrograms() ([]*Program, error)
+
+	// UpdateProgram 名前・説明を更新（バージョンは変えない）
+	UpdateProgram(program *Program) error
+
+	// CreateProgramVersion バージョンを1つ上げて新しいテンプレートを保存
+	CreateProgramVersion(id ProgramID, templates []*WorkoutTemplate) (*Program, error)
+
+	// DeleteProgram プログラムと全バージョンのテンプレートを削除
+	DeleteProgram(id ProgramID) error
+}
+
+// BodyMeasurementRepository 体重・身体測定の記録の永続化
+type BodyMeasurementRepository interface {
+	CreateBodyMeasurement(measurement *BodyMeasurement) error
+
+	GetBodyMeasurement(id BodyMeasurementID) (*BodyMeasurement, error)
+
+	// ListBodyMeasurements 測定日が期間内の記録を取得（測定日・IDの昇順、dateFromを含みdateToを含まない、nilなら制限なし）
+	ListBodyMeasurements(dateFrom, dateTo *time.Time) ([]*BodyMeasurement, error)
+
+	UpdateBodyMeasurement(measurement *BodyMeasurement) error
+
+	DeleteBodyMeasurement(id BodyMeasurementID) error
 }
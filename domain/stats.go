@@ -0,0 +1,87 @@
+package domain
+
+import "time"
+
+// StatsPeriod 統計の集計単位
+type StatsPeriod int
+
+const (
+	StatsPeriodDay   StatsPeriod = iota // 日別
+	StatsPeriodWeek                     // 週別（月曜始まり）
+	StatsPeriodMonth                    // 月別
+)
+
+// BucketStart 日時が属する集計期間の開始日時を返す（tのタイムゾーンで計算）
+func (p StatsPeriod) BucketStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch p {
+	case StatsPeriodWeek:
+		// time.Weekdayは日曜=0のため、月曜=0になるように補正
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case StatsPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+// Next 集計期間の開始日時から次の期間の開始日時を返す
+func (p StatsPeriod) Next(start time.Time) time.Time {
+	switch p {
+	case StatsPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case StatsPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+// ActivityAt 統計で使用するワークアウトの実施日時（完了日時、未完了なら実施予定日、どちらもなければ作成日時）
+func (w *Workout) ActivityAt() time.Time {
+	if w.CompletedAt != nil {
+		return *w.CompletedAt
+	}
+	if w.ScheduledFor != nil {
+		return *w.ScheduledFor
+	}
+	return w.CreatedAt
+}
+
+// TrainingStatsQuery トレーニング統計の集計条件
+type TrainingStatsQuery struct {
+	Period   StatsPeriod
+	DateFrom *time.Time     // nilの場合は下限なし（この日時を含む）
+	DateTo   *time.Time     // nilの場合は上限なし（この日時を含まない）
+	Location *time.Location // 集計期間の区切りに使用するタイムゾーン（nilの場合はtime.Local）
+}
+
+// TrainingStatsBucket 集計期間ごとのトレーニング統計
+// ボリュームとセット数は完了したワークアウトのみを集計する
+type TrainingStatsBucket struct {
+	PeriodStart       time.Time           `json:"period_start"`
+	WorkoutCount      int                 `json:"workout_count"`
+	CompletedCount    int                 `json:"completed_count"`
+	SkippedCount      int                 `json:"skipped_count"`
+	TotalVolume       float64             `json:"total_volume"` // Sets × Reps × Weight の合計（トン数）
+	TotalSets         int                 `json:"total_sets"`
+	AverageDifficulty float64             `json:"average_difficulty"` // Difficultyの平均値
+	SetsByMuscleGroup map[MuscleGroup]int `json:"sets_by_muscle_group,omitempty"`
+}
+
+// CompletionRate 完了率（0.0〜1.0）
+func (b *TrainingStatsBucket) CompletionRate() float64 {
+	if b.WorkoutCount == 0 {
+		return 0
+	}
+	return float64(b.CompletedCount) / float64(b.WorkoutCount)
+}
+
+// SkipRate スキップ率（0.0〜1.0）
+func (b *TrainingStatsBucket) SkipRate() float64 {
+	if b.WorkoutCount == 0 {
+		return 0
+	}
+	return float64(b.SkippedCount) / float64(b.WorkoutCount)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import "time"
+
+// WorkoutSetID 実施したセットのIDの型定義
+type WorkoutSetID int64
+
+// WorkoutSet 実施中のワークアウトで記録した1セット分の実績
+type WorkoutSet struct {
+	ID          WorkoutSetID `json:"id"`
+	WorkoutID   WorkoutID    `json:"workout_id"`
+	SetNumber   int          `json:"set_number"` // ワークアウト内で1から始まる連番
+	Reps        int          `json:"reps"`
+	Weight      float64      `json:"weight,omitempty"`
+	RPE         float64      `json:"rpe,omitempty"` // 0の場合は未記録
+	CompletedAt time.Time    `json:"completed_at"`
+}
+
+// ExerciseBest 種目ごとの自己ベスト（記録がなければ0）
+type ExerciseBest struct {
+	MaxWeight float64 // 最大重量
+	OneRepMax float64 // 推定1RMの最大値
+}
+
+// PersonalRecordType 自己ベストの種類
+type PersonalRecordType int
+
+const (
+	PersonalRecordUnspecified PersonalRecordType = iota // 未指定
+	PersonalRecordWeight                                // 最大重量
+	PersonalRecordOneRepMax                             // 推定1RM
+)
+
+// Japanese 自己ベストの種類の日本語名を返す
+func (t PersonalRecordType) Japanese() string {
+	switch t {
+	case PersonalRecordWeight:
+		return "最大重量"
+	case PersonalRecordOneRepMax:
+		return "推定1RM"
+	default:
+		return "未指定"
+	}
+}
+
+// PersonalRecord セットで更新した自己ベスト
+type PersonalRecord struct {
+	Type         PersonalRecordType
+	ExerciseType ExerciseType
+	Value        float64     // 更新後の記録(kg)
+	Previous     float64     // 更新前の記録(kg)、初めての記録なら0
+	Set          *WorkoutSet // 記録を更新したセット
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// DefaultSecondaryMuscleWeight 協働筋のセット数に掛ける重み（デフォルト）
+const DefaultSecondaryMuscleWeight = 0.5
+
+// ExerciseSets 種目・筋肉群ごとの完了セット数（集計結果）
+type ExerciseSets struct {
+	ExerciseType ExerciseType
+	MuscleGroup  MuscleGroup // ワークアウトに記録された筋肉群（未指定の場合あり）
+	Sets         int
+}
+
+// VolumeTarget 週あたりの目標セット数の範囲
+type VolumeTarget struct {
+	MinWeeklySets float64 `json:"min_weekly_sets"`
+	MaxWeeklySets float64 `json:"max_weekly_sets"`
+}
+
+// VolumeBalanceStatus 目標に対するボリュームの判定
+type VolumeBalanceStatus int
+
+const (
+	VolumeNoTarget VolumeBalanceStatus = iota // 目標未設定
+	VolumeUnder                               // 不足
+	VolumeWithin                              // 目標範囲内
+	VolumeOver                                // 過多
+)
+
+// Evaluate 週あたりのセット数を目標範囲と比較する
+func (t VolumeTarget) Evaluate(weeklySets float64) VolumeBalanceStatus {
+	if weeklySets < t.MinWeeklySets {
+		return VolumeUnder
+	}
+	if t.MaxWeeklySets > 0 && weeklySets > t.MaxWeeklySets {
+		return VolumeOver
+	}
+	return VolumeWithin
+}
+
+// MuscleVolume 筋肉群ごとのボリューム
+type MuscleVolume struct {
+	MuscleGroup   MuscleGroup
+	PrimarySets   float64 // 主働筋としてのセット数
+	SecondarySets float64 // 協働筋としてのセット数（重み付け前）
+	WeightedSets  float64 // PrimarySets + SecondarySets × 重み
+	WeeklySets    float64 // 週あたりに換算したセット数
+	Target        *VolumeTarget
+	Status        VolumeBalanceStatus
+}
+
+// MuscleBalanceReport 筋肉群ごとのボリュームバランスレポート
+type MuscleBalanceReport struct {
+	DateFrom        time.Time
+	DateTo          time.Time
+	Weeks           float64 // 集計期間の週数
+	SecondaryWeight float64
+	Muscles         []*MuscleVolume // MuscleGroupの定義順
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+// WeightUnit 重量の単位
+// 重量は常にkgで保存し、単位は入力・表示の変換にのみ使用する
+type WeightUnit int
+
+const (
+	WeightUnitKilogram WeightUnit = iota // キログラム
+	WeightUnitPound                      // ポンド
+)
+
+// KilogramsPerPound ポンドからキログラムへの換算（1lb = 0.45359237kg）
+const KilogramsPerPound = 0.45359237
+
+// プレートで組める重量の刻み（単位を変換した重量を丸める際に使用）
+const (
+	KilogramPlateIncrement = 2.5 // 1.25kgプレート × 2
+	PoundPlateIncrement    = 5.0 // 2.5lbプレート × 2
+)
+
+// weightUnitKeys 設定ファイル・メタデータで使用する重量の単位のキー
+var weightUnitKeys = map[string]WeightUnit{
+	"kg": WeightUnitKilogram,
+	"lb": WeightUnitPound,
+}
+
+// ParseWeightUnit 重量の単位のキー（例: "lb"）からWeightUnitを取得
+func ParseWeightUnit(key string) (WeightUnit, error) {
+	unit, ok := weightUnitKeys[key]
+	if !ok {
+		return WeightUnitKilogram, fmt.Errorf("unknown weight unit: %q", key)
+	}
+	return unit, nil
+}
+
+// Key 重量の単位のキー（例: "kg"）
+func (u WeightUnit) Key() string {
+	return keyOf(weightUnitKeys, u)
+}
+
+// ToKilograms この単位の重量をkgに変換する
+func (u WeightUnit) ToKilograms(weight float64) float64 {
+	if u == WeightUnitPound {
+		return weight * KilogramsPerPound
+	}
+	return weight
+}
+
+// FromKilograms kgの重量をこの単位に変換する
+func (u WeightUnit) FromKilograms(kg float64) float64 {
+	if u == WeightUnitPound {
+		return kg / KilogramsPerPound
+	}
+	return kg
+}
+
+// FromKilogramsRounded kgの重量をこの単位に変換し、0.01単位に丸める（応答の重量に使用）
+func (u WeightUnit) FromKilogramsRounded(kg float64) float64 {
+	return math.Round(u.FromKilograms(kg)*100) / 100
+}
+
+// PlateIncrement この単位のプレートで組める重量の刻み
+func (u WeightUnit) PlateIncrement() float64 {
+	if u == WeightUnitPound {
+		return PoundPlateIncrement
+	}
+	return KilogramPlateIncrement
+}
+
+// RoundToPlates この単位の重量をプレートで組める最も近い重量に丸める（例: 102.06kg → 102.5kg、225.3lb → 225lb）
+func (u WeightUnit) RoundToPlates(weight float64) float64 {
+	increment := u.PlateIncrement()
+	return math.Round(weight/increment) * increment
+}
+
+// WeightIn 重量を指定した単位で返す（0.01単位に丸める）
+// 記録した単位と同じ場合は入力した値。異なる単位で返した値を同じ単位で送り返しても、記録した重量は変わらない
+func (w *Workout) WeightIn(unit WeightUnit) float64 {
+	return unit.FromKilogramsRounded(w.Weight)
+}
+
+// PlateWeightIn 重量を指定した単位の表示用の値で返す
+// 記録した単位と同じ場合は入力した値、異なる場合は換算した値をプレートで組める重量に丸める
+func (w *Workout) PlateWeightIn(unit WeightUnit) float64 {
+	if unit != w.WeightUnit {
+		return unit.RoundToPlates(unit.FromKilograms(w.Weight))
+	}
+	return w.WeightIn(unit)
+}
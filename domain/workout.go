@@ -5,6 +5,14 @@ import "time"
 // WorkoutID ワークアウトIDの型定義
 type WorkoutID int64
 
+// ワークアウト・セットの値の上限（workouts・workout_setsテーブルのCHECK制約、proto/workout.protoの制約と同じ）
+const (
+	MaxSets   = 100     // セット数の上限（下限は1）
+	MaxReps   = 1000    // 回数の上限（下限は1）
+	MaxWeight = 9999.99 // 重量(kg)の上限（下限は0）
+	MaxRPE    = 10.0    // RPEの上限（0は未記録）
+)
+
 // Workout ワークアウトのドメインモデル（エンティティ）
 type Workout struct {
 	ID           WorkoutID     `json:"id"`
@@ -15,11 +23,55 @@ type Workout struct {
 	MuscleGroup  MuscleGroup   `json:"muscle_group"` // enum化
 	Sets         int           `json:"sets"`
 	Reps         int           `json:"reps"`
-	Weight       float64       `json:"weight,omitempty"` // 0の場合はJSONから除外
+	Weight       float64       `json:"weight,omitempty"` // 重量(kg)。0の場合はJSONから除外
+	WeightUnit   WeightUnit    `json:"weight_unit"`      // 重量を入力した単位（表示の変換に使用）
 	Notes        string        `json:"notes,omitempty"`  // 空の場合はJSONから除外
 	CreatedAt    time.Time     `json:"created_at"`
 	UpdatedAt    time.Time     `json:"updated_at"`
 	CompletedAt  *time.Time    `json:"completed_at,omitempty"` // nilの場合はJSONから除外
+	SkipReason   SkipReason    `json:"skip_reason,omitempty"`  // スキップ時のみ設定
+	// 実施予定日時（予定日のないワークアウトはnil）
+	ScheduledFor      *time.Time `json:"scheduled_for,omitempty"`
+	ScheduledTimezone string     `json:"scheduled_timezone,omitempty"` // 予定を登録したタイムゾーン（IANA名）
+	// プログラムから生成した予定のみ設定
+	ProgramID      *ProgramID `json:"program_id,omitempty"`      // 生成元のプログラム
+	ProgramVersion int        `json:"program_version,omitempty"` // 生成元のプログラムのバージョン
+	// 他のアプリから取り込んだ記録のみ設定（再インポート時の重複排除用）
+	ImportKey string `json:"import_key,omitempty"`
+}
+
+// ScheduledLocation 予定を登録したタイムゾーン（未設定・不正な場合はfallback）
+func (w *Workout) ScheduledLocation(fallback *time.Location) *time.Location {
+	if w.ScheduledTimezone == "" {
+		return fallback
+	}
+	loc, err := time.LoadLocation(w.ScheduledTimezone)
+	if err != nil {
+		return fallback
+	}
+	return loc
+}
+
+// IsMissed 予定日時を過ぎても実施されていない予定か判定する
+func (w *Workout) IsMissed(now time.Time) bool {
+	return w.Status == WorkoutStatusPlanned && w.ScheduledFor != nil && w.ScheduledFor.Before(now)
+}
+
+// Volume トレーニングボリューム（Sets × Reps × Weight）
+func (w *Workout) Volume() float64 {
+	return float64(w.Sets) * float64(w.Reps) * w.Weight
+}
+
+// EstimateOneRepMax ワークアウト・セット単位の推定1RM（Epley式、1回挙上は重量そのまま。回数が0以下の場合は0）
+// 自己ベスト・高強度判定のSQL（infraのリポジトリ）も同じ計算式を使用する
+func EstimateOneRepMax(weight float64, reps int) float64 {
+	if reps <= 0 {
+		return 0
+	}
+	if reps == 1 {
+		return weight
+	}
+	return weight * (1 + float64(reps)/30)
 }
 
 // WorkoutSummary ワークアウト概要（omitemptyの活用例）
@@ -0,0 +1,140 @@
+package i18n
+
+// englishCatalog 英語のメッセージカタログ
+// 語順が日本語と異なる場合は %[n]d のように引数の位置を指定する
+var englishCatalog = map[MessageID]string{
+	MsgListSeparator: ", ",
+
+	MsgOperationError:       "❌ %s error: %s - %s",
+	MsgOpCreateWorkout:      "Create workout",
+	MsgOpCreateProgram:      "Create program",
+	MsgOpCalculateOneRepMax: "1RM calculation",
+	MsgOpCreated:            "created",
+	MsgWorkoutSucceeded:     "🎉 Workout \"%s\" %s!",
+	MsgWorkoutUpdateFailed:  "❌ Failed to update workout: %v",
+	MsgWorkoutReloadFailed:  "❌ Failed to load the updated workout: %v",
+	MsgWorkoutUpdated:       "✅ Workout \"%s\" updated!",
+	MsgWorkoutDeleteFailed:  "❌ Failed to delete workout: %v",
+	MsgWorkoutDeleted:       "✅ Workout deleted!",
+	MsgWorkoutListEmpty:     "📋 No workouts",
+	MsgWorkoutListHeader:    "📋 Workouts (%d):",
+	MsgWorkoutSetsReps:      " - %d sets × %d reps",
+	MsgHighIntensityFound:   "💪 Found %d high-intensity workouts! Records of true beasts",
+	MsgHighIntensityEmpty:   "😅 No high-intensity workouts yet. Time to lift heavier!",
+	MsgOneRepMaxInput:       "%.1fkg × %d reps",
+	MsgOneRepMaxCalculated:  "🏋️ Estimated your 1RM with %d formulas",
+	MsgOneRepMaxNoFormula:   "😅 No applicable formula",
+
+	MsgBatchTooLarge:     "❌ A batch can contain at most %d items (got %d)",
+	MsgBatchCreateFailed: "❌ Failed to create workouts in batch: %v",
+	MsgBatchUpdateFailed: "❌ Failed to update workouts in batch: %v",
+	MsgBatchDeleteFailed: "❌ Failed to delete workouts in batch: %v",
+	MsgBatchSucceeded:    "✅ All %d items succeeded!",
+	MsgBatchRolledBack:   "❌ %d items failed, so nothing was applied",
+	MsgBatchPartial:      "⚠️ %d succeeded, %d failed",
+
+	MsgCalendarListed:    "📅 Calendar for %d days (%d planned)",
+	MsgRescheduleFailed:  "❌ Failed to reschedule: %v",
+	MsgRescheduled:       "📅 Rescheduled \"%s\" to %s!",
+	MsgICalExported:      "📤 Exported %d workouts to iCalendar",
+	MsgICalCalendarName:  "Workout Plan",
+	MsgICalSets:          "Sets",
+	MsgICalReps:          "Reps",
+	MsgICalWeight:        "Weight",
+	MsgICalNotes:         "Notes",
+	MsgStreakUnitDay:     " days",
+	MsgStreakUnitWeek:    " weeks",
+	MsgStreakBroken:      "💤 Your streak is broken (longest: %d%s). Start again today!",
+	MsgStreakActive:      "🔥 Training streak: %d%s! (longest: %d%s, adherence: %.0f%%)",
+	MsgStatsSummary:      "📊 Stats for %d periods. Total volume: %.1f%s",
+	MsgStatsEmpty:        "📊 No workouts to aggregate",
+	MsgBalanceOK:         "⚖️ All muscle groups are within their targets",
+	MsgBalanceOver:       "⚖️ Too much %s",
+	MsgBalanceUnder:      "⚖️ Not enough %s",
+	MsgBalanceOverUnder:  "⚖️ Too much %s, not enough %s",
+	MsgImportOptionsLate: "options must be sent once, in the first message",
+	MsgImportTooLarge:    "file is too large (max %dMB)",
+	MsgImportRejected:    "❌ %[2]d of %[1]d rows have errors, so nothing was imported",
+	MsgImportDryRun:      "🔍 %d workouts can be imported (dry run, nothing saved)%s",
+	MsgImportDone:        "📥 Imported %d workouts!%s",
+	MsgImportExclusions:  " (excluded %d already imported, %d unsupported exercises)",
+	MsgTrackStartFirst:   "send start in the first message",
+	MsgTrackStartTwice:   "start must be sent once, in the first message",
+	MsgTrackNoEvent:      "no event specified",
+	MsgSuggestBaseline:   "📝 No completed \"%s\" yet. Start with a weight you can handle comfortably!",
+	MsgSuggestWeight:     "📈 Next \"%s\": try %.1f%s × %d reps × %d sets!",
+	MsgSuggestReps:       "📈 Next \"%s\": stay at %.1f%s and aim for %d reps × %d sets!",
+	MsgSuggestDeload:     "🔻 \"%s\" missed the target %d times in a row, deloading to %.1f%s",
+	MsgSuggestRepeat:     "🔁 Next \"%s\": repeat %.1f%s × %d reps × %d sets (missed in a row: %d)",
+
+	MsgInvalidStartDate:  "❌ Invalid start_date: %v",
+	MsgProgramCreated:    "✅ Program \"%s\" created!",
+	MsgProgramSummary:    "📋 Program \"%s\" v%d (%d days)",
+	MsgProgramList:       "📋 %d programs",
+	MsgProgramUpdFailed:  "❌ Failed to update program: %v",
+	MsgProgramUpdated:    "✅ Program \"%s\" updated!",
+	MsgTemplateUpdFailed: "❌ Failed to update templates: %v",
+	MsgTemplatesSaved:    "✅ Saved program \"%s\" as version %d!",
+	MsgProgramDelFailed:  "❌ Failed to delete program: %v",
+	MsgProgramDeleted:    "✅ Program deleted! (Generated workouts are kept)",
+	MsgProgramApplyFail:  "❌ Failed to apply program: %v",
+	MsgProgramApplied:    "📅 Scheduled %[2]d workouts over %[1]d weeks!",
+
+	MsgBodyRecorded:     "⚖️ Saved measurement for %s!",
+	MsgBodyUpdated:      "✅ Measurement for %s updated!",
+	MsgBodyDeleted:      "✅ Body measurement deleted!",
+	MsgBodyList:         "📋 %d measurements",
+	MsgBodyweightTrend:  "⚖️ Bodyweight for %d days. Moving average change: %+.1f%s",
+	MsgBodyweightEmpty:  "⚖️ No bodyweight recorded in this period",
+	MsgRelativeStrength: "💪 Relative strength for %d workouts. Best: %.2fx bodyweight",
+	MsgRelativeStrEmpty: "💪 No completed workouts on days with a recorded bodyweight",
+
+	MsgPlatesExact:   "🏋️ %g%s: load %s per side",
+	MsgPlatesNearest: "🏋️ %g%s can't be loaded exactly. Nearest is %g%s: load %s per side",
+	MsgPlatesBarOnly: "bar only",
+
+	"exercise_type.unspecified":       "Unspecified",
+	"exercise_type.bench_press":       "Bench Press",
+	"exercise_type.squat":             "Squat",
+	"exercise_type.deadlift":          "Deadlift",
+	"exercise_type.dumbbell_shoulder": "Dumbbell Shoulder Press",
+	"exercise_type.pull_up":           "Pull-Up",
+	"exercise_type.side_raise":        "Side Raise",
+	"exercise_type.one_hand_row":      "One-Hand Row",
+	"exercise_type.high_pull":         "High Pull",
+
+	"muscle_group.unspecified": "Unspecified",
+	"muscle_group.chest":       "Chest",
+	"muscle_group.back":        "Back",
+	"muscle_group.legs":        "Legs",
+	"muscle_group.shoulders":   "Shoulders",
+	"muscle_group.arms":        "Arms",
+	"muscle_group.abs":         "Abs",
+	"muscle_group.core":        "Core",
+	"muscle_group.glutes":      "Glutes",
+	"muscle_group.cardio":      "Cardio",
+	"muscle_group.full_body":   "Full Body",
+
+	"difficulty.unspecified":  "Unspecified",
+	"difficulty.beginner":     "Beginner",
+	"difficulty.intermediate": "Intermediate",
+	"difficulty.advanced":     "Advanced",
+	"difficulty.beast":        "Beast",
+
+	"workout_status.unspecified": "Unspecified",
+	"workout_status.planned":     "Planned",
+	"workout_status.in_progress": "In Progress",
+	"workout_status.completed":   "Completed",
+	"workout_status.skipped":     "Skipped",
+
+	"skip_reason.unspecified": "Unspecified",
+	"skip_reason.sore":        "Sore",
+	"skip_reason.no_time":     "No time",
+	"skip_reason.sick":        "Sick",
+	"skip_reason.injury":      "Injury",
+	"skip_reason.travel":      "Travel",
+	"skip_reason.weather":     "Weather",
+	"skip_reason.motivation":  "No motivation",
+	"skip_reason.other":       "Other",
+	"skip_reason.missed":      "Missed (auto-skipped)",
+}
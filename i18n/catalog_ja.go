@@ -0,0 +1,139 @@
+package i18n
+
+// japaneseCatalog 日本語のメッセージカタログ
+var japaneseCatalog = map[MessageID]string{
+	MsgListSeparator: "・",
+
+	MsgOperationError:       "❌ %sエラー: %s - %s",
+	MsgOpCreateWorkout:      "ワークアウト作成",
+	MsgOpCreateProgram:      "プログラム作成",
+	MsgOpCalculateOneRepMax: "1RM計算",
+	MsgOpCreated:            "作成",
+	MsgWorkoutSucceeded:     "🎉 ワークアウト「%s」が%sされました！",
+	MsgWorkoutUpdateFailed:  "❌ ワークアウト更新に失敗しました: %v",
+	MsgWorkoutReloadFailed:  "❌ 更新されたワークアウトの取得に失敗しました: %v",
+	MsgWorkoutUpdated:       "✅ ワークアウト「%s」が更新されました！",
+	MsgWorkoutDeleteFailed:  "❌ ワークアウト削除に失敗しました: %v",
+	MsgWorkoutDeleted:       "✅ ワークアウトが削除されました！",
+	MsgWorkoutListEmpty:     "📋 ワークアウトがありません",
+	MsgWorkoutListHeader:    "📋 ワークアウト一覧 (%d件):",
+	MsgWorkoutSetsReps:      " - %dセット×%d回",
+	MsgHighIntensityFound:   "💪 高強度ワークアウト%d件を取得しました！野獣たちの記録です",
+	MsgHighIntensityEmpty:   "😅 高強度ワークアウトがありません。もっと重いものを持ち上げましょう！",
+	MsgOneRepMaxInput:       "%.1fkg × %d回",
+	MsgOneRepMaxCalculated:  "🏋️ %d種類の推定式で1RMを計算しました",
+	MsgOneRepMaxNoFormula:   "😅 適用可能な推定式がありません",
+
+	MsgBatchTooLarge:     "❌ 一括処理できるのは%d件までです（%d件）",
+	MsgBatchCreateFailed: "❌ ワークアウトの一括作成に失敗しました: %v",
+	MsgBatchUpdateFailed: "❌ ワークアウトの一括更新に失敗しました: %v",
+	MsgBatchDeleteFailed: "❌ ワークアウトの一括削除に失敗しました: %v",
+	MsgBatchSucceeded:    "✅ %d件すべて成功しました！",
+	MsgBatchRolledBack:   "❌ %d件が失敗したため、何も反映しませんでした",
+	MsgBatchPartial:      "⚠️ %d件成功、%d件失敗しました",
+
+	MsgCalendarListed:    "📅 %d日間のカレンダー（予定%d件）",
+	MsgRescheduleFailed:  "❌ 予定の変更に失敗しました: %v",
+	MsgRescheduled:       "📅 「%s」の予定を%sに変更しました！",
+	MsgICalExported:      "📤 %d件の予定をiCalendarに出力しました",
+	MsgICalCalendarName:  "ワークアウト予定",
+	MsgICalSets:          "セット数",
+	MsgICalReps:          "レップ数",
+	MsgICalWeight:        "重量",
+	MsgICalNotes:         "メモ",
+	MsgStreakUnitDay:     "日",
+	MsgStreakUnitWeek:    "週",
+	MsgStreakBroken:      "💤 ストリークが途切れています（最長: %d%s）。今日から再開しましょう！",
+	MsgStreakActive:      "🔥 %d%s連続でトレーニング中！（最長: %d%s、実施率: %.0f%%）",
+	MsgStatsSummary:      "📊 %d期間分の統計です。総ボリューム: %.1f%s",
+	MsgStatsEmpty:        "📊 集計対象のワークアウトがありません",
+	MsgBalanceOK:         "⚖️ すべての筋肉群が目標範囲内です",
+	MsgBalanceOver:       "⚖️ %sが多すぎます",
+	MsgBalanceUnder:      "⚖️ %sが不足しています",
+	MsgBalanceOverUnder:  "⚖️ %sが多すぎ、%sが不足しています",
+	MsgImportOptionsLate: "オプションは最初のメッセージで1回だけ送信してください",
+	MsgImportTooLarge:    "ファイルが大きすぎます（最大%dMB）",
+	MsgImportRejected:    "❌ %d行中%d行にエラーがあるため、何もインポートしませんでした",
+	MsgImportDryRun:      "🔍 %d件をインポートできます（dry-runのため保存していません）%s",
+	MsgImportDone:        "📥 %d件のワークアウトをインポートしました！%s",
+	MsgImportExclusions:  "（取り込み済み%d件・未対応の種目%d件を除外）",
+	MsgTrackStartFirst:   "最初のメッセージでstartを送信してください",
+	MsgTrackStartTwice:   "startは最初のメッセージで1回だけ送信してください",
+	MsgTrackNoEvent:      "イベントが指定されていません",
+	MsgSuggestBaseline:   "📝 「%s」の完了履歴がありません。まずは無理のない重量で記録しましょう！",
+	MsgSuggestWeight:     "📈 次回の「%s」は%.1f%s × %d回 × %dセットに挑戦しましょう！",
+	MsgSuggestReps:       "📈 次回の「%s」は%.1f%sのまま%d回 × %dセットを目指しましょう！",
+	MsgSuggestDeload:     "🔻 「%s」は%d回連続で目標未達のため%.1f%sにディロードします",
+	MsgSuggestRepeat:     "🔁 次回の「%s」も%.1f%s × %d回 × %dセットに再挑戦しましょう（連続未達: %d回）",
+
+	MsgInvalidStartDate:  "❌ start_dateが不正です: %v",
+	MsgProgramCreated:    "✅ プログラム「%s」が作成されました！",
+	MsgProgramSummary:    "📋 プログラム「%s」v%d（%d日分）",
+	MsgProgramList:       "📋 %d件のプログラムがあります",
+	MsgProgramUpdFailed:  "❌ プログラム更新に失敗しました: %v",
+	MsgProgramUpdated:    "✅ プログラム「%s」が更新されました！",
+	MsgTemplateUpdFailed: "❌ テンプレート更新に失敗しました: %v",
+	MsgTemplatesSaved:    "✅ プログラム「%s」をバージョン%dとして保存しました！",
+	MsgProgramDelFailed:  "❌ プログラム削除に失敗しました: %v",
+	MsgProgramDeleted:    "✅ プログラムが削除されました！（生成済みの予定は残ります）",
+	MsgProgramApplyFail:  "❌ プログラムの適用に失敗しました: %v",
+	MsgProgramApplied:    "📅 %d週間分・%d件の予定を作成しました！",
+
+	MsgBodyRecorded:     "⚖️ %sの記録を保存しました！",
+	MsgBodyUpdated:      "✅ %sの記録が更新されました！",
+	MsgBodyDeleted:      "✅ 体重・身体測定の記録が削除されました！",
+	MsgBodyList:         "📋 %d件の記録があります",
+	MsgBodyweightTrend:  "⚖️ %d日分の体重です。移動平均の変化: %+.1f%s",
+	MsgBodyweightEmpty:  "⚖️ 期間内に体重の記録がありません",
+	MsgRelativeStrength: "💪 %d件のワークアウトの体重比です。最高: 体重の%.2f倍",
+	MsgRelativeStrEmpty: "💪 体重を記録した日に完了したワークアウトがありません",
+
+	MsgPlatesExact:   "🏋️ %g%s: 片側に %s",
+	MsgPlatesNearest: "🏋️ %g%sはちょうど組めないため、最も近い%g%s: 片側に %s",
+	MsgPlatesBarOnly: "バーのみ",
+
+	"exercise_type.unspecified":       "未指定",
+	"exercise_type.bench_press":       "ベンチプレス",
+	"exercise_type.squat":             "スクワット",
+	"exercise_type.deadlift":          "デッドリフト",
+	"exercise_type.dumbbell_shoulder": "ダンベルショルダープレス",
+	"exercise_type.pull_up":           "懸垂",
+	"exercise_type.side_raise":        "サイドレイズ",
+	"exercise_type.one_hand_row":      "ワンハンドロー",
+	"exercise_type.high_pull":         "ハイプル",
+
+	"muscle_group.unspecified": "未指定",
+	"muscle_group.chest":       "胸",
+	"muscle_group.back":        "背中",
+	"muscle_group.legs":        "脚",
+	"muscle_group.shoulders":   "肩",
+	"muscle_group.arms":        "腕",
+	"muscle_group.abs":         "腹筋",
+	"muscle_group.core":        "体幹",
+	"muscle_group.glutes":      "臀部",
+	"muscle_group.cardio":      "有酸素",
+	"muscle_group.full_body":   "全身",
+
+	"difficulty.unspecified":  "未指定",
+	"difficulty.beginner":     "初心者",
+	"difficulty.intermediate": "中級者",
+	"difficulty.advanced":     "上級者",
+	"difficulty.beast":        "野獣級",
+
+	"workout_status.unspecified": "未指定",
+	"workout_status.planned":     "予定",
+	"workout_status.in_progress": "実行中",
+	"workout_status.completed":   "完了",
+	"workout_status.skipped":     "スキップ",
+
+	"skip_reason.unspecified": "未指定",
+	"skip_reason.sore":        "筋肉痛",
+	"skip_reason.no_time":     "時間がない",
+	"skip_reason.sick":        "体調不良",
+	"skip_reason.injury":      "怪我",
+	"skip_reason.travel":      "出張・旅行",
+	"skip_reason.weather":     "天候",
+	"skip_reason.motivation":  "やる気が出ない",
+	"skip_reason.other":       "その他",
+	"skip_reason.missed":      "未実施（自動スキップ）",
+}
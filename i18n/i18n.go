@@ -0,0 +1,142 @@
+// Package i18n レスポンスのメッセージ・列挙型の表示名を日本語・英語で提供する
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golv2-learning-app/domain"
+)
+
+// Locale 表示言語（BCP 47の言語コード）
+type Locale string
+
+const (
+	Japanese Locale = "ja"
+	English  Locale = "en"
+
+	// DefaultLocale 言語の指定がない・対応していない場合の表示言語
+	DefaultLocale = Japanese
+)
+
+// MessageID メッセージカタログのキー
+type MessageID string
+
+// catalogs 言語ごとのメッセージカタログ（書式はfmt.Sprintfと同じ）
+var catalogs = map[Locale]map[MessageID]string{
+	Japanese: japaneseCatalog,
+	English:  englishCatalog,
+}
+
+// Locales 対応している表示言語
+func Locales() []Locale {
+	return []Locale{Japanese, English}
+}
+
+// Parse 言語コード（例: "en"、"en-US"、"ja_JP"）から表示言語を取得
+// 地域などのサブタグは無視する
+func Parse(tag string) (Locale, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if i := strings.IndexAny(tag, "-_"); i >= 0 {
+		tag = tag[:i]
+	}
+	locale := Locale(tag)
+	if _, ok := catalogs[locale]; !ok {
+		return DefaultLocale, fmt.Errorf("unsupported language: %q (ja or en)", tag)
+	}
+	return locale, nil
+}
+
+// ParseAcceptLanguage Accept-Language（例: "en-US,en;q=0.9,ja;q=0.8"）から対応している言語のうち最も優先度の高いものを選ぶ
+// 対応している言語がない場合はDefaultLocale
+func ParseAcceptLanguage(header string) Locale {
+	type candidate struct {
+		locale Locale
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		q := 1.0
+		for _, param := range fields[1:] {
+			name, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || strings.TrimSpace(name) != "q" {
+				continue
+			}
+			parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			if err != nil {
+				parsed = 0
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		locale, err := Parse(fields[0])
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q})
+	}
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+	// 優先度が同じ場合は先に書かれた方を選ぶ
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	return candidates[0].locale
+}
+
+// Message メッセージを表示言語で組み立てる
+// カタログにない場合はDefaultLocaleのメッセージ、それもなければキーをそのまま使う
+func (l Locale) Message(id MessageID, args ...any) string {
+	format, ok := catalogs[l][id]
+	if !ok {
+		if format, ok = catalogs[DefaultLocale][id]; !ok {
+			format = string(id)
+		}
+	}
+	if len(args) == 0 {
+		return format
+	}
+	return fmt.Sprintf(format, args...)
+}
+
+// Join 表示名を列挙する（例: "胸・背中"、"Chest, Back"）
+func (l Locale) Join(items []string) string {
+	return strings.Join(items, l.Message(MsgListSeparator))
+}
+
+// ExerciseType 種目の表示名
+func (l Locale) ExerciseType(et domain.ExerciseType) string {
+	return l.enumName("exercise_type", et.Key())
+}
+
+// MuscleGroup 筋肉群の表示名
+func (l Locale) MuscleGroup(mg domain.MuscleGroup) string {
+	return l.enumName("muscle_group", mg.Key())
+}
+
+// Difficulty 難易度の表示名
+func (l Locale) Difficulty(d domain.Difficulty) string {
+	return l.enumName("difficulty", d.Key())
+}
+
+// WorkoutStatus ステータスの表示名
+func (l Locale) WorkoutStatus(s domain.WorkoutStatus) string {
+	return l.enumName("workout_status", s.Key())
+}
+
+// SkipReason スキップ理由の表示名
+func (l Locale) SkipReason(sr domain.SkipReason) string {
+	return l.enumName("skip_reason", sr.Key())
+}
+
+// enumName 列挙型の表示名（キーが空の場合は未指定）
+func (l Locale) enumName(kind, key string) string {
+	if key == "" {
+		key = "unspecified"
+	}
+	return l.Message(MessageID(kind + "." + key))
+}
@@ -0,0 +1,121 @@
+package i18n
+
+import (
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+)
+
+// TestCatalogs すべての言語のカタログに同じメッセージがあることをテスト
+func TestCatalogs(t *testing.T) {
+	for _, locale := range Locales() {
+		for id := range catalogs[DefaultLocale] {
+			if _, ok := catalogs[locale][id]; !ok {
+				t.Errorf("Expected %q in %s catalog", id, locale)
+			}
+		}
+		for id := range catalogs[locale] {
+			if _, ok := catalogs[DefaultLocale][id]; !ok {
+				t.Errorf("Unexpected %q in %s catalog (not in %s)", id, locale, DefaultLocale)
+			}
+		}
+	}
+}
+
+// TestEnumNames 列挙型のすべての値に表示名があることをテスト
+func TestEnumNames(t *testing.T) {
+	for _, locale := range Locales() {
+		var names []string
+		for v := 0; v == 0 || domain.ExerciseType(v).Key() != ""; v++ {
+			names = append(names, locale.ExerciseType(domain.ExerciseType(v)))
+		}
+		for v := 0; v == 0 || domain.MuscleGroup(v).Key() != ""; v++ {
+			names = append(names, locale.MuscleGroup(domain.MuscleGroup(v)))
+		}
+		for v := 0; v == 0 || domain.SkipReason(v).Key() != ""; v++ {
+			names = append(names, locale.SkipReason(domain.SkipReason(v)))
+		}
+		for v := 0; domain.Difficulty(v).Key() != ""; v++ {
+			names = append(names, locale.Difficulty(domain.Difficulty(v)))
+		}
+		for v := 0; domain.WorkoutStatus(v).Key() != ""; v++ {
+			names = append(names, locale.WorkoutStatus(domain.WorkoutStatus(v)))
+		}
+
+		for _, name := range names {
+			// カタログにない場合はキー（"exercise_type.squat" など）がそのまま返る
+			if name == "" || strings.Contains(name, ".") {
+				t.Errorf("Expected %s display name, got %q", locale, name)
+			}
+		}
+	}
+
+	if got := English.ExerciseType(domain.Squat); got != "Squat" {
+		t.Errorf("Expected Squat, got %q", got)
+	}
+	if got := Japanese.MuscleGroup(domain.Chest); got != "胸" {
+		t.Errorf("Expected 胸, got %q", got)
+	}
+}
+
+// TestParseAcceptLanguage テーブル駆動テストでAccept-Languageからの言語の選択をテスト
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		want        Locale
+		description string
+	}{
+		{
+			name:        "正常系: 未指定",
+			header:      "",
+			want:        Japanese,
+			description: "指定がなければ日本語",
+		},
+		{
+			name:        "正常系: 地域付き",
+			header:      "en-US",
+			want:        English,
+			description: "地域などのサブタグは無視する",
+		},
+		{
+			name:        "正常系: 優先度",
+			header:      "ja;q=0.5, en;q=0.8",
+			want:        English,
+			description: "qの大きい言語を選ぶ",
+		},
+		{
+			name:        "正常系: 未対応の言語を含む",
+			header:      "fr-FR,fr;q=0.9,en;q=0.8,ja;q=0.7",
+			want:        English,
+			description: "対応している言語のうち最も優先度が高いもの",
+		},
+		{
+			name:        "正常系: 同じ優先度",
+			header:      "en,ja",
+			want:        English,
+			description: "qが同じなら先に書かれた言語",
+		},
+		{
+			name:        "異常系: 未対応の言語のみ",
+			header:      "fr, de;q=0.5",
+			want:        Japanese,
+			description: "対応している言語がなければ日本語",
+		},
+		{
+			name:        "異常系: q=0",
+			header:      "en;q=0",
+			want:        Japanese,
+			description: "q=0は受け付けない言語として扱う",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseAcceptLanguage(tt.header); got != tt.want {
+				t.Errorf("ParseAcceptLanguage(%q) = %s, want %s", tt.header, got, tt.want)
+			}
+		})
+	}
+}
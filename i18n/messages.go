@@ -0,0 +1,99 @@
+package i18n
+
+// レスポンスのメッセージのキー
+// 列挙型の表示名は "<種類>.<キー>"（例: "exercise_type.bench_press"）をキーにする
+const (
+	MsgListSeparator MessageID = "list_separator"
+
+	// ワークアウト
+	MsgOperationError       MessageID = "operation.error"
+	MsgOpCreateWorkout      MessageID = "operation.create_workout"
+	MsgOpCreateProgram      MessageID = "operation.create_program"
+	MsgOpCalculateOneRepMax MessageID = "operation.calculate_one_rep_max"
+	MsgOpCreated            MessageID = "operation.created"
+	MsgWorkoutSucceeded     MessageID = "workout.succeeded"
+	MsgWorkoutUpdateFailed  MessageID = "workout.update_failed"
+	MsgWorkoutReloadFailed  MessageID = "workout.reload_failed"
+	MsgWorkoutUpdated       MessageID = "workout.updated"
+	MsgWorkoutDeleteFailed  MessageID = "workout.delete_failed"
+	MsgWorkoutDeleted       MessageID = "workout.deleted"
+	MsgWorkoutListEmpty     MessageID = "workout.list_empty"
+	MsgWorkoutListHeader    MessageID = "workout.list_header"
+	MsgWorkoutSetsReps      MessageID = "workout.sets_reps"
+	MsgHighIntensityFound   MessageID = "high_intensity.found"
+	MsgHighIntensityEmpty   MessageID = "high_intensity.empty"
+	MsgOneRepMaxInput       MessageID = "one_rep_max.input"
+	MsgOneRepMaxCalculated  MessageID = "one_rep_max.calculated"
+	MsgOneRepMaxNoFormula   MessageID = "one_rep_max.no_formula"
+
+	// 一括処理
+	MsgBatchTooLarge     MessageID = "batch.too_large"
+	MsgBatchCreateFailed MessageID = "batch.create_failed"
+	MsgBatchUpdateFailed MessageID = "batch.update_failed"
+	MsgBatchDeleteFailed MessageID = "batch.delete_failed"
+	MsgBatchSucceeded    MessageID = "batch.succeeded"
+	MsgBatchRolledBack   MessageID = "batch.rolled_back"
+	MsgBatchPartial      MessageID = "batch.partial"
+
+	// カレンダー・予定
+	MsgCalendarListed    MessageID = "calendar.listed"
+	MsgRescheduleFailed  MessageID = "calendar.reschedule_failed"
+	MsgRescheduled       MessageID = "calendar.rescheduled"
+	MsgICalExported      MessageID = "ical.exported"
+	MsgICalCalendarName  MessageID = "ical.calendar_name"
+	MsgICalSets          MessageID = "ical.sets"
+	MsgICalReps          MessageID = "ical.reps"
+	MsgICalWeight        MessageID = "ical.weight"
+	MsgICalNotes         MessageID = "ical.notes"
+	MsgStreakUnitDay     MessageID = "consistency.unit_day"
+	MsgStreakUnitWeek    MessageID = "consistency.unit_week"
+	MsgStreakBroken      MessageID = "consistency.streak_broken"
+	MsgStreakActive      MessageID = "consistency.streak_active"
+	MsgStatsSummary      MessageID = "stats.summary"
+	MsgStatsEmpty        MessageID = "stats.empty"
+	MsgBalanceOK         MessageID = "balance.ok"
+	MsgBalanceOver       MessageID = "balance.over"
+	MsgBalanceUnder      MessageID = "balance.under"
+	MsgBalanceOverUnder  MessageID = "balance.over_under"
+	MsgImportOptionsLate MessageID = "import.options_late"
+	MsgImportTooLarge    MessageID = "import.too_large"
+	MsgImportRejected    MessageID = "import.rejected"
+	MsgImportDryRun      MessageID = "import.dry_run"
+	MsgImportDone        MessageID = "import.done"
+	MsgImportExclusions  MessageID = "import.exclusions"
+	MsgTrackStartFirst   MessageID = "tracking.start_first"
+	MsgTrackStartTwice   MessageID = "tracking.start_twice"
+	MsgTrackNoEvent      MessageID = "tracking.no_event"
+	MsgSuggestBaseline   MessageID = "suggestion.baseline"
+	MsgSuggestWeight     MessageID = "suggestion.increase_weight"
+	MsgSuggestReps       MessageID = "suggestion.increase_reps"
+	MsgSuggestDeload     MessageID = "suggestion.deload"
+	MsgSuggestRepeat     MessageID = "suggestion.repeat"
+	MsgInvalidStartDate  MessageID = "program.invalid_start_date"
+	MsgProgramCreated    MessageID = "program.created"
+	MsgProgramSummary    MessageID = "program.summary"
+	MsgProgramList       MessageID = "program.list"
+	MsgProgramUpdFailed  MessageID = "program.update_failed"
+	MsgProgramUpdated    MessageID = "program.updated"
+	MsgTemplateUpdFailed MessageID = "program.template_update_failed"
+	MsgTemplatesSaved    MessageID = "program.templates_saved"
+	MsgProgramDelFailed  MessageID = "program.delete_failed"
+	MsgProgramDeleted    MessageID = "program.deleted"
+	MsgProgramApplyFail  MessageID = "program.apply_failed"
+	MsgProgramApplied    MessageID = "program.applied"
+
+	// 体重・身体測定
+	MsgBodyRecorded     MessageID = "body.recorded"
+	MsgBodyUpdated      MessageID = "body.updated"
+	MsgBodyDeleted      MessageID = "body.deleted"
+	MsgBodyList         MessageID = "body.list"
+	MsgBodyweightTrend  MessageID = "body.trend"
+	MsgBodyweightEmpty  MessageID = "body.trend_empty"
+	MsgRelativeStrength MessageID = "body.relative_strength"
+	MsgRelativeStrEmpty MessageID = "body.relative_strength_empty"
+
+	// プレートの組み合わせ計算
+	MsgPlatesExact   MessageID = "plates.exact"
+	MsgPlatesNearest MessageID = "plates.nearest"
+	MsgPlatesBarOnly MessageID = "plates.bar_only"
+)
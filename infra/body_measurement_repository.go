@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"gorm.io/gorm"
+)
+
+// bodyMeasurementRow body_measurementsテーブルの行
+type bodyMeasurementRow struct {
+	ID             domain.BodyMeasurementID `gorm:"primaryKey"`
+	MeasuredOn     time.Time
+	Bodyweight     float64
+	BodyFatPercent float64
+	Waist          float64
+	Chest          float64
+	Arm            float64
+	Notes          string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (bodyMeasurementRow) TableName() string { return "body_measurements" }
+
+// GORMBodyMeasurementRepository GORMを使用した体重・身体測定リポジトリ実装
+type GORMBodyMeasurementRepository struct {
+	db *gorm.DB
+}
+
+// NewGORMBodyMeasurementRepository 接続済みのGORM DBインスタンスから体重・身体測定リポジトリを作成
+func NewGORMBodyMeasurementRepository(db *gorm.DB) *GORMBodyMeasurementRepository {
+	return &GORMBodyMeasurementRepository{db: db}
+}
+
+// WithContext クエリにコンテキストを渡すリポジトリを返す
+func (r *GORMBodyMeasurementRepository) WithContext(ctx context.Context) domain.BodyMeasurementRepository {
+	return &GORMBodyMeasurementRepository{db: r.db.WithContext(ctx)}
+}
+
+// CreateBodyMeasurement 記録を作成
+func (r *GORMBodyMeasurementRepository) CreateBodyMeasurement(measurement *domain.BodyMeasurement) error {
+	now := time.Now()
+	row := newBodyMeasurementRow(measurement)
+	row.CreatedAt = now
+	row.UpdatedAt = now
+	if err := r.db.Create(&row).Error; err != nil {
+		return fmt.Errorf("failed to create body measurement (date=%s): %w", measurement.Date.Format(time.DateOnly), err)
+	}
+
+	measurement.ID = row.ID
+	measurement.CreatedAt = row.CreatedAt
+	measurement.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+// GetBodyMeasurement 記録をIDで取得
+func (r *GORMBodyMeasurementRepository) GetBodyMeasurement(id domain.BodyMeasurementID) (*domain.BodyMeasurement, error) {
+	var row bodyMeasurementRow
+	if err := r.db.First(&row, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("body measurement %w (id=%d): %w", domain.ErrNotFound, id, err)
+		}
+		return nil, fmt.Errorf("failed to get body measurement (id=%d): %w", id, err)
+	}
+	return row.toDomain(), nil
+}
+
+// ListBodyMeasurements 測定日が期間内の記録を取得（測定日・IDの昇順）
+// 期間は日付の部分で比較する
+func (r *GORMBodyMeasurementRepository) ListBodyMeasurements(dateFrom, dateTo *time.Time) ([]*domain.BodyMeasurement, error) {
+	query := r.db.Order("measured_on").Order("id")
+	if dateFrom != nil {
+		query = query.Where("measured_on >= ?", dateOnly(*dateFrom))
+	}
+	if dateTo != nil {
+		query = query.Where("measured_on < ?", dateOnly(*dateTo))
+	}
+
+	var rows []bodyMeasurementRow
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list body measurements: %w", err)
+	}
+	measurements := make([]*domain.BodyMeasurement, 0, len(rows))
+	for i := range rows {
+		measurements = append(measurements, rows[i].toDomain())
+	}
+	return measurements, nil
+}
+
+// UpdateBodyMeasurement 記録を更新
+func (r *GORMBodyMeasurementRepository) UpdateBodyMeasurement(measurement *domain.BodyMeasurement) error {
+	measurement.UpdatedAt = time.Now()
+	row := newBodyMeasurementRow(measurement)
+	result := r.db.Model(&bodyMeasurementRow{ID: measurement.ID}).Updates(map[string]interface{}{
+		"measured_on":      row.MeasuredOn,
+		"bodyweight":       row.Bodyweight,
+		"body_fat_percent": row.BodyFatPercent,
+		"waist":            row.Waist,
+		"chest":            row.Chest,
+		"arm":              row.Arm,
+		"notes":            row.Notes,
+		"updated_at":       measurement.UpdatedAt,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update body measurement (id=%d): %w", measurement.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("body measurement %w (id=%d): %w", domain.ErrNotFound, measurement.ID, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// DeleteBodyMeasurement 記録を削除
+func (r *GORMBodyMeasurementRepository) DeleteBodyMeasurement(id domain.BodyMeasurementID) error {
+	result := r.db.Delete(&bodyMeasurementRow{}, id)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete body measurement (id=%d): %w", id, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("body measurement %w (id=%d): %w", domain.ErrNotFound, id, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// dateOnly DATE列に渡す値
+// DATE列はDSNの loc=Local で読み書きされるため、tのタイムゾーンでの年月日をLocalの0時として渡す
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// newBodyMeasurementRow ドメインモデルを行に変換
+func newBodyMeasurementRow(m *domain.BodyMeasurement) bodyMeasurementRow {
+	return bodyMeasurementRow{
+		ID:             m.ID,
+		MeasuredOn:     dateOnly(m.Date),
+		Bodyweight:     m.Bodyweight,
+		BodyFatPercent: m.BodyFatPercent,
+		Waist:          m.Waist,
+		Chest:          m.Chest,
+		Arm:            m.Arm,
+		Notes:          m.Notes,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+// toDomain 行をドメインモデルに変換（測定日はLocalの0時、ユースケースでユーザーのタイムゾーンに合わせる）
+func (row *bodyMeasurementRow) toDomain() *domain.BodyMeasurement {
+	return &domain.BodyMeasurement{
+		ID:             row.ID,
+		Date:           dateOnly(row.MeasuredOn),
+		Bodyweight:     row.Bodyweight,
+		BodyFatPercent: row.BodyFatPercent,
+		Waist:          row.Waist,
+		Chest:          row.Chest,
+		Arm:            row.Arm,
+		Notes:          row.Notes,
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+	}
+}
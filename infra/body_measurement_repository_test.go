@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestGORMBodyMeasurementRepository_ListBodyMeasurements 測定日での絞り込みのテスト
+func TestGORMBodyMeasurementRepository_ListBodyMeasurements(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	// JSTの5/1 0時はUTCでは4/30のため、年月日をそのまま渡すことを確認する
+	dateFrom := time.Date(2024, 5, 1, 0, 0, 0, 0, jst)
+	dateTo := time.Date(2024, 5, 8, 0, 0, 0, 0, jst)
+	measuredOn := time.Date(2024, 5, 3, 0, 0, 0, 0, time.Local)
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		dateFrom    *time.Time
+		dateTo      *time.Time
+		wantSQL     string
+		wantArgs    []driver.Value
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 期間の指定",
+			dateFrom:    &dateFrom,
+			dateTo:      &dateTo,
+			wantSQL:     "SELECT * FROM `body_measurements` WHERE measured_on >= ? AND measured_on < ? ORDER BY measured_on,id",
+			wantArgs:    []driver.Value{time.Date(2024, 5, 1, 0, 0, 0, 0, time.Local), time.Date(2024, 5, 8, 0, 0, 0, 0, time.Local)},
+			description: "指定したタイムゾーンの年月日で比較する",
+		},
+		{
+			name:        "正常系: 期間の指定なし",
+			wantSQL:     "SELECT * FROM `body_measurements` ORDER BY measured_on,id",
+			description: "全件を測定日順に取得",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			wantSQL:     "SELECT * FROM `body_measurements` ORDER BY measured_on,id",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutRepo, mock, db := setupMockDB(t)
+			defer db.Close()
+			repo := &GORMBodyMeasurementRepository{db: workoutRepo.db}
+
+			query := mock.ExpectQuery(regexp.QuoteMeta(tt.wantSQL))
+			if tt.wantArgs != nil {
+				query.WithArgs(tt.wantArgs...)
+			}
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"id", "measured_on", "bodyweight", "body_fat_percent", "waist", "chest", "arm", "notes", "created_at", "updated_at"}).
+					AddRow(1, measuredOn, 72.5, 15.0, 0, 0, 0, "", now, now))
+			}
+
+			measurements, err := repo.ListBodyMeasurements(tt.dateFrom, tt.dateTo)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ListBodyMeasurements() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if len(measurements) != 1 || measurements[0].Bodyweight != 72.5 || measurements[0].Date.Format(time.DateOnly) != "2024-05-03" {
+					t.Errorf("Unexpected measurements: %+v", measurements)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMBodyMeasurementRepository_DeleteBodyMeasurement 存在しない記録の削除がErrNotFoundになることをテスト
+func TestGORMBodyMeasurementRepository_DeleteBodyMeasurement(t *testing.T) {
+	workoutRepo, mock, db := setupMockDB(t)
+	defer db.Close()
+	repo := &GORMBodyMeasurementRepository{db: workoutRepo.db}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `body_measurements` WHERE `body_measurements`.`id` = ?")).
+		WithArgs(99).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.DeleteBodyMeasurement(99)
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled mock expectations: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// MockBodyMeasurementRepository テスト用の体重・身体測定リポジトリのモック実装
+type MockBodyMeasurementRepository struct {
+	measurements map[domain.BodyMeasurementID]*domain.BodyMeasurement
+	nextID       domain.BodyMeasurementID
+}
+
+// NewMockBodyMeasurementRepository 新しいモックリポジトリを作成
+func NewMockBodyMeasurementRepository() *MockBodyMeasurementRepository {
+	return &MockBodyMeasurementRepository{
+		measurements: make(map[domain.BodyMeasurementID]*domain.BodyMeasurement),
+		nextID:       1,
+	}
+}
+
+// CreateBodyMeasurement 記録を作成（メモリ上）
+func (m *MockBodyMeasurementRepository) CreateBodyMeasurement(measurement *domain.BodyMeasurement) error {
+	now := time.Now()
+	measurement.ID = m.nextID
+	measurement.CreatedAt = now
+	measurement.UpdatedAt = now
+	m.nextID++
+
+	stored := *measurement
+	m.measurements[measurement.ID] = &stored
+	return nil
+}
+
+// GetBodyMeasurement 記録をIDで取得
+func (m *MockBodyMeasurementRepository) GetBodyMeasurement(id domain.BodyMeasurementID) (*domain.BodyMeasurement, error) {
+	stored, exists := m.measurements[id]
+	if !exists {
+		return nil, fmt.Errorf("body measurement %w: id=%d", domain.ErrNotFound, id)
+	}
+	measurement := *stored
+	return &measurement, nil
+}
+
+// ListBodyMeasurements 測定日が期間内の記録を取得（メモリ上、測定日・IDの昇順）
+func (m *MockBodyMeasurementRepository) ListBodyMeasurements(dateFrom, dateTo *time.Time) ([]*domain.BodyMeasurement, error) {
+	measurements := make([]*domain.BodyMeasurement, 0, len(m.measurements))
+	for _, stored := range m.measurements {
+		if dateFrom != nil && stored.Date.Before(*dateFrom) {
+			continue
+		}
+		if dateTo != nil && !stored.Date.Before(*dateTo) {
+			continue
+		}
+		measurement := *stored
+		measurements = append(measurements, &measurement)
+	}
+	sort.Slice(measurements, func(i, j int) bool {
+		if !measurements[i].Date.Equal(measurements[j].Date) {
+			return measurements[i].Date.Before(measurements[j].Date)
+		}
+		return measurements[i].ID < measurements[j].ID
+	})
+	return measurements, nil
+}
+
+// UpdateBodyMeasurement 記録を更新（メモリ上）
+func (m *MockBodyMeasurementRepository) UpdateBodyMeasurement(measurement *domain.BodyMeasurement) error {
+	stored, exists := m.measurements[measurement.ID]
+	if !exists {
+		return fmt.Errorf("body measurement %w: id=%d", domain.ErrNotFound, measurement.ID)
+	}
+	measurement.CreatedAt = stored.CreatedAt
+	measurement.UpdatedAt = time.Now()
+	*stored = *measurement
+	return nil
+}
+
+// DeleteBodyMeasurement 記録を削除（メモリ上）
+func (m *MockBodyMeasurementRepository) DeleteBodyMeasurement(id domain.BodyMeasurementID) error {
+	if _, exists := m.measurements[id]; !exists {
+		return fmt.Errorf("body measurement %w: id=%d", domain.ErrNotFound, id)
+	}
+	delete(m.measurements, id)
+	return nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// MockProgramRepository テスト用のプログラムリポジトリのモック実装
+type MockProgramRepository struct {
+	programs       map[domain.ProgramID]*domain.Program
+	templates      map[domain.ProgramID]map[int][]*domain.WorkoutTemplate // プログラムID → バージョン → テンプレート
+	nextID         domain.ProgramID
+	nextTemplateID domain.TemplateID
+}
+
+// NewMockProgramRepository 新しいモックリポジトリを作成
+func NewMockProgramRepository() *MockProgramRepository {
+	return &MockProgramRepository{
+		programs:       make(map[domain.ProgramID]*domain.Program),
+		templates:      make(map[domain.ProgramID]map[int][]*domain.WorkoutTemplate),
+		nextID:         1,
+		nextTemplateID: 1,
+	}
+}
+
+// CreateProgram プログラムとバージョン1のテンプレートを作成（メモリ上）
+func (m *MockProgramRepository) CreateProgram(program *domain.Program) error {
+	now := time.Now()
+	program.ID = m.nextID
+	program.Version = 1
+	program.CreatedAt = now
+	program.UpdatedAt = now
+	m.nextID++
+
+	m.programs[program.ID] = &domain.Program{
+		ID:          program.ID,
+		Name:        program.Name,
+		Description: program.Description,
+		Version:     program.Version,
+		CreatedAt:   program.CreatedAt,
+		UpdatedAt:   program.UpdatedAt,
+	}
+	m.templates[program.ID] = map[int][]*domain.WorkoutTemplate{
+		1: m.storeTemplates(program.ID, 1, program.Templates),
+	}
+	return nil
+}
+
+// GetProgram 指定バージョンのテンプレートを含めてプログラムを取得（version が0の場合は最新）
+func (m *MockProgramRepository) GetProgram(id domain.ProgramID, version int) (*domain.Program, error) {
+	stored, exists := m.programs[id]
+	if !exists {
+		return nil, fmt.Errorf("program %w: id=%d", domain.ErrNotFound, id)
+	}
+	if version == 0 {
+		version = stored.Version
+	}
+	templates, exists := m.templates[id][version]
+	if !exists {
+		return nil, fmt.Errorf("program version %w: id=%d, version=%d", domain.ErrNotFound, id, version)
+	}
+
+	program := *stored
+	program.Version = version
+	program.Templates = templates
+	return &program, nil
+}
+
+// ListPrograms プログラム一覧を取得（ID順、テンプレートは含めない）
+func (m *MockProgramRepository) ListPrograms() ([]*domain.Program, error) {
+	programs := make([]*domain.Program, 0, len(m.programs))
+	for _, stored := range m.programs {
+		program := *stored
+		programs = append(programs, &program)
+	}
+	sort.Slice(programs, func(i, j int) bool { return programs[i].ID < programs[j].ID })
+	return programs, nil
+}
+
+// UpdateProgram 名前・説明を更新（メモリ上）
+func (m *MockProgramRepository) UpdateProgram(program *domain.Program) error {
+	stored, exists := m.programs[program.ID]
+	if !exists {
+		return fmt.Errorf("program %w: id=%d", domain.ErrNotFound, program.ID)
+	}
+	program.UpdatedAt = time.Now()
+	stored.Name = program.Name
+	stored.Description = program.Description
+	stored.UpdatedAt = program.UpdatedAt
+	return nil
+}
+
+// CreateProgramVersion バージョンを1つ上げて新しいテンプレートを保存（メモリ上）
+func (m *MockProgramRepository) CreateProgramVersion(id domain.ProgramID, templates []*domain.WorkoutTemplate) (*domain.Program, error) {
+	stored, exists := m.programs[id]
+	if !exists {
+		return nil, fmt.Errorf("program %w: id=%d", domain.ErrNotFound, id)
+	}
+	stored.Version++
+	stored.UpdatedAt = time.Now()
+	m.templates[id][stored.Version] = m.storeTemplates(id, stored.Version, templates)
+
+	program := *stored
+	program.Templates = templates
+	return &program, nil
+}
+
+// DeleteProgram プログラムと全バージョンのテンプレートを削除（メモリ上）
+func (m *MockProgramRepository) DeleteProgram(id domain.ProgramID) error {
+	if _, exists := m.programs[id]; !exists {
+		return fmt.Errorf("program %w: id=%d", domain.ErrNotFound, id)
+	}
+	delete(m.programs, id)
+	delete(m.templates, id)
+	return nil
+}
+
+// storeTemplates テンプレートにIDとバージョンを設定する
+func (m *MockProgramRepository) storeTemplates(programID domain.ProgramID, version int, templates []*domain.WorkoutTemplate) []*domain.WorkoutTemplate {
+	for i, t := range templates {
+		t.ID = m.nextTemplateID
+		t.ProgramID = programID
+		t.Version = version
+		t.Position = i
+		m.nextTemplateID++
+	}
+	return templates
+}
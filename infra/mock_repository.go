@@ -2,14 +2,18 @@ package repository
 
 import (
 	"fmt"
+	"sort"
+	"time"
 
 	"golv2-learning-app/domain"
 )
 
 // MockWorkoutRepository テスト用のモック実装
 type MockWorkoutRepository struct {
-	workouts map[domain.WorkoutID]*domain.Workout
-	nextID   domain.WorkoutID
+	workouts  map[domain.WorkoutID]*domain.Workout
+	nextID    domain.WorkoutID
+	sets      []*domain.WorkoutSet
+	nextSetID domain.WorkoutSetID
 }
 
 // NewMockWorkoutRepository 新しいモックリポジトリを作成
@@ -21,18 +25,45 @@ func NewMockWorkoutRepository() *MockWorkoutRepository {
 }
 
 // CreateWorkout ワークアウトを作成（メモリ上）
+// 取り込み識別子はDBの一意インデックスと同じく重複を許さない
 func (m *MockWorkoutRepository) CreateWorkout(workout *domain.Workout) error {
+	if workout.ImportKey != "" {
+		for _, existing := range m.workouts {
+			if existing.ImportKey == workout.ImportKey {
+				return fmt.Errorf("workout %w: import_key=%s", domain.ErrAlreadyExists, workout.ImportKey)
+			}
+		}
+	}
 	workout.ID = m.nextID
 	m.workouts[m.nextID] = workout
 	m.nextID++
 	return nil
 }
 
+// CreateWorkouts 複数のワークアウトを作成（メモリ上、失敗した場合は作成した分を取り消す）
+func (m *MockWorkoutRepository) CreateWorkouts(workouts []*domain.Workout) error {
+	for i, workout := range workouts {
+		if err := m.CreateWorkout(workout); err != nil {
+			for _, created := range workouts[:i] {
+				delete(m.workouts, created.ID)
+				created.ID = 0
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// BatchCreateWorkouts 複数のワークアウトを作成（メモリ上）
+func (m *MockWorkoutRepository) BatchCreateWorkouts(workouts []*domain.Workout, batchSize int) error {
+	return m.CreateWorkouts(workouts)
+}
+
 // GetWorkout ワークアウトをIDで取得
 func (m *MockWorkoutRepository) GetWorkout(id domain.WorkoutID) (*domain.Workout, error) {
 	workout, exists := m.workouts[id]
 	if !exists {
-		return nil, fmt.Errorf("workout not found: id=%d", id)
+		return nil, fmt.Errorf("workout %w: id=%d", domain.ErrNotFound, id)
 	}
 	return workout, nil
 }
@@ -40,16 +71,54 @@ func (m *MockWorkoutRepository) GetWorkout(id domain.WorkoutID) (*domain.Workout
 // UpdateWorkout ワークアウトを更新
 func (m *MockWorkoutRepository) UpdateWorkout(workout *domain.Workout) error {
 	if _, exists := m.workouts[workout.ID]; !exists {
-		return fmt.Errorf("workout not found: id=%d", workout.ID)
+		return fmt.Errorf("workout %w: id=%d", domain.ErrNotFound, workout.ID)
 	}
 	m.workouts[workout.ID] = workout
 	return nil
 }
 
+// GetWorkouts 指定したIDのワークアウトのコピーを取得（メモリ上、存在しないIDは含めない）
+// コピーを返すため、保存するまで変更は反映されない
+func (m *MockWorkoutRepository) GetWorkouts(ids []domain.WorkoutID) ([]*domain.Workout, error) {
+	workouts := make([]*domain.Workout, 0, len(ids))
+	for _, id := range ids {
+		if workout, exists := m.workouts[id]; exists {
+			copied := *workout
+			workouts = append(workouts, &copied)
+		}
+	}
+	return workouts, nil
+}
+
+// BatchUpdateWorkouts 複数のワークアウトを更新（メモリ上、1件でも存在しなければ何も更新しない）
+func (m *MockWorkoutRepository) BatchUpdateWorkouts(workouts []*domain.Workout) error {
+	for _, workout := range workouts {
+		if _, exists := m.workouts[workout.ID]; !exists {
+			return fmt.Errorf("workout %w: id=%d", domain.ErrNotFound, workout.ID)
+		}
+	}
+	for _, workout := range workouts {
+		m.workouts[workout.ID] = workout
+	}
+	return nil
+}
+
+// BatchDeleteWorkouts 指定したIDのワークアウトを削除し、削除した件数を返す（メモリ上）
+func (m *MockWorkoutRepository) BatchDeleteWorkouts(ids []domain.WorkoutID) (int, error) {
+	deleted := 0
+	for _, id := range ids {
+		if _, exists := m.workouts[id]; exists {
+			delete(m.workouts, id)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
 // DeleteWorkout ワークアウトを削除
 func (m *MockWorkoutRepository) DeleteWorkout(id domain.WorkoutID) error {
 	if _, exists := m.workouts[id]; !exists {
-		return fmt.Errorf("workout not found: id=%d", id)
+		return fmt.Errorf("workout %w: id=%d", domain.ErrNotFound, id)
 	}
 	delete(m.workouts, id)
 	return nil
@@ -78,3 +147,317 @@ func (m *MockWorkoutRepository) ListWorkouts(statusFilter *int, difficultyFilter
 func (m *MockWorkoutRepository) GetWorkoutCount() (int, error) {
 	return len(m.workouts), nil
 }
+
+// FindHighIntensityWorkouts 高強度ルールに一致するワークアウトを取得（メモリ上でルールを評価）
+func (m *MockWorkoutRepository) FindHighIntensityWorkouts(query domain.IntensityQuery) ([]*domain.IntensityMatch, error) {
+	// 種目ごとのベストe1RM（GORM実装のサブクエリと同じ計算）
+	bestOneRepMax := make(map[domain.ExerciseType]float64)
+	for _, workout := range m.workouts {
+		if oneRepMax := domain.EstimateOneRepMax(workout.Weight, workout.Reps); oneRepMax > bestOneRepMax[workout.ExerciseType] {
+			bestOneRepMax[workout.ExerciseType] = oneRepMax
+		}
+	}
+
+	matches := make([]*domain.IntensityMatch, 0)
+	for _, workout := range m.workouts {
+		for _, rule := range query.Rules {
+			if rule.Matches(workout, bestOneRepMax[workout.ExerciseType], query.Bodyweight) {
+				matches = append(matches, &domain.IntensityMatch{Workout: workout, MatchedRule: rule.Name})
+				break
+			}
+		}
+	}
+
+	// マップの走査順は不定のためIDの降順（作成の新しい順）に並べる
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].Workout.ID > matches[j].Workout.ID
+	})
+	return matches, nil
+}
+
+// GetTrainingStats 集計期間ごとのトレーニング統計を取得（メモリ上で集計）
+func (m *MockWorkoutRepository) GetTrainingStats(query domain.TrainingStatsQuery) ([]*domain.TrainingStatsBucket, error) {
+	bucketByStart := make(map[time.Time]*domain.TrainingStatsBucket)
+	difficultySum := make(map[time.Time]int)
+
+	for _, workout := range m.workouts {
+		activityAt := workout.ActivityAt()
+		if query.DateFrom != nil && activityAt.Before(*query.DateFrom) {
+			continue
+		}
+		if query.DateTo != nil && !activityAt.Before(*query.DateTo) {
+			continue
+		}
+
+		if query.Location != nil {
+			activityAt = activityAt.In(query.Location)
+		}
+		start := query.Period.BucketStart(activityAt)
+		b, exists := bucketByStart[start]
+		if !exists {
+			b = &domain.TrainingStatsBucket{
+				PeriodStart:       start,
+				SetsByMuscleGroup: make(map[domain.MuscleGroup]int),
+			}
+			bucketByStart[start] = b
+		}
+
+		b.WorkoutCount++
+		difficultySum[start] += int(workout.Difficulty)
+		switch workout.Status {
+		case domain.WorkoutStatusCompleted:
+			b.CompletedCount++
+			b.TotalVolume += workout.Volume()
+			b.TotalSets += workout.Sets
+			b.SetsByMuscleGroup[workout.MuscleGroup] += workout.Sets
+		case domain.WorkoutStatusSkipped:
+			b.SkippedCount++
+		}
+	}
+
+	buckets := make([]*domain.TrainingStatsBucket, 0, len(bucketByStart))
+	for start, b := range bucketByStart {
+		b.AverageDifficulty = float64(difficultySum[start]) / float64(b.WorkoutCount)
+		buckets = append(buckets, b)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].PeriodStart.Before(buckets[j].PeriodStart)
+	})
+	return buckets, nil
+}
+
+// GetCompletedSetsByExercise 期間内に完了したセット数を種目・筋肉群ごとに集計（メモリ上）
+func (m *MockWorkoutRepository) GetCompletedSetsByExercise(dateFrom, dateTo *time.Time) ([]*domain.ExerciseSets, error) {
+	type key struct {
+		exerciseType domain.ExerciseType
+		muscleGroup  domain.MuscleGroup
+	}
+	setsByKey := make(map[key]*domain.ExerciseSets)
+	for _, workout := range m.workouts {
+		if workout.Status != domain.WorkoutStatusCompleted {
+			continue
+		}
+		activityAt := workout.ActivityAt()
+		if dateFrom != nil && activityAt.Before(*dateFrom) {
+			continue
+		}
+		if dateTo != nil && !activityAt.Before(*dateTo) {
+			continue
+		}
+		k := key{workout.ExerciseType, workout.MuscleGroup}
+		if _, exists := setsByKey[k]; !exists {
+			setsByKey[k] = &domain.ExerciseSets{ExerciseType: k.exerciseType, MuscleGroup: k.muscleGroup}
+		}
+		setsByKey[k].Sets += workout.Sets
+	}
+
+	result := make([]*domain.ExerciseSets, 0, len(setsByKey))
+	for _, sets := range setsByKey {
+		result = append(result, sets)
+	}
+	return result, nil
+}
+
+// GetCompletionTimes 完了したワークアウトの完了日時を取得（メモリ上、古い順）
+func (m *MockWorkoutRepository) GetCompletionTimes(dateFrom, dateTo *time.Time) ([]time.Time, error) {
+	times := make([]time.Time, 0, len(m.workouts))
+	for _, workout := range m.workouts {
+		if workout.Status != domain.WorkoutStatusCompleted || workout.CompletedAt == nil {
+			continue
+		}
+		completedAt := *workout.CompletedAt
+		if dateFrom != nil && completedAt.Before(*dateFrom) {
+			continue
+		}
+		if dateTo != nil && !completedAt.Before(*dateTo) {
+			continue
+		}
+		times = append(times, completedAt)
+	}
+	sort.Slice(times, func(i, j int) bool { return times[i].Before(times[j]) })
+	return times, nil
+}
+
+// ListWorkoutsByActivity 実施日時が期間内のワークアウトを取得（メモリ上、実施日時の昇順）
+func (m *MockWorkoutRepository) ListWorkoutsByActivity(dateFrom, dateTo *time.Time) ([]*domain.Workout, error) {
+	workouts := make([]*domain.Workout, 0, len(m.workouts))
+	for _, workout := range m.workouts {
+		activityAt := workout.ActivityAt()
+		if dateFrom != nil && activityAt.Before(*dateFrom) {
+			continue
+		}
+		if dateTo != nil && !activityAt.Before(*dateTo) {
+			continue
+		}
+		workouts = append(workouts, workout)
+	}
+	sort.Slice(workouts, func(i, j int) bool {
+		return workouts[i].ActivityAt().Before(workouts[j].ActivityAt())
+	})
+	return workouts, nil
+}
+
+// GetExerciseHistory 種目ごとの完了済みワークアウトを直近limit件取得（メモリ上、古い順）
+func (m *MockWorkoutRepository) GetExerciseHistory(exerciseType domain.ExerciseType, programID *domain.ProgramID, limit int) ([]*domain.Workout, error) {
+	workouts := make([]*domain.Workout, 0, limit)
+	for _, workout := range m.workouts {
+		if workout.ExerciseType != exerciseType || workout.Status != domain.WorkoutStatusCompleted || workout.CompletedAt == nil {
+			continue
+		}
+		if programID != nil && (workout.ProgramID == nil || *workout.ProgramID != *programID) {
+			continue
+		}
+		workouts = append(workouts, workout)
+	}
+	sort.Slice(workouts, func(i, j int) bool {
+		return workouts[i].CompletedAt.Before(*workouts[j].CompletedAt)
+	})
+	if len(workouts) > limit {
+		workouts = workouts[len(workouts)-limit:]
+	}
+	return workouts, nil
+}
+
+// MarkMissedWorkouts 予定日時を過ぎた予定をスキップ（未実施）に変更（メモリ上）
+func (m *MockWorkoutRepository) MarkMissedWorkouts(scheduledBefore time.Time) ([]*domain.Workout, error) {
+	var missed []*domain.Workout
+	for _, workout := range m.workouts {
+		if !workout.IsMissed(scheduledBefore) {
+			continue
+		}
+		workout.Status = domain.WorkoutStatusSkipped
+		workout.SkipReason = domain.SkipReasonMissed
+		workout.UpdatedAt = time.Now()
+		updated := *workout
+		missed = append(missed, &updated)
+	}
+	return missed, nil
+}
+
+// ListScheduledWorkouts 実施予定日時が期間内のワークアウトを取得（メモリ上、予定日時の昇順）
+func (m *MockWorkoutRepository) ListScheduledWorkouts(dateFrom, dateTo time.Time, statuses []domain.WorkoutStatus) ([]*domain.Workout, error) {
+	workouts := make([]*domain.Workout, 0, len(m.workouts))
+	for _, workout := range m.workouts {
+		if workout.ScheduledFor == nil || workout.ScheduledFor.Before(dateFrom) || !workout.ScheduledFor.Before(dateTo) {
+			continue
+		}
+		if len(statuses) > 0 && !containsStatus(statuses, workout.Status) {
+			continue
+		}
+		workouts = append(workouts, workout)
+	}
+	sort.Slice(workouts, func(i, j int) bool {
+		return workouts[i].ScheduledFor.Before(*workouts[j].ScheduledFor)
+	})
+	return workouts, nil
+}
+
+// containsStatus ステータスの一覧に含まれるか
+func containsStatus(statuses []domain.WorkoutStatus, status domain.WorkoutStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// FindImportKeys 指定した取り込み識別子のうち、既に保存されているものを返す（メモリ上）
+func (m *MockWorkoutRepository) FindImportKeys(keys []string) ([]string, error) {
+	wanted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+	}
+	existing := make([]string, 0, len(keys))
+	for _, workout := range m.workouts {
+		if workout.ImportKey != "" && wanted[workout.ImportKey] {
+			existing = append(existing, workout.ImportKey)
+			delete(wanted, workout.ImportKey)
+		}
+	}
+	return existing, nil
+}
+
+// StreamWorkouts フィルタに一致するワークアウトをID順にbatchSize件ずつfnに渡す（メモリ上）
+func (m *MockWorkoutRepository) StreamWorkouts(filter domain.WorkoutFilter, batchSize int, fn func(batch []*domain.Workout) error) error {
+	workouts := make([]*domain.Workout, 0, len(m.workouts))
+	for _, workout := range m.workouts {
+		if matchesWorkoutFilter(workout, filter) {
+			workouts = append(workouts, workout)
+		}
+	}
+	sort.Slice(workouts, func(i, j int) bool {
+		return workouts[i].ID < workouts[j].ID
+	})
+	for start := 0; start < len(workouts); start += batchSize {
+		end := min(start+batchSize, len(workouts))
+		if err := fn(workouts[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesWorkoutFilter ワークアウトがフィルタ条件を全て満たすか
+func matchesWorkoutFilter(workout *domain.Workout, filter domain.WorkoutFilter) bool {
+	activityAt := workout.ActivityAt()
+	switch {
+	case filter.Status != nil && workout.Status != *filter.Status,
+		filter.Difficulty != nil && workout.Difficulty != *filter.Difficulty,
+		filter.MuscleGroup != nil && workout.MuscleGroup != *filter.MuscleGroup,
+		filter.MinWeight != nil && workout.Weight < *filter.MinWeight,
+		filter.MaxWeight != nil && workout.Weight > *filter.MaxWeight,
+		filter.DateFrom != nil && activityAt.Before(*filter.DateFrom),
+		filter.DateTo != nil && !activityAt.Before(*filter.DateTo):
+		return false
+	}
+	return true
+}
+
+// CreateWorkoutSet 記録したセットを保存（メモリ上）
+func (m *MockWorkoutRepository) CreateWorkoutSet(set *domain.WorkoutSet) error {
+	if _, exists := m.workouts[set.WorkoutID]; !exists {
+		return fmt.Errorf("workout %w: id=%d", domain.ErrNotFound, set.WorkoutID)
+	}
+	m.nextSetID++
+	set.ID = m.nextSetID
+	m.sets = append(m.sets, set)
+	return nil
+}
+
+// ListWorkoutSets ワークアウトで記録したセットを取得（メモリ上、セット番号の昇順）
+func (m *MockWorkoutRepository) ListWorkoutSets(workoutID domain.WorkoutID) ([]*domain.WorkoutSet, error) {
+	sets := make([]*domain.WorkoutSet, 0)
+	for _, set := range m.sets {
+		if set.WorkoutID == workoutID {
+			sets = append(sets, set)
+		}
+	}
+	sort.Slice(sets, func(i, j int) bool {
+		return sets[i].SetNumber < sets[j].SetNumber
+	})
+	return sets, nil
+}
+
+// GetExerciseBest 完了済みワークアウトと記録したセットから種目の自己ベストを取得（メモリ上）
+func (m *MockWorkoutRepository) GetExerciseBest(exerciseType domain.ExerciseType) (*domain.ExerciseBest, error) {
+	best := &domain.ExerciseBest{}
+	record := func(weight float64, reps int) {
+		if reps <= 0 {
+			return
+		}
+		best.MaxWeight = max(best.MaxWeight, weight)
+		best.OneRepMax = max(best.OneRepMax, domain.EstimateOneRepMax(weight, reps))
+	}
+	for _, workout := range m.workouts {
+		if workout.ExerciseType == exerciseType && workout.Status == domain.WorkoutStatusCompleted {
+			record(workout.Weight, workout.Reps)
+		}
+	}
+	for _, set := range m.sets {
+		if workout, exists := m.workouts[set.WorkoutID]; exists && workout.ExerciseType == exerciseType {
+			record(set.Weight, set.Reps)
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,262 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// programRow programsテーブルの行
+type programRow struct {
+	ID          domain.ProgramID `gorm:"primaryKey"`
+	Name        string
+	Description string
+	Version     int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (programRow) TableName() string { return "programs" }
+
+// workoutTemplateRow workout_templatesテーブルの行（種目とセット構成はJSONで保存）
+type workoutTemplateRow struct {
+	ID        domain.TemplateID `gorm:"primaryKey"`
+	ProgramID domain.ProgramID
+	Version   int
+	Position  int
+	Name      string
+	DayOfWeek int
+	Exercises string
+}
+
+func (workoutTemplateRow) TableName() string { return "workout_templates" }
+
+// GORMProgramRepository GORMを使用したプログラムリポジトリ実装
+type GORMProgramRepository struct {
+	db *gorm.DB
+}
+
+// NewGORMProgramRepository 接続済みのGORM DBインスタンスからプログラムリポジトリを作成
+func NewGORMProgramRepository(db *gorm.DB) *GORMProgramRepository {
+	return &GORMProgramRepository{db: db}
+}
+
+// WithContext クエリにコンテキストを渡すリポジトリを返す
+func (r *GORMProgramRepository) WithContext(ctx context.Context) domain.ProgramRepository {
+	return &GORMProgramRepository{db: r.db.WithContext(ctx)}
+}
+
+// CreateProgram プログラムとバージョン1のテンプレートを作成
+func (r *GORMProgramRepository) CreateProgram(program *domain.Program) error {
+	now := time.Now()
+	row := programRow{
+		Name:        program.Name,
+		Description: program.Description,
+		Version:     1,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&row).Error; err != nil {
+			return err
+		}
+		return createTemplates(tx, row.ID, row.Version, program.Templates)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create program (name=%s): %w", program.Name, err)
+	}
+
+	program.ID = row.ID
+	program.Version = row.Version
+	program.CreatedAt = row.CreatedAt
+	program.UpdatedAt = row.UpdatedAt
+	return nil
+}
+
+// GetProgram 指定バージョンのテンプレートを含めてプログラムを取得（version が0の場合は最新）
+func (r *GORMProgramRepository) GetProgram(id domain.ProgramID, version int) (*domain.Program, error) {
+	var row programRow
+	if err := r.db.First(&row, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("program %w (id=%d): %w", domain.ErrNotFound, id, err)
+		}
+		return nil, fmt.Errorf("failed to get program (id=%d): %w", id, err)
+	}
+	if version == 0 {
+		version = row.Version
+	}
+	if version < 1 || version > row.Version {
+		return nil, fmt.Errorf("program version %w (id=%d, version=%d, latest=%d)", domain.ErrNotFound, id, version, row.Version)
+	}
+
+	templates, err := findTemplates(r.db, id, version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get program templates (id=%d, version=%d): %w", id, version, err)
+	}
+
+	program := row.toDomain()
+	program.Version = version
+	program.Templates = templates
+	return program, nil
+}
+
+// ListPrograms プログラム一覧を取得（テンプレートは含めない）
+func (r *GORMProgramRepository) ListPrograms() ([]*domain.Program, error) {
+	var rows []programRow
+	if err := r.db.Order("id").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to list programs: %w", err)
+	}
+	programs := make([]*domain.Program, 0, len(rows))
+	for i := range rows {
+		programs = append(programs, rows[i].toDomain())
+	}
+	return programs, nil
+}
+
+// UpdateProgram 名前・説明を更新（バージョンは変えない）
+func (r *GORMProgramRepository) UpdateProgram(program *domain.Program) error {
+	program.UpdatedAt = time.Now()
+	result := r.db.Model(&programRow{ID: program.ID}).Updates(map[string]interface{}{
+		"name":        program.Name,
+		"description": program.Description,
+		"updated_at":  program.UpdatedAt,
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to update program (id=%d): %w", program.ID, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("program %w (id=%d): %w", domain.ErrNotFound, program.ID, gorm.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// CreateProgramVersion バージョンを1つ上げて新しいテンプレートを保存
+// 同時に編集された場合に同じバージョンを作らないよう、プログラムの行をロックする
+func (r *GORMProgramRepository) CreateProgramVersion(id domain.ProgramID, templates []*domain.WorkoutTemplate) (*domain.Program, error) {
+	var row programRow
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&row, id).Error; err != nil {
+			return err
+		}
+		row.Version++
+		row.UpdatedAt = time.Now()
+		if err := createTemplates(tx, id, row.Version, templates); err != nil {
+			return err
+		}
+		return tx.Model(&programRow{ID: id}).Updates(map[string]interface{}{
+			"version":    row.Version,
+			"updated_at": row.UpdatedAt,
+		}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("program %w (id=%d): %w", domain.ErrNotFound, id, err)
+		}
+		return nil, fmt.Errorf("failed to create program version (id=%d): %w", id, err)
+	}
+
+	program := row.toDomain()
+	program.Templates = templates
+	return program, nil
+}
+
+// DeleteProgram プログラムと全バージョンのテンプレートを削除
+// 生成済みのワークアウトは履歴として残す
+func (r *GORMProgramRepository) DeleteProgram(id domain.ProgramID) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("program_id = ?", id).Delete(&workoutTemplateRow{}).Error; err != nil {
+			return err
+		}
+		result := tx.Delete(&programRow{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("program %w: %w", domain.ErrNotFound, gorm.ErrRecordNotFound)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete program (id=%d): %w", id, err)
+	}
+	return nil
+}
+
+// createTemplates テンプレートを指定バージョンとして保存し、採番されたIDを設定する
+func createTemplates(tx *gorm.DB, programID domain.ProgramID, version int, templates []*domain.WorkoutTemplate) error {
+	if len(templates) == 0 {
+		return nil
+	}
+	rows := make([]workoutTemplateRow, 0, len(templates))
+	for i, t := range templates {
+		exercises, err := json.Marshal(t.Exercises)
+		if err != nil {
+			return fmt.Errorf("failed to encode template exercises (name=%s): %w", t.Name, err)
+		}
+		rows = append(rows, workoutTemplateRow{
+			ProgramID: programID,
+			Version:   version,
+			Position:  i,
+			Name:      t.Name,
+			DayOfWeek: int(t.DayOfWeek),
+			Exercises: string(exercises),
+		})
+	}
+	if err := tx.Create(&rows).Error; err != nil {
+		return err
+	}
+	for i, t := range templates {
+		t.ID = rows[i].ID
+		t.ProgramID = programID
+		t.Version = version
+		t.Position = i
+	}
+	return nil
+}
+
+// findTemplates 指定バージョンのテンプレートを並び順で取得
+func findTemplates(db *gorm.DB, programID domain.ProgramID, version int) ([]*domain.WorkoutTemplate, error) {
+	var rows []workoutTemplateRow
+	err := db.Where("program_id = ? AND version = ?", programID, version).
+		Order("position").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]*domain.WorkoutTemplate, 0, len(rows))
+	for _, row := range rows {
+		t := &domain.WorkoutTemplate{
+			ID:        row.ID,
+			ProgramID: row.ProgramID,
+			Version:   row.Version,
+			Position:  row.Position,
+			Name:      row.Name,
+			DayOfWeek: time.Weekday(row.DayOfWeek),
+		}
+		if err := json.Unmarshal([]byte(row.Exercises), &t.Exercises); err != nil {
+			return nil, fmt.Errorf("failed to decode template exercises (id=%d): %w", row.ID, err)
+		}
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
+
+// toDomain 行をドメインモデルに変換
+func (row *programRow) toDomain() *domain.Program {
+	return &domain.Program{
+		ID:          row.ID,
+		Name:        row.Name,
+		Description: row.Description,
+		Version:     row.Version,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestGORMProgramRepository_GetProgram バージョン指定でのプログラム取得のテスト
+func TestGORMProgramRepository_GetProgram(t *testing.T) {
+	now := time.Now()
+	exercisesJSON := `[{"exercise_type":2,"difficulty":1,"weeks":[[{"sets":1,"reps":5,"percent_of_training_max":0.65}]]}]`
+
+	tests := []struct {
+		name          string
+		version       int
+		mockError     error
+		wantVersion   int
+		wantTemplates bool // テンプレートのクエリが実行されること
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: 最新バージョン",
+			version:       0,
+			wantVersion:   2,
+			wantTemplates: true,
+			description:   "version=0は最新バージョンのテンプレートを取得",
+		},
+		{
+			name:          "正常系: 過去のバージョン",
+			version:       1,
+			wantVersion:   1,
+			wantTemplates: true,
+			description:   "過去のバージョンのテンプレートも取得できる",
+		},
+		{
+			name:        "異常系: 存在しないバージョン",
+			version:     3,
+			wantErr:     true,
+			description: "最新より新しいバージョンはエラー",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			version:     0,
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutRepo, mock, db := setupMockDB(t)
+			defer db.Close()
+			repo := &GORMProgramRepository{db: workoutRepo.db}
+
+			programQuery := mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `programs` WHERE `programs`.`id` = ?")).
+				WithArgs(1)
+			if tt.mockError != nil {
+				programQuery.WillReturnError(tt.mockError)
+			} else {
+				programQuery.WillReturnRows(sqlmock.NewRows([]string{"id", "name", "description", "version", "created_at", "updated_at"}).
+					AddRow(1, "5/3/1", "", 2, now, now))
+			}
+			if tt.wantTemplates {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workout_templates` WHERE program_id = ? AND version = ? ORDER BY position")).
+					WithArgs(1, tt.wantVersion).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "program_id", "version", "position", "name", "day_of_week", "exercises"}).
+						AddRow(10, 1, tt.wantVersion, 0, "Squat Day", int(time.Monday), exercisesJSON))
+			}
+
+			program, err := repo.GetProgram(1, tt.version)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetProgram() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if program.Version != tt.wantVersion {
+					t.Errorf("Expected version %d, got %d", tt.wantVersion, program.Version)
+				}
+				if len(program.Templates) != 1 || len(program.Templates[0].Exercises) != 1 {
+					t.Fatalf("Expected 1 template with 1 exercise, got %+v", program.Templates)
+				}
+				scheme := program.Templates[0].Exercises[0].SchemeForWeek(0)
+				if program.Templates[0].Exercises[0].ExerciseType != domain.Squat || scheme[0].PercentOfTrainingMax != 0.65 {
+					t.Errorf("Unexpected decoded exercise: %+v", program.Templates[0].Exercises[0])
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// registerQueryCallbacks GORM標準のクエリのコールバックの前後に処理を登録する
+// before・afterは操作（create・query・update・delete・row・raw）ごとに呼び出され、その操作のコールバックを返す
+func registerQueryCallbacks(db *gorm.DB, prefix string, before, after func(operation string) func(*gorm.DB)) error {
+	callback := db.Callback()
+	processors := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("gorm:create").Register, callback.Create().After("gorm:create").Register},
+		{"query", callback.Query().Before("gorm:query").Register, callback.Query().After("gorm:query").Register},
+		{"update", callback.Update().Before("gorm:update").Register, callback.Update().After("gorm:update").Register},
+		{"delete", callback.Delete().Before("gorm:delete").Register, callback.Delete().After("gorm:delete").Register},
+		{"row", callback.Row().Before("gorm:row").Register, callback.Row().After("gorm:row").Register},
+		{"raw", callback.Raw().Before("gorm:raw").Register, callback.Raw().After("gorm:raw").Register},
+	}
+	for _, p := range processors {
+		if err := p.before(prefix+":before_"+p.operation, before(p.operation)); err != nil {
+			return fmt.Errorf("failed to register %s %s callback: %w", p.operation, prefix, err)
+		}
+		if err := p.after(prefix+":after_"+p.operation, after(p.operation)); err != nil {
+			return fmt.Errorf("failed to register %s %s callback: %w", p.operation, prefix, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"time"
+
+	"golv2-learning-app/metrics"
+
+	"gorm.io/gorm"
+)
+
+// queryStartKey クエリの開始時刻を保存するGORMのインスタンス変数
+const queryStartKey = "metrics:query_start"
+
+// RegisterQueryMetrics GORMのコールバックでクエリごとの処理時間を記録する
+// 全てのリポジトリ（ワークアウト・プログラム・統計）のクエリが対象になる
+func RegisterQueryMetrics(db *gorm.DB, m *metrics.Metrics) error {
+	return registerQueryCallbacks(db, "metrics",
+		func(string) func(*gorm.DB) { return startQuery },
+		func(operation string) func(*gorm.DB) { return observeQuery(m, operation) })
+}
+
+// startQuery クエリの開始時刻を保存する
+func startQuery(db *gorm.DB) {
+	db.InstanceSet(queryStartKey, time.Now())
+}
+
+// observeQuery 保存した開始時刻からの処理時間をテーブル名とともに記録する
+func observeQuery(m *metrics.Metrics, operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(queryStartKey)
+		if !ok {
+			return
+		}
+		start, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		table := db.Statement.Table
+		if table == "" {
+			table = "unknown"
+		}
+		m.QueryObserved(operation, table, time.Since(start))
+	}
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/metrics"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestRegisterQueryMetrics テーブル駆動テストでクエリの処理時間とコネクションプールの状態の記録をテスト
+func TestRegisterQueryMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(mock sqlmock.Sqlmock)
+		run         func(repo *GORMRepository) error
+		wantErr     bool
+		wantLines   []string
+		description string
+	}{
+		{
+			name: "正常系: 取得",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectWorkoutQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type"}).AddRow(1, domain.Squat))
+			},
+			run: func(repo *GORMRepository) error {
+				_, err := repo.GetWorkout(1)
+				return err
+			},
+			wantLines: []string{
+				`golv2_db_query_duration_seconds_count{operation="query",table="workouts"} 1`,
+			},
+			description: "SELECTはquery、テーブル名付きで記録される",
+		},
+		{
+			name: "異常系: 削除の失敗",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(deleteWorkoutQuery).WithArgs(1).WillReturnError(errors.New("connection lost"))
+				mock.ExpectRollback()
+			},
+			run: func(repo *GORMRepository) error {
+				return repo.DeleteWorkout(1)
+			},
+			wantErr: true,
+			wantLines: []string{
+				`golv2_db_query_duration_seconds_count{operation="delete",table="workouts"} 1`,
+			},
+			description: "失敗したクエリも処理時間を記録する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+			m := metrics.New()
+			if err := RegisterQueryMetrics(repo.db, m); err != nil {
+				t.Fatalf("RegisterQueryMetrics() error = %v", err)
+			}
+			if err := m.RegisterDBStats(db, "workoutdb"); err != nil {
+				t.Fatalf("RegisterDBStats() error = %v", err)
+			}
+
+			tt.setupMock(mock)
+			if err := tt.run(repo); (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			rec := httptest.NewRecorder()
+			m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metrics.Path, nil))
+			body := rec.Body.String()
+			for _, want := range append(tt.wantLines, `go_sql_open_connections{db_name="workoutdb"}`) {
+				if !strings.Contains(body, want) {
+					t.Errorf("Expected %q in:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// querySpanKey クエリのスパンを保存するGORMのインスタンス変数
+const querySpanKey = "tracing:query_span"
+
+// tracerName DBのスパンを作成するTracerの名前
+const tracerName = "golv2-learning-app/infra"
+
+// RegisterTracing GORMのコールバックでクエリごとのスパンを作成する
+// 親のスパンはクエリのコンテキスト（WithContextで渡したもの）から引き継ぎ、SQL文（値はプレースホルダのまま）を属性に記録する
+func RegisterTracing(db *gorm.DB, tp trace.TracerProvider) error {
+	tracer := tp.Tracer(tracerName)
+	return registerQueryCallbacks(db, "tracing",
+		func(operation string) func(*gorm.DB) { return startQuerySpan(tracer, operation) },
+		func(string) func(*gorm.DB) { return endQuerySpan })
+}
+
+// startQuerySpan クエリのスパンを開始して保存する（スパン名の例: "gorm.query workouts"）
+func startQuerySpan(tracer trace.Tracer, operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		name := "gorm." + operation
+		if db.Statement.Table != "" {
+			name += " " + db.Statement.Table
+		}
+		_, span := tracer.Start(db.Statement.Context, name,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(semconv.DBSystemMySQL, semconv.DBOperation(operation)))
+		db.InstanceSet(querySpanKey, span)
+	}
+}
+
+// endQuerySpan 実行したSQL文・影響した行数・エラーを記録してスパンを終了する
+func endQuerySpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(querySpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if db.Statement.Table != "" {
+		span.SetAttributes(semconv.DBSQLTable(db.Statement.Table))
+	}
+	if statement := strings.TrimSpace(db.Statement.SQL.String()); statement != "" {
+		span.SetAttributes(semconv.DBStatement(statement))
+	}
+	span.SetAttributes(attribute.Int64("db.rows_affected", db.RowsAffected))
+	if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+		span.RecordError(db.Error)
+		span.SetStatus(codes.Error, db.Error.Error())
+	}
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// TestRegisterTracing テーブル駆動テストでクエリごとのスパンの作成をテスト
+func TestRegisterTracing(t *testing.T) {
+	tests := []struct {
+		name          string
+		setupMock     func(mock sqlmock.Sqlmock)
+		run           func(repo *GORMRepository) error
+		wantSpan      string
+		wantStatement string
+		wantStatus    codes.Code
+		description   string
+	}{
+		{
+			name: "正常系: 取得",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectWorkoutQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type"}).AddRow(1, domain.Squat))
+			},
+			run: func(repo *GORMRepository) error {
+				_, err := repo.GetWorkout(1)
+				return err
+			},
+			wantSpan:      "gorm.query workouts",
+			wantStatement: "SELECT * FROM `workouts` WHERE `workouts`.`id` = ?",
+			wantStatus:    codes.Unset,
+			description:   "SQL文は値をプレースホルダのまま記録する",
+		},
+		{
+			name: "正常系: 存在しないID",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(selectWorkoutQuery).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			run: func(repo *GORMRepository) error {
+				_, err := repo.GetWorkout(1)
+				return err
+			},
+			wantSpan:      "gorm.query workouts",
+			wantStatement: "SELECT * FROM `workouts`",
+			wantStatus:    codes.Unset,
+			description:   "レコードがないことはクエリの失敗として扱わない",
+		},
+		{
+			name: "異常系: 削除の失敗",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(deleteWorkoutQuery).WithArgs(1).WillReturnError(errors.New("connection lost"))
+				mock.ExpectRollback()
+			},
+			run: func(repo *GORMRepository) error {
+				return repo.DeleteWorkout(1)
+			},
+			wantSpan:      "gorm.delete workouts",
+			wantStatement: "DELETE FROM `workouts` WHERE `workouts`.`id` = ?",
+			wantStatus:    codes.Error,
+			description:   "失敗したクエリはスパンにエラーを記録する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			if err := RegisterTracing(repo.db, tp); err != nil {
+				t.Fatalf("RegisterTracing() error = %v", err)
+			}
+
+			ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+			tt.setupMock(mock)
+			_ = tt.run(repo.WithContext(ctx).(*GORMRepository))
+			parent.End()
+
+			spans := recorder.Ended()
+			if len(spans) != 2 {
+				t.Fatalf("Expected 2 spans (query and parent), got %d", len(spans))
+			}
+			span := spans[0]
+			if span.Name() != tt.wantSpan {
+				t.Errorf("Expected span %q, got %q", tt.wantSpan, span.Name())
+			}
+			if span.Parent().SpanID() != parent.SpanContext().SpanID() {
+				t.Errorf("Expected query span to be a child of the context span")
+			}
+			if got := spanAttribute(span, "db.statement"); !strings.HasPrefix(got, tt.wantStatement) {
+				t.Errorf("Expected db.statement to start with %q, got %q", tt.wantStatement, got)
+			}
+			if got := spanAttribute(span, "db.sql.table"); got != "workouts" {
+				t.Errorf("Expected db.sql.table workouts, got %q", got)
+			}
+			if span.Status().Code != tt.wantStatus {
+				t.Errorf("Expected status %v, got %v", tt.wantStatus, span.Status().Code)
+			}
+		})
+	}
+}
+
+// spanAttribute スパンの属性の値（文字列）
+func spanAttribute(span sdktrace.ReadOnlySpan, key attribute.Key) string {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value.Emit()
+		}
+	}
+	return ""
+}
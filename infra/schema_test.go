@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"golv2-learning-app/domain"
+)
+
+// TestSchemaCheckConstraints workoutsテーブルのCHECK制約がドメインの値の範囲と一致していることをテスト
+// 範囲がずれていると、検証を通った値がMySQLのCHECK制約違反（わかりにくいエラー）で失敗する
+func TestSchemaCheckConstraints(t *testing.T) {
+	schema, err := os.ReadFile(filepath.Join("..", "sql", "init.sql"))
+	if err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		column      string
+		wantMin     float64
+		wantMax     float64
+		description string
+	}{
+		{
+			name:        "正常系: 状態",
+			column:      "status",
+			wantMin:     float64(domain.WorkoutStatusPlanned),
+			wantMax:     float64(domain.WorkoutStatusSkipped),
+			description: "予定〜スキップ",
+		},
+		{
+			name:        "正常系: 難易度",
+			column:      "difficulty",
+			wantMin:     float64(domain.DifficultyBeginner),
+			wantMax:     float64(domain.DifficultyBeast),
+			description: "初心者（0、DIFFICULTY_UNSPECIFIEDも初心者）を保存できる",
+		},
+		{
+			name:        "正常系: スキップの理由",
+			column:      "skip_reason",
+			wantMin:     float64(domain.SkipReasonUnspecified),
+			wantMax:     float64(domain.SkipReasonMissed),
+			description: "未指定〜自動スキップ",
+		},
+		{
+			name:        "正常系: 重量の単位",
+			column:      "weight_unit",
+			wantMin:     float64(domain.WeightUnitKilogram),
+			wantMax:     float64(domain.WeightUnitPound),
+			description: "kg・lb",
+		},
+		{
+			name:        "正常系: 重量",
+			column:      "weight",
+			wantMin:     0,
+			wantMax:     domain.MaxWeight,
+			description: "ユースケースの検証と同じ上限",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern := regexp.MustCompile(`CHECK \(` + tt.column + ` >= ([\d.]+) AND ` + tt.column + ` <= ([\d.]+)\)`)
+			match := pattern.FindSubmatch(schema)
+			if match == nil {
+				t.Fatalf("CHECK constraint for %s not found", tt.column)
+			}
+			gotMin, _ := strconv.ParseFloat(string(match[1]), 64)
+			gotMax, _ := strconv.ParseFloat(string(match[2]), 64)
+			if gotMin != tt.wantMin || gotMax != tt.wantMax {
+				t.Errorf("CHECK (%s) = %v..%v, want %v..%v", tt.column, gotMin, gotMax, tt.wantMin, tt.wantMax)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"gorm.io/gorm"
+)
+
+// activityAtExpr ワークアウトの実施日時（完了日時、未完了なら実施予定日、どちらもなければ作成日時）
+// domain.Workout.ActivityAt と同じ定義
+const activityAtExpr = "COALESCE(completed_at, scheduled_for, created_at)"
+
+// bucketExpr 集計単位ごとの期間開始日を求めるSQL式（MySQL）
+// atExprは集計のタイムゾーンに変換した実施日時（localActivityAtExpr）
+func bucketExpr(period domain.StatsPeriod, atExpr string) string {
+	switch period {
+	case domain.StatsPeriodWeek:
+		// 月曜始まり（WEEKDAYは月曜=0）
+		return "DATE_SUB(DATE(" + atExpr + "), INTERVAL WEEKDAY(" + atExpr + ") DAY)"
+	case domain.StatsPeriodMonth:
+		return "CAST(DATE_FORMAT(" + atExpr + ", '%Y-%m-01') AS DATE)"
+	default:
+		return "DATE(" + atExpr + ")"
+	}
+}
+
+// localActivityAtExpr 実施日時を集計のタイムゾーン（query.Location）に変換するSQL式
+// 日時はDSNの loc=Local で保存されているため、Localとの時差をCONVERT_TZで補正してから日付を求める
+// MySQLのタイムゾーン表に依存しないよう時差（+09:00形式）で指定するため、
+// 夏時間の切り替えをまたぐ期間では期間の開始時点の時差を使う
+func localActivityAtExpr(query domain.TrainingStatsQuery) string {
+	if query.Location == nil {
+		return activityAtExpr
+	}
+	at := time.Now()
+	if query.DateFrom != nil {
+		at = *query.DateFrom
+	} else if query.DateTo != nil {
+		at = *query.DateTo
+	}
+	from := at.In(time.Local).Format("-07:00")
+	to := at.In(query.Location).Format("-07:00")
+	if from == to {
+		return activityAtExpr
+	}
+	return fmt.Sprintf("CONVERT_TZ(%s, '%s', '%s')", activityAtExpr, from, to)
+}
+
+// statsRow 集計期間ごとの集計結果
+type statsRow struct {
+	PeriodStart       time.Time
+	WorkoutCount      int
+	CompletedCount    int
+	SkippedCount      int
+	TotalVolume       float64
+	TotalSets         int
+	AverageDifficulty float64
+}
+
+// muscleSetsRow 集計期間・筋肉群ごとのセット数
+type muscleSetsRow struct {
+	PeriodStart time.Time
+	MuscleGroup domain.MuscleGroup
+	Sets        int
+}
+
+// GetTrainingStats 集計期間ごとのトレーニング統計を取得（GROUP BYで集計）
+func (r *GORMRepository) GetTrainingStats(query domain.TrainingStatsQuery) ([]*domain.TrainingStatsBucket, error) {
+	bucket := bucketExpr(query.Period, localActivityAtExpr(query))
+	completed := int(domain.WorkoutStatusCompleted)
+	skipped := int(domain.WorkoutStatusSkipped)
+
+	var rows []statsRow
+	err := r.statsScope(query).
+		Select(bucket+" AS period_start, "+
+			"COUNT(*) AS workout_count, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS completed_count, "+
+			"SUM(CASE WHEN status = ? THEN 1 ELSE 0 END) AS skipped_count, "+
+			"SUM(CASE WHEN status = ? THEN sets * reps * weight ELSE 0 END) AS total_volume, "+
+			"SUM(CASE WHEN status = ? THEN sets ELSE 0 END) AS total_sets, "+
+			"AVG(difficulty) AS average_difficulty",
+			completed, skipped, completed, completed).
+		Group("period_start").
+		Order("period_start").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get training stats (period=%d): %w", query.Period, err)
+	}
+
+	var muscleRows []muscleSetsRow
+	err = r.statsScope(query).
+		Select(bucket+" AS period_start, muscle_group, SUM(sets) AS sets").
+		Where("status = ?", completed).
+		Group("period_start, muscle_group").
+		Scan(&muscleRows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sets by muscle group (period=%d): %w", query.Period, err)
+	}
+
+	buckets := make([]*domain.TrainingStatsBucket, 0, len(rows))
+	bucketByStart := make(map[time.Time]*domain.TrainingStatsBucket, len(rows))
+	for _, row := range rows {
+		b := &domain.TrainingStatsBucket{
+			PeriodStart:       periodStartIn(row.PeriodStart, query.Location),
+			WorkoutCount:      row.WorkoutCount,
+			CompletedCount:    row.CompletedCount,
+			SkippedCount:      row.SkippedCount,
+			TotalVolume:       row.TotalVolume,
+			TotalSets:         row.TotalSets,
+			AverageDifficulty: row.AverageDifficulty,
+			SetsByMuscleGroup: make(map[domain.MuscleGroup]int),
+		}
+		buckets = append(buckets, b)
+		bucketByStart[row.PeriodStart] = b
+	}
+	for _, row := range muscleRows {
+		if b, ok := bucketByStart[row.PeriodStart]; ok {
+			b.SetsByMuscleGroup[row.MuscleGroup] = row.Sets
+		}
+	}
+
+	return buckets, nil
+}
+
+// periodStartIn DATE列として読み込んだ期間の開始日（Localの0時）をlocの0時にする
+func periodStartIn(start time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		return start
+	}
+	return time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc)
+}
+
+// statsScope 集計対象期間で絞り込んだクエリ
+func (r *GORMRepository) statsScope(query domain.TrainingStatsQuery) *gorm.DB {
+	scope := r.db.Model(&domain.Workout{})
+	if query.DateFrom != nil {
+		scope = scope.Where(activityAtExpr+" >= ?", *query.DateFrom)
+	}
+	if query.DateTo != nil {
+		scope = scope.Where(activityAtExpr+" < ?", *query.DateTo)
+	}
+	return scope
+}
+
+// GetCompletedSetsByExercise 期間内に完了したセット数を種目・筋肉群ごとに集計
+func (r *GORMRepository) GetCompletedSetsByExercise(dateFrom, dateTo *time.Time) ([]*domain.ExerciseSets, error) {
+	rows := make([]*domain.ExerciseSets, 0, 16)
+	err := r.statsScope(domain.TrainingStatsQuery{DateFrom: dateFrom, DateTo: dateTo}).
+		Select("exercise_type, muscle_group, SUM(sets) AS sets").
+		Where("status = ?", int(domain.WorkoutStatusCompleted)).
+		Group("exercise_type, muscle_group").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get completed sets by exercise: %w", err)
+	}
+	return rows, nil
+}
+
+// GetCompletionTimes 完了したワークアウトの完了日時を取得（古い順）
+func (r *GORMRepository) GetCompletionTimes(dateFrom, dateTo *time.Time) ([]time.Time, error) {
+	scope := r.db.Model(&domain.Workout{}).
+		Where("status = ? AND completed_at IS NOT NULL", int(domain.WorkoutStatusCompleted))
+	if dateFrom != nil {
+		scope = scope.Where("completed_at >= ?", *dateFrom)
+	}
+	if dateTo != nil {
+		scope = scope.Where("completed_at < ?", *dateTo)
+	}
+
+	times := make([]time.Time, 0, 128)
+	if err := scope.Order("completed_at").Pluck("completed_at", &times).Error; err != nil {
+		return nil, fmt.Errorf("failed to get completion times: %w", err)
+	}
+	return times, nil
+}
+
+// ListWorkoutsByActivity 実施日時が期間内のワークアウトを取得（実施日時の昇順）
+func (r *GORMRepository) ListWorkoutsByActivity(dateFrom, dateTo *time.Time) ([]*domain.Workout, error) {
+	workouts := make([]*domain.Workout, 0, 100)
+	err := r.statsScope(domain.TrainingStatsQuery{DateFrom: dateFrom, DateTo: dateTo}).
+		Order(activityAtExpr).
+		Find(&workouts).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workouts by activity: %w", err)
+	}
+	return workouts, nil
+}
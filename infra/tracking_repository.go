@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"fmt"
+
+	"golv2-learning-app/domain"
+)
+
+// CreateWorkoutSet 実施中のワークアウトで記録したセットを1件保存
+func (r *GORMRepository) CreateWorkoutSet(set *domain.WorkoutSet) error {
+	if err := r.db.Create(set).Error; err != nil {
+		return fmt.Errorf("failed to create workout set (workout_id=%d, set_number=%d): %w", set.WorkoutID, set.SetNumber, err)
+	}
+	return nil
+}
+
+// ListWorkoutSets ワークアウトで記録したセットを取得（セット番号の昇順）
+func (r *GORMRepository) ListWorkoutSets(workoutID domain.WorkoutID) ([]*domain.WorkoutSet, error) {
+	sets := make([]*domain.WorkoutSet, 0, 10)
+	if err := r.db.Where("workout_id = ?", workoutID).Order("set_number").Find(&sets).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workout sets (workout_id=%d): %w", workoutID, err)
+	}
+	return sets, nil
+}
+
+// performedSetsQuery 種目の完了済みワークアウトと記録したセットの重量・回数を1つにまとめる
+// 実施中のワークアウトは完了するまでセット単位でのみ集計する
+const performedSetsQuery = "SELECT weight, reps FROM workouts WHERE exercise_type = ? AND status = ? AND reps > 0" +
+	" UNION ALL SELECT s.weight, s.reps FROM workout_sets s JOIN workouts w ON w.id = s.workout_id" +
+	" WHERE w.exercise_type = ? AND s.reps > 0"
+
+// GetExerciseBest 完了済みワークアウトと記録したセットから種目の自己ベストを取得
+// 推定1RMはdomain.EstimateOneRepMax（Epley式、1回挙上は重量そのまま）と同じ計算式を使用する
+func (r *GORMRepository) GetExerciseBest(exerciseType domain.ExerciseType) (*domain.ExerciseBest, error) {
+	var best domain.ExerciseBest
+	err := r.db.Raw("SELECT COALESCE(MAX(weight), 0) AS max_weight,"+
+		" COALESCE(MAX(CASE WHEN reps = 1 THEN weight ELSE weight * (1 + reps / 30.0) END), 0) AS one_rep_max"+
+		" FROM ("+performedSetsQuery+") AS performed",
+		exerciseType, domain.WorkoutStatusCompleted, exerciseType).
+		Scan(&best).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exercise best (exercise_type=%d): %w", exerciseType, err)
+	}
+	return &best, nil
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestGORMRepository_CreateWorkoutSet
+func TestGORMRepository_CreateWorkoutSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: セットを保存",
+			description: "採番されたIDをセットに反映",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			set := &domain.WorkoutSet{WorkoutID: 1, SetNumber: 2, Reps: 5, Weight: 100, RPE: 8.5, CompletedAt: time.Now()}
+			mock.ExpectBegin()
+			exec := mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `workout_sets` (`workout_id`,`set_number`,`reps`,`weight`,`rpe`,`completed_at`)")).
+				WithArgs(set.WorkoutID, set.SetNumber, set.Reps, set.Weight, set.RPE, sqlmock.AnyArg())
+			if tt.mockError != nil {
+				exec.WillReturnError(tt.mockError)
+				mock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(7, 1))
+				mock.ExpectCommit()
+			}
+
+			err := repo.CreateWorkoutSet(set)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateWorkoutSet() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && set.ID != 7 {
+				t.Errorf("Expected ID 7, got %d", set.ID)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_ListWorkoutSets
+func TestGORMRepository_ListWorkoutSets(t *testing.T) {
+	repo, mock, db := setupMockDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workout_sets` WHERE workout_id = ? ORDER BY set_number")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "workout_id", "set_number", "reps", "weight"}).
+			AddRow(1, 1, 1, 5, 100.0).
+			AddRow(2, 1, 2, 5, 102.5))
+
+	sets, err := repo.ListWorkoutSets(1)
+	if err != nil {
+		t.Fatalf("ListWorkoutSets() error = %v", err)
+	}
+	if len(sets) != 2 || sets[1].Weight != 102.5 {
+		t.Errorf("Expected 2 sets, got %v", sets)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled mock expectations: %v", err)
+	}
+}
+
+// TestGORMRepository_GetExerciseBest
+func TestGORMRepository_GetExerciseBest(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 自己ベストを取得",
+			description: "完了済みワークアウトと記録したセットをまとめて集計",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			query := mock.ExpectQuery(regexp.QuoteMeta("FROM (SELECT weight, reps FROM workouts WHERE exercise_type = ? AND status = ? AND reps > 0 UNION ALL")).
+				WithArgs(domain.Squat, domain.WorkoutStatusCompleted, domain.Squat)
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"max_weight", "one_rep_max"}).AddRow(140.0, 150.0))
+			}
+
+			best, err := repo.GetExerciseBest(domain.Squat)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetExerciseBest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && (best.MaxWeight != 140 || best.OneRepMax != 150) {
+				t.Errorf("Expected 140kg / e1RM 150kg, got %+v", best)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
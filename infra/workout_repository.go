@@ -1,23 +1,46 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"strings"
 	"time"
 
 	"golv2-learning-app/domain"
+	"golv2-learning-app/logging"
 
+	"github.com/go-sql-driver/mysql"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
+// mysqlErrDuplicateEntry 一意制約に違反した場合のMySQLのエラー番号（ER_DUP_ENTRY）
+const mysqlErrDuplicateEntry = 1062
+
 // GORMRepository GORMを使用したリポジトリ実装
 type GORMRepository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger // クエリの実行時間などの出力先（デフォルトは出力しない）
 }
 
 // NewGORMRepository 接続済みのGORM DBインスタンスからリポジトリを作成
 func NewGORMRepository(db *gorm.DB) *GORMRepository {
-	return &GORMRepository{db: db}
+	return &GORMRepository{db: db, logger: logging.Discard()}
+}
+
+// SetLogger ログの出力先を設定
+func (r *GORMRepository) SetLogger(logger *slog.Logger) {
+	r.logger = logger
+}
+
+// WithContext クエリにコンテキストを渡すリポジトリを返す
+// コンテキストのスパンがDBのスパンの親になる（RegisterTracingを参照）
+func (r *GORMRepository) WithContext(ctx context.Context) domain.WorkoutRepository {
+	scoped := *r
+	scoped.db = r.db.WithContext(ctx)
+	return &scoped
 }
 
 // CreateWorkout ワークアウトを作成
@@ -28,12 +51,47 @@ func (r *GORMRepository) CreateWorkout(workout *domain.Workout) error {
 	return nil
 }
 
+// CreateWorkouts 複数のワークアウトを1トランザクションで作成
+func (r *GORMRepository) CreateWorkouts(workouts []*domain.Workout) error {
+	if len(workouts) == 0 {
+		return nil
+	}
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(&workouts).Error
+	})
+	if isDuplicateEntry(err) {
+		return fmt.Errorf("failed to create workouts (count=%d): %w: %w", len(workouts), domain.ErrAlreadyExists, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create workouts (count=%d): %w", len(workouts), err)
+	}
+	return nil
+}
+
+// isDuplicateEntry 一意制約の違反（同じ取り込み識別子の記録が保存済みなど）か判定する
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
+
+// BatchCreateWorkouts batchSize件ずつまとめてINSERTし、全体を1トランザクションで作成
+// CreateInBatchesは複数回のINSERTを1トランザクションで実行する
+func (r *GORMRepository) BatchCreateWorkouts(workouts []*domain.Workout, batchSize int) error {
+	if len(workouts) == 0 {
+		return nil
+	}
+	if err := r.db.CreateInBatches(&workouts, batchSize).Error; err != nil {
+		return fmt.Errorf("failed to batch create workouts (count=%d, batch_size=%d): %w", len(workouts), batchSize, err)
+	}
+	return nil
+}
+
 // GetWorkout ワークアウトをIDで取得
 func (r *GORMRepository) GetWorkout(id domain.WorkoutID) (*domain.Workout, error) {
 	var workout domain.Workout
 	if err := r.db.First(&workout, id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("workout not found (id=%d): %w", id, err)
+			return nil, fmt.Errorf("workout %w (id=%d): %w", domain.ErrNotFound, id, err)
 		}
 		return nil, fmt.Errorf("failed to get workout (id=%d): %w", id, err)
 	}
@@ -50,6 +108,56 @@ func (r *GORMRepository) UpdateWorkout(workout *domain.Workout) error {
 	return nil
 }
 
+// GetWorkouts 指定したIDのワークアウトを取得（存在しないIDは結果に含めない）
+func (r *GORMRepository) GetWorkouts(ids []domain.WorkoutID) ([]*domain.Workout, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	workouts := make([]*domain.Workout, 0, len(ids))
+	if err := r.db.Where("id IN ?", ids).Find(&workouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get workouts (count=%d): %w", len(ids), err)
+	}
+	return workouts, nil
+}
+
+// BatchUpdateWorkouts 複数のワークアウトを1トランザクションで更新
+func (r *GORMRepository) BatchUpdateWorkouts(workouts []*domain.Workout) error {
+	if len(workouts) == 0 {
+		return nil
+	}
+	now := time.Now()
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		for _, workout := range workouts {
+			workout.UpdatedAt = now
+			if err := tx.Save(workout).Error; err != nil {
+				return fmt.Errorf("id=%d: %w", workout.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to batch update workouts (count=%d): %w", len(workouts), err)
+	}
+	return nil
+}
+
+// BatchDeleteWorkouts 指定したIDのワークアウトを1つのDELETE文で削除し、削除した件数を返す
+func (r *GORMRepository) BatchDeleteWorkouts(ids []domain.WorkoutID) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&domain.Workout{}, ids)
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to batch delete workouts (count=%d): %w", len(ids), err)
+	}
+	return int(deleted), nil
+}
+
 // DeleteWorkout ワークアウトを削除
 func (r *GORMRepository) DeleteWorkout(id domain.WorkoutID) error {
 	if err := r.db.Delete(&domain.Workout{}, id).Error; err != nil {
@@ -61,11 +169,6 @@ func (r *GORMRepository) DeleteWorkout(id domain.WorkoutID) error {
 // ListWorkouts ワークアウト一覧を取得
 func (r *GORMRepository) ListWorkouts(statusFilter *int, difficultyFilter *int, muscleGroupFilter *int) ([]*domain.Workout, error) {
 	start := time.Now()
-	defer func() {
-		duration := time.Since(start)
-		fmt.Printf("🔍 ListWorkouts実行時間: %v\n", duration)
-	}()
-
 	workouts := make([]*domain.Workout, 0, 100)
 
 	query := r.db.Model(&domain.Workout{})
@@ -93,7 +196,9 @@ func (r *GORMRepository) ListWorkouts(statusFilter *int, difficultyFilter *int,
 		return nil, fmt.Errorf("failed to list workouts: %w", err)
 	}
 
-	fmt.Printf("🎯 取得件数: %d件\n", len(workouts))
+	r.logger.Debug("ワークアウト一覧を取得しました",
+		slog.String(logging.KeyOp, "ListWorkouts"), slog.Int("rows", len(workouts)),
+		slog.Duration(logging.KeyDuration, time.Since(start)))
 	return workouts, nil
 }
 
@@ -105,3 +210,257 @@ func (r *GORMRepository) GetWorkoutCount() (int, error) {
 	}
 	return int(count), nil
 }
+
+// bestOneRepMaxJoin 種目ごとのベストe1RM（b.best）を結合する導出テーブル
+// 行ごとの相関サブクエリにせず、種目ごとに1回だけ集計する
+// domain.EstimateOneRepMax（Epley式、1回挙上は重量そのまま）と同じ計算式を使用する
+// 記録のない種目のワークアウトも他のルールで一致できるようLEFT JOINにする（b.bestはNULLになり1RM比のルールには一致しない）
+const bestOneRepMaxJoin = "LEFT JOIN (SELECT exercise_type, MAX(CASE WHEN reps = 1 THEN weight ELSE weight * (1 + reps / 30.0) END) AS best" +
+	" FROM workouts WHERE reps > 0 GROUP BY exercise_type) b USING (exercise_type)"
+
+// intensityRow 高強度ワークアウト検索結果の行（一致したルール名を含む）
+type intensityRow struct {
+	domain.Workout `gorm:"embedded"`
+	MatchedRule    string `gorm:"column:matched_rule"`
+}
+
+// FindHighIntensityWorkouts 高強度ルールに一致するワークアウトを取得
+// 各ルールをSQLの条件式に変換し、CASE式で最初に一致したルール名を返す
+func (r *GORMRepository) FindHighIntensityWorkouts(query domain.IntensityQuery) ([]*domain.IntensityMatch, error) {
+	names := make([]string, 0, len(query.Rules))
+	conditions := make([]string, 0, len(query.Rules))
+	conditionArgs := make([][]interface{}, 0, len(query.Rules))
+	needsOneRepMax := false
+	for _, rule := range query.Rules {
+		condition, args := buildIntensityCondition(rule, query.Bodyweight)
+		if condition == "" {
+			continue
+		}
+		needsOneRepMax = needsOneRepMax || rule.MinPercentOfOneRepMax != nil
+		names = append(names, rule.Name)
+		conditions = append(conditions, condition)
+		conditionArgs = append(conditionArgs, args)
+	}
+	if len(conditions) == 0 {
+		return []*domain.IntensityMatch{}, nil
+	}
+
+	// SELECT句: CASE WHEN (条件1) THEN 'ルール1' WHEN (条件2) THEN 'ルール2' END
+	var caseExpr strings.Builder
+	selectArgs := make([]interface{}, 0)
+	caseExpr.WriteString("workouts.*, CASE")
+	for i, condition := range conditions {
+		caseExpr.WriteString(" WHEN ")
+		caseExpr.WriteString(condition)
+		caseExpr.WriteString(" THEN ?")
+		selectArgs = append(selectArgs, conditionArgs[i]...)
+		selectArgs = append(selectArgs, names[i])
+	}
+	caseExpr.WriteString(" END AS matched_rule")
+
+	// WHERE句: (条件1) OR (条件2)
+	whereArgs := make([]interface{}, 0)
+	for _, args := range conditionArgs {
+		whereArgs = append(whereArgs, args...)
+	}
+
+	scope := r.db.Table("workouts")
+	if needsOneRepMax {
+		scope = scope.Joins(bestOneRepMaxJoin)
+	}
+
+	rows := make([]intensityRow, 0, 100)
+	err := scope.
+		Select(caseExpr.String(), selectArgs...).
+		Where(strings.Join(conditions, " OR "), whereArgs...).
+		Order("created_at DESC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find high intensity workouts (rules=%d): %w", len(conditions), err)
+	}
+
+	matches := make([]*domain.IntensityMatch, 0, len(rows))
+	for i := range rows {
+		workout := rows[i].Workout
+		matches = append(matches, &domain.IntensityMatch{
+			Workout:     &workout,
+			MatchedRule: rows[i].MatchedRule,
+		})
+	}
+	return matches, nil
+}
+
+// buildIntensityCondition ルールをSQLの条件式に変換（全ての条件をANDで結合）
+func buildIntensityCondition(rule domain.IntensityRule, bodyweight float64) (string, []interface{}) {
+	if !rule.HasCriteria() {
+		return "", nil
+	}
+
+	clauses := make([]string, 0, 7)
+	args := make([]interface{}, 0, 7)
+	if rule.ExerciseType != nil {
+		clauses = append(clauses, "exercise_type = ?")
+		args = append(args, *rule.ExerciseType)
+	}
+	if rule.MinDifficulty != nil {
+		clauses = append(clauses, "difficulty >= ?")
+		args = append(args, *rule.MinDifficulty)
+	}
+	if rule.MinWeight != nil {
+		clauses = append(clauses, "weight >= ?")
+		args = append(args, *rule.MinWeight)
+	}
+	if rule.MinBodyweightRatio != nil {
+		if bodyweight <= 0 {
+			// 体重が不明な場合は体重比ルールに一致しない
+			clauses = append(clauses, "1 = 0")
+		} else {
+			clauses = append(clauses, "weight >= ?")
+			args = append(args, *rule.MinBodyweightRatio*bodyweight)
+		}
+	}
+	if rule.MinPercentOfOneRepMax != nil {
+		clauses = append(clauses, "weight > 0 AND weight >= ? * b.best")
+		args = append(args, *rule.MinPercentOfOneRepMax)
+	}
+	if rule.MinVolume != nil {
+		clauses = append(clauses, "sets * reps * weight >= ?")
+		args = append(args, *rule.MinVolume)
+	}
+	if rule.MinSets != nil {
+		clauses = append(clauses, "sets >= ?")
+		args = append(args, *rule.MinSets)
+	}
+
+	return "(" + strings.Join(clauses, " AND ") + ")", args
+}
+
+// GetExerciseHistory 種目ごとの完了済みワークアウトを直近limit件取得（古い順）
+func (r *GORMRepository) GetExerciseHistory(exerciseType domain.ExerciseType, programID *domain.ProgramID, limit int) ([]*domain.Workout, error) {
+	query := r.db.Model(&domain.Workout{}).
+		Where("exercise_type = ? AND status = ?", exerciseType, domain.WorkoutStatusCompleted)
+	if programID != nil {
+		query = query.Where("program_id = ?", *programID)
+	}
+
+	workouts := make([]*domain.Workout, 0, limit)
+	if err := query.Order("completed_at DESC").Limit(limit).Find(&workouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to get exercise history (exercise_type=%d): %w", exerciseType, err)
+	}
+
+	// 新しい順に取得したものを古い順に並べ替える
+	for i, j := 0, len(workouts)-1; i < j; i, j = i+1, j-1 {
+		workouts[i], workouts[j] = workouts[j], workouts[i]
+	}
+	return workouts, nil
+}
+
+// MarkMissedWorkouts 予定日時を過ぎた予定をスキップ（未実施）に変更
+// 対象の行をSELECT ... FOR UPDATEでロックしてから同じトランザクションでIDを指定して更新するため、
+// 取得から更新までの間に完了された予定を上書きせず、返すワークアウトは実際に変更した行と一致する
+func (r *GORMRepository) MarkMissedWorkouts(scheduledBefore time.Time) ([]*domain.Workout, error) {
+	var missed []*domain.Workout
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("status = ? AND scheduled_for < ?", domain.WorkoutStatusPlanned, scheduledBefore).
+			Find(&missed).Error; err != nil {
+			return err
+		}
+		if len(missed) == 0 {
+			return nil
+		}
+
+		ids := make([]domain.WorkoutID, 0, len(missed))
+		for _, workout := range missed {
+			ids = append(ids, workout.ID)
+		}
+		updatedAt := time.Now()
+		if err := tx.Model(&domain.Workout{}).
+			Where("id IN ? AND status = ?", ids, domain.WorkoutStatusPlanned).
+			Updates(map[string]interface{}{
+				"status":      domain.WorkoutStatusSkipped,
+				"skip_reason": domain.SkipReasonMissed,
+				"updated_at":  updatedAt,
+			}).Error; err != nil {
+			return err
+		}
+		for _, workout := range missed {
+			workout.Status = domain.WorkoutStatusSkipped
+			workout.SkipReason = domain.SkipReasonMissed
+			workout.UpdatedAt = updatedAt
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark missed workouts (before=%s): %w", scheduledBefore.Format(time.RFC3339), err)
+	}
+	return missed, nil
+}
+
+// ListScheduledWorkouts 実施予定日時が期間内のワークアウトを取得（予定日時の昇順）
+func (r *GORMRepository) ListScheduledWorkouts(dateFrom, dateTo time.Time, statuses []domain.WorkoutStatus) ([]*domain.Workout, error) {
+	query := r.db.Model(&domain.Workout{}).
+		Where("scheduled_for >= ? AND scheduled_for < ?", dateFrom, dateTo)
+	if len(statuses) > 0 {
+		query = query.Where("status IN ?", statuses)
+	}
+
+	workouts := make([]*domain.Workout, 0, 100)
+	if err := query.Order("scheduled_for").Find(&workouts).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scheduled workouts: %w", err)
+	}
+	return workouts, nil
+}
+
+// FindImportKeys 指定した取り込み識別子のうち、既に保存されているものを返す
+// 一意インデックスのある生成列（import_key_unique）で検索する
+func (r *GORMRepository) FindImportKeys(keys []string) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	existing := make([]string, 0, len(keys))
+	err := r.db.Model(&domain.Workout{}).
+		Where("import_key_unique IN ?", keys).
+		Distinct().
+		Pluck("import_key", &existing).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to find import keys (count=%d): %w", len(keys), err)
+	}
+	return existing, nil
+}
+
+// StreamWorkouts フィルタに一致するワークアウトをID順にbatchSize件ずつ取得してfnに渡す
+// FindInBatchesで主キーを使って次のバッチを取得するため、件数が多くてもメモリ使用量は一定
+func (r *GORMRepository) StreamWorkouts(filter domain.WorkoutFilter, batchSize int, fn func(batch []*domain.Workout) error) error {
+	query := r.db.Model(&domain.Workout{})
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.Difficulty != nil {
+		query = query.Where("difficulty = ?", *filter.Difficulty)
+	}
+	if filter.MuscleGroup != nil {
+		query = query.Where("muscle_group = ?", *filter.MuscleGroup)
+	}
+	if filter.MinWeight != nil {
+		query = query.Where("weight >= ?", *filter.MinWeight)
+	}
+	if filter.MaxWeight != nil {
+		query = query.Where("weight <= ?", *filter.MaxWeight)
+	}
+	if filter.DateFrom != nil {
+		query = query.Where(activityAtExpr+" >= ?", *filter.DateFrom)
+	}
+	if filter.DateTo != nil {
+		query = query.Where(activityAtExpr+" < ?", *filter.DateTo)
+	}
+
+	batch := make([]*domain.Workout, 0, batchSize)
+	result := query.FindInBatches(&batch, batchSize, func(tx *gorm.DB, batchNo int) error {
+		return fn(batch)
+	})
+	if result.Error != nil {
+		return fmt.Errorf("failed to stream workouts (batch_size=%d): %w", batchSize, result.Error)
+	}
+	return nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"regexp"
 	"testing"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"golv2-learning-app/domain"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	mysqlDriver "github.com/go-sql-driver/mysql"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -159,10 +162,17 @@ func TestGORMRepository_CreateWorkout(t *testing.T) {
 						tt.workout.Sets,
 						tt.workout.Reps,
 						tt.workout.Weight,
+						sqlmock.AnyArg(), // weight_unit
 						tt.workout.Notes,
 						sqlmock.AnyArg(), // created_at
 						sqlmock.AnyArg(), // updated_at
 						sqlmock.AnyArg(), // completed_at
+						sqlmock.AnyArg(), // skip_reason
+						sqlmock.AnyArg(), // scheduled_for
+						sqlmock.AnyArg(), // scheduled_timezone
+						sqlmock.AnyArg(), // program_id
+						sqlmock.AnyArg(), // program_version
+						sqlmock.AnyArg(), // import_key
 					).
 					WillReturnResult(sqlmock.NewResult(tt.mockResultID, tt.mockAffected))
 				mock.ExpectCommit()
@@ -455,3 +465,850 @@ func TestGORMRepository_DeleteWorkout(t *testing.T) {
 		})
 	}
 }
+
+// TestGORMRepository_FindHighIntensityWorkouts ルールがSQLの条件式に変換され、一致したルール名が返ることをテスト
+func TestGORMRepository_FindHighIntensityWorkouts(t *testing.T) {
+	now := time.Now()
+	deadlift := domain.Deadlift
+	ratio := 2.0
+	minSets := 5
+	percent := 0.9
+
+	tests := []struct {
+		name        string
+		query       domain.IntensityQuery
+		mockError   error
+		wantQuery   bool   // SQLが発行されるか
+		wantFrom    string // 発行されるSQLのFROM句（空の場合は確認しない）
+		wantMatches int
+		wantErr     bool
+		description string
+	}{
+		{
+			name: "正常系: 複数ルール",
+			query: domain.IntensityQuery{
+				Rules: []domain.IntensityRule{
+					{Name: "deadlift_2x", ExerciseType: &deadlift, MinBodyweightRatio: &ratio},
+					{Name: "many_sets", MinSets: &minSets},
+				},
+				Bodyweight: 70.0,
+			},
+			wantQuery:   true,
+			wantFrom:    "FROM `workouts` WHERE",
+			wantMatches: 1,
+			description: "CASE式で一致したルール名を取得（1RM比のルールがなければ結合しない）",
+		},
+		{
+			name: "正常系: 1RM比のルール",
+			query: domain.IntensityQuery{
+				Rules: []domain.IntensityRule{
+					{Name: "deadlift_2x", ExerciseType: &deadlift, MinPercentOfOneRepMax: &percent},
+				},
+			},
+			wantQuery: true,
+			wantFrom: "FROM `workouts` LEFT JOIN (SELECT exercise_type, MAX(CASE WHEN reps = 1 THEN weight ELSE weight * (1 + reps / 30.0) END) AS best" +
+				" FROM workouts WHERE reps > 0 GROUP BY exercise_type) b USING (exercise_type) WHERE (exercise_type = ? AND weight > 0 AND weight >= ? * b.best)",
+			wantMatches: 1,
+			description: "種目ごとのベストe1RMは行ごとの相関サブクエリではなく導出テーブルで1回だけ集計する",
+		},
+		{
+			name: "正常系: 条件のないルールのみ",
+			query: domain.IntensityQuery{
+				Rules: []domain.IntensityRule{{Name: "empty"}},
+			},
+			wantQuery:   false,
+			wantMatches: 0,
+			description: "評価可能な条件がなければSQLを発行しない",
+		},
+		{
+			name: "異常系: DB接続エラー",
+			query: domain.IntensityQuery{
+				Rules: []domain.IntensityRule{{Name: "many_sets", MinSets: &minSets}},
+			},
+			mockError:   sql.ErrConnDone,
+			wantQuery:   true,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			if tt.wantQuery {
+				expected := mock.ExpectQuery(regexp.QuoteMeta("SELECT workouts.*, CASE WHEN") + ".*" + regexp.QuoteMeta(tt.wantFrom))
+				if tt.mockError != nil {
+					expected.WillReturnError(tt.mockError)
+				} else {
+					rows := sqlmock.NewRows([]string{"id", "exercise_type", "status", "difficulty", "muscle_group", "sets", "reps", "weight", "created_at", "updated_at", "matched_rule"}).
+						AddRow(1, domain.Deadlift, domain.WorkoutStatusCompleted, domain.DifficultyBeast, domain.Back, 3, 5, 150.0, now, now, "deadlift_2x")
+					expected.WillReturnRows(rows)
+				}
+			}
+
+			matches, err := repo.FindHighIntensityWorkouts(tt.query)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindHighIntensityWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if len(matches) != tt.wantMatches {
+					t.Fatalf("Expected %d matches, got %d", tt.wantMatches, len(matches))
+				}
+				if tt.wantMatches > 0 && matches[0].MatchedRule != "deadlift_2x" {
+					t.Errorf("Expected matched rule deadlift_2x, got %q", matches[0].MatchedRule)
+				}
+				if tt.wantMatches > 0 && matches[0].Workout.Weight != 150.0 {
+					t.Errorf("Expected Weight=150.0, got %.1f", matches[0].Workout.Weight)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_GetTrainingStats 期間別の集計クエリと筋肉群別セット数の結合をテスト
+func TestGORMRepository_GetTrainingStats(t *testing.T) {
+	week := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	// Localから9時間進んだタイムゾーン（テストを実行する環境のタイムゾーンに依存しないように）
+	_, localOffset := week.Zone()
+	userLoc := time.FixedZone("user", localOffset+9*60*60)
+
+	tests := []struct {
+		name        string
+		period      domain.StatsPeriod
+		location    *time.Location
+		wantGroupBy string
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 週別",
+			period:      domain.StatsPeriodWeek,
+			wantGroupBy: "WEEKDAY",
+			description: "月曜始まりの週で集計",
+		},
+		{
+			name:        "正常系: 月別",
+			period:      domain.StatsPeriodMonth,
+			wantGroupBy: "DATE_FORMAT",
+			description: "月初で集計",
+		},
+		{
+			name:        "正常系: ユーザーのタイムゾーンで日別",
+			period:      domain.StatsPeriodDay,
+			location:    userLoc,
+			wantGroupBy: "DATE(CONVERT_TZ(COALESCE(completed_at, scheduled_for, created_at), '" + week.Format("-07:00") + "', '" + week.In(userLoc).Format("-07:00") + "'))",
+			description: "Localで保存された日時をユーザーのタイムゾーンに変換してから日付を求める",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			period:      domain.StatsPeriodDay,
+			wantGroupBy: "DATE(",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			statsQuery := mock.ExpectQuery(regexp.QuoteMeta(tt.wantGroupBy) + ".*GROUP BY `period_start`")
+			if tt.mockError != nil {
+				statsQuery.WillReturnError(tt.mockError)
+			} else {
+				statsQuery.WillReturnRows(sqlmock.NewRows([]string{"period_start", "workout_count", "completed_count", "skipped_count", "total_volume", "total_sets", "average_difficulty"}).
+					AddRow(week, 3, 2, 1, 4300.0, 8, 1.0))
+				mock.ExpectQuery("GROUP BY period_start, muscle_group").
+					WillReturnRows(sqlmock.NewRows([]string{"period_start", "muscle_group", "sets"}).
+						AddRow(week, domain.Chest, 3).
+						AddRow(week, domain.Legs, 5))
+			}
+
+			buckets, err := repo.GetTrainingStats(domain.TrainingStatsQuery{Period: tt.period, DateFrom: &week, Location: tt.location})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTrainingStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if len(buckets) != 1 {
+					t.Fatalf("Expected 1 bucket, got %d", len(buckets))
+				}
+				if buckets[0].TotalVolume != 4300.0 {
+					t.Errorf("Expected TotalVolume=4300.0, got %.1f", buckets[0].TotalVolume)
+				}
+				if buckets[0].SetsByMuscleGroup[domain.Legs] != 5 {
+					t.Errorf("Expected Legs sets=5, got %d", buckets[0].SetsByMuscleGroup[domain.Legs])
+				}
+				if tt.location != nil && !buckets[0].PeriodStart.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, tt.location)) {
+					t.Errorf("Expected period start at midnight in %s, got %s", tt.location, buckets[0].PeriodStart)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_GetCompletedSetsByExercise 種目・筋肉群ごとの完了セット数集計のテスト
+func TestGORMRepository_GetCompletedSetsByExercise(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name        string
+		mockError   error
+		wantRows    int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 種目・筋肉群ごとに集計",
+			wantRows:    2,
+			description: "完了したワークアウトのセット数をGROUP BYで集計",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT exercise_type, muscle_group, SUM(sets) AS sets")+".*GROUP BY exercise_type, muscle_group").
+				WithArgs(from, to, int(domain.WorkoutStatusCompleted))
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"exercise_type", "muscle_group", "sets"}).
+					AddRow(domain.BenchPress, domain.Chest, 12).
+					AddRow(domain.PullUp, domain.Unspecified, 4))
+			}
+
+			rows, err := repo.GetCompletedSetsByExercise(&from, &to)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCompletedSetsByExercise() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if len(rows) != tt.wantRows {
+					t.Fatalf("Expected %d rows, got %d", tt.wantRows, len(rows))
+				}
+				if rows[0].ExerciseType != domain.BenchPress || rows[0].Sets != 12 {
+					t.Errorf("Expected BenchPress 12 sets, got %+v", rows[0])
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_GetCompletionTimes 完了日時の取得のテスト
+func TestGORMRepository_GetCompletionTimes(t *testing.T) {
+	day := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		mockError   error
+		wantTimes   int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 完了日時を古い順に取得",
+			wantTimes:   2,
+			description: "完了ステータスのcompleted_atのみ取得",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			query := mock.ExpectQuery(regexp.QuoteMeta("SELECT `completed_at` FROM `workouts` WHERE status = ? AND completed_at IS NOT NULL ORDER BY completed_at")).
+				WithArgs(int(domain.WorkoutStatusCompleted))
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"completed_at"}).
+					AddRow(day).
+					AddRow(day.AddDate(0, 0, 1)))
+			}
+
+			times, err := repo.GetCompletionTimes(nil, nil)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetCompletionTimes() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(times) != tt.wantTimes {
+				t.Errorf("Expected %d times, got %d", tt.wantTimes, len(times))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_GetExerciseHistory 種目ごとの完了履歴取得のテスト
+func TestGORMRepository_GetExerciseHistory(t *testing.T) {
+	day := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	programID := domain.ProgramID(3)
+
+	tests := []struct {
+		name        string
+		programID   *domain.ProgramID
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 種目の履歴を古い順に取得",
+			description: "新しい順にLIMITで取得し、古い順に並べ替える",
+		},
+		{
+			name:        "正常系: プログラムで絞り込み",
+			programID:   &programID,
+			description: "program_idの条件を追加",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			sqlQuery := "SELECT * FROM `workouts` WHERE exercise_type = ? AND status = ? ORDER BY completed_at DESC LIMIT 20"
+			args := []driver.Value{int(domain.Squat), int(domain.WorkoutStatusCompleted)}
+			if tt.programID != nil {
+				sqlQuery = "SELECT * FROM `workouts` WHERE (exercise_type = ? AND status = ?) AND program_id = ? ORDER BY completed_at DESC LIMIT 20"
+				args = []driver.Value{int(domain.Squat), int(domain.WorkoutStatusCompleted), int(*tt.programID)}
+			}
+			query := mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).WithArgs(args...)
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				newer := day.AddDate(0, 0, 2)
+				query.WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type", "status", "sets", "reps", "weight", "completed_at"}).
+					AddRow(2, domain.Squat, domain.WorkoutStatusCompleted, 5, 5, 102.5, newer).
+					AddRow(1, domain.Squat, domain.WorkoutStatusCompleted, 5, 5, 100.0, day))
+			}
+
+			workouts, err := repo.GetExerciseHistory(domain.Squat, tt.programID, 20)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetExerciseHistory() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr {
+				if len(workouts) != 2 || workouts[0].ID != 1 || workouts[1].ID != 2 {
+					t.Errorf("Expected workouts in ascending order [1 2], got %+v", workouts)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_MarkMissedWorkouts 予定日時を過ぎた予定の一括スキップのテスト
+func TestGORMRepository_MarkMissedWorkouts(t *testing.T) {
+	before := time.Date(2024, 1, 10, 0, 0, 0, 0, time.Local)
+	selectQuery := regexp.QuoteMeta("SELECT * FROM `workouts` WHERE status = ? AND scheduled_for < ? FOR UPDATE")
+	updateQuery := regexp.QuoteMeta("UPDATE `workouts` SET `skip_reason`=?,`status`=?,`updated_at`=? WHERE id IN (?,?) AND status = ?")
+
+	tests := []struct {
+		name        string
+		mockIDs     []domain.WorkoutID // ロックした予定のID
+		selectError error
+		updateError error
+		wantIDs     []domain.WorkoutID
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 2件を未実施に変更",
+			mockIDs:     []domain.WorkoutID{3, 5},
+			wantIDs:     []domain.WorkoutID{3, 5},
+			description: "ロックした行だけをIDで更新し、変更したワークアウトを返す",
+		},
+		{
+			name:        "正常系: 対象なし",
+			description: "ロックした行がなければ更新しない",
+		},
+		{
+			name:        "異常系: ロック取得エラー",
+			selectError: sql.ErrConnDone,
+			wantErr:     true,
+			description: "ロールバックして何も返さない",
+		},
+		{
+			name:        "異常系: 更新エラー",
+			mockIDs:     []domain.WorkoutID{3, 5},
+			updateError: sql.ErrConnDone,
+			wantErr:     true,
+			description: "ロールバックして何も返さない（通知もされない）",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(selectQuery).WithArgs(int(domain.WorkoutStatusPlanned), before)
+			switch {
+			case tt.selectError != nil:
+				query.WillReturnError(tt.selectError)
+				mock.ExpectRollback()
+			default:
+				rows := sqlmock.NewRows([]string{"id", "exercise_type", "status"})
+				for _, id := range tt.mockIDs {
+					rows.AddRow(id, domain.Squat, domain.WorkoutStatusPlanned)
+				}
+				query.WillReturnRows(rows)
+				if len(tt.mockIDs) == 0 {
+					mock.ExpectCommit()
+					break
+				}
+				exec := mock.ExpectExec(updateQuery).
+					WithArgs(int(domain.SkipReasonMissed), int(domain.WorkoutStatusSkipped), sqlmock.AnyArg(), tt.mockIDs[0], tt.mockIDs[1], int(domain.WorkoutStatusPlanned))
+				if tt.updateError != nil {
+					exec.WillReturnError(tt.updateError)
+					mock.ExpectRollback()
+				} else {
+					exec.WillReturnResult(sqlmock.NewResult(0, int64(len(tt.mockIDs))))
+					mock.ExpectCommit()
+				}
+			}
+
+			missed, err := repo.MarkMissedWorkouts(before)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarkMissedWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(missed) != len(tt.wantIDs) {
+				t.Fatalf("Expected %d workouts, got %d", len(tt.wantIDs), len(missed))
+			}
+			for i, workout := range missed {
+				if workout.ID != tt.wantIDs[i] || workout.Status != domain.WorkoutStatusSkipped || workout.SkipReason != domain.SkipReasonMissed {
+					t.Errorf("Expected workout %d to be skipped as missed, got %+v", tt.wantIDs[i], workout)
+				}
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_ListScheduledWorkouts 予定日時での期間検索のテスト
+func TestGORMRepository_ListScheduledWorkouts(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	to := from.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name        string
+		statuses    []domain.WorkoutStatus
+		mockError   error
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 全ステータス",
+			description: "予定日時の期間のみで絞り込む",
+		},
+		{
+			name:        "正常系: 予定のみ",
+			statuses:    []domain.WorkoutStatus{domain.WorkoutStatusPlanned},
+			description: "statusのIN条件を追加",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			sqlQuery := "SELECT * FROM `workouts` WHERE scheduled_for >= ? AND scheduled_for < ? ORDER BY scheduled_for"
+			args := []driver.Value{from, to}
+			if len(tt.statuses) > 0 {
+				sqlQuery = "SELECT * FROM `workouts` WHERE (scheduled_for >= ? AND scheduled_for < ?) AND status IN (?) ORDER BY scheduled_for"
+				args = append(args, int(domain.WorkoutStatusPlanned))
+			}
+			query := mock.ExpectQuery(regexp.QuoteMeta(sqlQuery)).WithArgs(args...)
+			if tt.mockError != nil {
+				query.WillReturnError(tt.mockError)
+			} else {
+				query.WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type", "status", "scheduled_for"}).
+					AddRow(1, domain.Squat, domain.WorkoutStatusPlanned, from.Add(9*time.Hour)).
+					AddRow(2, domain.Deadlift, domain.WorkoutStatusPlanned, from.AddDate(0, 0, 2)))
+			}
+
+			workouts, err := repo.ListScheduledWorkouts(from, to, tt.statuses)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListScheduledWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && len(workouts) != 2 {
+				t.Errorf("Expected 2 workouts, got %d", len(workouts))
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_FindImportKeys 取り込み識別子の存在チェックのテスト
+func TestGORMRepository_FindImportKeys(t *testing.T) {
+	tests := []struct {
+		name        string
+		keys        []string
+		mockError   error
+		wantKeys    int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 既存の識別子を返す",
+			keys:        []string{"strong:a", "strong:b"},
+			wantKeys:    1,
+			description: "IN条件で検索",
+		},
+		{
+			name:        "正常系: 識別子なし",
+			description: "クエリを実行しない",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			keys:        []string{"strong:a"},
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			if len(tt.keys) > 0 {
+				args := make([]driver.Value, 0, len(tt.keys))
+				for _, key := range tt.keys {
+					args = append(args, key)
+				}
+				query := mock.ExpectQuery(regexp.QuoteMeta("SELECT DISTINCT `import_key` FROM `workouts` WHERE import_key_unique IN (")).WithArgs(args...)
+				if tt.mockError != nil {
+					query.WillReturnError(tt.mockError)
+				} else {
+					query.WillReturnRows(sqlmock.NewRows([]string{"import_key"}).AddRow("strong:a"))
+				}
+			}
+
+			keys, err := repo.FindImportKeys(tt.keys)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FindImportKeys() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(keys) != tt.wantKeys {
+				t.Errorf("Expected %d keys, got %v", tt.wantKeys, keys)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_StreamWorkouts テーブル駆動テストでバッチ取得をテスト
+func TestGORMRepository_StreamWorkouts(t *testing.T) {
+	status := domain.WorkoutStatusCompleted
+	minWeight := 50.0
+
+	tests := []struct {
+		name        string
+		filter      domain.WorkoutFilter
+		batches     [][]int64 // バッチごとに返すID
+		mockError   error
+		stopAfter   int // fnがエラーを返すバッチ番号（0の場合は返さない）
+		wantCalls   int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 複数バッチ",
+			filter:      domain.WorkoutFilter{Status: &status, MinWeight: &minWeight},
+			batches:     [][]int64{{1, 2}, {3}},
+			wantCalls:   2,
+			description: "バッチの件数がbatchSize未満になるまで前回の最後のIDより後を取得",
+		},
+		{
+			name:        "異常系: fnのエラーで中断",
+			batches:     [][]int64{{1, 2}},
+			stopAfter:   1,
+			wantCalls:   1,
+			wantErr:     true,
+			description: "送信に失敗した場合は次のバッチを取得しない",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "DB接続エラー時のエラーハンドリング",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			if tt.mockError != nil {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workouts`")).WillReturnError(tt.mockError)
+			}
+			for i, ids := range tt.batches {
+				query := "SELECT * FROM `workouts` WHERE status = ? AND weight >= ? ORDER BY `workouts`.`id` LIMIT 2"
+				if tt.filter.Status == nil {
+					query = "SELECT * FROM `workouts` ORDER BY `workouts`.`id` LIMIT 2"
+				}
+				if i > 0 {
+					query = "SELECT * FROM `workouts` WHERE status = ? AND weight >= ? AND `workouts`.`id` > ? ORDER BY `workouts`.`id` LIMIT 2"
+				}
+				rows := sqlmock.NewRows([]string{"id", "exercise_type", "status"})
+				for _, id := range ids {
+					rows.AddRow(id, int(domain.BenchPress), int(domain.WorkoutStatusCompleted))
+				}
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WillReturnRows(rows)
+			}
+
+			calls := 0
+			var gotIDs []domain.WorkoutID
+			err := repo.StreamWorkouts(tt.filter, 2, func(batch []*domain.Workout) error {
+				calls++
+				for _, w := range batch {
+					gotIDs = append(gotIDs, w.ID)
+				}
+				if calls == tt.stopAfter {
+					return errors.New("stream closed")
+				}
+				return nil
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("StreamWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("Expected %d batches, got %d (ids=%v)", tt.wantCalls, calls, gotIDs)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_CreateWorkouts テーブル駆動テストで1トランザクションのINSERTと一意制約の違反をテスト
+func TestGORMRepository_CreateWorkouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockError   error
+		wantErr     error // errors.Isで判定するエラー（nilは成功）
+		description string
+	}{
+		{
+			name:        "正常系: 1回のINSERT",
+			description: "全件を1トランザクションで作成",
+		},
+		{
+			name:        "異常系: 取り込み識別子の重複",
+			mockError:   &mysqlDriver.MySQLError{Number: mysqlErrDuplicateEntry, Message: "Duplicate entry 'hevy:a' for key 'workouts.idx_workouts_import_key'"},
+			wantErr:     domain.ErrAlreadyExists,
+			description: "一意制約の違反はdomain.ErrAlreadyExistsをラップして返す",
+		},
+		{
+			name:        "異常系: DB接続エラー",
+			mockError:   sql.ErrConnDone,
+			wantErr:     sql.ErrConnDone,
+			description: "一意制約以外のエラーはErrAlreadyExistsにしない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			workouts := []*domain.Workout{
+				{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100, ImportKey: "hevy:a"},
+				{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 105, ImportKey: "hevy:b"},
+			}
+
+			mock.ExpectBegin()
+			if tt.mockError != nil {
+				mock.ExpectExec(insertWorkoutQuery).WillReturnError(tt.mockError)
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec(insertWorkoutQuery).WillReturnResult(sqlmock.NewResult(1, 2))
+				mock.ExpectCommit()
+			}
+
+			err := repo.CreateWorkouts(workouts)
+
+			if tt.wantErr == nil && err != nil {
+				t.Errorf("CreateWorkouts() error = %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.wantErr != domain.ErrAlreadyExists && errors.Is(err, domain.ErrAlreadyExists) {
+				t.Errorf("Expected no ErrAlreadyExists, got %v", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_BatchCreateWorkouts テーブル駆動テストでbatchSize件ずつのINSERTをテスト
+func TestGORMRepository_BatchCreateWorkouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		count       int
+		mockError   error
+		wantInserts int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 2回に分けてINSERT",
+			count:       3,
+			wantInserts: 2,
+			description: "batchSize=2なので2件と1件に分ける",
+		},
+		{
+			name:        "異常系: INSERTエラー",
+			count:       3,
+			mockError:   sql.ErrConnDone,
+			wantErr:     true,
+			description: "エラー時はロールバック",
+		},
+		{
+			name:        "正常系: 0件",
+			description: "クエリを実行しない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock, db := setupMockDB(t)
+			defer db.Close()
+
+			workouts := make([]*domain.Workout, 0, tt.count)
+			for i := 0; i < tt.count; i++ {
+				workouts = append(workouts, &domain.Workout{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100})
+			}
+
+			if tt.count > 0 {
+				mock.ExpectBegin()
+				if tt.mockError != nil {
+					mock.ExpectExec(insertWorkoutQuery).WillReturnError(tt.mockError)
+					mock.ExpectRollback()
+				} else {
+					mock.ExpectExec(insertWorkoutQuery).WillReturnResult(sqlmock.NewResult(1, 2))
+					mock.ExpectExec(insertWorkoutQuery).WillReturnResult(sqlmock.NewResult(3, 1))
+					mock.ExpectCommit()
+				}
+			}
+
+			err := repo.BatchCreateWorkouts(workouts, 2)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BatchCreateWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tt.count > 0 && workouts[2].ID != 3 {
+				t.Errorf("Expected IDs to be assigned, got %d", workouts[2].ID)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("Unfulfilled mock expectations: %v", err)
+			}
+		})
+	}
+}
+
+// TestGORMRepository_BatchDeleteWorkouts 1つのDELETE文での一括削除をテスト
+func TestGORMRepository_BatchDeleteWorkouts(t *testing.T) {
+	repo, mock, db := setupMockDB(t)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `workouts` WHERE `workouts`.`id` IN (?,?,?)")).
+		WithArgs(1, 2, 3).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	deleted, err := repo.BatchDeleteWorkouts([]domain.WorkoutID{1, 2, 3})
+	if err != nil {
+		t.Fatalf("BatchDeleteWorkouts() error = %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("Expected 2 deleted, got %d", deleted)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Errorf("Unfulfilled mock expectations: %v", err)
+	}
+}
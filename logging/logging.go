@@ -0,0 +1,69 @@
+// Package logging config.yamlの設定から構造化ログ（log/slog）のロガーを作成する
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+)
+
+// ログに付与する属性のキー
+// 同じ意味の値は層をまたいで同じキーで出力し、検索・集計できるようにする
+const (
+	KeyRequestID = "request_id" // リクエストごとのID（gRPCメタデータの x-request-id）
+	KeyMethod    = "method"     // gRPCのメソッド名（例: /workout.WorkoutService/CreateWorkout）
+	KeyOp        = "op"         // 処理名（例: "CreateWorkout"）
+	KeyWorkoutID = "workout_id" // 対象のワークアウトID
+	KeyDuration  = "duration"   // 処理時間
+	KeyError     = "error"      // エラー内容
+	KeyTraceID   = "trace_id"   // OpenTelemetryのトレースID（トレースを記録している場合のみ）
+)
+
+// New ログレベル（debug/info/warn/error）と形式（json/text）からロガーを作成
+// 未設定の場合はinfo・textとして扱う
+func New(w io.Writer, level, format string) (*slog.Logger, error) {
+	var lvl slog.Level
+	if level != "" {
+		if err := lvl.UnmarshalText([]byte(level)); err != nil {
+			return nil, fmt.Errorf("invalid logging.level %q: %w", level, err)
+		}
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	switch strings.ToLower(format) {
+	case "", "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid logging.format %q: expected json or text", format)
+	}
+}
+
+// Discard 何も出力しないロガー（テストやロガー未設定時のデフォルト）
+func Discard() *slog.Logger {
+	return slog.New(discardHandler{})
+}
+
+// discardHandler 全てのレベルを無効にし、ログの組み立て自体を行わないハンドラー
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
+
+type contextKey struct{}
+
+// NewContext リクエストの属性を付与したロガーをコンテキストに保存
+func NewContext(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, logger)
+}
+
+// FromContext コンテキストに保存されたロガーを返す
+func FromContext(ctx context.Context) (*slog.Logger, bool) {
+	logger, ok := ctx.Value(contextKey{}).(*slog.Logger)
+	return logger, ok
+}
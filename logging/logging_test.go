@@ -0,0 +1,121 @@
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// TestNew テーブル駆動テストでログレベル・形式の設定をテスト
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		level       string
+		format      string
+		wantErr     bool
+		wantDebug   bool   // Debugレベルが出力されるか
+		wantPrefix  string // 出力の先頭
+		description string
+	}{
+		{
+			name:        "正常系: JSON形式",
+			level:       "info",
+			format:      "json",
+			wantPrefix:  "{",
+			description: "1行1JSONで出力",
+		},
+		{
+			name:        "正常系: テキスト形式でdebug",
+			level:       "debug",
+			format:      "text",
+			wantDebug:   true,
+			wantPrefix:  "time=",
+			description: "key=value形式で出力",
+		},
+		{
+			name:        "正常系: 未設定",
+			wantPrefix:  "time=",
+			description: "info・textとして扱う",
+		},
+		{
+			name:        "正常系: 大文字",
+			level:       "WARN",
+			format:      "JSON",
+			wantPrefix:  "",
+			description: "大文字小文字を区別しない（warnのためInfoは出力されない）",
+		},
+		{
+			name:        "異常系: 不明なレベル",
+			level:       "verbose",
+			wantErr:     true,
+			description: "設定の誤りは起動時に検出する",
+		},
+		{
+			name:        "異常系: 不明な形式",
+			format:      "yaml",
+			wantErr:     true,
+			description: "json・text以外はエラー",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger, err := New(&buf, tt.level, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := logger.Enabled(context.Background(), slog.LevelDebug); got != tt.wantDebug {
+				t.Errorf("Enabled(debug) = %t, want %t", got, tt.wantDebug)
+			}
+			logger.Info("テスト", KeyOp, "TestNew")
+			if !strings.HasPrefix(buf.String(), tt.wantPrefix) {
+				t.Errorf("Expected output to start with %q, got %q", tt.wantPrefix, buf.String())
+			}
+		})
+	}
+}
+
+// TestContext コンテキストに保存したロガーの属性が引き継がれることをテスト
+func TestContext(t *testing.T) {
+	var buf bytes.Buffer
+	base, err := New(&buf, "info", "json")
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, ok := FromContext(context.Background()); ok {
+		t.Fatal("FromContext() should not find a logger in an empty context")
+	}
+
+	ctx := NewContext(context.Background(), base.With(KeyRequestID, "req-1"))
+	logger, ok := FromContext(ctx)
+	if !ok {
+		t.Fatal("FromContext() should find the stored logger")
+	}
+	logger.Info("テスト", KeyWorkoutID, 42)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Unmarshal() error = %v: %s", err, buf.String())
+	}
+	if entry[KeyRequestID] != "req-1" || entry[KeyWorkoutID] != float64(42) {
+		t.Errorf("Expected request_id and workout_id attributes, got %v", entry)
+	}
+}
+
+// TestDiscard 何も出力しないロガー
+func TestDiscard(t *testing.T) {
+	logger := Discard()
+	if logger.Enabled(context.Background(), slog.LevelError) {
+		t.Error("Discard() should disable every level")
+	}
+	logger.With(KeyOp, "TestDiscard").WithGroup("group").Error("出力されない")
+}
@@ -0,0 +1,163 @@
+// Package metrics Prometheus形式のメトリクス（RPC・DBクエリ・コネクションプール・ワークアウトの件数）
+package metrics
+
+import (
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Path メトリクスを返すパス
+const Path = "/metrics"
+
+// namespace アプリケーション固有のメトリクスの接頭辞
+const namespace = "golv2"
+
+// Metrics メトリクスの集計先
+// nilの場合はすべての記録を無視するため、メトリクスを使わない構成（テスト・CLI）では設定しなくてよい
+type Metrics struct {
+	registry          *prometheus.Registry
+	rpcStarted        *prometheus.CounterVec
+	rpcHandled        *prometheus.CounterVec
+	rpcDuration       *prometheus.HistogramVec
+	queryDuration     *prometheus.HistogramVec
+	workoutsCreated   prometheus.Counter
+	workoutsCompleted prometheus.Counter
+	workoutsSkipped   *prometheus.CounterVec
+}
+
+// New メトリクスを作成（Goランタイム・プロセスのメトリクスも含む）
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcStarted: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_started_total",
+			Help: "Total number of RPCs started on the server.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, regardless of success or failure.",
+		}, []string{"grpc_type", "grpc_service", "grpc_method", "grpc_code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Histogram of response latency (seconds) of RPCs handled by the server.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_type", "grpc_service", "grpc_method"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "db_query_duration_seconds",
+			Help:      "Histogram of database query latency (seconds) by operation and table.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"operation", "table"}),
+		workoutsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "workouts_created_total",
+			Help:      "Total number of workouts created.",
+		}),
+		workoutsCompleted: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "workouts_completed_total",
+			Help:      "Total number of workouts completed.",
+		}),
+		workoutsSkipped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "workouts_skipped_total",
+			Help:      "Total number of workouts skipped, by reason.",
+		}, []string{"reason"}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.rpcStarted,
+		m.rpcHandled,
+		m.rpcDuration,
+		m.queryDuration,
+		m.workoutsCreated,
+		m.workoutsCompleted,
+		m.workoutsSkipped,
+	)
+	return m
+}
+
+// Handler Prometheusのテキスト形式でメトリクスを返すhttp.Handler
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// Start メトリクス専用のポートでPathを公開する
+// アプリケーションのAPIとは別のポートにすることで、外部に公開せずにPrometheusから収集できる
+func (m *Metrics) Start(port int) error {
+	mux := http.NewServeMux()
+	mux.Handle(Path, m.Handler())
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// RegisterDBStats コネクションプールの状態（sql.DB.Stats()）を収集する
+// dbNameはメトリクスのdb_nameラベル
+func (m *Metrics) RegisterDBStats(db *sql.DB, dbName string) error {
+	if m == nil {
+		return nil
+	}
+	return m.registry.Register(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// RPCStarted RPCの開始を記録
+// rpcTypeは unary・client_stream・server_stream・bidi_stream のいずれか
+func (m *Metrics) RPCStarted(rpcType, service, method string) {
+	if m == nil {
+		return
+	}
+	m.rpcStarted.WithLabelValues(rpcType, service, method).Inc()
+}
+
+// RPCHandled RPCの終了をステータスコード・処理時間とともに記録
+func (m *Metrics) RPCHandled(rpcType, service, method, code string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.rpcHandled.WithLabelValues(rpcType, service, method, code).Inc()
+	m.rpcDuration.WithLabelValues(rpcType, service, method).Observe(duration.Seconds())
+}
+
+// QueryObserved DBクエリの処理時間を記録
+// operationは create・query・update・delete・row・raw のいずれか
+func (m *Metrics) QueryObserved(operation, table string, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.queryDuration.WithLabelValues(operation, table).Observe(duration.Seconds())
+}
+
+// WorkoutCreated ワークアウトの作成を記録
+func (m *Metrics) WorkoutCreated() {
+	if m == nil {
+		return
+	}
+	m.workoutsCreated.Inc()
+}
+
+// WorkoutCompleted ワークアウトの完了を記録
+func (m *Metrics) WorkoutCompleted() {
+	if m == nil {
+		return
+	}
+	m.workoutsCompleted.Inc()
+}
+
+// WorkoutSkipped ワークアウトのスキップを理由とともに記録
+func (m *Metrics) WorkoutSkipped(reason string) {
+	if m == nil {
+		return
+	}
+	m.workoutsSkipped.WithLabelValues(reason).Inc()
+}
@@ -0,0 +1,107 @@
+package metrics
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scrape Prometheusのテキスト形式で出力されたメトリクスを取得
+func scrape(t *testing.T, m *Metrics) string {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", rec.Code)
+	}
+	return rec.Body.String()
+}
+
+// TestMetrics テーブル駆動テストで記録した値がPrometheus形式で出力されることをテスト
+func TestMetrics(t *testing.T) {
+	tests := []struct {
+		name        string
+		record      func(m *Metrics)
+		wantLines   []string
+		description string
+	}{
+		{
+			name: "正常系: RPC",
+			record: func(m *Metrics) {
+				m.RPCStarted("unary", "workout.WorkoutService", "GetWorkout")
+				m.RPCHandled("unary", "workout.WorkoutService", "GetWorkout", "NotFound", 30*time.Millisecond)
+			},
+			wantLines: []string{
+				`grpc_server_started_total{grpc_method="GetWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+				`grpc_server_handled_total{grpc_code="NotFound",grpc_method="GetWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+				`grpc_server_handling_seconds_bucket{grpc_method="GetWorkout",grpc_service="workout.WorkoutService",grpc_type="unary",le="0.05"} 1`,
+				`grpc_server_handling_seconds_count{grpc_method="GetWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+			},
+			description: "件数・ステータスコード・処理時間のヒストグラム",
+		},
+		{
+			name: "正常系: DBクエリ",
+			record: func(m *Metrics) {
+				m.QueryObserved("query", "workouts", 2*time.Millisecond)
+				m.QueryObserved("query", "workouts", 3*time.Millisecond)
+			},
+			wantLines: []string{
+				`golv2_db_query_duration_seconds_count{operation="query",table="workouts"} 2`,
+			},
+			description: "操作・テーブルごとのヒストグラム",
+		},
+		{
+			name: "正常系: ワークアウトの件数",
+			record: func(m *Metrics) {
+				m.WorkoutCreated()
+				m.WorkoutCreated()
+				m.WorkoutCompleted()
+				m.WorkoutSkipped("sore")
+			},
+			wantLines: []string{
+				`golv2_workouts_created_total 2`,
+				`golv2_workouts_completed_total 1`,
+				`golv2_workouts_skipped_total{reason="sore"} 1`,
+			},
+			description: "作成・完了・スキップ（理由ごと）",
+		},
+		{
+			name:   "正常系: ランタイム",
+			record: func(m *Metrics) {},
+			wantLines: []string{
+				`# TYPE go_goroutines gauge`,
+			},
+			description: "Goランタイムのメトリクスも出力する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			tt.record(m)
+			body := scrape(t, m)
+			for _, want := range tt.wantLines {
+				if !strings.Contains(body, want+"\n") {
+					t.Errorf("Expected line %q in:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
+
+// TestMetrics_Nil nilのMetricsへの記録は何もしないことをテスト
+func TestMetrics_Nil(t *testing.T) {
+	var m *Metrics
+	m.RPCStarted("unary", "svc", "Method")
+	m.RPCHandled("unary", "svc", "Method", "OK", time.Second)
+	m.QueryObserved("query", "workouts", time.Second)
+	m.WorkoutCreated()
+	m.WorkoutCompleted()
+	m.WorkoutSkipped("sore")
+	if err := m.RegisterDBStats(&sql.DB{}, "test"); err != nil {
+		t.Errorf("RegisterDBStats() error = %v", err)
+	}
+}
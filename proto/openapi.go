@@ -0,0 +1,9 @@
+package proto
+
+import _ "embed"
+
+// OpenAPIDocument workout.protoのHTTPアノテーションから生成したOpenAPI（Swagger 2.0）ドキュメント
+// make proto で workout.swagger.json と一緒に再生成される
+//
+//go:embed workout.swagger.json
+var OpenAPIDocument []byte
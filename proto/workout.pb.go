@@ -7,6 +7,8 @@
 package proto
 
 import (
+	_ "golv2-learning-app/proto/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -274,608 +276,774 @@ func (ExerciseType) EnumDescriptor() ([]byte, []int) {
 	return file_proto_workout_proto_rawDescGZIP(), []int{3}
 }
 
-// ワークアウト情報
-type Workout struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// 重量の単位
+// 入力した単位と異なる単位で返す場合はプレートで組める重量（2.5kg・5lb刻み）に丸める
+type WeightUnit int32
 
-	Id           int32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExerciseType ExerciseType  `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
-	Description  string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status       WorkoutStatus `protobuf:"varint,4,opt,name=status,proto3,enum=workout.WorkoutStatus" json:"status,omitempty"`
-	Difficulty   Difficulty    `protobuf:"varint,5,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
-	MuscleGroup  MuscleGroup   `protobuf:"varint,6,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
-	Sets         int32         `protobuf:"varint,7,opt,name=sets,proto3" json:"sets,omitempty"`
-	Reps         int32         `protobuf:"varint,8,opt,name=reps,proto3" json:"reps,omitempty"`
-	Weight       float64       `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	Notes        string        `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
-	CreatedAt    string        `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt    string        `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CompletedAt  string        `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-}
+const (
+	WeightUnit_WEIGHT_UNIT_UNSPECIFIED WeightUnit = 0 // ユーザー設定の単位
+	WeightUnit_WEIGHT_UNIT_KG          WeightUnit = 1 // キログラム
+	WeightUnit_WEIGHT_UNIT_LB          WeightUnit = 2 // ポンド
+)
 
-func (x *Workout) Reset() {
-	*x = Workout{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for WeightUnit.
+var (
+	WeightUnit_name = map[int32]string{
+		0: "WEIGHT_UNIT_UNSPECIFIED",
+		1: "WEIGHT_UNIT_KG",
+		2: "WEIGHT_UNIT_LB",
+	}
+	WeightUnit_value = map[string]int32{
+		"WEIGHT_UNIT_UNSPECIFIED": 0,
+		"WEIGHT_UNIT_KG":          1,
+		"WEIGHT_UNIT_LB":          2,
 	}
+)
+
+func (x WeightUnit) Enum() *WeightUnit {
+	p := new(WeightUnit)
+	*p = x
+	return p
 }
 
-func (x *Workout) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WeightUnit) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*Workout) ProtoMessage() {}
+func (WeightUnit) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[4].Descriptor()
+}
 
-func (x *Workout) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (WeightUnit) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[4]
 }
 
-// Deprecated: Use Workout.ProtoReflect.Descriptor instead.
-func (*Workout) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{0}
+func (x WeightUnit) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Workout) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use WeightUnit.Descriptor instead.
+func (WeightUnit) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Workout) GetExerciseType() ExerciseType {
-	if x != nil {
-		return x.ExerciseType
+// 1RM推定式
+type OneRepMaxFormula int32
+
+const (
+	OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED OneRepMaxFormula = 0
+	OneRepMaxFormula_ONE_REP_MAX_FORMULA_EPLEY       OneRepMaxFormula = 1 // Epley式
+	OneRepMaxFormula_ONE_REP_MAX_FORMULA_BRZYCKI     OneRepMaxFormula = 2 // Brzycki式
+	OneRepMaxFormula_ONE_REP_MAX_FORMULA_LOMBARDI    OneRepMaxFormula = 3 // Lombardi式
+	OneRepMaxFormula_ONE_REP_MAX_FORMULA_RPE         OneRepMaxFormula = 4 // RPEチャート
+)
+
+// Enum value maps for OneRepMaxFormula.
+var (
+	OneRepMaxFormula_name = map[int32]string{
+		0: "ONE_REP_MAX_FORMULA_UNSPECIFIED",
+		1: "ONE_REP_MAX_FORMULA_EPLEY",
+		2: "ONE_REP_MAX_FORMULA_BRZYCKI",
+		3: "ONE_REP_MAX_FORMULA_LOMBARDI",
+		4: "ONE_REP_MAX_FORMULA_RPE",
 	}
-	return ExerciseType_EXERCISE_UNSPECIFIED
+	OneRepMaxFormula_value = map[string]int32{
+		"ONE_REP_MAX_FORMULA_UNSPECIFIED": 0,
+		"ONE_REP_MAX_FORMULA_EPLEY":       1,
+		"ONE_REP_MAX_FORMULA_BRZYCKI":     2,
+		"ONE_REP_MAX_FORMULA_LOMBARDI":    3,
+		"ONE_REP_MAX_FORMULA_RPE":         4,
+	}
+)
+
+func (x OneRepMaxFormula) Enum() *OneRepMaxFormula {
+	p := new(OneRepMaxFormula)
+	*p = x
+	return p
 }
 
-func (x *Workout) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x OneRepMaxFormula) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Workout) GetStatus() WorkoutStatus {
-	if x != nil {
-		return x.Status
-	}
-	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+func (OneRepMaxFormula) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[5].Descriptor()
 }
 
-func (x *Workout) GetDifficulty() Difficulty {
-	if x != nil {
-		return x.Difficulty
-	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
+func (OneRepMaxFormula) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[5]
 }
 
-func (x *Workout) GetMuscleGroup() MuscleGroup {
-	if x != nil {
-		return x.MuscleGroup
-	}
-	return MuscleGroup_UNSPECIFIED
+func (x OneRepMaxFormula) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Workout) GetSets() int32 {
-	if x != nil {
-		return x.Sets
-	}
-	return 0
+// Deprecated: Use OneRepMaxFormula.Descriptor instead.
+func (OneRepMaxFormula) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Workout) GetReps() int32 {
-	if x != nil {
-		return x.Reps
+// 統計の集計単位
+type StatsPeriod int32
+
+const (
+	StatsPeriod_STATS_PERIOD_UNSPECIFIED StatsPeriod = 0 // 未指定（週別として扱う）
+	StatsPeriod_STATS_PERIOD_DAY         StatsPeriod = 1 // 日別
+	StatsPeriod_STATS_PERIOD_WEEK        StatsPeriod = 2 // 週別（月曜始まり）
+	StatsPeriod_STATS_PERIOD_MONTH       StatsPeriod = 3 // 月別
+)
+
+// Enum value maps for StatsPeriod.
+var (
+	StatsPeriod_name = map[int32]string{
+		0: "STATS_PERIOD_UNSPECIFIED",
+		1: "STATS_PERIOD_DAY",
+		2: "STATS_PERIOD_WEEK",
+		3: "STATS_PERIOD_MONTH",
 	}
-	return 0
+	StatsPeriod_value = map[string]int32{
+		"STATS_PERIOD_UNSPECIFIED": 0,
+		"STATS_PERIOD_DAY":         1,
+		"STATS_PERIOD_WEEK":        2,
+		"STATS_PERIOD_MONTH":       3,
+	}
+)
+
+func (x StatsPeriod) Enum() *StatsPeriod {
+	p := new(StatsPeriod)
+	*p = x
+	return p
 }
 
-func (x *Workout) GetWeight() float64 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
+func (x StatsPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *Workout) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+func (StatsPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[6].Descriptor()
 }
 
-func (x *Workout) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+func (StatsPeriod) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[6]
 }
 
-func (x *Workout) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
+func (x StatsPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Workout) GetCompletedAt() string {
-	if x != nil {
-		return x.CompletedAt
-	}
-	return ""
+// Deprecated: Use StatsPeriod.Descriptor instead.
+func (StatsPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{6}
 }
 
-// ワークアウト作成リクエスト
-type CreateWorkoutRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// 目標に対するボリュームの判定
+type VolumeBalanceStatus int32
 
-	ExerciseType ExerciseType `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
-	Description  string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Difficulty   Difficulty   `protobuf:"varint,3,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
-	MuscleGroup  MuscleGroup  `protobuf:"varint,4,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
-	Sets         int32        `protobuf:"varint,5,opt,name=sets,proto3" json:"sets,omitempty"`
-	Reps         int32        `protobuf:"varint,6,opt,name=reps,proto3" json:"reps,omitempty"`
-	Weight       float64      `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
-	Notes        string       `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
-}
+const (
+	VolumeBalanceStatus_VOLUME_BALANCE_STATUS_UNSPECIFIED VolumeBalanceStatus = 0 // 目標未設定
+	VolumeBalanceStatus_VOLUME_BALANCE_STATUS_UNDER       VolumeBalanceStatus = 1 // 不足
+	VolumeBalanceStatus_VOLUME_BALANCE_STATUS_WITHIN      VolumeBalanceStatus = 2 // 目標範囲内
+	VolumeBalanceStatus_VOLUME_BALANCE_STATUS_OVER        VolumeBalanceStatus = 3 // 過多
+)
 
-func (x *CreateWorkoutRequest) Reset() {
-	*x = CreateWorkoutRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for VolumeBalanceStatus.
+var (
+	VolumeBalanceStatus_name = map[int32]string{
+		0: "VOLUME_BALANCE_STATUS_UNSPECIFIED",
+		1: "VOLUME_BALANCE_STATUS_UNDER",
+		2: "VOLUME_BALANCE_STATUS_WITHIN",
+		3: "VOLUME_BALANCE_STATUS_OVER",
 	}
+	VolumeBalanceStatus_value = map[string]int32{
+		"VOLUME_BALANCE_STATUS_UNSPECIFIED": 0,
+		"VOLUME_BALANCE_STATUS_UNDER":       1,
+		"VOLUME_BALANCE_STATUS_WITHIN":      2,
+		"VOLUME_BALANCE_STATUS_OVER":        3,
+	}
+)
+
+func (x VolumeBalanceStatus) Enum() *VolumeBalanceStatus {
+	p := new(VolumeBalanceStatus)
+	*p = x
+	return p
 }
 
-func (x *CreateWorkoutRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x VolumeBalanceStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*CreateWorkoutRequest) ProtoMessage() {}
+func (VolumeBalanceStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[7].Descriptor()
+}
 
-func (x *CreateWorkoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (VolumeBalanceStatus) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[7]
 }
 
-// Deprecated: Use CreateWorkoutRequest.ProtoReflect.Descriptor instead.
-func (*CreateWorkoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{1}
+func (x VolumeBalanceStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateWorkoutRequest) GetExerciseType() ExerciseType {
-	if x != nil {
-		return x.ExerciseType
-	}
-	return ExerciseType_EXERCISE_UNSPECIFIED
+// Deprecated: Use VolumeBalanceStatus.Descriptor instead.
+func (VolumeBalanceStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *CreateWorkoutRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
+// スキップ理由
+type SkipReason int32
+
+const (
+	SkipReason_SKIP_REASON_UNSPECIFIED SkipReason = 0 // 未指定
+	SkipReason_SKIP_REASON_SORE        SkipReason = 1 // 筋肉痛
+	SkipReason_SKIP_REASON_NO_TIME     SkipReason = 2 // 時間がない
+	SkipReason_SKIP_REASON_SICK        SkipReason = 3 // 体調不良
+	SkipReason_SKIP_REASON_INJURY      SkipReason = 4 // 怪我
+	SkipReason_SKIP_REASON_TRAVEL      SkipReason = 5 // 出張・旅行
+	SkipReason_SKIP_REASON_WEATHER     SkipReason = 6 // 天候
+	SkipReason_SKIP_REASON_MOTIVATION  SkipReason = 7 // やる気が出ない
+	SkipReason_SKIP_REASON_OTHER       SkipReason = 8 // その他
+	SkipReason_SKIP_REASON_MISSED      SkipReason = 9 // 予定日を過ぎたため自動でスキップ
+)
+
+// Enum value maps for SkipReason.
+var (
+	SkipReason_name = map[int32]string{
+		0: "SKIP_REASON_UNSPECIFIED",
+		1: "SKIP_REASON_SORE",
+		2: "SKIP_REASON_NO_TIME",
+		3: "SKIP_REASON_SICK",
+		4: "SKIP_REASON_INJURY",
+		5: "SKIP_REASON_TRAVEL",
+		6: "SKIP_REASON_WEATHER",
+		7: "SKIP_REASON_MOTIVATION",
+		8: "SKIP_REASON_OTHER",
+		9: "SKIP_REASON_MISSED",
 	}
-	return ""
+	SkipReason_value = map[string]int32{
+		"SKIP_REASON_UNSPECIFIED": 0,
+		"SKIP_REASON_SORE":        1,
+		"SKIP_REASON_NO_TIME":     2,
+		"SKIP_REASON_SICK":        3,
+		"SKIP_REASON_INJURY":      4,
+		"SKIP_REASON_TRAVEL":      5,
+		"SKIP_REASON_WEATHER":     6,
+		"SKIP_REASON_MOTIVATION":  7,
+		"SKIP_REASON_OTHER":       8,
+		"SKIP_REASON_MISSED":      9,
+	}
+)
+
+func (x SkipReason) Enum() *SkipReason {
+	p := new(SkipReason)
+	*p = x
+	return p
 }
 
-func (x *CreateWorkoutRequest) GetDifficulty() Difficulty {
-	if x != nil {
-		return x.Difficulty
-	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
+func (x SkipReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *CreateWorkoutRequest) GetMuscleGroup() MuscleGroup {
-	if x != nil {
-		return x.MuscleGroup
-	}
-	return MuscleGroup_UNSPECIFIED
+func (SkipReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[8].Descriptor()
 }
 
-func (x *CreateWorkoutRequest) GetSets() int32 {
-	if x != nil {
-		return x.Sets
-	}
-	return 0
+func (SkipReason) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[8]
 }
 
-func (x *CreateWorkoutRequest) GetReps() int32 {
-	if x != nil {
-		return x.Reps
-	}
-	return 0
+func (x SkipReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *CreateWorkoutRequest) GetWeight() float64 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
+// Deprecated: Use SkipReason.Descriptor instead.
+func (SkipReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *CreateWorkoutRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
+// 曜日
+type DayOfWeek int32
+
+const (
+	DayOfWeek_DAY_OF_WEEK_UNSPECIFIED DayOfWeek = 0
+	DayOfWeek_DAY_OF_WEEK_MONDAY      DayOfWeek = 1
+	DayOfWeek_DAY_OF_WEEK_TUESDAY     DayOfWeek = 2
+	DayOfWeek_DAY_OF_WEEK_WEDNESDAY   DayOfWeek = 3
+	DayOfWeek_DAY_OF_WEEK_THURSDAY    DayOfWeek = 4
+	DayOfWeek_DAY_OF_WEEK_FRIDAY      DayOfWeek = 5
+	DayOfWeek_DAY_OF_WEEK_SATURDAY    DayOfWeek = 6
+	DayOfWeek_DAY_OF_WEEK_SUNDAY      DayOfWeek = 7
+)
+
+// Enum value maps for DayOfWeek.
+var (
+	DayOfWeek_name = map[int32]string{
+		0: "DAY_OF_WEEK_UNSPECIFIED",
+		1: "DAY_OF_WEEK_MONDAY",
+		2: "DAY_OF_WEEK_TUESDAY",
+		3: "DAY_OF_WEEK_WEDNESDAY",
+		4: "DAY_OF_WEEK_THURSDAY",
+		5: "DAY_OF_WEEK_FRIDAY",
+		6: "DAY_OF_WEEK_SATURDAY",
+		7: "DAY_OF_WEEK_SUNDAY",
 	}
-	return ""
+	DayOfWeek_value = map[string]int32{
+		"DAY_OF_WEEK_UNSPECIFIED": 0,
+		"DAY_OF_WEEK_MONDAY":      1,
+		"DAY_OF_WEEK_TUESDAY":     2,
+		"DAY_OF_WEEK_WEDNESDAY":   3,
+		"DAY_OF_WEEK_THURSDAY":    4,
+		"DAY_OF_WEEK_FRIDAY":      5,
+		"DAY_OF_WEEK_SATURDAY":    6,
+		"DAY_OF_WEEK_SUNDAY":      7,
+	}
+)
+
+func (x DayOfWeek) Enum() *DayOfWeek {
+	p := new(DayOfWeek)
+	*p = x
+	return p
 }
 
-// ワークアウト作成レスポンス
-type CreateWorkoutResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x DayOfWeek) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
-	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (DayOfWeek) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[9].Descriptor()
 }
 
-func (x *CreateWorkoutResponse) Reset() {
-	*x = CreateWorkoutResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (DayOfWeek) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[9]
 }
 
-func (x *CreateWorkoutResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x DayOfWeek) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*CreateWorkoutResponse) ProtoMessage() {}
-
-func (x *CreateWorkoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+// Deprecated: Use DayOfWeek.Descriptor instead.
+func (DayOfWeek) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{9}
 }
 
-// Deprecated: Use CreateWorkoutResponse.ProtoReflect.Descriptor instead.
-func (*CreateWorkoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{2}
-}
+// 漸進的過負荷の進め方
+type ProgressionScheme int32
 
-func (x *CreateWorkoutResponse) GetWorkout() *Workout {
-	if x != nil {
-		return x.Workout
+const (
+	ProgressionScheme_PROGRESSION_SCHEME_LINEAR ProgressionScheme = 0 // 目標回数を達成したら重量を上げる
+	ProgressionScheme_PROGRESSION_SCHEME_DOUBLE ProgressionScheme = 1 // 回数を上限まで伸ばしてから重量を上げる
+)
+
+// Enum value maps for ProgressionScheme.
+var (
+	ProgressionScheme_name = map[int32]string{
+		0: "PROGRESSION_SCHEME_LINEAR",
+		1: "PROGRESSION_SCHEME_DOUBLE",
 	}
-	return nil
+	ProgressionScheme_value = map[string]int32{
+		"PROGRESSION_SCHEME_LINEAR": 0,
+		"PROGRESSION_SCHEME_DOUBLE": 1,
+	}
+)
+
+func (x ProgressionScheme) Enum() *ProgressionScheme {
+	p := new(ProgressionScheme)
+	*p = x
+	return p
 }
 
-func (x *CreateWorkoutResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+func (x ProgressionScheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// ワークアウト取得リクエスト
-type GetWorkoutRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ProgressionScheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[10].Descriptor()
+}
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (ProgressionScheme) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[10]
 }
 
-func (x *GetWorkoutRequest) Reset() {
-	*x = GetWorkoutRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ProgressionScheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetWorkoutRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ProgressionScheme.Descriptor instead.
+func (ProgressionScheme) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{10}
 }
 
-func (*GetWorkoutRequest) ProtoMessage() {}
+// 次回のワークアウトに対する提案内容
+type ProgressionAction int32
 
-func (x *GetWorkoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ProgressionAction_PROGRESSION_ACTION_BASELINE        ProgressionAction = 0 // 履歴なし
+	ProgressionAction_PROGRESSION_ACTION_INCREASE_WEIGHT ProgressionAction = 1
+	ProgressionAction_PROGRESSION_ACTION_INCREASE_REPS   ProgressionAction = 2
+	ProgressionAction_PROGRESSION_ACTION_REPEAT          ProgressionAction = 3 // 失敗したため同じ内容を再挑戦
+	ProgressionAction_PROGRESSION_ACTION_DELOAD          ProgressionAction = 4 // 連続で失敗したため重量を下げる
+)
+
+// Enum value maps for ProgressionAction.
+var (
+	ProgressionAction_name = map[int32]string{
+		0: "PROGRESSION_ACTION_BASELINE",
+		1: "PROGRESSION_ACTION_INCREASE_WEIGHT",
+		2: "PROGRESSION_ACTION_INCREASE_REPS",
+		3: "PROGRESSION_ACTION_REPEAT",
+		4: "PROGRESSION_ACTION_DELOAD",
 	}
-	return mi.MessageOf(x)
-}
+	ProgressionAction_value = map[string]int32{
+		"PROGRESSION_ACTION_BASELINE":        0,
+		"PROGRESSION_ACTION_INCREASE_WEIGHT": 1,
+		"PROGRESSION_ACTION_INCREASE_REPS":   2,
+		"PROGRESSION_ACTION_REPEAT":          3,
+		"PROGRESSION_ACTION_DELOAD":          4,
+	}
+)
 
-// Deprecated: Use GetWorkoutRequest.ProtoReflect.Descriptor instead.
-func (*GetWorkoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{3}
+func (x ProgressionAction) Enum() *ProgressionAction {
+	p := new(ProgressionAction)
+	*p = x
+	return p
 }
 
-func (x *GetWorkoutRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (x ProgressionAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// ワークアウト取得レスポンス
-type GetWorkoutResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ProgressionAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[11].Descriptor()
+}
 
-	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+func (ProgressionAction) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[11]
 }
 
-func (x *GetWorkoutResponse) Reset() {
-	*x = GetWorkoutResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ProgressionAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetWorkoutResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ProgressionAction.Descriptor instead.
+func (ProgressionAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{11}
 }
 
-func (*GetWorkoutResponse) ProtoMessage() {}
+// インポートするファイルの形式
+type ImportFormat int32
 
-func (x *GetWorkoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ImportFormat_IMPORT_FORMAT_UNSPECIFIED ImportFormat = 0 // CSVとして扱う
+	ImportFormat_IMPORT_FORMAT_CSV         ImportFormat = 1 // 1行目はヘッダー
+	ImportFormat_IMPORT_FORMAT_JSONL       ImportFormat = 2 // 1行に1つのワークアウト（JSON）
+	ImportFormat_IMPORT_FORMAT_STRONG      ImportFormat = 3 // StrongアプリのCSVエクスポート
+	ImportFormat_IMPORT_FORMAT_HEVY        ImportFormat = 4 // HevyアプリのCSVエクスポート
+	ImportFormat_IMPORT_FORMAT_FITNOTES    ImportFormat = 5 // FitNotesアプリのCSVエクスポート
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "IMPORT_FORMAT_UNSPECIFIED",
+		1: "IMPORT_FORMAT_CSV",
+		2: "IMPORT_FORMAT_JSONL",
+		3: "IMPORT_FORMAT_STRONG",
+		4: "IMPORT_FORMAT_HEVY",
+		5: "IMPORT_FORMAT_FITNOTES",
 	}
-	return mi.MessageOf(x)
-}
+	ImportFormat_value = map[string]int32{
+		"IMPORT_FORMAT_UNSPECIFIED": 0,
+		"IMPORT_FORMAT_CSV":         1,
+		"IMPORT_FORMAT_JSONL":       2,
+		"IMPORT_FORMAT_STRONG":      3,
+		"IMPORT_FORMAT_HEVY":        4,
+		"IMPORT_FORMAT_FITNOTES":    5,
+	}
+)
 
-// Deprecated: Use GetWorkoutResponse.ProtoReflect.Descriptor instead.
-func (*GetWorkoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{4}
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
 }
 
-func (x *GetWorkoutResponse) GetWorkout() *Workout {
-	if x != nil {
-		return x.Workout
-	}
-	return nil
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-// ワークアウト更新リクエスト
-type UpdateWorkoutRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[12].Descriptor()
+}
 
-	Id           int32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	ExerciseType ExerciseType  `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
-	Description  string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Status       WorkoutStatus `protobuf:"varint,4,opt,name=status,proto3,enum=workout.WorkoutStatus" json:"status,omitempty"`
-	Difficulty   Difficulty    `protobuf:"varint,5,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
-	MuscleGroup  MuscleGroup   `protobuf:"varint,6,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
-	Sets         int32         `protobuf:"varint,7,opt,name=sets,proto3" json:"sets,omitempty"`
-	Reps         int32         `protobuf:"varint,8,opt,name=reps,proto3" json:"reps,omitempty"`
-	Weight       float64       `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
-	Notes        string        `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[12]
 }
 
-func (x *UpdateWorkoutRequest) Reset() {
-	*x = UpdateWorkoutRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateWorkoutRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{12}
 }
 
-func (*UpdateWorkoutRequest) ProtoMessage() {}
+// エクスポートするファイルの形式
+type ExportFormat int32
 
-func (x *UpdateWorkoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0 // PROTOとして扱う
+	ExportFormat_EXPORT_FORMAT_PROTO       ExportFormat = 1 // workoutsにワークアウトを入れて送る
+	ExportFormat_EXPORT_FORMAT_JSONL       ExportFormat = 2 // chunkにJSON Linesを入れて送る（ImportWorkoutsでそのまま取り込める）
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 3 // chunkにCSVを入れて送る（ImportWorkoutsでそのまま取り込める）
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_PROTO",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_CSV",
 	}
-	return mi.MessageOf(x)
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_PROTO":       1,
+		"EXPORT_FORMAT_JSONL":       2,
+		"EXPORT_FORMAT_CSV":         3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-// Deprecated: Use UpdateWorkoutRequest.ProtoReflect.Descriptor instead.
-func (*UpdateWorkoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{5}
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UpdateWorkoutRequest) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[13].Descriptor()
 }
 
-func (x *UpdateWorkoutRequest) GetExerciseType() ExerciseType {
-	if x != nil {
-		return x.ExerciseType
-	}
-	return ExerciseType_EXERCISE_UNSPECIFIED
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[13]
 }
 
-func (x *UpdateWorkoutRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateWorkoutRequest) GetStatus() WorkoutStatus {
-	if x != nil {
-		return x.Status
-	}
-	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *UpdateWorkoutRequest) GetDifficulty() Difficulty {
-	if x != nil {
-		return x.Difficulty
+// 一括処理の結果の扱い
+type BatchMode int32
+
+const (
+	BatchMode_BATCH_MODE_UNSPECIFIED BatchMode = 0 // ATOMICとして扱う
+	BatchMode_BATCH_MODE_ATOMIC      BatchMode = 1 // 1件でも失敗したら何も反映しない
+	BatchMode_BATCH_MODE_PER_ITEM    BatchMode = 2 // 成功した項目だけ反映する
+)
+
+// Enum value maps for BatchMode.
+var (
+	BatchMode_name = map[int32]string{
+		0: "BATCH_MODE_UNSPECIFIED",
+		1: "BATCH_MODE_ATOMIC",
+		2: "BATCH_MODE_PER_ITEM",
 	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
+	BatchMode_value = map[string]int32{
+		"BATCH_MODE_UNSPECIFIED": 0,
+		"BATCH_MODE_ATOMIC":      1,
+		"BATCH_MODE_PER_ITEM":    2,
+	}
+)
+
+func (x BatchMode) Enum() *BatchMode {
+	p := new(BatchMode)
+	*p = x
+	return p
 }
 
-func (x *UpdateWorkoutRequest) GetMuscleGroup() MuscleGroup {
-	if x != nil {
-		return x.MuscleGroup
-	}
-	return MuscleGroup_UNSPECIFIED
+func (x BatchMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *UpdateWorkoutRequest) GetSets() int32 {
-	if x != nil {
-		return x.Sets
-	}
-	return 0
+func (BatchMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[14].Descriptor()
 }
 
-func (x *UpdateWorkoutRequest) GetReps() int32 {
-	if x != nil {
-		return x.Reps
-	}
-	return 0
+func (BatchMode) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[14]
 }
 
-func (x *UpdateWorkoutRequest) GetWeight() float64 {
-	if x != nil {
-		return x.Weight
-	}
-	return 0
+func (x BatchMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateWorkoutRequest) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
+// Deprecated: Use BatchMode.Descriptor instead.
+func (BatchMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{14}
 }
 
-// ワークアウト更新レスポンス
-type UpdateWorkoutResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// ワークアウトの変更イベントの種類
+type WorkoutEventType int32
 
-	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
-	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-}
+const (
+	WorkoutEventType_WORKOUT_EVENT_TYPE_UNSPECIFIED    WorkoutEventType = 0
+	WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED        WorkoutEventType = 1 // 作成
+	WorkoutEventType_WORKOUT_EVENT_TYPE_UPDATED        WorkoutEventType = 2 // 更新（ステータス以外の変更）
+	WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED        WorkoutEventType = 3 // 削除
+	WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED WorkoutEventType = 4 // ステータスの変更
+)
 
-func (x *UpdateWorkoutResponse) Reset() {
-	*x = UpdateWorkoutResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for WorkoutEventType.
+var (
+	WorkoutEventType_name = map[int32]string{
+		0: "WORKOUT_EVENT_TYPE_UNSPECIFIED",
+		1: "WORKOUT_EVENT_TYPE_CREATED",
+		2: "WORKOUT_EVENT_TYPE_UPDATED",
+		3: "WORKOUT_EVENT_TYPE_DELETED",
+		4: "WORKOUT_EVENT_TYPE_STATUS_CHANGED",
 	}
+	WorkoutEventType_value = map[string]int32{
+		"WORKOUT_EVENT_TYPE_UNSPECIFIED":    0,
+		"WORKOUT_EVENT_TYPE_CREATED":        1,
+		"WORKOUT_EVENT_TYPE_UPDATED":        2,
+		"WORKOUT_EVENT_TYPE_DELETED":        3,
+		"WORKOUT_EVENT_TYPE_STATUS_CHANGED": 4,
+	}
+)
+
+func (x WorkoutEventType) Enum() *WorkoutEventType {
+	p := new(WorkoutEventType)
+	*p = x
+	return p
 }
 
-func (x *UpdateWorkoutResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x WorkoutEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*UpdateWorkoutResponse) ProtoMessage() {}
+func (WorkoutEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[15].Descriptor()
+}
 
-func (x *UpdateWorkoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (WorkoutEventType) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[15]
 }
 
-// Deprecated: Use UpdateWorkoutResponse.ProtoReflect.Descriptor instead.
-func (*UpdateWorkoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{6}
+func (x WorkoutEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *UpdateWorkoutResponse) GetWorkout() *Workout {
-	if x != nil {
-		return x.Workout
-	}
-	return nil
+// Deprecated: Use WorkoutEventType.Descriptor instead.
+func (WorkoutEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UpdateWorkoutResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
+// 自己ベストの種類
+type PersonalRecordType int32
+
+const (
+	PersonalRecordType_PERSONAL_RECORD_TYPE_UNSPECIFIED PersonalRecordType = 0
+	PersonalRecordType_PERSONAL_RECORD_TYPE_WEIGHT      PersonalRecordType = 1 // 最大重量
+	PersonalRecordType_PERSONAL_RECORD_TYPE_ONE_REP_MAX PersonalRecordType = 2 // 推定1RM（Epley式）
+)
+
+// Enum value maps for PersonalRecordType.
+var (
+	PersonalRecordType_name = map[int32]string{
+		0: "PERSONAL_RECORD_TYPE_UNSPECIFIED",
+		1: "PERSONAL_RECORD_TYPE_WEIGHT",
+		2: "PERSONAL_RECORD_TYPE_ONE_REP_MAX",
 	}
-	return ""
+	PersonalRecordType_value = map[string]int32{
+		"PERSONAL_RECORD_TYPE_UNSPECIFIED": 0,
+		"PERSONAL_RECORD_TYPE_WEIGHT":      1,
+		"PERSONAL_RECORD_TYPE_ONE_REP_MAX": 2,
+	}
+)
+
+func (x PersonalRecordType) Enum() *PersonalRecordType {
+	p := new(PersonalRecordType)
+	*p = x
+	return p
 }
 
-// ワークアウト削除リクエスト
-type DeleteWorkoutRequest struct {
+func (x PersonalRecordType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PersonalRecordType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_workout_proto_enumTypes[16].Descriptor()
+}
+
+func (PersonalRecordType) Type() protoreflect.EnumType {
+	return &file_proto_workout_proto_enumTypes[16]
+}
+
+func (x PersonalRecordType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PersonalRecordType.Descriptor instead.
+func (PersonalRecordType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{16}
+}
+
+// ワークアウト情報
+type Workout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id                 int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExerciseType       ExerciseType        `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Description        string              `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status             WorkoutStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=workout.WorkoutStatus" json:"status,omitempty"`
+	Difficulty         Difficulty          `protobuf:"varint,5,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
+	MuscleGroup        MuscleGroup         `protobuf:"varint,6,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	Sets               int32               `protobuf:"varint,7,opt,name=sets,proto3" json:"sets,omitempty"`
+	Reps               int32               `protobuf:"varint,8,opt,name=reps,proto3" json:"reps,omitempty"`
+	Weight             float64             `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	Notes              string              `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt          string              `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          string              `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CompletedAt        string              `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	EstimatedOneRepMax float64             `protobuf:"fixed64,14,opt,name=estimated_one_rep_max,json=estimatedOneRepMax,proto3" json:"estimated_one_rep_max,omitempty"`                        // 推定1RM（デフォルトの推定式で計算）
+	OneRepMaxFormula   OneRepMaxFormula    `protobuf:"varint,15,opt,name=one_rep_max_formula,json=oneRepMaxFormula,proto3,enum=workout.OneRepMaxFormula" json:"one_rep_max_formula,omitempty"` // 推定1RMの計算に使用した推定式
+	SkipReason         SkipReason          `protobuf:"varint,16,opt,name=skip_reason,json=skipReason,proto3,enum=workout.SkipReason" json:"skip_reason,omitempty"`                             // スキップ理由（スキップ時のみ）
+	ScheduledFor       string              `protobuf:"bytes,17,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`                                                // 実施予定日時（RFC3339、scheduled_timezoneのオフセット付き）
+	ProgramId          int32               `protobuf:"varint,18,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`                                                        // 生成元のプログラム（0の場合はなし）
+	ProgramVersion     int32               `protobuf:"varint,19,opt,name=program_version,json=programVersion,proto3" json:"program_version,omitempty"`                                         // 生成元のプログラムのバージョン
+	ScheduledTimezone  string              `protobuf:"bytes,20,opt,name=scheduled_timezone,json=scheduledTimezone,proto3" json:"scheduled_timezone,omitempty"`                                 // 予定を登録したタイムゾーン（IANA名）
+	DisplayName        *WorkoutDisplayName `protobuf:"bytes,21,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`                                                   // 列挙値の表示名（accept-languageの言語）
+	WeightUnit         WeightUnit          `protobuf:"varint,22,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"`                             // weight・estimated_one_rep_max・plate_weightの単位（x-weight-unit、省略時はユーザー設定）
+	PlateWeight        float64             `protobuf:"fixed64,23,opt,name=plate_weight,json=plateWeight,proto3" json:"plate_weight,omitempty"`                                                 // weightをプレートで組める重量に丸めた値（表示用、記録した単位と同じ場合はweightと同じ）
 }
 
-func (x *DeleteWorkoutRequest) Reset() {
-	*x = DeleteWorkoutRequest{}
+func (x *Workout) Reset() {
+	*x = Workout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[7]
+		mi := &file_proto_workout_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DeleteWorkoutRequest) String() string {
+func (x *Workout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteWorkoutRequest) ProtoMessage() {}
+func (*Workout) ProtoMessage() {}
 
-func (x *DeleteWorkoutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[7]
+func (x *Workout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,158 +1054,202 @@ func (x *DeleteWorkoutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteWorkoutRequest.ProtoReflect.Descriptor instead.
-func (*DeleteWorkoutRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use Workout.ProtoReflect.Descriptor instead.
+func (*Workout) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *DeleteWorkoutRequest) GetId() int32 {
+func (x *Workout) GetId() int32 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-// ワークアウト削除レスポンス
-type DeleteWorkoutResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Workout) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
 
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (x *Workout) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *DeleteWorkoutResponse) Reset() {
-	*x = DeleteWorkoutResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Workout) GetStatus() WorkoutStatus {
+	if x != nil {
+		return x.Status
 	}
+	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
 }
 
-func (x *DeleteWorkoutResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Workout) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
 }
 
-func (*DeleteWorkoutResponse) ProtoMessage() {}
+func (x *Workout) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
 
-func (x *DeleteWorkoutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Workout) GetSets() int32 {
+	if x != nil {
+		return x.Sets
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteWorkoutResponse.ProtoReflect.Descriptor instead.
-func (*DeleteWorkoutResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{8}
+func (x *Workout) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
 }
 
-func (x *DeleteWorkoutResponse) GetMessage() string {
+func (x *Workout) GetWeight() float64 {
 	if x != nil {
-		return x.Message
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Workout) GetNotes() string {
+	if x != nil {
+		return x.Notes
 	}
 	return ""
 }
 
-// ワークアウト一覧取得リクエスト
-type ListWorkoutsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Workout) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
 
-	StatusFilter      WorkoutStatus `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=workout.WorkoutStatus" json:"status_filter,omitempty"`
-	DifficultyFilter  Difficulty    `protobuf:"varint,2,opt,name=difficulty_filter,json=difficultyFilter,proto3,enum=workout.Difficulty" json:"difficulty_filter,omitempty"`
-	MuscleGroupFilter MuscleGroup   `protobuf:"varint,3,opt,name=muscle_group_filter,json=muscleGroupFilter,proto3,enum=workout.MuscleGroup" json:"muscle_group_filter,omitempty"`
+func (x *Workout) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
-func (x *ListWorkoutsRequest) Reset() {
-	*x = ListWorkoutsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Workout) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
 	}
+	return ""
 }
 
-func (x *ListWorkoutsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Workout) GetEstimatedOneRepMax() float64 {
+	if x != nil {
+		return x.EstimatedOneRepMax
+	}
+	return 0
 }
 
-func (*ListWorkoutsRequest) ProtoMessage() {}
+func (x *Workout) GetOneRepMaxFormula() OneRepMaxFormula {
+	if x != nil {
+		return x.OneRepMaxFormula
+	}
+	return OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED
+}
 
-func (x *ListWorkoutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Workout) GetSkipReason() SkipReason {
+	if x != nil {
+		return x.SkipReason
 	}
-	return mi.MessageOf(x)
+	return SkipReason_SKIP_REASON_UNSPECIFIED
 }
 
-// Deprecated: Use ListWorkoutsRequest.ProtoReflect.Descriptor instead.
-func (*ListWorkoutsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{9}
+func (x *Workout) GetScheduledFor() string {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return ""
 }
 
-func (x *ListWorkoutsRequest) GetStatusFilter() WorkoutStatus {
+func (x *Workout) GetProgramId() int32 {
 	if x != nil {
-		return x.StatusFilter
+		return x.ProgramId
 	}
-	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+	return 0
 }
 
-func (x *ListWorkoutsRequest) GetDifficultyFilter() Difficulty {
+func (x *Workout) GetProgramVersion() int32 {
 	if x != nil {
-		return x.DifficultyFilter
+		return x.ProgramVersion
 	}
-	return Difficulty_DIFFICULTY_UNSPECIFIED
+	return 0
 }
 
-func (x *ListWorkoutsRequest) GetMuscleGroupFilter() MuscleGroup {
+func (x *Workout) GetScheduledTimezone() string {
 	if x != nil {
-		return x.MuscleGroupFilter
+		return x.ScheduledTimezone
 	}
-	return MuscleGroup_UNSPECIFIED
+	return ""
 }
 
-// ワークアウト一覧取得レスポンス
-type ListWorkoutsResponse struct {
+func (x *Workout) GetDisplayName() *WorkoutDisplayName {
+	if x != nil {
+		return x.DisplayName
+	}
+	return nil
+}
+
+func (x *Workout) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+func (x *Workout) GetPlateWeight() float64 {
+	if x != nil {
+		return x.PlateWeight
+	}
+	return 0
+}
+
+// ワークアウトの列挙値の表示名
+type WorkoutDisplayName struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Workouts   []*Workout `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
-	TotalCount int32      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Message    string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // サマリーメッセージ
+	ExerciseType string `protobuf:"bytes,1,opt,name=exercise_type,json=exerciseType,proto3" json:"exercise_type,omitempty"`
+	MuscleGroup  string `protobuf:"bytes,2,opt,name=muscle_group,json=muscleGroup,proto3" json:"muscle_group,omitempty"`
+	Difficulty   string `protobuf:"bytes,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Status       string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	SkipReason   string `protobuf:"bytes,5,opt,name=skip_reason,json=skipReason,proto3" json:"skip_reason,omitempty"` // スキップ時のみ
 }
 
-func (x *ListWorkoutsResponse) Reset() {
-	*x = ListWorkoutsResponse{}
+func (x *WorkoutDisplayName) Reset() {
+	*x = WorkoutDisplayName{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[10]
+		mi := &file_proto_workout_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListWorkoutsResponse) String() string {
+func (x *WorkoutDisplayName) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWorkoutsResponse) ProtoMessage() {}
+func (*WorkoutDisplayName) ProtoMessage() {}
 
-func (x *ListWorkoutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[10]
+func (x *WorkoutDisplayName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1048,430 +1260,11018 @@ func (x *ListWorkoutsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWorkoutsResponse.ProtoReflect.Descriptor instead.
-func (*ListWorkoutsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use WorkoutDisplayName.ProtoReflect.Descriptor instead.
+func (*WorkoutDisplayName) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ListWorkoutsResponse) GetWorkouts() []*Workout {
+func (x *WorkoutDisplayName) GetExerciseType() string {
 	if x != nil {
-		return x.Workouts
+		return x.ExerciseType
 	}
-	return nil
+	return ""
 }
 
-func (x *ListWorkoutsResponse) GetTotalCount() int32 {
+func (x *WorkoutDisplayName) GetMuscleGroup() string {
 	if x != nil {
-		return x.TotalCount
+		return x.MuscleGroup
+	}
+	return ""
+}
+
+func (x *WorkoutDisplayName) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
+	}
+	return ""
+}
+
+func (x *WorkoutDisplayName) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WorkoutDisplayName) GetSkipReason() string {
+	if x != nil {
+		return x.SkipReason
+	}
+	return ""
+}
+
+// ワークアウト作成リクエスト
+// 数値の範囲はworkoutsテーブルのCHECK制約と同じ（sets・repsは省略時はデフォルト値）
+type CreateWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseType ExerciseType `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Description  string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Difficulty   Difficulty   `protobuf:"varint,3,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
+	MuscleGroup  MuscleGroup  `protobuf:"varint,4,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	Sets         *int32       `protobuf:"varint,5,opt,name=sets,proto3,oneof" json:"sets,omitempty"`
+	Reps         *int32       `protobuf:"varint,6,opt,name=reps,proto3,oneof" json:"reps,omitempty"`
+	Weight       float64      `protobuf:"fixed64,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	Notes        string       `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	ScheduledFor string       `protobuf:"bytes,9,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"`                     // 実施予定日時（YYYY-MM-DD または RFC3339、省略時は予定日なし）
+	Timezone     string       `protobuf:"bytes,10,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                // 予定日時のタイムゾーン（IANA名、省略時はユーザー設定）
+	WeightUnit   WeightUnit   `protobuf:"varint,11,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // weightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *CreateWorkoutRequest) Reset() {
+	*x = CreateWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkoutRequest) ProtoMessage() {}
+
+func (x *CreateWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*CreateWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateWorkoutRequest) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *CreateWorkoutRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateWorkoutRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *CreateWorkoutRequest) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *CreateWorkoutRequest) GetSets() int32 {
+	if x != nil && x.Sets != nil {
+		return *x.Sets
 	}
 	return 0
 }
 
-func (x *ListWorkoutsResponse) GetMessage() string {
+func (x *CreateWorkoutRequest) GetReps() int32 {
+	if x != nil && x.Reps != nil {
+		return *x.Reps
+	}
+	return 0
+}
+
+func (x *CreateWorkoutRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CreateWorkoutRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateWorkoutRequest) GetScheduledFor() string {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return ""
+}
+
+func (x *CreateWorkoutRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateWorkoutRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// ワークアウト作成レスポンス
+type CreateWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CreateWorkoutResponse) Reset() {
+	*x = CreateWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWorkoutResponse) ProtoMessage() {}
+
+func (x *CreateWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*CreateWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateWorkoutResponse) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *CreateWorkoutResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// 高強度ワークアウト取得リクエスト
-type GetHighIntensityWorkoutsRequest struct {
+// ワークアウト取得リクエスト
+type GetWorkoutRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GetHighIntensityWorkoutsRequest) Reset() {
-	*x = GetHighIntensityWorkoutsRequest{}
+func (x *GetWorkoutRequest) Reset() {
+	*x = GetWorkoutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[11]
+		mi := &file_proto_workout_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetHighIntensityWorkoutsRequest) String() string {
+func (x *GetWorkoutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHighIntensityWorkoutsRequest) ProtoMessage() {}
+func (*GetWorkoutRequest) ProtoMessage() {}
 
-func (x *GetHighIntensityWorkoutsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[11]
+func (x *GetWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHighIntensityWorkoutsRequest.ProtoReflect.Descriptor instead.
-func (*GetHighIntensityWorkoutsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{11}
-}
-
-// 高強度ワークアウト取得レスポンス
-type GetHighIntensityWorkoutsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Workouts   []*Workout `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
-	TotalCount int32      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Message    string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *GetHighIntensityWorkoutsResponse) Reset() {
-	*x = GetHighIntensityWorkoutsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_workout_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *GetHighIntensityWorkoutsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetHighIntensityWorkoutsResponse) ProtoMessage() {}
-
-func (x *GetHighIntensityWorkoutsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_workout_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetWorkoutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ワークアウト取得レスポンス
+type GetWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+}
+
+func (x *GetWorkoutResponse) Reset() {
+	*x = GetWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkoutResponse) ProtoMessage() {}
+
+func (x *GetWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetWorkoutResponse) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+// ワークアウト更新リクエスト
+// sets・repsが省略された場合は変更しない
+type UpdateWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExerciseType ExerciseType  `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Description  string        `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Status       WorkoutStatus `protobuf:"varint,4,opt,name=status,proto3,enum=workout.WorkoutStatus" json:"status,omitempty"`
+	Difficulty   Difficulty    `protobuf:"varint,5,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
+	MuscleGroup  MuscleGroup   `protobuf:"varint,6,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	Sets         *int32        `protobuf:"varint,7,opt,name=sets,proto3,oneof" json:"sets,omitempty"`
+	Reps         *int32        `protobuf:"varint,8,opt,name=reps,proto3,oneof" json:"reps,omitempty"`
+	Weight       float64       `protobuf:"fixed64,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	Notes        string        `protobuf:"bytes,10,opt,name=notes,proto3" json:"notes,omitempty"`
+	SkipReason   SkipReason    `protobuf:"varint,11,opt,name=skip_reason,json=skipReason,proto3,enum=workout.SkipReason" json:"skip_reason,omitempty"` // ステータスがスキップの場合のみ反映
+	WeightUnit   WeightUnit    `protobuf:"varint,12,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // weightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *UpdateWorkoutRequest) Reset() {
+	*x = UpdateWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkoutRequest) ProtoMessage() {}
+
+func (x *UpdateWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpdateWorkoutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWorkoutRequest) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *UpdateWorkoutRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateWorkoutRequest) GetStatus() WorkoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateWorkoutRequest) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *UpdateWorkoutRequest) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *UpdateWorkoutRequest) GetSets() int32 {
+	if x != nil && x.Sets != nil {
+		return *x.Sets
+	}
+	return 0
+}
+
+func (x *UpdateWorkoutRequest) GetReps() int32 {
+	if x != nil && x.Reps != nil {
+		return *x.Reps
+	}
+	return 0
+}
+
+func (x *UpdateWorkoutRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *UpdateWorkoutRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateWorkoutRequest) GetSkipReason() SkipReason {
+	if x != nil {
+		return x.SkipReason
+	}
+	return SkipReason_SKIP_REASON_UNSPECIFIED
+}
+
+func (x *UpdateWorkoutRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// ワークアウト更新レスポンス
+type UpdateWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateWorkoutResponse) Reset() {
+	*x = UpdateWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWorkoutResponse) ProtoMessage() {}
+
+func (x *UpdateWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateWorkoutResponse) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *UpdateWorkoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ワークアウト削除リクエスト
+type DeleteWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteWorkoutRequest) Reset() {
+	*x = DeleteWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkoutRequest) ProtoMessage() {}
+
+func (x *DeleteWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeleteWorkoutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ワークアウト削除レスポンス
+type DeleteWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteWorkoutResponse) Reset() {
+	*x = DeleteWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWorkoutResponse) ProtoMessage() {}
+
+func (x *DeleteWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteWorkoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ワークアウト一覧取得リクエスト
+type ListWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusFilter      WorkoutStatus `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=workout.WorkoutStatus" json:"status_filter,omitempty"`
+	DifficultyFilter  Difficulty    `protobuf:"varint,2,opt,name=difficulty_filter,json=difficultyFilter,proto3,enum=workout.Difficulty" json:"difficulty_filter,omitempty"`
+	MuscleGroupFilter MuscleGroup   `protobuf:"varint,3,opt,name=muscle_group_filter,json=muscleGroupFilter,proto3,enum=workout.MuscleGroup" json:"muscle_group_filter,omitempty"`
+}
+
+func (x *ListWorkoutsRequest) Reset() {
+	*x = ListWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkoutsRequest) ProtoMessage() {}
+
+func (x *ListWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListWorkoutsRequest) GetStatusFilter() WorkoutStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+}
+
+func (x *ListWorkoutsRequest) GetDifficultyFilter() Difficulty {
+	if x != nil {
+		return x.DifficultyFilter
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *ListWorkoutsRequest) GetMuscleGroupFilter() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroupFilter
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+// ワークアウト一覧取得レスポンス
+type ListWorkoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts   []*Workout `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	TotalCount int32      `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Message    string     `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // サマリーメッセージ
+}
+
+func (x *ListWorkoutsResponse) Reset() {
+	*x = ListWorkoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWorkoutsResponse) ProtoMessage() {}
+
+func (x *ListWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListWorkoutsResponse) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *ListWorkoutsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListWorkoutsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 高強度判定ルール（指定した条件を全て満たすワークアウトが一致する）
+type IntensityRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                  string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExerciseType          ExerciseType `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`           // 未指定の場合は全種目
+	MinDifficulty         Difficulty   `protobuf:"varint,3,opt,name=min_difficulty,json=minDifficulty,proto3,enum=workout.Difficulty" json:"min_difficulty,omitempty"`          // 難易度の下限
+	MinWeight             float64      `protobuf:"fixed64,4,opt,name=min_weight,json=minWeight,proto3" json:"min_weight,omitempty"`                                             // 絶対重量の下限（リクエストのweight_unitの単位）
+	MinBodyweightRatio    float64      `protobuf:"fixed64,5,opt,name=min_bodyweight_ratio,json=minBodyweightRatio,proto3" json:"min_bodyweight_ratio,omitempty"`                // 体重比の下限
+	MinPercentOfOneRepMax float64      `protobuf:"fixed64,6,opt,name=min_percent_of_one_rep_max,json=minPercentOfOneRepMax,proto3" json:"min_percent_of_one_rep_max,omitempty"` // 種目ごとのベストe1RM比の下限（0.0〜1.0）
+	MinVolume             float64      `protobuf:"fixed64,7,opt,name=min_volume,json=minVolume,proto3" json:"min_volume,omitempty"`                                             // Sets × Reps × Weight の下限（リクエストのweight_unitの単位）
+	MinSets               int32        `protobuf:"varint,8,opt,name=min_sets,json=minSets,proto3" json:"min_sets,omitempty"`                                                    // セット数の下限
+}
+
+func (x *IntensityRule) Reset() {
+	*x = IntensityRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IntensityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntensityRule) ProtoMessage() {}
+
+func (x *IntensityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntensityRule.ProtoReflect.Descriptor instead.
+func (*IntensityRule) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *IntensityRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IntensityRule) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *IntensityRule) GetMinDifficulty() Difficulty {
+	if x != nil {
+		return x.MinDifficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *IntensityRule) GetMinWeight() float64 {
+	if x != nil {
+		return x.MinWeight
+	}
+	return 0
+}
+
+func (x *IntensityRule) GetMinBodyweightRatio() float64 {
+	if x != nil {
+		return x.MinBodyweightRatio
+	}
+	return 0
+}
+
+func (x *IntensityRule) GetMinPercentOfOneRepMax() float64 {
+	if x != nil {
+		return x.MinPercentOfOneRepMax
+	}
+	return 0
+}
+
+func (x *IntensityRule) GetMinVolume() float64 {
+	if x != nil {
+		return x.MinVolume
+	}
+	return 0
+}
+
+func (x *IntensityRule) GetMinSets() int32 {
+	if x != nil {
+		return x.MinSets
+	}
+	return 0
+}
+
+// 高強度ワークアウト取得リクエスト
+type GetHighIntensityWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rules      []*IntensityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`                                                      // 未指定の場合はサーバー設定のルールを使用（サーバー設定のルールはkg）
+	Bodyweight float64          `protobuf:"fixed64,2,opt,name=bodyweight,proto3" json:"bodyweight,omitempty"`                                          // 体重比ルールで使用する体重（weight_unitの単位）
+	WeightUnit WeightUnit       `protobuf:"varint,3,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // rulesの重量・bodyweightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *GetHighIntensityWorkoutsRequest) Reset() {
+	*x = GetHighIntensityWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHighIntensityWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHighIntensityWorkoutsRequest) ProtoMessage() {}
+
+func (x *GetHighIntensityWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHighIntensityWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*GetHighIntensityWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetHighIntensityWorkoutsRequest) GetRules() []*IntensityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *GetHighIntensityWorkoutsRequest) GetBodyweight() float64 {
+	if x != nil {
+		return x.Bodyweight
+	}
+	return 0
+}
+
+func (x *GetHighIntensityWorkoutsRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 高強度ルールに一致したワークアウト
+type HighIntensityMatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout     *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	MatchedRule string   `protobuf:"bytes,2,opt,name=matched_rule,json=matchedRule,proto3" json:"matched_rule,omitempty"` // 最初に一致したルール名
+}
+
+func (x *HighIntensityMatch) Reset() {
+	*x = HighIntensityMatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HighIntensityMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HighIntensityMatch) ProtoMessage() {}
+
+func (x *HighIntensityMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HighIntensityMatch.ProtoReflect.Descriptor instead.
+func (*HighIntensityMatch) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *HighIntensityMatch) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *HighIntensityMatch) GetMatchedRule() string {
+	if x != nil {
+		return x.MatchedRule
+	}
+	return ""
+}
+
+// 高強度ワークアウト取得レスポンス
+type GetHighIntensityWorkoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts   []*Workout            `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	TotalCount int32                 `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Message    string                `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Matches    []*HighIntensityMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches,omitempty"`
+}
+
+func (x *GetHighIntensityWorkoutsResponse) Reset() {
+	*x = GetHighIntensityWorkoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHighIntensityWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHighIntensityWorkoutsResponse) ProtoMessage() {}
+
+func (x *GetHighIntensityWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHighIntensityWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*GetHighIntensityWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetHighIntensityWorkoutsResponse) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *GetHighIntensityWorkoutsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetHighIntensityWorkoutsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetHighIntensityWorkoutsResponse) GetMatches() []*HighIntensityMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+// 1RM計算リクエスト
+type CalculateOneRepMaxRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight  float64          `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Reps    int32            `protobuf:"varint,2,opt,name=reps,proto3" json:"reps,omitempty"`
+	Rpe     float64          `protobuf:"fixed64,3,opt,name=rpe,proto3" json:"rpe,omitempty"`                                      // RPEチャートを使う場合のみ指定（6.0〜10.0）
+	Formula OneRepMaxFormula `protobuf:"varint,4,opt,name=formula,proto3,enum=workout.OneRepMaxFormula" json:"formula,omitempty"` // 未指定の場合は適用可能な全ての推定式で計算
+}
+
+func (x *CalculateOneRepMaxRequest) Reset() {
+	*x = CalculateOneRepMaxRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateOneRepMaxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateOneRepMaxRequest) ProtoMessage() {}
+
+func (x *CalculateOneRepMaxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateOneRepMaxRequest.ProtoReflect.Descriptor instead.
+func (*CalculateOneRepMaxRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CalculateOneRepMaxRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CalculateOneRepMaxRequest) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
+}
+
+func (x *CalculateOneRepMaxRequest) GetRpe() float64 {
+	if x != nil {
+		return x.Rpe
+	}
+	return 0
+}
+
+func (x *CalculateOneRepMaxRequest) GetFormula() OneRepMaxFormula {
+	if x != nil {
+		return x.Formula
+	}
+	return OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED
+}
+
+// 推定1RM
+type OneRepMaxEstimate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Formula            OneRepMaxFormula `protobuf:"varint,1,opt,name=formula,proto3,enum=workout.OneRepMaxFormula" json:"formula,omitempty"`
+	EstimatedOneRepMax float64          `protobuf:"fixed64,2,opt,name=estimated_one_rep_max,json=estimatedOneRepMax,proto3" json:"estimated_one_rep_max,omitempty"`
+}
+
+func (x *OneRepMaxEstimate) Reset() {
+	*x = OneRepMaxEstimate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneRepMaxEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneRepMaxEstimate) ProtoMessage() {}
+
+func (x *OneRepMaxEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneRepMaxEstimate.ProtoReflect.Descriptor instead.
+func (*OneRepMaxEstimate) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OneRepMaxEstimate) GetFormula() OneRepMaxFormula {
+	if x != nil {
+		return x.Formula
+	}
+	return OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED
+}
+
+func (x *OneRepMaxEstimate) GetEstimatedOneRepMax() float64 {
+	if x != nil {
+		return x.EstimatedOneRepMax
+	}
+	return 0
+}
+
+// 1RM計算レスポンス
+type CalculateOneRepMaxResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Estimates []*OneRepMaxEstimate `protobuf:"bytes,1,rep,name=estimates,proto3" json:"estimates,omitempty"`
+	Message   string               `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CalculateOneRepMaxResponse) Reset() {
+	*x = CalculateOneRepMaxResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculateOneRepMaxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculateOneRepMaxResponse) ProtoMessage() {}
+
+func (x *CalculateOneRepMaxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculateOneRepMaxResponse.ProtoReflect.Descriptor instead.
+func (*CalculateOneRepMaxResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CalculateOneRepMaxResponse) GetEstimates() []*OneRepMaxEstimate {
+	if x != nil {
+		return x.Estimates
+	}
+	return nil
+}
+
+func (x *CalculateOneRepMaxResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// トレーニング統計取得リクエスト
+type GetTrainingStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period   StatsPeriod `protobuf:"varint,1,opt,name=period,proto3,enum=workout.StatsPeriod" json:"period,omitempty"`
+	DateFrom string      `protobuf:"bytes,2,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD または RFC3339（この日を含む）
+	DateTo   string      `protobuf:"bytes,3,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD または RFC3339（この日を含む）
+	Timezone string      `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                 // 日付・集計期間の区切りに使用するタイムゾーン（IANA名、省略時はユーザー設定）
+}
+
+func (x *GetTrainingStatsRequest) Reset() {
+	*x = GetTrainingStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrainingStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingStatsRequest) ProtoMessage() {}
+
+func (x *GetTrainingStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainingStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTrainingStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetTrainingStatsRequest) GetPeriod() StatsPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StatsPeriod_STATS_PERIOD_UNSPECIFIED
+}
+
+func (x *GetTrainingStatsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetTrainingStatsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetTrainingStatsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 筋肉群ごとのセット数
+type MuscleGroupSets struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MuscleGroup MuscleGroup `protobuf:"varint,1,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	Sets        int32       `protobuf:"varint,2,opt,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *MuscleGroupSets) Reset() {
+	*x = MuscleGroupSets{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuscleGroupSets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuscleGroupSets) ProtoMessage() {}
+
+func (x *MuscleGroupSets) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuscleGroupSets.ProtoReflect.Descriptor instead.
+func (*MuscleGroupSets) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MuscleGroupSets) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *MuscleGroupSets) GetSets() int32 {
+	if x != nil {
+		return x.Sets
+	}
+	return 0
+}
+
+// 集計期間ごとのトレーニング統計
+type TrainingStatsBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodStart       string             `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // 期間の開始日（YYYY-MM-DD）
+	WorkoutCount      int32              `protobuf:"varint,2,opt,name=workout_count,json=workoutCount,proto3" json:"workout_count,omitempty"`
+	CompletedCount    int32              `protobuf:"varint,3,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	SkippedCount      int32              `protobuf:"varint,4,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	CompletionRate    float64            `protobuf:"fixed64,5,opt,name=completion_rate,json=completionRate,proto3" json:"completion_rate,omitempty"`          // 完了率（0.0〜1.0）
+	SkipRate          float64            `protobuf:"fixed64,6,opt,name=skip_rate,json=skipRate,proto3" json:"skip_rate,omitempty"`                            // スキップ率（0.0〜1.0）
+	TotalVolume       float64            `protobuf:"fixed64,7,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`                   // 完了分の Sets × Reps × Weight の合計（weight_unitの単位）
+	TotalSets         int32              `protobuf:"varint,8,opt,name=total_sets,json=totalSets,proto3" json:"total_sets,omitempty"`                          // 完了分のセット数
+	AverageDifficulty float64            `protobuf:"fixed64,9,opt,name=average_difficulty,json=averageDifficulty,proto3" json:"average_difficulty,omitempty"` // 平均難易度（1.0:初心者 〜 4.0:野獣級）
+	SetsByMuscleGroup []*MuscleGroupSets `protobuf:"bytes,10,rep,name=sets_by_muscle_group,json=setsByMuscleGroup,proto3" json:"sets_by_muscle_group,omitempty"`
+}
+
+func (x *TrainingStatsBucket) Reset() {
+	*x = TrainingStatsBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingStatsBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingStatsBucket) ProtoMessage() {}
+
+func (x *TrainingStatsBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainingStatsBucket.ProtoReflect.Descriptor instead.
+func (*TrainingStatsBucket) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TrainingStatsBucket) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *TrainingStatsBucket) GetWorkoutCount() int32 {
+	if x != nil {
+		return x.WorkoutCount
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetCompletionRate() float64 {
+	if x != nil {
+		return x.CompletionRate
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetSkipRate() float64 {
+	if x != nil {
+		return x.SkipRate
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetTotalSets() int32 {
+	if x != nil {
+		return x.TotalSets
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetAverageDifficulty() float64 {
+	if x != nil {
+		return x.AverageDifficulty
+	}
+	return 0
+}
+
+func (x *TrainingStatsBucket) GetSetsByMuscleGroup() []*MuscleGroupSets {
+	if x != nil {
+		return x.SetsByMuscleGroup
+	}
+	return nil
+}
+
+// トレーニング統計取得レスポンス
+type GetTrainingStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets     []*TrainingStatsBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	TotalVolume float64                `protobuf:"fixed64,2,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"` // weight_unitの単位
+	Message     string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	WeightUnit  WeightUnit             `protobuf:"varint,4,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // total_volumeの単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *GetTrainingStatsResponse) Reset() {
+	*x = GetTrainingStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTrainingStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrainingStatsResponse) ProtoMessage() {}
+
+func (x *GetTrainingStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrainingStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTrainingStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetTrainingStatsResponse) GetBuckets() []*TrainingStatsBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+func (x *GetTrainingStatsResponse) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *GetTrainingStatsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetTrainingStatsResponse) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 筋肉群ごとの週あたり目標セット数
+type VolumeTarget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MuscleGroup   MuscleGroup `protobuf:"varint,1,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	MinWeeklySets float64     `protobuf:"fixed64,2,opt,name=min_weekly_sets,json=minWeeklySets,proto3" json:"min_weekly_sets,omitempty"`
+	MaxWeeklySets float64     `protobuf:"fixed64,3,opt,name=max_weekly_sets,json=maxWeeklySets,proto3" json:"max_weekly_sets,omitempty"` // 0の場合は上限なし
+}
+
+func (x *VolumeTarget) Reset() {
+	*x = VolumeTarget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VolumeTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeTarget) ProtoMessage() {}
+
+func (x *VolumeTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeTarget.ProtoReflect.Descriptor instead.
+func (*VolumeTarget) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VolumeTarget) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *VolumeTarget) GetMinWeeklySets() float64 {
+	if x != nil {
+		return x.MinWeeklySets
+	}
+	return 0
+}
+
+func (x *VolumeTarget) GetMaxWeeklySets() float64 {
+	if x != nil {
+		return x.MaxWeeklySets
+	}
+	return 0
+}
+
+// ボリュームバランスレポート取得リクエスト
+type GetMuscleBalanceReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom        string          `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                              // YYYY-MM-DD または RFC3339（省略時は date_to の7日前）
+	DateTo          string          `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                                    // YYYY-MM-DD または RFC3339（この日を含む、省略時は現在）
+	SecondaryWeight *float64        `protobuf:"fixed64,3,opt,name=secondary_weight,json=secondaryWeight,proto3,oneof" json:"secondary_weight,omitempty"` // 協働筋の重み（0.0〜1.0、省略時は設定値）
+	Targets         []*VolumeTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`                                                // 指定した筋肉群のみ設定値を上書き
+	Timezone        string          `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                                              // 日付の解釈に使用するタイムゾーン（IANA名、省略時はユーザー設定）
+}
+
+func (x *GetMuscleBalanceReportRequest) Reset() {
+	*x = GetMuscleBalanceReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMuscleBalanceReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMuscleBalanceReportRequest) ProtoMessage() {}
+
+func (x *GetMuscleBalanceReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMuscleBalanceReportRequest.ProtoReflect.Descriptor instead.
+func (*GetMuscleBalanceReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetMuscleBalanceReportRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetMuscleBalanceReportRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetMuscleBalanceReportRequest) GetSecondaryWeight() float64 {
+	if x != nil && x.SecondaryWeight != nil {
+		return *x.SecondaryWeight
+	}
+	return 0
+}
+
+func (x *GetMuscleBalanceReportRequest) GetTargets() []*VolumeTarget {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+func (x *GetMuscleBalanceReportRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 筋肉群ごとのボリューム
+type MuscleGroupVolume struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MuscleGroup   MuscleGroup         `protobuf:"varint,1,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"`
+	PrimarySets   float64             `protobuf:"fixed64,2,opt,name=primary_sets,json=primarySets,proto3" json:"primary_sets,omitempty"`       // 主働筋としてのセット数
+	SecondarySets float64             `protobuf:"fixed64,3,opt,name=secondary_sets,json=secondarySets,proto3" json:"secondary_sets,omitempty"` // 協働筋としてのセット数（重み付け前）
+	WeightedSets  float64             `protobuf:"fixed64,4,opt,name=weighted_sets,json=weightedSets,proto3" json:"weighted_sets,omitempty"`    // 重み付け後のセット数
+	WeeklySets    float64             `protobuf:"fixed64,5,opt,name=weekly_sets,json=weeklySets,proto3" json:"weekly_sets,omitempty"`          // 週あたりのセット数
+	Target        *VolumeTarget       `protobuf:"bytes,6,opt,name=target,proto3" json:"target,omitempty"`                                      // 目標未設定の場合は空
+	Status        VolumeBalanceStatus `protobuf:"varint,7,opt,name=status,proto3,enum=workout.VolumeBalanceStatus" json:"status,omitempty"`
+	DisplayName   string              `protobuf:"bytes,8,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // 筋肉群の表示名
+}
+
+func (x *MuscleGroupVolume) Reset() {
+	*x = MuscleGroupVolume{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuscleGroupVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuscleGroupVolume) ProtoMessage() {}
+
+func (x *MuscleGroupVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuscleGroupVolume.ProtoReflect.Descriptor instead.
+func (*MuscleGroupVolume) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MuscleGroupVolume) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *MuscleGroupVolume) GetPrimarySets() float64 {
+	if x != nil {
+		return x.PrimarySets
+	}
+	return 0
+}
+
+func (x *MuscleGroupVolume) GetSecondarySets() float64 {
+	if x != nil {
+		return x.SecondarySets
+	}
+	return 0
+}
+
+func (x *MuscleGroupVolume) GetWeightedSets() float64 {
+	if x != nil {
+		return x.WeightedSets
+	}
+	return 0
+}
+
+func (x *MuscleGroupVolume) GetWeeklySets() float64 {
+	if x != nil {
+		return x.WeeklySets
+	}
+	return 0
+}
+
+func (x *MuscleGroupVolume) GetTarget() *VolumeTarget {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *MuscleGroupVolume) GetStatus() VolumeBalanceStatus {
+	if x != nil {
+		return x.Status
+	}
+	return VolumeBalanceStatus_VOLUME_BALANCE_STATUS_UNSPECIFIED
+}
+
+func (x *MuscleGroupVolume) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// ボリュームバランスレポート取得レスポンス
+type GetMuscleBalanceReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MuscleGroups    []*MuscleGroupVolume `protobuf:"bytes,1,rep,name=muscle_groups,json=muscleGroups,proto3" json:"muscle_groups,omitempty"`
+	Weeks           float64              `protobuf:"fixed64,2,opt,name=weeks,proto3" json:"weeks,omitempty"` // 集計期間の週数
+	SecondaryWeight float64              `protobuf:"fixed64,3,opt,name=secondary_weight,json=secondaryWeight,proto3" json:"secondary_weight,omitempty"`
+	Message         string               `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"` // 例: "胸が多すぎ、背中が不足しています"
+}
+
+func (x *GetMuscleBalanceReportResponse) Reset() {
+	*x = GetMuscleBalanceReportResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMuscleBalanceReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMuscleBalanceReportResponse) ProtoMessage() {}
+
+func (x *GetMuscleBalanceReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMuscleBalanceReportResponse.ProtoReflect.Descriptor instead.
+func (*GetMuscleBalanceReportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetMuscleBalanceReportResponse) GetMuscleGroups() []*MuscleGroupVolume {
+	if x != nil {
+		return x.MuscleGroups
+	}
+	return nil
+}
+
+func (x *GetMuscleBalanceReportResponse) GetWeeks() float64 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *GetMuscleBalanceReportResponse) GetSecondaryWeight() float64 {
+	if x != nil {
+		return x.SecondaryWeight
+	}
+	return 0
+}
+
+func (x *GetMuscleBalanceReportResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 継続状況取得リクエスト
+type GetConsistencyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom         string      `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                                                     // YYYY-MM-DD または RFC3339（省略時は date_to の1年前）
+	DateTo           string      `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                                                           // YYYY-MM-DD または RFC3339（この日を含む、省略時は今日）
+	StreakUnit       StatsPeriod `protobuf:"varint,3,opt,name=streak_unit,json=streakUnit,proto3,enum=workout.StatsPeriod" json:"streak_unit,omitempty"`                     // ストリークの単位（日別・週別、未指定は日別）
+	SkipReasonPeriod StatsPeriod `protobuf:"varint,4,opt,name=skip_reason_period,json=skipReasonPeriod,proto3,enum=workout.StatsPeriod" json:"skip_reason_period,omitempty"` // スキップ理由の集計単位（未指定は週別）
+	Timezone         string      `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                                     // IANAタイムゾーン名（例: Asia/Tokyo、省略時はサーバー設定）
+}
+
+func (x *GetConsistencyRequest) Reset() {
+	*x = GetConsistencyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyRequest) ProtoMessage() {}
+
+func (x *GetConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*GetConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetConsistencyRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetConsistencyRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetConsistencyRequest) GetStreakUnit() StatsPeriod {
+	if x != nil {
+		return x.StreakUnit
+	}
+	return StatsPeriod_STATS_PERIOD_UNSPECIFIED
+}
+
+func (x *GetConsistencyRequest) GetSkipReasonPeriod() StatsPeriod {
+	if x != nil {
+		return x.SkipReasonPeriod
+	}
+	return StatsPeriod_STATS_PERIOD_UNSPECIFIED
+}
+
+func (x *GetConsistencyRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 連続してトレーニングした期間
+type Streak struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Length int32  `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"` // 連続した日数または週数
+	Start  string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`    // 最初の期間の開始日（YYYY-MM-DD）
+	End    string `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`        // 最後の期間の開始日（YYYY-MM-DD）
+}
+
+func (x *Streak) Reset() {
+	*x = Streak{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Streak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Streak) ProtoMessage() {}
+
+func (x *Streak) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Streak.ProtoReflect.Descriptor instead.
+func (*Streak) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Streak) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *Streak) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Streak) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+// スキップ理由ごとの件数
+type SkipReasonCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason      SkipReason `protobuf:"varint,1,opt,name=reason,proto3,enum=workout.SkipReason" json:"reason,omitempty"`
+	Count       int32      `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	DisplayName string     `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"` // スキップ理由の表示名
+}
+
+func (x *SkipReasonCount) Reset() {
+	*x = SkipReasonCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipReasonCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipReasonCount) ProtoMessage() {}
+
+func (x *SkipReasonCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipReasonCount.ProtoReflect.Descriptor instead.
+func (*SkipReasonCount) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SkipReasonCount) GetReason() SkipReason {
+	if x != nil {
+		return x.Reason
+	}
+	return SkipReason_SKIP_REASON_UNSPECIFIED
+}
+
+func (x *SkipReasonCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SkipReasonCount) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+// 集計期間ごとのスキップ理由
+type SkipReasonBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeriodStart string             `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"` // 期間の開始日（YYYY-MM-DD）
+	Counts      []*SkipReasonCount `protobuf:"bytes,2,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *SkipReasonBucket) Reset() {
+	*x = SkipReasonBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SkipReasonBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipReasonBucket) ProtoMessage() {}
+
+func (x *SkipReasonBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipReasonBucket.ProtoReflect.Descriptor instead.
+func (*SkipReasonBucket) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SkipReasonBucket) GetPeriodStart() string {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return ""
+}
+
+func (x *SkipReasonBucket) GetCounts() []*SkipReasonCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+// カレンダーヒートマップの1日分
+type HeatmapDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"` // YYYY-MM-DD（指定タイムゾーンでの日付）
+	CompletedCount int32   `protobuf:"varint,2,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	TotalVolume    float64 `protobuf:"fixed64,3,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`
+}
+
+func (x *HeatmapDay) Reset() {
+	*x = HeatmapDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapDay) ProtoMessage() {}
+
+func (x *HeatmapDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapDay.ProtoReflect.Descriptor instead.
+func (*HeatmapDay) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *HeatmapDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *HeatmapDay) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *HeatmapDay) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+// 継続状況取得レスポンス
+type GetConsistencyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentStreak  *Streak             `protobuf:"bytes,1,opt,name=current_streak,json=currentStreak,proto3" json:"current_streak,omitempty"`
+	LongestStreak  *Streak             `protobuf:"bytes,2,opt,name=longest_streak,json=longestStreak,proto3" json:"longest_streak,omitempty"`
+	StreakUnit     StatsPeriod         `protobuf:"varint,3,opt,name=streak_unit,json=streakUnit,proto3,enum=workout.StatsPeriod" json:"streak_unit,omitempty"`
+	DueCount       int32               `protobuf:"varint,4,opt,name=due_count,json=dueCount,proto3" json:"due_count,omitempty"` // 実施予定日を過ぎたワークアウト数
+	CompletedCount int32               `protobuf:"varint,5,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	SkippedCount   int32               `protobuf:"varint,6,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+	MissedCount    int32               `protobuf:"varint,7,opt,name=missed_count,json=missedCount,proto3" json:"missed_count,omitempty"`        // 予定のまま実施日を過ぎたワークアウト数
+	AdherenceRate  float64             `protobuf:"fixed64,8,opt,name=adherence_rate,json=adherenceRate,proto3" json:"adherence_rate,omitempty"` // 予定の実施率（0.0〜1.0）
+	SkipReasons    []*SkipReasonBucket `protobuf:"bytes,9,rep,name=skip_reasons,json=skipReasons,proto3" json:"skip_reasons,omitempty"`
+	Heatmap        []*HeatmapDay       `protobuf:"bytes,10,rep,name=heatmap,proto3" json:"heatmap,omitempty"` // 期間内の全日（完了0件の日を含む）
+	Timezone       string              `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Message        string              `protobuf:"bytes,12,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetConsistencyResponse) Reset() {
+	*x = GetConsistencyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConsistencyResponse) ProtoMessage() {}
+
+func (x *GetConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*GetConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetConsistencyResponse) GetCurrentStreak() *Streak {
+	if x != nil {
+		return x.CurrentStreak
+	}
+	return nil
+}
+
+func (x *GetConsistencyResponse) GetLongestStreak() *Streak {
+	if x != nil {
+		return x.LongestStreak
+	}
+	return nil
+}
+
+func (x *GetConsistencyResponse) GetStreakUnit() StatsPeriod {
+	if x != nil {
+		return x.StreakUnit
+	}
+	return StatsPeriod_STATS_PERIOD_UNSPECIFIED
+}
+
+func (x *GetConsistencyResponse) GetDueCount() int32 {
+	if x != nil {
+		return x.DueCount
+	}
+	return 0
+}
+
+func (x *GetConsistencyResponse) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *GetConsistencyResponse) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *GetConsistencyResponse) GetMissedCount() int32 {
+	if x != nil {
+		return x.MissedCount
+	}
+	return 0
+}
+
+func (x *GetConsistencyResponse) GetAdherenceRate() float64 {
+	if x != nil {
+		return x.AdherenceRate
+	}
+	return 0
+}
+
+func (x *GetConsistencyResponse) GetSkipReasons() []*SkipReasonBucket {
+	if x != nil {
+		return x.SkipReasons
+	}
+	return nil
+}
+
+func (x *GetConsistencyResponse) GetHeatmap() []*HeatmapDay {
+	if x != nil {
+		return x.Heatmap
+	}
+	return nil
+}
+
+func (x *GetConsistencyResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetConsistencyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// セット・レップの構成
+type SetScheme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets   int32   `protobuf:"varint,1,opt,name=sets,proto3" json:"sets,omitempty"`
+	Reps   int32   `protobuf:"varint,2,opt,name=reps,proto3" json:"reps,omitempty"`
+	Weight float64 `protobuf:"fixed64,3,opt,name=weight,proto3" json:"weight,omitempty"` // 固定重量（kg）
+	// トレーニングマックスに対する割合（例: 0.85）。0より大きい場合はweightより優先
+	PercentOfTrainingMax float64 `protobuf:"fixed64,4,opt,name=percent_of_training_max,json=percentOfTrainingMax,proto3" json:"percent_of_training_max,omitempty"`
+}
+
+func (x *SetScheme) Reset() {
+	*x = SetScheme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetScheme) ProtoMessage() {}
+
+func (x *SetScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetScheme.ProtoReflect.Descriptor instead.
+func (*SetScheme) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetScheme) GetSets() int32 {
+	if x != nil {
+		return x.Sets
+	}
+	return 0
+}
+
+func (x *SetScheme) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
+}
+
+func (x *SetScheme) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SetScheme) GetPercentOfTrainingMax() float64 {
+	if x != nil {
+		return x.PercentOfTrainingMax
+	}
+	return 0
+}
+
+// 1週分のセット構成
+type WeekScheme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sets []*SetScheme `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets,omitempty"`
+}
+
+func (x *WeekScheme) Reset() {
+	*x = WeekScheme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WeekScheme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeekScheme) ProtoMessage() {}
+
+func (x *WeekScheme) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeekScheme.ProtoReflect.Descriptor instead.
+func (*WeekScheme) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *WeekScheme) GetSets() []*SetScheme {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+// テンプレート内の種目
+type TemplateExercise struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseType ExerciseType  `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	MuscleGroup  MuscleGroup   `protobuf:"varint,2,opt,name=muscle_group,json=muscleGroup,proto3,enum=workout.MuscleGroup" json:"muscle_group,omitempty"` // 未指定の場合は種目カタログの主働筋
+	Difficulty   Difficulty    `protobuf:"varint,3,opt,name=difficulty,proto3,enum=workout.Difficulty" json:"difficulty,omitempty"`
+	Notes        string        `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	Weeks        []*WeekScheme `protobuf:"bytes,5,rep,name=weeks,proto3" json:"weeks,omitempty"` // 週ごとのセット構成（プログラムの週番号で循環）
+}
+
+func (x *TemplateExercise) Reset() {
+	*x = TemplateExercise{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateExercise) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateExercise) ProtoMessage() {}
+
+func (x *TemplateExercise) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateExercise.ProtoReflect.Descriptor instead.
+func (*TemplateExercise) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *TemplateExercise) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *TemplateExercise) GetMuscleGroup() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroup
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *TemplateExercise) GetDifficulty() Difficulty {
+	if x != nil {
+		return x.Difficulty
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *TemplateExercise) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *TemplateExercise) GetWeeks() []*WeekScheme {
+	if x != nil {
+		return x.Weeks
+	}
+	return nil
+}
+
+// 1日分のワークアウトテンプレート
+type WorkoutTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32               `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // 例: "Push"、"Day 1"
+	DayOfWeek DayOfWeek           `protobuf:"varint,3,opt,name=day_of_week,json=dayOfWeek,proto3,enum=workout.DayOfWeek" json:"day_of_week,omitempty"`
+	Exercises []*TemplateExercise `protobuf:"bytes,4,rep,name=exercises,proto3" json:"exercises,omitempty"`
+}
+
+func (x *WorkoutTemplate) Reset() {
+	*x = WorkoutTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkoutTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkoutTemplate) ProtoMessage() {}
+
+func (x *WorkoutTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkoutTemplate.ProtoReflect.Descriptor instead.
+func (*WorkoutTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *WorkoutTemplate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkoutTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WorkoutTemplate) GetDayOfWeek() DayOfWeek {
+	if x != nil {
+		return x.DayOfWeek
+	}
+	return DayOfWeek_DAY_OF_WEEK_UNSPECIFIED
+}
+
+func (x *WorkoutTemplate) GetExercises() []*TemplateExercise {
+	if x != nil {
+		return x.Exercises
+	}
+	return nil
+}
+
+// トレーニングプログラム
+type Program struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string             `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Version     int32              `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"` // テンプレートを編集するたびに1つ増える
+	Templates   []*WorkoutTemplate `protobuf:"bytes,5,rep,name=templates,proto3" json:"templates,omitempty"`
+	CreatedAt   string             `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string             `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *Program) Reset() {
+	*x = Program{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Program) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Program) ProtoMessage() {}
+
+func (x *Program) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Program.ProtoReflect.Descriptor instead.
+func (*Program) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Program) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Program) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Program) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Program) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Program) GetTemplates() []*WorkoutTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *Program) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Program) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+// プログラム作成リクエスト
+type CreateProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string             `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Templates   []*WorkoutTemplate `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *CreateProgramRequest) Reset() {
+	*x = CreateProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProgramRequest) ProtoMessage() {}
+
+func (x *CreateProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProgramRequest.ProtoReflect.Descriptor instead.
+func (*CreateProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateProgramRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProgramRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProgramRequest) GetTemplates() []*WorkoutTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// プログラム作成レスポンス
+type CreateProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Program *Program `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CreateProgramResponse) Reset() {
+	*x = CreateProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProgramResponse) ProtoMessage() {}
+
+func (x *CreateProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProgramResponse.ProtoReflect.Descriptor instead.
+func (*CreateProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *CreateProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// プログラム取得リクエスト
+type GetProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version int32 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 0の場合は最新バージョン
+}
+
+func (x *GetProgramRequest) Reset() {
+	*x = GetProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgramRequest) ProtoMessage() {}
+
+func (x *GetProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgramRequest.ProtoReflect.Descriptor instead.
+func (*GetProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetProgramRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetProgramRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// プログラム取得レスポンス
+type GetProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Program *Program `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetProgramResponse) Reset() {
+	*x = GetProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProgramResponse) ProtoMessage() {}
+
+func (x *GetProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProgramResponse.ProtoReflect.Descriptor instead.
+func (*GetProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *GetProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// プログラム一覧取得リクエスト
+type ListProgramsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProgramsRequest) Reset() {
+	*x = ListProgramsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProgramsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramsRequest) ProtoMessage() {}
+
+func (x *ListProgramsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramsRequest.ProtoReflect.Descriptor instead.
+func (*ListProgramsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{42}
+}
+
+// プログラム一覧取得レスポンス（テンプレートは含めない）
+type ListProgramsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Programs []*Program `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"`
+	Message  string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ListProgramsResponse) Reset() {
+	*x = ListProgramsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProgramsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProgramsResponse) ProtoMessage() {}
+
+func (x *ListProgramsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProgramsResponse.ProtoReflect.Descriptor instead.
+func (*ListProgramsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListProgramsResponse) GetPrograms() []*Program {
+	if x != nil {
+		return x.Programs
+	}
+	return nil
+}
+
+func (x *ListProgramsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// プログラム更新リクエスト
+type UpdateProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int32   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+}
+
+func (x *UpdateProgramRequest) Reset() {
+	*x = UpdateProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProgramRequest) ProtoMessage() {}
+
+func (x *UpdateProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProgramRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *UpdateProgramRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProgramRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProgramRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+// プログラム更新レスポンス
+type UpdateProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Program *Program `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateProgramResponse) Reset() {
+	*x = UpdateProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProgramResponse) ProtoMessage() {}
+
+func (x *UpdateProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProgramResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UpdateProgramResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *UpdateProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// テンプレート編集リクエスト（新しいバージョンを作成）
+type UpdateProgramTemplatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int32              `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Templates []*WorkoutTemplate `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty"`
+}
+
+func (x *UpdateProgramTemplatesRequest) Reset() {
+	*x = UpdateProgramTemplatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProgramTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProgramTemplatesRequest) ProtoMessage() {}
+
+func (x *UpdateProgramTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProgramTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProgramTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateProgramTemplatesRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProgramTemplatesRequest) GetTemplates() []*WorkoutTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+// テンプレート編集レスポンス
+type UpdateProgramTemplatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Program *Program `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateProgramTemplatesResponse) Reset() {
+	*x = UpdateProgramTemplatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateProgramTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProgramTemplatesResponse) ProtoMessage() {}
+
+func (x *UpdateProgramTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProgramTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProgramTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *UpdateProgramTemplatesResponse) GetProgram() *Program {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *UpdateProgramTemplatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// プログラム削除リクエスト
+type DeleteProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteProgramRequest) Reset() {
+	*x = DeleteProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProgramRequest) ProtoMessage() {}
+
+func (x *DeleteProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProgramRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteProgramRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// プログラム削除レスポンス
+type DeleteProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteProgramResponse) Reset() {
+	*x = DeleteProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProgramResponse) ProtoMessage() {}
+
+func (x *DeleteProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProgramResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 種目ごとのトレーニングマックス
+type TrainingMax struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseType ExerciseType `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Weight       float64      `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *TrainingMax) Reset() {
+	*x = TrainingMax{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrainingMax) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrainingMax) ProtoMessage() {}
+
+func (x *TrainingMax) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrainingMax.ProtoReflect.Descriptor instead.
+func (*TrainingMax) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *TrainingMax) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *TrainingMax) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+// プログラム適用リクエスト
+type ApplyProgramRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramId     int32          `protobuf:"varint,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Version       int32          `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`                     // 0の場合は最新バージョン
+	StartDate     string         `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"` // YYYY-MM-DD または RFC3339（省略時は今日）
+	Weeks         int32          `protobuf:"varint,4,opt,name=weeks,proto3" json:"weeks,omitempty"`                         // 生成する週数（1〜52）
+	TrainingMaxes []*TrainingMax `protobuf:"bytes,5,rep,name=training_maxes,json=trainingMaxes,proto3" json:"training_maxes,omitempty"`
+	WeightUnit    WeightUnit     `protobuf:"varint,6,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // training_maxesの重量の単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *ApplyProgramRequest) Reset() {
+	*x = ApplyProgramRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyProgramRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyProgramRequest) ProtoMessage() {}
+
+func (x *ApplyProgramRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyProgramRequest.ProtoReflect.Descriptor instead.
+func (*ApplyProgramRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ApplyProgramRequest) GetProgramId() int32 {
+	if x != nil {
+		return x.ProgramId
+	}
+	return 0
+}
+
+func (x *ApplyProgramRequest) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ApplyProgramRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *ApplyProgramRequest) GetWeeks() int32 {
+	if x != nil {
+		return x.Weeks
+	}
+	return 0
+}
+
+func (x *ApplyProgramRequest) GetTrainingMaxes() []*TrainingMax {
+	if x != nil {
+		return x.TrainingMaxes
+	}
+	return nil
+}
+
+func (x *ApplyProgramRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// プログラム適用レスポンス
+type ApplyProgramResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts []*Workout `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	Message  string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ApplyProgramResponse) Reset() {
+	*x = ApplyProgramResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyProgramResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyProgramResponse) ProtoMessage() {}
+
+func (x *ApplyProgramResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyProgramResponse.ProtoReflect.Descriptor instead.
+func (*ApplyProgramResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ApplyProgramResponse) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *ApplyProgramResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 次回のワークアウト提案リクエスト
+type SuggestNextWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseType ExerciseType `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	ProgramId    int32        `protobuf:"varint,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"` // 0の場合はプログラムを問わず種目の履歴を使用
+}
+
+func (x *SuggestNextWorkoutRequest) Reset() {
+	*x = SuggestNextWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestNextWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestNextWorkoutRequest) ProtoMessage() {}
+
+func (x *SuggestNextWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestNextWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*SuggestNextWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SuggestNextWorkoutRequest) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *SuggestNextWorkoutRequest) GetProgramId() int32 {
+	if x != nil {
+		return x.ProgramId
+	}
+	return 0
+}
+
+// 次回のワークアウト提案レスポンス
+type SuggestNextWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExerciseType        ExerciseType      `protobuf:"varint,1,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Scheme              ProgressionScheme `protobuf:"varint,2,opt,name=scheme,proto3,enum=workout.ProgressionScheme" json:"scheme,omitempty"`
+	Action              ProgressionAction `protobuf:"varint,3,opt,name=action,proto3,enum=workout.ProgressionAction" json:"action,omitempty"`
+	Sets                int32             `protobuf:"varint,4,opt,name=sets,proto3" json:"sets,omitempty"`
+	Reps                int32             `protobuf:"varint,5,opt,name=reps,proto3" json:"reps,omitempty"`
+	Weight              float64           `protobuf:"fixed64,6,opt,name=weight,proto3" json:"weight,omitempty"` // weight_unitの単位（kg以外の単位ではその単位のプレートで組める重量に丸める）
+	ConsecutiveFailures int32             `protobuf:"varint,7,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	LastWorkout         *Workout          `protobuf:"bytes,8,opt,name=last_workout,json=lastWorkout,proto3" json:"last_workout,omitempty"` // 提案の基になった直近の完了ワークアウト
+	Message             string            `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
+	WeightUnit          WeightUnit        `protobuf:"varint,10,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // weight・last_workoutの重量の単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *SuggestNextWorkoutResponse) Reset() {
+	*x = SuggestNextWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuggestNextWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuggestNextWorkoutResponse) ProtoMessage() {}
+
+func (x *SuggestNextWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuggestNextWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*SuggestNextWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SuggestNextWorkoutResponse) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *SuggestNextWorkoutResponse) GetScheme() ProgressionScheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return ProgressionScheme_PROGRESSION_SCHEME_LINEAR
+}
+
+func (x *SuggestNextWorkoutResponse) GetAction() ProgressionAction {
+	if x != nil {
+		return x.Action
+	}
+	return ProgressionAction_PROGRESSION_ACTION_BASELINE
+}
+
+func (x *SuggestNextWorkoutResponse) GetSets() int32 {
+	if x != nil {
+		return x.Sets
+	}
+	return 0
+}
+
+func (x *SuggestNextWorkoutResponse) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
+}
+
+func (x *SuggestNextWorkoutResponse) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SuggestNextWorkoutResponse) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *SuggestNextWorkoutResponse) GetLastWorkout() *Workout {
+	if x != nil {
+		return x.LastWorkout
+	}
+	return nil
+}
+
+func (x *SuggestNextWorkoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SuggestNextWorkoutResponse) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// カレンダー取得リクエスト
+type ListCalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom string `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD または RFC3339（省略時は今週の月曜）
+	DateTo   string `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD（その日を含む）または RFC3339（省略時はdate_fromの4週間後）
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                 // 日付の区切りに使用するタイムゾーン（省略時はユーザー設定）
+}
+
+func (x *ListCalendarRequest) Reset() {
+	*x = ListCalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarRequest) ProtoMessage() {}
+
+func (x *ListCalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarRequest.ProtoReflect.Descriptor instead.
+func (*ListCalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListCalendarRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListCalendarRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ListCalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// カレンダーの1日分
+type CalendarDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           string     `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`         // YYYY-MM-DD
+	Workouts       []*Workout `protobuf:"bytes,2,rep,name=workouts,proto3" json:"workouts,omitempty"` // 実施日時の昇順
+	PlannedCount   int32      `protobuf:"varint,3,opt,name=planned_count,json=plannedCount,proto3" json:"planned_count,omitempty"`
+	CompletedCount int32      `protobuf:"varint,4,opt,name=completed_count,json=completedCount,proto3" json:"completed_count,omitempty"`
+	SkippedCount   int32      `protobuf:"varint,5,opt,name=skipped_count,json=skippedCount,proto3" json:"skipped_count,omitempty"`
+}
+
+func (x *CalendarDay) Reset() {
+	*x = CalendarDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalendarDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalendarDay) ProtoMessage() {}
+
+func (x *CalendarDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalendarDay.ProtoReflect.Descriptor instead.
+func (*CalendarDay) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CalendarDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CalendarDay) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *CalendarDay) GetPlannedCount() int32 {
+	if x != nil {
+		return x.PlannedCount
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetCompletedCount() int32 {
+	if x != nil {
+		return x.CompletedCount
+	}
+	return 0
+}
+
+func (x *CalendarDay) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+// カレンダー取得レスポンス
+type ListCalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Days     []*CalendarDay `protobuf:"bytes,1,rep,name=days,proto3" json:"days,omitempty"` // 期間内の全日（ワークアウトのない日を含む）
+	Timezone string         `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Message  string         `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ListCalendarResponse) Reset() {
+	*x = ListCalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalendarResponse) ProtoMessage() {}
+
+func (x *ListCalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCalendarResponse.ProtoReflect.Descriptor instead.
+func (*ListCalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListCalendarResponse) GetDays() []*CalendarDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *ListCalendarResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ListCalendarResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 予定変更リクエスト
+type RescheduleWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledFor string `protobuf:"bytes,2,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"` // 新しい実施予定日時（YYYY-MM-DD または RFC3339）
+	Timezone     string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                             // 省略時は元の予定のタイムゾーン
+}
+
+func (x *RescheduleWorkoutRequest) Reset() {
+	*x = RescheduleWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleWorkoutRequest) ProtoMessage() {}
+
+func (x *RescheduleWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*RescheduleWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RescheduleWorkoutRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RescheduleWorkoutRequest) GetScheduledFor() string {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return ""
+}
+
+func (x *RescheduleWorkoutRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 予定変更レスポンス
+type RescheduleWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout *Workout `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	Message string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *RescheduleWorkoutResponse) Reset() {
+	*x = RescheduleWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RescheduleWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RescheduleWorkoutResponse) ProtoMessage() {}
+
+func (x *RescheduleWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RescheduleWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*RescheduleWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RescheduleWorkoutResponse) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *RescheduleWorkoutResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// iCalendar出力リクエスト
+type ExportICalendarRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom        string `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                       // YYYY-MM-DD または RFC3339（省略時は今日）
+	DateTo          string `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                             // YYYY-MM-DD（その日を含む）または RFC3339（省略時はdate_fromの4週間後）
+	Timezone        string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                                       // 日付の解釈に使用するタイムゾーン（省略時はユーザー設定）
+	Language        string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`                                       // 種目名・説明の言語: "ja" または "en"（空の場合はaccept-languageの言語）
+	IncludeDone     bool   `protobuf:"varint,5,opt,name=include_done,json=includeDone,proto3" json:"include_done,omitempty"`             // trueなら完了・スキップ済みの予定も含める
+	DurationMinutes int32  `protobuf:"varint,6,opt,name=duration_minutes,json=durationMinutes,proto3" json:"duration_minutes,omitempty"` // 時刻指定の予定の長さ（省略時は60分）
+}
+
+func (x *ExportICalendarRequest) Reset() {
+	*x = ExportICalendarRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportICalendarRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICalendarRequest) ProtoMessage() {}
+
+func (x *ExportICalendarRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICalendarRequest.ProtoReflect.Descriptor instead.
+func (*ExportICalendarRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ExportICalendarRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ExportICalendarRequest) GetIncludeDone() bool {
+	if x != nil {
+		return x.IncludeDone
+	}
+	return false
+}
+
+func (x *ExportICalendarRequest) GetDurationMinutes() int32 {
+	if x != nil {
+		return x.DurationMinutes
+	}
+	return 0
+}
+
+// iCalendar出力レスポンス
+type ExportICalendarResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ics        []byte `protobuf:"bytes,1,opt,name=ics,proto3" json:"ics,omitempty"`           // RFC 5545形式のカレンダー（UTF-8, CRLF改行）
+	Filename   string `protobuf:"bytes,2,opt,name=filename,proto3" json:"filename,omitempty"` // 保存時のファイル名の候補
+	EventCount int32  `protobuf:"varint,3,opt,name=event_count,json=eventCount,proto3" json:"event_count,omitempty"`
+	Message    string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ExportICalendarResponse) Reset() {
+	*x = ExportICalendarResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportICalendarResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportICalendarResponse) ProtoMessage() {}
+
+func (x *ExportICalendarResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportICalendarResponse.ProtoReflect.Descriptor instead.
+func (*ExportICalendarResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExportICalendarResponse) GetIcs() []byte {
+	if x != nil {
+		return x.Ics
+	}
+	return nil
+}
+
+func (x *ExportICalendarResponse) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportICalendarResponse) GetEventCount() int32 {
+	if x != nil {
+		return x.EventCount
+	}
+	return 0
+}
+
+func (x *ExportICalendarResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// インポートのオプション
+type ImportOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Format        ImportFormat      `protobuf:"varint,1,opt,name=format,proto3,enum=workout.ImportFormat" json:"format,omitempty"`
+	ColumnMapping map[string]string `protobuf:"bytes,2,rep,name=column_mapping,json=columnMapping,proto3" json:"column_mapping,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // CSVのフィールド名 → 列名（例: exercise_type → 種目）
+	Timezone      string            `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                                                                                        // タイムゾーンのない日時の解釈に使用（省略時はユーザー設定）
+	DryRun        bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`                                                                                                             // trueなら検証のみ行い保存しない
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ImportOptions) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_IMPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportOptions) GetColumnMapping() map[string]string {
+	if x != nil {
+		return x.ColumnMapping
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// インポートリクエスト（最初のメッセージはoptions、以降はchunk）
+type ImportWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//	*ImportWorkoutsRequest_Options
+	//	*ImportWorkoutsRequest_Chunk
+	Payload isImportWorkoutsRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *ImportWorkoutsRequest) Reset() {
+	*x = ImportWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkoutsRequest) ProtoMessage() {}
+
+func (x *ImportWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*ImportWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{63}
+}
+
+func (m *ImportWorkoutsRequest) GetPayload() isImportWorkoutsRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *ImportWorkoutsRequest) GetOptions() *ImportOptions {
+	if x, ok := x.GetPayload().(*ImportWorkoutsRequest_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportWorkoutsRequest) GetChunk() []byte {
+	if x, ok := x.GetPayload().(*ImportWorkoutsRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+type isImportWorkoutsRequest_Payload interface {
+	isImportWorkoutsRequest_Payload()
+}
+
+type ImportWorkoutsRequest_Options struct {
+	Options *ImportOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type ImportWorkoutsRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"` // ファイルの内容の一部（順番に連結する）
+}
+
+func (*ImportWorkoutsRequest_Options) isImportWorkoutsRequest_Payload() {}
+
+func (*ImportWorkoutsRequest_Chunk) isImportWorkoutsRequest_Payload() {}
+
+// インポートの行ごとのエラー
+type ImportRowError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Line    int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 元ファイルの行番号（1始まり）
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// インポートレスポンス
+type ImportWorkoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalRows      int32             `protobuf:"varint,1,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	ImportedCount  int32             `protobuf:"varint,2,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"` // 保存した（dry_runの場合は保存できる）件数
+	Errors         []*ImportRowError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`                                     // 1件でもあれば何も保存しない
+	DryRun         bool              `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Committed      bool              `protobuf:"varint,5,opt,name=committed,proto3" json:"committed,omitempty"`
+	Message        string            `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Skipped        []*ImportRowError `protobuf:"bytes,7,rep,name=skipped,proto3" json:"skipped,omitempty"`                                      // 対応する種目がないため取り込まなかった行（別名を設定すると取り込める）
+	DuplicateCount int32             `protobuf:"varint,8,opt,name=duplicate_count,json=duplicateCount,proto3" json:"duplicate_count,omitempty"` // 取り込み済みのため除外した件数
+}
+
+func (x *ImportWorkoutsResponse) Reset() {
+	*x = ImportWorkoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportWorkoutsResponse) ProtoMessage() {}
+
+func (x *ImportWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*ImportWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ImportWorkoutsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportWorkoutsResponse) GetImportedCount() int32 {
+	if x != nil {
+		return x.ImportedCount
+	}
+	return 0
+}
+
+func (x *ImportWorkoutsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportWorkoutsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportWorkoutsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *ImportWorkoutsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ImportWorkoutsResponse) GetSkipped() []*ImportRowError {
+	if x != nil {
+		return x.Skipped
+	}
+	return nil
+}
+
+func (x *ImportWorkoutsResponse) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+// エクスポートリクエスト（未指定の条件ではフィルタしない）
+type ExportWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusFilter      WorkoutStatus `protobuf:"varint,1,opt,name=status_filter,json=statusFilter,proto3,enum=workout.WorkoutStatus" json:"status_filter,omitempty"`
+	DifficultyFilter  Difficulty    `protobuf:"varint,2,opt,name=difficulty_filter,json=difficultyFilter,proto3,enum=workout.Difficulty" json:"difficulty_filter,omitempty"`
+	MuscleGroupFilter MuscleGroup   `protobuf:"varint,3,opt,name=muscle_group_filter,json=muscleGroupFilter,proto3,enum=workout.MuscleGroup" json:"muscle_group_filter,omitempty"`
+	MinWeight         *float64      `protobuf:"fixed64,4,opt,name=min_weight,json=minWeight,proto3,oneof" json:"min_weight,omitempty"` // weight_unitの単位
+	MaxWeight         *float64      `protobuf:"fixed64,5,opt,name=max_weight,json=maxWeight,proto3,oneof" json:"max_weight,omitempty"` // weight_unitの単位
+	DateFrom          string        `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`            // 実施日時（YYYY-MM-DD または RFC3339）
+	DateTo            string        `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                  // 実施日時（YYYY-MM-DD（その日を含む）または RFC3339）
+	Timezone          string        `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`                            // 日付の区切りに使用するタイムゾーン（省略時はユーザー設定）
+	Format            ExportFormat  `protobuf:"varint,9,opt,name=format,proto3,enum=workout.ExportFormat" json:"format,omitempty"`
+	BatchSize         int32         `protobuf:"varint,10,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`                            // 1メッセージあたりの件数（省略時は500、最大5000）
+	WeightUnit        WeightUnit    `protobuf:"varint,11,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // min_weight・max_weightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *ExportWorkoutsRequest) Reset() {
+	*x = ExportWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkoutsRequest) ProtoMessage() {}
+
+func (x *ExportWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*ExportWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ExportWorkoutsRequest) GetStatusFilter() WorkoutStatus {
+	if x != nil {
+		return x.StatusFilter
+	}
+	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+}
+
+func (x *ExportWorkoutsRequest) GetDifficultyFilter() Difficulty {
+	if x != nil {
+		return x.DifficultyFilter
+	}
+	return Difficulty_DIFFICULTY_UNSPECIFIED
+}
+
+func (x *ExportWorkoutsRequest) GetMuscleGroupFilter() MuscleGroup {
+	if x != nil {
+		return x.MuscleGroupFilter
+	}
+	return MuscleGroup_UNSPECIFIED
+}
+
+func (x *ExportWorkoutsRequest) GetMinWeight() float64 {
+	if x != nil && x.MinWeight != nil {
+		return *x.MinWeight
+	}
+	return 0
+}
+
+func (x *ExportWorkoutsRequest) GetMaxWeight() float64 {
+	if x != nil && x.MaxWeight != nil {
+		return *x.MaxWeight
+	}
+	return 0
+}
+
+func (x *ExportWorkoutsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ExportWorkoutsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *ExportWorkoutsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ExportWorkoutsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportWorkoutsRequest) GetBatchSize() int32 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *ExportWorkoutsRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// エクスポートレスポンス（バッチごとに1メッセージ）
+type ExportWorkoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts      []*Workout `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`                                 // EXPORT_FORMAT_PROTO の場合
+	Chunk         []byte     `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`                                       // JSONL・CSV の場合（順番に連結するとファイルになる）
+	ExportedCount int32      `protobuf:"varint,3,opt,name=exported_count,json=exportedCount,proto3" json:"exported_count,omitempty"` // これまでに送信した件数（累計）
+}
+
+func (x *ExportWorkoutsResponse) Reset() {
+	*x = ExportWorkoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportWorkoutsResponse) ProtoMessage() {}
+
+func (x *ExportWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*ExportWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ExportWorkoutsResponse) GetWorkouts() []*Workout {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *ExportWorkoutsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportWorkoutsResponse) GetExportedCount() int32 {
+	if x != nil {
+		return x.ExportedCount
+	}
+	return 0
+}
+
+// 一括作成リクエスト
+// 各項目の制約は項目ごとに検証し、違反した項目だけを失敗にする（BATCH_MODE_PER_ITEMの場合）
+type BatchCreateWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts []*CreateWorkoutRequest `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	Mode     BatchMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=workout.BatchMode" json:"mode,omitempty"`
+}
+
+func (x *BatchCreateWorkoutsRequest) Reset() {
+	*x = BatchCreateWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchCreateWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCreateWorkoutsRequest) ProtoMessage() {}
+
+func (x *BatchCreateWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCreateWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*BatchCreateWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *BatchCreateWorkoutsRequest) GetWorkouts() []*CreateWorkoutRequest {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *BatchCreateWorkoutsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// 一括更新リクエスト（同じIDは1回だけ指定できる）
+type BatchUpdateWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workouts []*UpdateWorkoutRequest `protobuf:"bytes,1,rep,name=workouts,proto3" json:"workouts,omitempty"`
+	Mode     BatchMode               `protobuf:"varint,2,opt,name=mode,proto3,enum=workout.BatchMode" json:"mode,omitempty"`
+}
+
+func (x *BatchUpdateWorkoutsRequest) Reset() {
+	*x = BatchUpdateWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateWorkoutsRequest) ProtoMessage() {}
+
+func (x *BatchUpdateWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *BatchUpdateWorkoutsRequest) GetWorkouts() []*UpdateWorkoutRequest {
+	if x != nil {
+		return x.Workouts
+	}
+	return nil
+}
+
+func (x *BatchUpdateWorkoutsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// 一括削除リクエスト
+type BatchDeleteWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids  []int64   `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Mode BatchMode `protobuf:"varint,2,opt,name=mode,proto3,enum=workout.BatchMode" json:"mode,omitempty"`
+}
+
+func (x *BatchDeleteWorkoutsRequest) Reset() {
+	*x = BatchDeleteWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchDeleteWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDeleteWorkoutsRequest) ProtoMessage() {}
+
+func (x *BatchDeleteWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDeleteWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*BatchDeleteWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *BatchDeleteWorkoutsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchDeleteWorkoutsRequest) GetMode() BatchMode {
+	if x != nil {
+		return x.Mode
+	}
+	return BatchMode_BATCH_MODE_UNSPECIFIED
+}
+
+// 一括処理の1項目分の結果
+type BatchItemResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index   int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`    // リクエスト内の位置（0始まり）
+	Code    int32    `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`      // gRPCのステータスコード（0: OK, 3: INVALID_ARGUMENT, 5: NOT_FOUND, 10: ABORTED, 13: INTERNAL）
+	Message string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"` // 失敗した理由（成功した場合は空）
+	Id      int64    `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`          // 対象のワークアウトID
+	Workout *Workout `protobuf:"bytes,5,opt,name=workout,proto3" json:"workout,omitempty"` // 作成・更新後のワークアウト（削除・失敗した場合は空）
+}
+
+func (x *BatchItemResult) Reset() {
+	*x = BatchItemResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResult) ProtoMessage() {}
+
+func (x *BatchItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResult.ProtoReflect.Descriptor instead.
+func (*BatchItemResult) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *BatchItemResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchItemResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchItemResult) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+// 一括処理レスポンス
+type BatchWorkoutsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results      []*BatchItemResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // リクエストと同じ順番
+	SuccessCount int32              `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount int32              `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	Committed    bool               `protobuf:"varint,4,opt,name=committed,proto3" json:"committed,omitempty"` // 1件以上反映したか
+	Message      string             `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *BatchWorkoutsResponse) Reset() {
+	*x = BatchWorkoutsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchWorkoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchWorkoutsResponse) ProtoMessage() {}
+
+func (x *BatchWorkoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchWorkoutsResponse.ProtoReflect.Descriptor instead.
+func (*BatchWorkoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *BatchWorkoutsResponse) GetResults() []*BatchItemResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BatchWorkoutsResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BatchWorkoutsResponse) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+func (x *BatchWorkoutsResponse) GetCommitted() bool {
+	if x != nil {
+		return x.Committed
+	}
+	return false
+}
+
+func (x *BatchWorkoutsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ワークアウトの変更の購読リクエスト（フィルタは空なら絞り込まない。複数指定した場合はいずれかに一致）
+type WatchWorkoutsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EventTypes    []WorkoutEventType `protobuf:"varint,1,rep,packed,name=event_types,json=eventTypes,proto3,enum=workout.WorkoutEventType" json:"event_types,omitempty"`
+	WorkoutIds    []int64            `protobuf:"varint,2,rep,packed,name=workout_ids,json=workoutIds,proto3" json:"workout_ids,omitempty"`
+	ExerciseTypes []ExerciseType     `protobuf:"varint,3,rep,packed,name=exercise_types,json=exerciseTypes,proto3,enum=workout.ExerciseType" json:"exercise_types,omitempty"`
+	Statuses      []WorkoutStatus    `protobuf:"varint,4,rep,packed,name=statuses,proto3,enum=workout.WorkoutStatus" json:"statuses,omitempty"` // 変更後のステータス
+	ResumeToken   string             `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`           // 最後に受け取ったイベントのresume_token（空なら購読開始以降のみ）
+}
+
+func (x *WatchWorkoutsRequest) Reset() {
+	*x = WatchWorkoutsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchWorkoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWorkoutsRequest) ProtoMessage() {}
+
+func (x *WatchWorkoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWorkoutsRequest.ProtoReflect.Descriptor instead.
+func (*WatchWorkoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *WatchWorkoutsRequest) GetEventTypes() []WorkoutEventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WatchWorkoutsRequest) GetWorkoutIds() []int64 {
+	if x != nil {
+		return x.WorkoutIds
+	}
+	return nil
+}
+
+func (x *WatchWorkoutsRequest) GetExerciseTypes() []ExerciseType {
+	if x != nil {
+		return x.ExerciseTypes
+	}
+	return nil
+}
+
+func (x *WatchWorkoutsRequest) GetStatuses() []WorkoutStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *WatchWorkoutsRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// ワークアウトの変更イベント
+// 受信が追いつかずサーバーのバッファがあふれた場合はRESOURCE_EXHAUSTED、
+// resume_tokenが古すぎる（サーバーの再起動前など）場合はOUT_OF_RANGEでストリームを終了する
+type WorkoutEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type           WorkoutEventType `protobuf:"varint,1,opt,name=type,proto3,enum=workout.WorkoutEventType" json:"type,omitempty"`
+	Workout        *Workout         `protobuf:"bytes,2,opt,name=workout,proto3" json:"workout,omitempty"`                                                                 // 変更後のワークアウト（削除の場合は削除前）
+	PreviousStatus WorkoutStatus    `protobuf:"varint,3,opt,name=previous_status,json=previousStatus,proto3,enum=workout.WorkoutStatus" json:"previous_status,omitempty"` // ステータス変更の場合のみ、変更前のステータス
+	OccurredAt     string           `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                         // 発生日時（RFC3339）
+	ResumeToken    string           `protobuf:"bytes,5,opt,name=resume_token,json=resumeToken,proto3" json:"resume_token,omitempty"`                                      // このイベントの次から再開するためのトークン
+}
+
+func (x *WorkoutEvent) Reset() {
+	*x = WorkoutEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkoutEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkoutEvent) ProtoMessage() {}
+
+func (x *WorkoutEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkoutEvent.ProtoReflect.Descriptor instead.
+func (*WorkoutEvent) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *WorkoutEvent) GetType() WorkoutEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WorkoutEventType_WORKOUT_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *WorkoutEvent) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *WorkoutEvent) GetPreviousStatus() WorkoutStatus {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED
+}
+
+func (x *WorkoutEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *WorkoutEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// 実施中のワークアウトの記録でクライアントが送るイベント
+type TrackWorkoutRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*TrackWorkoutRequest_Start
+	//	*TrackWorkoutRequest_SetCompleted
+	//	*TrackWorkoutRequest_Note
+	//	*TrackWorkoutRequest_Pause
+	Event isTrackWorkoutRequest_Event `protobuf_oneof:"event"`
+}
+
+func (x *TrackWorkoutRequest) Reset() {
+	*x = TrackWorkoutRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackWorkoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackWorkoutRequest) ProtoMessage() {}
+
+func (x *TrackWorkoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackWorkoutRequest.ProtoReflect.Descriptor instead.
+func (*TrackWorkoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{75}
+}
+
+func (m *TrackWorkoutRequest) GetEvent() isTrackWorkoutRequest_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *TrackWorkoutRequest) GetStart() *StartTracking {
+	if x, ok := x.GetEvent().(*TrackWorkoutRequest_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TrackWorkoutRequest) GetSetCompleted() *CompleteSet {
+	if x, ok := x.GetEvent().(*TrackWorkoutRequest_SetCompleted); ok {
+		return x.SetCompleted
+	}
+	return nil
+}
+
+func (x *TrackWorkoutRequest) GetNote() *AddNote {
+	if x, ok := x.GetEvent().(*TrackWorkoutRequest_Note); ok {
+		return x.Note
+	}
+	return nil
+}
+
+func (x *TrackWorkoutRequest) GetPause() *PauseTracking {
+	if x, ok := x.GetEvent().(*TrackWorkoutRequest_Pause); ok {
+		return x.Pause
+	}
+	return nil
+}
+
+type isTrackWorkoutRequest_Event interface {
+	isTrackWorkoutRequest_Event()
+}
+
+type TrackWorkoutRequest_Start struct {
+	Start *StartTracking `protobuf:"bytes,1,opt,name=start,proto3,oneof"` // 最初のメッセージで1回だけ送信
+}
+
+type TrackWorkoutRequest_SetCompleted struct {
+	SetCompleted *CompleteSet `protobuf:"bytes,2,opt,name=set_completed,json=setCompleted,proto3,oneof"`
+}
+
+type TrackWorkoutRequest_Note struct {
+	Note *AddNote `protobuf:"bytes,3,opt,name=note,proto3,oneof"`
+}
+
+type TrackWorkoutRequest_Pause struct {
+	Pause *PauseTracking `protobuf:"bytes,4,opt,name=pause,proto3,oneof"`
+}
+
+func (*TrackWorkoutRequest_Start) isTrackWorkoutRequest_Event() {}
+
+func (*TrackWorkoutRequest_SetCompleted) isTrackWorkoutRequest_Event() {}
+
+func (*TrackWorkoutRequest_Note) isTrackWorkoutRequest_Event() {}
+
+func (*TrackWorkoutRequest_Pause) isTrackWorkoutRequest_Event() {}
+
+// 記録の開始（再接続した場合は記録済みのセットの続きから記録する）
+type StartTracking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkoutId   int32 `protobuf:"varint,1,opt,name=workout_id,json=workoutId,proto3" json:"workout_id,omitempty"`       // 実施中（IN_PROGRESS）のワークアウト
+	RestSeconds int32 `protobuf:"varint,2,opt,name=rest_seconds,json=restSeconds,proto3" json:"rest_seconds,omitempty"` // セット間の休憩時間（0ならデフォルトの90秒）
+}
+
+func (x *StartTracking) Reset() {
+	*x = StartTracking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartTracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartTracking) ProtoMessage() {}
+
+func (x *StartTracking) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartTracking.ProtoReflect.Descriptor instead.
+func (*StartTracking) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *StartTracking) GetWorkoutId() int32 {
+	if x != nil {
+		return x.WorkoutId
+	}
+	return 0
+}
+
+func (x *StartTracking) GetRestSeconds() int32 {
+	if x != nil {
+		return x.RestSeconds
+	}
+	return 0
+}
+
+// セットの完了
+type CompleteSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reps       int32      `protobuf:"varint,1,opt,name=reps,proto3" json:"reps,omitempty"`
+	Weight     float64    `protobuf:"fixed64,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rpe        float64    `protobuf:"fixed64,3,opt,name=rpe,proto3" json:"rpe,omitempty"`                                                        // オプション: 0なら未記録
+	WeightUnit WeightUnit `protobuf:"varint,4,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // weightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *CompleteSet) Reset() {
+	*x = CompleteSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteSet) ProtoMessage() {}
+
+func (x *CompleteSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteSet.ProtoReflect.Descriptor instead.
+func (*CompleteSet) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CompleteSet) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
+}
+
+func (x *CompleteSet) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *CompleteSet) GetRpe() float64 {
+	if x != nil {
+		return x.Rpe
+	}
+	return 0
+}
+
+func (x *CompleteSet) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// ワークアウトのメモに追記
+type AddNote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *AddNote) Reset() {
+	*x = AddNote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNote) ProtoMessage() {}
+
+func (x *AddNote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNote.ProtoReflect.Descriptor instead.
+func (*AddNote) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *AddNote) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+// 一時停止・再開（一時停止中は休憩タイマーを止める）
+type PauseTracking struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"` // trueで一時停止、falseで再開
+}
+
+func (x *PauseTracking) Reset() {
+	*x = PauseTracking{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseTracking) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseTracking) ProtoMessage() {}
+
+func (x *PauseTracking) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseTracking.ProtoReflect.Descriptor instead.
+func (*PauseTracking) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *PauseTracking) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+// 記録したセット
+type WorkoutSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WorkoutId   int32      `protobuf:"varint,2,opt,name=workout_id,json=workoutId,proto3" json:"workout_id,omitempty"`
+	SetNumber   int32      `protobuf:"varint,3,opt,name=set_number,json=setNumber,proto3" json:"set_number,omitempty"` // ワークアウト内で1から始まる連番
+	Reps        int32      `protobuf:"varint,4,opt,name=reps,proto3" json:"reps,omitempty"`
+	Weight      float64    `protobuf:"fixed64,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Rpe         float64    `protobuf:"fixed64,6,opt,name=rpe,proto3" json:"rpe,omitempty"`
+	CompletedAt string     `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`                       // 完了日時（RFC3339）
+	WeightUnit  WeightUnit `protobuf:"varint,8,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // weightの単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *WorkoutSet) Reset() {
+	*x = WorkoutSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkoutSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkoutSet) ProtoMessage() {}
+
+func (x *WorkoutSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkoutSet.ProtoReflect.Descriptor instead.
+func (*WorkoutSet) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *WorkoutSet) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetWorkoutId() int32 {
+	if x != nil {
+		return x.WorkoutId
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetSetNumber() int32 {
+	if x != nil {
+		return x.SetNumber
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetReps() int32 {
+	if x != nil {
+		return x.Reps
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetRpe() float64 {
+	if x != nil {
+		return x.Rpe
+	}
+	return 0
+}
+
+func (x *WorkoutSet) GetCompletedAt() string {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return ""
+}
+
+func (x *WorkoutSet) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 実施中のワークアウトの記録でサーバーが送るイベント
+type TrackWorkoutResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*TrackWorkoutResponse_Started
+	//	*TrackWorkoutResponse_SetLogged
+	//	*TrackWorkoutResponse_RestTimerExpired
+	//	*TrackWorkoutResponse_PersonalRecord
+	//	*TrackWorkoutResponse_NoteSaved
+	//	*TrackWorkoutResponse_Paused
+	//	*TrackWorkoutResponse_Completed
+	//	*TrackWorkoutResponse_Error
+	Event isTrackWorkoutResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *TrackWorkoutResponse) Reset() {
+	*x = TrackWorkoutResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackWorkoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackWorkoutResponse) ProtoMessage() {}
+
+func (x *TrackWorkoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackWorkoutResponse.ProtoReflect.Descriptor instead.
+func (*TrackWorkoutResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{81}
+}
+
+func (m *TrackWorkoutResponse) GetEvent() isTrackWorkoutResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetStarted() *TrackingStarted {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetSetLogged() *SetLogged {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_SetLogged); ok {
+		return x.SetLogged
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetRestTimerExpired() *RestTimerExpired {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_RestTimerExpired); ok {
+		return x.RestTimerExpired
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetPersonalRecord() *PersonalRecord {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_PersonalRecord); ok {
+		return x.PersonalRecord
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetNoteSaved() *NoteSaved {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_NoteSaved); ok {
+		return x.NoteSaved
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetPaused() *TrackingPaused {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_Paused); ok {
+		return x.Paused
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetCompleted() *TrackingCompleted {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_Completed); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *TrackWorkoutResponse) GetError() string {
+	if x, ok := x.GetEvent().(*TrackWorkoutResponse_Error); ok {
+		return x.Error
+	}
+	return ""
+}
+
+type isTrackWorkoutResponse_Event interface {
+	isTrackWorkoutResponse_Event()
+}
+
+type TrackWorkoutResponse_Started struct {
+	Started *TrackingStarted `protobuf:"bytes,1,opt,name=started,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_SetLogged struct {
+	SetLogged *SetLogged `protobuf:"bytes,2,opt,name=set_logged,json=setLogged,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_RestTimerExpired struct {
+	RestTimerExpired *RestTimerExpired `protobuf:"bytes,3,opt,name=rest_timer_expired,json=restTimerExpired,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_PersonalRecord struct {
+	PersonalRecord *PersonalRecord `protobuf:"bytes,4,opt,name=personal_record,json=personalRecord,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_NoteSaved struct {
+	NoteSaved *NoteSaved `protobuf:"bytes,5,opt,name=note_saved,json=noteSaved,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_Paused struct {
+	Paused *TrackingPaused `protobuf:"bytes,6,opt,name=paused,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_Completed struct {
+	Completed *TrackingCompleted `protobuf:"bytes,7,opt,name=completed,proto3,oneof"`
+}
+
+type TrackWorkoutResponse_Error struct {
+	Error string `protobuf:"bytes,8,opt,name=error,proto3,oneof"` // 受け付けなかったイベントの理由（ストリームは継続する）
+}
+
+func (*TrackWorkoutResponse_Started) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_SetLogged) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_RestTimerExpired) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_PersonalRecord) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_NoteSaved) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_Paused) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_Completed) isTrackWorkoutResponse_Event() {}
+
+func (*TrackWorkoutResponse_Error) isTrackWorkoutResponse_Event() {}
+
+// 記録の開始
+type TrackingStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout     *Workout      `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	Sets        []*WorkoutSet `protobuf:"bytes,2,rep,name=sets,proto3" json:"sets,omitempty"` // 記録済みのセット（再接続した場合）
+	RestSeconds int32         `protobuf:"varint,3,opt,name=rest_seconds,json=restSeconds,proto3" json:"rest_seconds,omitempty"`
+}
+
+func (x *TrackingStarted) Reset() {
+	*x = TrackingStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackingStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingStarted) ProtoMessage() {}
+
+func (x *TrackingStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingStarted.ProtoReflect.Descriptor instead.
+func (*TrackingStarted) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *TrackingStarted) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *TrackingStarted) GetSets() []*WorkoutSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+func (x *TrackingStarted) GetRestSeconds() int32 {
+	if x != nil {
+		return x.RestSeconds
+	}
+	return 0
+}
+
+// セットを保存した
+type SetLogged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Set       *WorkoutSet `protobuf:"bytes,1,opt,name=set,proto3" json:"set,omitempty"`
+	RestUntil string      `protobuf:"bytes,2,opt,name=rest_until,json=restUntil,proto3" json:"rest_until,omitempty"` // 休憩の終了予定日時（RFC3339、一時停止中は空）
+}
+
+func (x *SetLogged) Reset() {
+	*x = SetLogged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogged) ProtoMessage() {}
+
+func (x *SetLogged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogged.ProtoReflect.Descriptor instead.
+func (*SetLogged) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SetLogged) GetSet() *WorkoutSet {
+	if x != nil {
+		return x.Set
+	}
+	return nil
+}
+
+func (x *SetLogged) GetRestUntil() string {
+	if x != nil {
+		return x.RestUntil
+	}
+	return ""
+}
+
+// 休憩時間の終了
+type RestTimerExpired struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SetNumber   int32 `protobuf:"varint,1,opt,name=set_number,json=setNumber,proto3" json:"set_number,omitempty"` // 直前に完了したセット
+	RestSeconds int32 `protobuf:"varint,2,opt,name=rest_seconds,json=restSeconds,proto3" json:"rest_seconds,omitempty"`
+}
+
+func (x *RestTimerExpired) Reset() {
+	*x = RestTimerExpired{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestTimerExpired) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestTimerExpired) ProtoMessage() {}
+
+func (x *RestTimerExpired) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestTimerExpired.ProtoReflect.Descriptor instead.
+func (*RestTimerExpired) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *RestTimerExpired) GetSetNumber() int32 {
+	if x != nil {
+		return x.SetNumber
+	}
+	return 0
+}
+
+func (x *RestTimerExpired) GetRestSeconds() int32 {
+	if x != nil {
+		return x.RestSeconds
+	}
+	return 0
+}
+
+// 自己ベストの更新
+type PersonalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type         PersonalRecordType `protobuf:"varint,1,opt,name=type,proto3,enum=workout.PersonalRecordType" json:"type,omitempty"`
+	ExerciseType ExerciseType       `protobuf:"varint,2,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"`
+	Value        float64            `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`       // 更新後の記録（weight_unitの単位）
+	Previous     float64            `protobuf:"fixed64,4,opt,name=previous,proto3" json:"previous,omitempty"` // 更新前の記録（weight_unitの単位）、初めての記録なら0
+	SetNumber    int32              `protobuf:"varint,5,opt,name=set_number,json=setNumber,proto3" json:"set_number,omitempty"`
+	WeightUnit   WeightUnit         `protobuf:"varint,6,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // value・previousの単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *PersonalRecord) Reset() {
+	*x = PersonalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PersonalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalRecord) ProtoMessage() {}
+
+func (x *PersonalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalRecord.ProtoReflect.Descriptor instead.
+func (*PersonalRecord) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PersonalRecord) GetType() PersonalRecordType {
+	if x != nil {
+		return x.Type
+	}
+	return PersonalRecordType_PERSONAL_RECORD_TYPE_UNSPECIFIED
+}
+
+func (x *PersonalRecord) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *PersonalRecord) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *PersonalRecord) GetPrevious() float64 {
+	if x != nil {
+		return x.Previous
+	}
+	return 0
+}
+
+func (x *PersonalRecord) GetSetNumber() int32 {
+	if x != nil {
+		return x.SetNumber
+	}
+	return 0
+}
+
+func (x *PersonalRecord) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// メモを保存した
+type NoteSaved struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notes string `protobuf:"bytes,1,opt,name=notes,proto3" json:"notes,omitempty"` // 追記後のメモ全体
+}
+
+func (x *NoteSaved) Reset() {
+	*x = NoteSaved{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NoteSaved) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteSaved) ProtoMessage() {}
+
+func (x *NoteSaved) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteSaved.ProtoReflect.Descriptor instead.
+func (*NoteSaved) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *NoteSaved) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// 一時停止・再開した
+type TrackingPaused struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused               bool  `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	RestRemainingSeconds int32 `protobuf:"varint,2,opt,name=rest_remaining_seconds,json=restRemainingSeconds,proto3" json:"rest_remaining_seconds,omitempty"` // 一時停止した時点の休憩の残り時間（休憩中でなければ0）
+}
+
+func (x *TrackingPaused) Reset() {
+	*x = TrackingPaused{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackingPaused) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingPaused) ProtoMessage() {}
+
+func (x *TrackingPaused) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingPaused.ProtoReflect.Descriptor instead.
+func (*TrackingPaused) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *TrackingPaused) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *TrackingPaused) GetRestRemainingSeconds() int32 {
+	if x != nil {
+		return x.RestRemainingSeconds
+	}
+	return 0
+}
+
+// ワークアウトを完了した
+type TrackingCompleted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout     *Workout   `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	SetCount    int32      `protobuf:"varint,2,opt,name=set_count,json=setCount,proto3" json:"set_count,omitempty"`
+	TotalVolume float64    `protobuf:"fixed64,3,opt,name=total_volume,json=totalVolume,proto3" json:"total_volume,omitempty"`                     // 記録したセットの重量 × 回数の合計（weight_unitの単位）
+	WeightUnit  WeightUnit `protobuf:"varint,4,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // total_volumeの単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *TrackingCompleted) Reset() {
+	*x = TrackingCompleted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackingCompleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackingCompleted) ProtoMessage() {}
+
+func (x *TrackingCompleted) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackingCompleted.ProtoReflect.Descriptor instead.
+func (*TrackingCompleted) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *TrackingCompleted) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *TrackingCompleted) GetSetCount() int32 {
+	if x != nil {
+		return x.SetCount
+	}
+	return 0
+}
+
+func (x *TrackingCompleted) GetTotalVolume() float64 {
+	if x != nil {
+		return x.TotalVolume
+	}
+	return 0
+}
+
+func (x *TrackingCompleted) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重・身体測定の記録（測定しなかった項目は0）
+type BodyMeasurement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date           string     `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`                                               // 測定日（YYYY-MM-DD）
+	Bodyweight     float64    `protobuf:"fixed64,3,opt,name=bodyweight,proto3" json:"bodyweight,omitempty"`                                 // 体重（weight_unitの単位）
+	BodyFatPercent float64    `protobuf:"fixed64,4,opt,name=body_fat_percent,json=bodyFatPercent,proto3" json:"body_fat_percent,omitempty"` // 体脂肪率(%)
+	Waist          float64    `protobuf:"fixed64,5,opt,name=waist,proto3" json:"waist,omitempty"`                                           // ウエスト(cm)
+	Chest          float64    `protobuf:"fixed64,6,opt,name=chest,proto3" json:"chest,omitempty"`                                           // 胸囲(cm)
+	Arm            float64    `protobuf:"fixed64,7,opt,name=arm,proto3" json:"arm,omitempty"`                                               // 上腕囲(cm)
+	Notes          string     `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt      string     `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string     `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	WeightUnit     WeightUnit `protobuf:"varint,11,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // bodyweightの単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *BodyMeasurement) Reset() {
+	*x = BodyMeasurement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BodyMeasurement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyMeasurement) ProtoMessage() {}
+
+func (x *BodyMeasurement) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyMeasurement.ProtoReflect.Descriptor instead.
+func (*BodyMeasurement) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *BodyMeasurement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BodyMeasurement) GetBodyweight() float64 {
+	if x != nil {
+		return x.Bodyweight
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetBodyFatPercent() float64 {
+	if x != nil {
+		return x.BodyFatPercent
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetWaist() float64 {
+	if x != nil {
+		return x.Waist
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetChest() float64 {
+	if x != nil {
+		return x.Chest
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetArm() float64 {
+	if x != nil {
+		return x.Arm
+	}
+	return 0
+}
+
+func (x *BodyMeasurement) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BodyMeasurement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *BodyMeasurement) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *BodyMeasurement) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重・身体測定の記録作成リクエスト（少なくとも1項目は必須）
+type CreateBodyMeasurementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date           string     `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`               // YYYY-MM-DD または RFC3339（省略時は今日）
+	Bodyweight     float64    `protobuf:"fixed64,2,opt,name=bodyweight,proto3" json:"bodyweight,omitempty"` // weight_unitの単位（上限は500kgをlbにした値、kgでの上限はユースケースで検証）
+	BodyFatPercent float64    `protobuf:"fixed64,3,opt,name=body_fat_percent,json=bodyFatPercent,proto3" json:"body_fat_percent,omitempty"`
+	Waist          float64    `protobuf:"fixed64,4,opt,name=waist,proto3" json:"waist,omitempty"`
+	Chest          float64    `protobuf:"fixed64,5,opt,name=chest,proto3" json:"chest,omitempty"`
+	Arm            float64    `protobuf:"fixed64,6,opt,name=arm,proto3" json:"arm,omitempty"`
+	Notes          string     `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	WeightUnit     WeightUnit `protobuf:"varint,8,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // bodyweightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *CreateBodyMeasurementRequest) Reset() {
+	*x = CreateBodyMeasurementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBodyMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBodyMeasurementRequest) ProtoMessage() {}
+
+func (x *CreateBodyMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBodyMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*CreateBodyMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CreateBodyMeasurementRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *CreateBodyMeasurementRequest) GetBodyweight() float64 {
+	if x != nil {
+		return x.Bodyweight
+	}
+	return 0
+}
+
+func (x *CreateBodyMeasurementRequest) GetBodyFatPercent() float64 {
+	if x != nil {
+		return x.BodyFatPercent
+	}
+	return 0
+}
+
+func (x *CreateBodyMeasurementRequest) GetWaist() float64 {
+	if x != nil {
+		return x.Waist
+	}
+	return 0
+}
+
+func (x *CreateBodyMeasurementRequest) GetChest() float64 {
+	if x != nil {
+		return x.Chest
+	}
+	return 0
+}
+
+func (x *CreateBodyMeasurementRequest) GetArm() float64 {
+	if x != nil {
+		return x.Arm
+	}
+	return 0
+}
+
+func (x *CreateBodyMeasurementRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreateBodyMeasurementRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重・身体測定の記録作成レスポンス
+type CreateBodyMeasurementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measurement *BodyMeasurement `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Message     string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CreateBodyMeasurementResponse) Reset() {
+	*x = CreateBodyMeasurementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateBodyMeasurementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBodyMeasurementResponse) ProtoMessage() {}
+
+func (x *CreateBodyMeasurementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBodyMeasurementResponse.ProtoReflect.Descriptor instead.
+func (*CreateBodyMeasurementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateBodyMeasurementResponse) GetMeasurement() *BodyMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+func (x *CreateBodyMeasurementResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 体重・身体測定の記録取得リクエスト
+type GetBodyMeasurementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetBodyMeasurementRequest) Reset() {
+	*x = GetBodyMeasurementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBodyMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBodyMeasurementRequest) ProtoMessage() {}
+
+func (x *GetBodyMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBodyMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*GetBodyMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetBodyMeasurementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 体重・身体測定の記録取得レスポンス
+type GetBodyMeasurementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measurement *BodyMeasurement `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+}
+
+func (x *GetBodyMeasurementResponse) Reset() {
+	*x = GetBodyMeasurementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBodyMeasurementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBodyMeasurementResponse) ProtoMessage() {}
+
+func (x *GetBodyMeasurementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBodyMeasurementResponse.ProtoReflect.Descriptor instead.
+func (*GetBodyMeasurementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetBodyMeasurementResponse) GetMeasurement() *BodyMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+// 体重・身体測定の記録一覧取得リクエスト
+type ListBodyMeasurementsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom string `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"` // YYYY-MM-DD または RFC3339（この日を含む、省略時は下限なし）
+	DateTo   string `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`       // YYYY-MM-DD または RFC3339（この日を含む、省略時は上限なし）
+}
+
+func (x *ListBodyMeasurementsRequest) Reset() {
+	*x = ListBodyMeasurementsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBodyMeasurementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBodyMeasurementsRequest) ProtoMessage() {}
+
+func (x *ListBodyMeasurementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBodyMeasurementsRequest.ProtoReflect.Descriptor instead.
+func (*ListBodyMeasurementsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListBodyMeasurementsRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *ListBodyMeasurementsRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+// 体重・身体測定の記録一覧取得レスポンス（測定日の昇順）
+type ListBodyMeasurementsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measurements []*BodyMeasurement `protobuf:"bytes,1,rep,name=measurements,proto3" json:"measurements,omitempty"`
+	Message      string             `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ListBodyMeasurementsResponse) Reset() {
+	*x = ListBodyMeasurementsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBodyMeasurementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBodyMeasurementsResponse) ProtoMessage() {}
+
+func (x *ListBodyMeasurementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBodyMeasurementsResponse.ProtoReflect.Descriptor instead.
+func (*ListBodyMeasurementsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListBodyMeasurementsResponse) GetMeasurements() []*BodyMeasurement {
+	if x != nil {
+		return x.Measurements
+	}
+	return nil
+}
+
+func (x *ListBodyMeasurementsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 体重・身体測定の記録更新リクエスト（指定した項目のみ更新、0を指定すると未測定にする）
+type UpdateBodyMeasurementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Date           *string    `protobuf:"bytes,2,opt,name=date,proto3,oneof" json:"date,omitempty"`               // YYYY-MM-DD または RFC3339
+	Bodyweight     *float64   `protobuf:"fixed64,3,opt,name=bodyweight,proto3,oneof" json:"bodyweight,omitempty"` // weight_unitの単位
+	BodyFatPercent *float64   `protobuf:"fixed64,4,opt,name=body_fat_percent,json=bodyFatPercent,proto3,oneof" json:"body_fat_percent,omitempty"`
+	Waist          *float64   `protobuf:"fixed64,5,opt,name=waist,proto3,oneof" json:"waist,omitempty"`
+	Chest          *float64   `protobuf:"fixed64,6,opt,name=chest,proto3,oneof" json:"chest,omitempty"`
+	Arm            *float64   `protobuf:"fixed64,7,opt,name=arm,proto3,oneof" json:"arm,omitempty"`
+	Notes          *string    `protobuf:"bytes,8,opt,name=notes,proto3,oneof" json:"notes,omitempty"`
+	WeightUnit     WeightUnit `protobuf:"varint,9,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // bodyweightの単位（省略時はx-weight-unit・ユーザー設定）
+}
+
+func (x *UpdateBodyMeasurementRequest) Reset() {
+	*x = UpdateBodyMeasurementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBodyMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBodyMeasurementRequest) ProtoMessage() {}
+
+func (x *UpdateBodyMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBodyMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBodyMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *UpdateBodyMeasurementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetDate() string {
+	if x != nil && x.Date != nil {
+		return *x.Date
+	}
+	return ""
+}
+
+func (x *UpdateBodyMeasurementRequest) GetBodyweight() float64 {
+	if x != nil && x.Bodyweight != nil {
+		return *x.Bodyweight
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetBodyFatPercent() float64 {
+	if x != nil && x.BodyFatPercent != nil {
+		return *x.BodyFatPercent
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetWaist() float64 {
+	if x != nil && x.Waist != nil {
+		return *x.Waist
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetChest() float64 {
+	if x != nil && x.Chest != nil {
+		return *x.Chest
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetArm() float64 {
+	if x != nil && x.Arm != nil {
+		return *x.Arm
+	}
+	return 0
+}
+
+func (x *UpdateBodyMeasurementRequest) GetNotes() string {
+	if x != nil && x.Notes != nil {
+		return *x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateBodyMeasurementRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重・身体測定の記録更新レスポンス
+type UpdateBodyMeasurementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Measurement *BodyMeasurement `protobuf:"bytes,1,opt,name=measurement,proto3" json:"measurement,omitempty"`
+	Message     string           `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UpdateBodyMeasurementResponse) Reset() {
+	*x = UpdateBodyMeasurementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateBodyMeasurementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBodyMeasurementResponse) ProtoMessage() {}
+
+func (x *UpdateBodyMeasurementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateBodyMeasurementResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBodyMeasurementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *UpdateBodyMeasurementResponse) GetMeasurement() *BodyMeasurement {
+	if x != nil {
+		return x.Measurement
+	}
+	return nil
+}
+
+func (x *UpdateBodyMeasurementResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 体重・身体測定の記録削除リクエスト
+type DeleteBodyMeasurementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteBodyMeasurementRequest) Reset() {
+	*x = DeleteBodyMeasurementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBodyMeasurementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBodyMeasurementRequest) ProtoMessage() {}
+
+func (x *DeleteBodyMeasurementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBodyMeasurementRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBodyMeasurementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DeleteBodyMeasurementRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// 体重・身体測定の記録削除レスポンス
+type DeleteBodyMeasurementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteBodyMeasurementResponse) Reset() {
+	*x = DeleteBodyMeasurementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteBodyMeasurementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBodyMeasurementResponse) ProtoMessage() {}
+
+func (x *DeleteBodyMeasurementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBodyMeasurementResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBodyMeasurementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DeleteBodyMeasurementResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 体重の推移取得リクエスト
+type GetBodyweightTrendRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom   string `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`        // YYYY-MM-DD または RFC3339（省略時は date_to の90日前）
+	DateTo     string `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`              // YYYY-MM-DD または RFC3339（この日を含む、省略時は今日）
+	WindowDays int32  `protobuf:"varint,3,opt,name=window_days,json=windowDays,proto3" json:"window_days,omitempty"` // 移動平均の日数（省略時は7日）
+	Timezone   string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                        // IANAタイムゾーン名（例: Asia/Tokyo、省略時はサーバー設定）
+}
+
+func (x *GetBodyweightTrendRequest) Reset() {
+	*x = GetBodyweightTrendRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBodyweightTrendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBodyweightTrendRequest) ProtoMessage() {}
+
+func (x *GetBodyweightTrendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBodyweightTrendRequest.ProtoReflect.Descriptor instead.
+func (*GetBodyweightTrendRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetBodyweightTrendRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetBodyweightTrendRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetBodyweightTrendRequest) GetWindowDays() int32 {
+	if x != nil {
+		return x.WindowDays
+	}
+	return 0
+}
+
+func (x *GetBodyweightTrendRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// 体重の推移の1日分
+type BodyweightTrendPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date          string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                          // YYYY-MM-DD
+	Bodyweight    float64 `protobuf:"fixed64,2,opt,name=bodyweight,proto3" json:"bodyweight,omitempty"`                            // その日の体重（複数回測定した場合は平均、weight_unitの単位）
+	MovingAverage float64 `protobuf:"fixed64,3,opt,name=moving_average,json=movingAverage,proto3" json:"moving_average,omitempty"` // その日を含む直近window_days日の移動平均（weight_unitの単位）
+	Samples       int32   `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`                                   // 移動平均の計算に使用した日数
+}
+
+func (x *BodyweightTrendPoint) Reset() {
+	*x = BodyweightTrendPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BodyweightTrendPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BodyweightTrendPoint) ProtoMessage() {}
+
+func (x *BodyweightTrendPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BodyweightTrendPoint.ProtoReflect.Descriptor instead.
+func (*BodyweightTrendPoint) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *BodyweightTrendPoint) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *BodyweightTrendPoint) GetBodyweight() float64 {
+	if x != nil {
+		return x.Bodyweight
+	}
+	return 0
+}
+
+func (x *BodyweightTrendPoint) GetMovingAverage() float64 {
+	if x != nil {
+		return x.MovingAverage
+	}
+	return 0
+}
+
+func (x *BodyweightTrendPoint) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+// 体重の推移取得レスポンス（体重を測定した日のみ、日付の昇順）
+type GetBodyweightTrendResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points     []*BodyweightTrendPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	Change     float64                 `protobuf:"fixed64,2,opt,name=change,proto3" json:"change,omitempty"` // 期間の最初と最後の移動平均の差（weight_unitの単位）
+	Message    string                  `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	WeightUnit WeightUnit              `protobuf:"varint,4,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // 体重の単位（x-weight-unit、省略時はユーザー設定）
+}
+
+func (x *GetBodyweightTrendResponse) Reset() {
+	*x = GetBodyweightTrendResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBodyweightTrendResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBodyweightTrendResponse) ProtoMessage() {}
+
+func (x *GetBodyweightTrendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBodyweightTrendResponse.ProtoReflect.Descriptor instead.
+func (*GetBodyweightTrendResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *GetBodyweightTrendResponse) GetPoints() []*BodyweightTrendPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetBodyweightTrendResponse) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *GetBodyweightTrendResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetBodyweightTrendResponse) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重比取得リクエスト
+type GetRelativeStrengthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DateFrom     string       `protobuf:"bytes,1,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`                                        // YYYY-MM-DD または RFC3339（省略時は date_to の90日前）
+	DateTo       string       `protobuf:"bytes,2,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`                                              // YYYY-MM-DD または RFC3339（この日を含む、省略時は今日）
+	ExerciseType ExerciseType `protobuf:"varint,3,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"` // 未指定の場合は全種目
+	Timezone     string       `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                        // IANAタイムゾーン名（例: Asia/Tokyo、省略時はサーバー設定）
+}
+
+func (x *GetRelativeStrengthRequest) Reset() {
+	*x = GetRelativeStrengthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelativeStrengthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelativeStrengthRequest) ProtoMessage() {}
+
+func (x *GetRelativeStrengthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelativeStrengthRequest.ProtoReflect.Descriptor instead.
+func (*GetRelativeStrengthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *GetRelativeStrengthRequest) GetDateFrom() string {
+	if x != nil {
+		return x.DateFrom
+	}
+	return ""
+}
+
+func (x *GetRelativeStrengthRequest) GetDateTo() string {
+	if x != nil {
+		return x.DateTo
+	}
+	return ""
+}
+
+func (x *GetRelativeStrengthRequest) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *GetRelativeStrengthRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// ワークアウトの体重比
+type RelativeStrength struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Workout        *Workout   `protobuf:"bytes,1,opt,name=workout,proto3" json:"workout,omitempty"`
+	Bodyweight     float64    `protobuf:"fixed64,2,opt,name=bodyweight,proto3" json:"bodyweight,omitempty"`                                          // 実施日の体重（weight_unitの単位）
+	WeightRatio    float64    `protobuf:"fixed64,3,opt,name=weight_ratio,json=weightRatio,proto3" json:"weight_ratio,omitempty"`                     // 重量 ÷ 体重
+	OneRepMax      float64    `protobuf:"fixed64,4,opt,name=one_rep_max,json=oneRepMax,proto3" json:"one_rep_max,omitempty"`                         // 推定1RM（weight_unitの単位、Epley式）
+	OneRepMaxRatio float64    `protobuf:"fixed64,5,opt,name=one_rep_max_ratio,json=oneRepMaxRatio,proto3" json:"one_rep_max_ratio,omitempty"`        // 推定1RM ÷ 体重
+	WeightUnit     WeightUnit `protobuf:"varint,6,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // bodyweight・one_rep_maxの単位（workoutと同じ）
+}
+
+func (x *RelativeStrength) Reset() {
+	*x = RelativeStrength{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelativeStrength) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelativeStrength) ProtoMessage() {}
+
+func (x *RelativeStrength) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelativeStrength.ProtoReflect.Descriptor instead.
+func (*RelativeStrength) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RelativeStrength) GetWorkout() *Workout {
+	if x != nil {
+		return x.Workout
+	}
+	return nil
+}
+
+func (x *RelativeStrength) GetBodyweight() float64 {
+	if x != nil {
+		return x.Bodyweight
+	}
+	return 0
+}
+
+func (x *RelativeStrength) GetWeightRatio() float64 {
+	if x != nil {
+		return x.WeightRatio
+	}
+	return 0
+}
+
+func (x *RelativeStrength) GetOneRepMax() float64 {
+	if x != nil {
+		return x.OneRepMax
+	}
+	return 0
+}
+
+func (x *RelativeStrength) GetOneRepMaxRatio() float64 {
+	if x != nil {
+		return x.OneRepMaxRatio
+	}
+	return 0
+}
+
+func (x *RelativeStrength) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+// 体重比取得レスポンス（実施日時の昇順）
+type GetRelativeStrengthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*RelativeStrength `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Message string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetRelativeStrengthResponse) Reset() {
+	*x = GetRelativeStrengthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRelativeStrengthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelativeStrengthResponse) ProtoMessage() {}
+
+func (x *GetRelativeStrengthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelativeStrengthResponse.ProtoReflect.Descriptor instead.
+func (*GetRelativeStrengthResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetRelativeStrengthResponse) GetResults() []*RelativeStrength {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetRelativeStrengthResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// プレートの在庫（countは両側合わせた枚数、片側に付けられるのはcount/2枚）
+type Plate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight float64 `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Count  int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *Plate) Reset() {
+	*x = Plate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plate) ProtoMessage() {}
+
+func (x *Plate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plate.ProtoReflect.Descriptor instead.
+func (*Plate) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *Plate) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *Plate) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// プレートの組み合わせ計算リクエスト
+// 重量はすべてweight_unitの単位。在庫の単位と異なる場合は在庫の単位に換算して計算する
+type CalculatePlatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetWeight   float64      `protobuf:"fixed64,1,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`                          // メインセットの目標重量
+	WeightUnit     WeightUnit   `protobuf:"varint,2,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"`         // 省略時はx-weight-unit・ユーザー設定
+	ExerciseType   ExerciseType `protobuf:"varint,3,opt,name=exercise_type,json=exerciseType,proto3,enum=workout.ExerciseType" json:"exercise_type,omitempty"` // 指定した場合はバーベル種目（ベンチプレス・スクワット・デッドリフト）のみ
+	BarWeight      *float64     `protobuf:"fixed64,4,opt,name=bar_weight,json=barWeight,proto3,oneof" json:"bar_weight,omitempty"`                             // 省略時は在庫のバー
+	Plates         []*Plate     `protobuf:"bytes,5,rep,name=plates,proto3" json:"plates,omitempty"`                                                            // 省略時はユーザー設定の在庫
+	WarmupPercents []float64    `protobuf:"fixed64,6,rep,packed,name=warmup_percents,json=warmupPercents,proto3" json:"warmup_percents,omitempty"`             // ウォームアップセットの割合（例: 0.4）
+	IncludeWarmups bool         `protobuf:"varint,7,opt,name=include_warmups,json=includeWarmups,proto3" json:"include_warmups,omitempty"`                     // warmup_percentsを省略した場合に40%・60%・80%のウォームアップセットを含める
+}
+
+func (x *CalculatePlatesRequest) Reset() {
+	*x = CalculatePlatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculatePlatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePlatesRequest) ProtoMessage() {}
+
+func (x *CalculatePlatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePlatesRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePlatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CalculatePlatesRequest) GetTargetWeight() float64 {
+	if x != nil {
+		return x.TargetWeight
+	}
+	return 0
+}
+
+func (x *CalculatePlatesRequest) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+func (x *CalculatePlatesRequest) GetExerciseType() ExerciseType {
+	if x != nil {
+		return x.ExerciseType
+	}
+	return ExerciseType_EXERCISE_UNSPECIFIED
+}
+
+func (x *CalculatePlatesRequest) GetBarWeight() float64 {
+	if x != nil && x.BarWeight != nil {
+		return *x.BarWeight
+	}
+	return 0
+}
+
+func (x *CalculatePlatesRequest) GetPlates() []*Plate {
+	if x != nil {
+		return x.Plates
+	}
+	return nil
+}
+
+func (x *CalculatePlatesRequest) GetWarmupPercents() []float64 {
+	if x != nil {
+		return x.WarmupPercents
+	}
+	return nil
+}
+
+func (x *CalculatePlatesRequest) GetIncludeWarmups() bool {
+	if x != nil {
+		return x.IncludeWarmups
+	}
+	return false
+}
+
+// 片側に付けるプレート
+type PlateLoad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Weight float64 `protobuf:"fixed64,1,opt,name=weight,proto3" json:"weight,omitempty"`
+	Count  int32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PlateLoad) Reset() {
+	*x = PlateLoad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlateLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlateLoad) ProtoMessage() {}
+
+func (x *PlateLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlateLoad.ProtoReflect.Descriptor instead.
+func (*PlateLoad) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PlateLoad) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PlateLoad) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 1セット分のプレートの組み合わせ
+type PlateSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Percent        float64      `protobuf:"fixed64,1,opt,name=percent,proto3" json:"percent,omitempty"`                                     // 目標重量に対する割合（メインセットは1.0）
+	TargetWeight   float64      `protobuf:"fixed64,2,opt,name=target_weight,json=targetWeight,proto3" json:"target_weight,omitempty"`       // このセットの目標重量
+	AchievedWeight float64      `protobuf:"fixed64,3,opt,name=achieved_weight,json=achievedWeight,proto3" json:"achieved_weight,omitempty"` // 実際に組める最も近い重量（バー + 両側のプレート）
+	Exact          bool         `protobuf:"varint,4,opt,name=exact,proto3" json:"exact,omitempty"`                                          // 目標重量をちょうど組めるか
+	PerSide        []*PlateLoad `protobuf:"bytes,5,rep,name=per_side,json=perSide,proto3" json:"per_side,omitempty"`                        // 片側に付けるプレート（重い順、空の場合はバーのみ）
+}
+
+func (x *PlateSet) Reset() {
+	*x = PlateSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlateSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlateSet) ProtoMessage() {}
+
+func (x *PlateSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlateSet.ProtoReflect.Descriptor instead.
+func (*PlateSet) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *PlateSet) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *PlateSet) GetTargetWeight() float64 {
+	if x != nil {
+		return x.TargetWeight
+	}
+	return 0
+}
+
+func (x *PlateSet) GetAchievedWeight() float64 {
+	if x != nil {
+		return x.AchievedWeight
+	}
+	return 0
+}
+
+func (x *PlateSet) GetExact() bool {
+	if x != nil {
+		return x.Exact
+	}
+	return false
+}
+
+func (x *PlateSet) GetPerSide() []*PlateLoad {
+	if x != nil {
+		return x.PerSide
+	}
+	return nil
+}
+
+// プレートの組み合わせ計算レスポンス
+type CalculatePlatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WeightUnit WeightUnit  `protobuf:"varint,1,opt,name=weight_unit,json=weightUnit,proto3,enum=workout.WeightUnit" json:"weight_unit,omitempty"` // 重量の単位（在庫の単位）
+	BarWeight  float64     `protobuf:"fixed64,2,opt,name=bar_weight,json=barWeight,proto3" json:"bar_weight,omitempty"`
+	Sets       []*PlateSet `protobuf:"bytes,3,rep,name=sets,proto3" json:"sets,omitempty"` // ウォームアップセット（軽い順）→ メインセット
+	Message    string      `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *CalculatePlatesResponse) Reset() {
+	*x = CalculatePlatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_workout_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculatePlatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePlatesResponse) ProtoMessage() {}
+
+func (x *CalculatePlatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_workout_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePlatesResponse.ProtoReflect.Descriptor instead.
+func (*CalculatePlatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_workout_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CalculatePlatesResponse) GetWeightUnit() WeightUnit {
+	if x != nil {
+		return x.WeightUnit
+	}
+	return WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+}
+
+func (x *CalculatePlatesResponse) GetBarWeight() float64 {
+	if x != nil {
+		return x.BarWeight
+	}
+	return 0
+}
+
+func (x *CalculatePlatesResponse) GetSets() []*PlateSet {
+	if x != nil {
+		return x.Sets
+	}
+	return nil
+}
+
+func (x *CalculatePlatesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+var File_proto_workout_proto protoreflect.FileDescriptor
+
+var file_proto_workout_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x1a, 0x1c,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb4, 0x07, 0x0a, 0x07,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x75,
+	0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f,
+	0x6d, 0x61, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x12, 0x48, 0x0a,
+	0x13, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x46, 0x6f,
+	0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x10, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78,
+	0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x34, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x0a, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xb5, 0x01, 0x0a, 0x12, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x21,
+	0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xb0, 0x04, 0x0a, 0x14, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x75,
+	0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01,
+	0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a,
+	0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x8a, 0xb2, 0x19,
+	0x06, 0x52, 0x04, 0x10, 0x01, 0x20, 0x64, 0x48, 0x00, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x88,
+	0x01, 0x01, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0x8a, 0xb2, 0x19, 0x07, 0x52, 0x05, 0x10, 0x01, 0x20, 0xe8, 0x07, 0x48, 0x01, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12,
+	0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87,
+	0xc3, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x6f,
+	0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x6a, 0x02, 0x10,
+	0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52,
+	0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x73, 0x65, 0x74, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x22, 0x5d, 0x0a,
+	0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2d, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x52, 0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xf9, 0x04,
+	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x52, 0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x44, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08,
+	0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
+	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x08, 0x8a, 0xb2, 0x19,
+	0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x41, 0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0x8a, 0xb2, 0x19, 0x06, 0x52, 0x04, 0x10, 0x01, 0x20, 0x64, 0x48, 0x00,
+	0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x04, 0x72, 0x65, 0x70,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x8a, 0xb2, 0x19, 0x07, 0x52, 0x05, 0x10,
+	0x01, 0x20, 0xe8, 0x07, 0x48, 0x01, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87, 0xc3, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x73, 0x6b, 0x69,
+	0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x65, 0x74, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x72, 0x65, 0x70, 0x73, 0x22, 0x5d, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x8a, 0xb2,
+	0x19, 0x04, 0x52, 0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xda, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x40, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x13, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63,
+	0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x7f, 0x0a, 0x14, 0x4c, 0x69,
+	0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x0d,
+	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a,
+	0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x44,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x42, 0x6f, 0x64, 0x79, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x39, 0x0a, 0x1a, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x6f, 0x66, 0x5f, 0x6f, 0x6e, 0x65,
+	0x5f, 0x72, 0x65, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15,
+	0x6d, 0x69, 0x6e, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x52,
+	0x65, 0x70, 0x4d, 0x61, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x73, 0x22,
+	0xaf, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6e, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19,
+	0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x22, 0x63, 0x0a, 0x12, 0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x79, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x72,
+	0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x64, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xc2, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x48, 0x69,
+	0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x19,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d,
+	0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62,
+	0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe,
+	0x87, 0xc3, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x72,
+	0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0x8a, 0xb2, 0x19, 0x07, 0x52,
+	0x05, 0x08, 0x00, 0x20, 0xe8, 0x07, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x2a, 0x0a, 0x03,
+	0x72, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62,
+	0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x24, 0x40, 0x52, 0x03, 0x72, 0x70, 0x65, 0x12, 0x3d, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x6d,
+	0x75, 0x6c, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x46, 0x6f, 0x72,
+	0x6d, 0x75, 0x6c, 0x61, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x07,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x22, 0x7b, 0x0a, 0x11, 0x4f, 0x6e, 0x65, 0x52, 0x65,
+	0x70, 0x4d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x07,
+	0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61,
+	0x78, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x6d, 0x75, 0x6c,
+	0x61, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6f,
+	0x6e, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x65, 0x52, 0x65,
+	0x70, 0x4d, 0x61, 0x78, 0x22, 0x70, 0x0a, 0x1a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x6a, 0x02,
+	0x10, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x5e, 0x0a, 0x0f,
+	0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x73, 0x12,
+	0x37, 0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73,
+	0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0xad, 0x03, 0x0a,
+	0x13, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x65,
+	0x74, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x69,
+	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11,
+	0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
+	0x79, 0x12, 0x49, 0x0a, 0x14, 0x73, 0x65, 0x74, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x6d, 0x75, 0x73,
+	0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x53, 0x65, 0x74, 0x73, 0x52, 0x11, 0x73, 0x65, 0x74, 0x73, 0x42,
+	0x79, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x22, 0xc5, 0x01, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34,
+	0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x57, 0x65, 0x65, 0x6b,
+	0x6c, 0x79, 0x53, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x77, 0x65,
+	0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0d, 0x6d, 0x61, 0x78, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x53, 0x65, 0x74, 0x73, 0x22, 0xf1,
+	0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x2e, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x48, 0x00, 0x52, 0x0f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x6a,
+	0x02, 0x10, 0x40, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0xe4, 0x02, 0x0a, 0x11, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x75, 0x73, 0x63,
+	0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x73, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x53, 0x65, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x53, 0x65, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x5f, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x53, 0x65, 0x74,
+	0x73, 0x12, 0x2d, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75,
+	0x6d, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x56, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xbc, 0x01, 0x0a, 0x1e, 0x47, 0x65,
+	0x74, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3f, 0x0a, 0x0d,
+	0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75,
+	0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x52,
+	0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x65,
+	0x65, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe4, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12,
+	0x17, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x42, 0x0a, 0x12, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x10, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x48, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x77, 0x0a, 0x0f, 0x53, 0x6b, 0x69,
+	0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x67, 0x0a, 0x10, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x6c, 0x0a, 0x0a, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x97, 0x04, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x36, 0x0a, 0x0e,
+	0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x0d, 0x6c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6b, 0x12, 0x35, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52,
+	0x0a, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x75, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x64, 0x75, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x68,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x61, 0x64, 0x68, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x0b, 0x73, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2d,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61,
+	0x70, 0x44, 0x61, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0x8a, 0xb2, 0x19, 0x06, 0x52, 0x04, 0x10, 0x01, 0x20, 0x64, 0x52, 0x04, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x1f, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0b, 0x8a, 0xb2, 0x19, 0x07, 0x52, 0x05, 0x10, 0x01, 0x20, 0xe8, 0x07, 0x52, 0x04, 0x72, 0x65,
+	0x70, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87, 0xc3, 0x40, 0x52, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x12, 0x4f, 0x0a, 0x17, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f,
+	0x6f, 0x66, 0x5f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x78, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x3f, 0x52,
+	0x14, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x4f, 0x66, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x4d, 0x61, 0x78, 0x22, 0x34, 0x0a, 0x0a, 0x57, 0x65, 0x65, 0x6b, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x53,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x10,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
+	0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c,
+	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x0c,
+	0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73,
+	0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
+	0x6c, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a,
+	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x65, 0x6b, 0x53, 0x63,
+	0x68, 0x65, 0x6d, 0x65, 0x52, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0f,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x6a, 0x02, 0x10, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x64, 0x61, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x61, 0x79,
+	0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01,
+	0x52, 0x09, 0x64, 0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x37, 0x0a, 0x09, 0x65,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x52, 0x09, 0x65, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x73, 0x22, 0xdf, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x36, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x6a, 0x02, 0x10, 0x64, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x36, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x95, 0x01, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x52, 0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x8a, 0xb2, 0x19, 0x06, 0x6a,
+	0x04, 0x08, 0x01, 0x10, 0x64, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x5d, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x67, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x36, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x09, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x22, 0x66, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x0b,
+	0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78, 0x12, 0x44, 0x0a, 0x0d, 0x65,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72,
+	0x02, 0x08, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87, 0xc3, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x8c, 0x02, 0x0a, 0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x77, 0x65, 0x65, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0x8a, 0xb2, 0x19, 0x06, 0x52, 0x04, 0x10, 0x01, 0x20, 0x34, 0x52, 0x05,
+	0x77, 0x65, 0x65, 0x6b, 0x73, 0x12, 0x3b, 0x0a, 0x0e, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x61, 0x78, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x4d, 0x61, 0x78, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x78,
+	0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2,
+	0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e,
+	0x69, 0x74, 0x22, 0x5e, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x76, 0x0a, 0x19, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x78,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x49, 0x64, 0x22, 0xb8, 0x03, 0x0a, 0x1a, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x74,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x31, 0x0a,
+	0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x67, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61, 0x74,
+	0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xc2,
+	0x01, 0x0a, 0x0b, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x76, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x64,
+	0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x44, 0x61, 0x79, 0x52,
+	0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6b, 0x0a, 0x18, 0x52,
+	0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x5f, 0x66, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x46, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x61, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x16,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67,
+	0x75, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f,
+	0x64, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x44, 0x6f, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x22, 0x82, 0x01, 0x0a, 0x17, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x69, 0x63, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x02, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x6d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x6a, 0x02, 0x10, 0x40,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72,
+	0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79,
+	0x52, 0x75, 0x6e, 0x1a, 0x40, 0x0a, 0x12, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x61, 0x70,
+	0x70, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6e, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32,
+	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x42, 0x09, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x3e, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xbc, 0x02, 0x0a, 0x16, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x77, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64,
+	0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa2, 0x04, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b,
+	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x11, 0x64,
+	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x10, 0x64, 0x69, 0x66,
+	0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a,
+	0x13, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x11, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x09, 0x6d,
+	0x61, 0x78, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x64,
+	0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65,
+	0x5f, 0x74, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54,
+	0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2d, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52,
+	0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x16, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0x87, 0x01, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41,
+	0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42,
+	0x06, 0x8a, 0xb2, 0x19, 0x02, 0x10, 0x01, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1a, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0x8a, 0xb2, 0x19, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d,
+	0x6f, 0x64, 0x65, 0x22, 0x56, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x91, 0x01, 0x0a, 0x0f,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x22,
+	0xcd, 0x01, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x23, 0x0a,
+	0x0d, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0xc0, 0x02, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x0b, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x0c, 0x8a, 0xb2, 0x19, 0x08, 0x7a, 0x06,
+	0x1a, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0c, 0x8a, 0xb2, 0x19, 0x08, 0x7a, 0x06, 0x1a,
+	0x04, 0x5a, 0x02, 0x08, 0x00, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x4a, 0x0a, 0x0e, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x0c, 0x8a, 0xb2, 0x19, 0x08, 0x7a, 0x06, 0x1a, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0d,
+	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x40, 0x0a,
+	0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x0c, 0x8a, 0xb2, 0x19, 0x08, 0x7a, 0x06, 0x1a,
+	0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0xee, 0x01, 0x0a, 0x0c, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x3f,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69,
+	0x6e, 0x67, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73,
+	0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65,
+	0x12, 0x2e, 0x0a, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x05, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x0d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0a, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08,
+	0x8a, 0xb2, 0x19, 0x04, 0x52, 0x02, 0x08, 0x00, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x52,
+	0x02, 0x10, 0x00, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b,
+	0x8a, 0xb2, 0x19, 0x07, 0x52, 0x05, 0x08, 0x00, 0x20, 0xe8, 0x07, 0x52, 0x04, 0x72, 0x65, 0x70,
+	0x73, 0x12, 0x30, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87, 0xc3, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x72, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x40, 0x52, 0x03, 0x72, 0x70, 0x65, 0x12,
+	0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72,
+	0x02, 0x08, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22,
+	0x1d, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x27,
+	0x0a, 0x0d, 0x50, 0x61, 0x75, 0x73, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x74, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x72, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x72,
+	0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xd5, 0x03, 0x0a, 0x14,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x65,
+	0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x12,
+	0x49, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x72, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a, 0x0f, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x0e,
+	0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x33,
+	0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x61, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4e, 0x6f, 0x74,
+	0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x65, 0x53, 0x61,
+	0x76, 0x65, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x48, 0x00, 0x52, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x22, 0x89, 0x01, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22,
+	0x51, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x03,
+	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x74, 0x52, 0x03,
+	0x73, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x74, 0x69,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x73, 0x74, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x74, 0x5f, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x73, 0x65, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x72, 0x65, 0x73,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x0e, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x2f, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
+	0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78,
+	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72,
+	0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65,
+	0x74, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09,
+	0x73, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22,
+	0x21, 0x0a, 0x09, 0x4e, 0x6f, 0x74, 0x65, 0x53, 0x61, 0x76, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x5e, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16,
+	0x72, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x14, 0x72, 0x65,
+	0x73, 0x74, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x69, 0x6e, 0x67, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x6f,
+	0x6c, 0x75, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75,
+	0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xc7, 0x02, 0x0a, 0x0f, 0x42,
+	0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x66, 0x61, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x62, 0x6f,
+	0x64, 0x79, 0x46, 0x61, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x77, 0x61, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x77, 0x61, 0x69,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x63, 0x68, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x61, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x34,
+	0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x22, 0x92, 0x03, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a,
+	0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x0a,
+	0xd7, 0xa3, 0x70, 0x3d, 0x39, 0x91, 0x40, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x42, 0x0a, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x66, 0x61, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a,
+	0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x0e, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x61, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x77, 0x61, 0x69, 0x73, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x72, 0x40,
+	0x52, 0x05, 0x77, 0x61, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x73, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x72, 0x40,
+	0x52, 0x05, 0x63, 0x68, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x61, 0x72, 0x6d, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x72, 0x40, 0x52, 0x03,
+	0x61, 0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0x75, 0x0a, 0x1d, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x35, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x5a,
+	0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64, 0x22, 0x58, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x42, 0x6f,
+	0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x22, 0x53, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a,
+	0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x22, 0x76, 0x0a, 0x1c, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f,
+	0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa2,
+	0x04, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0x8a, 0xb2, 0x19,
+	0x04, 0x5a, 0x02, 0x08, 0x00, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x0a, 0xd7, 0xa3, 0x70, 0x3d, 0x39, 0x91, 0x40,
+	0x48, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x47, 0x0a, 0x10, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x66, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19,
+	0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x59, 0x40, 0x48, 0x02, 0x52, 0x0e, 0x62, 0x6f, 0x64, 0x79, 0x46, 0x61, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x05, 0x77, 0x61,
+	0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62,
+	0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0xc0, 0x72, 0x40, 0x48, 0x03, 0x52, 0x05, 0x77, 0x61, 0x69, 0x73, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x33, 0x0a, 0x05, 0x63, 0x68, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18,
+	0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x72, 0x40, 0x48, 0x04, 0x52, 0x05, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x03, 0x61, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x72, 0x40, 0x48, 0x05, 0x52, 0x03, 0x61,
+	0x72, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04,
+	0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x6f,
+	0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x62, 0x6f, 0x64,
+	0x79, 0x5f, 0x66, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x77, 0x61, 0x69, 0x73, 0x74, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63, 0x68, 0x65, 0x73,
+	0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x61, 0x72, 0x6d, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64,
+	0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x1c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x5a, 0x02, 0x08, 0x00,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x39, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x9a, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74,
+	0x65, 0x54, 0x6f, 0x12, 0x2b, 0x0a, 0x0b, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x64, 0x61,
+	0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x8a, 0xb2, 0x19, 0x06, 0x52, 0x04,
+	0x10, 0x00, 0x20, 0x5a, 0x52, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x44, 0x61, 0x79, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x8b, 0x01, 0x0a,
+	0x14, 0x42, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62,
+	0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x76,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0d, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x42, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72,
+	0x65, 0x6e, 0x64, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x22, 0xb4, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x12, 0x44, 0x0a,
+	0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45,
+	0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0x8a, 0xb2, 0x19,
+	0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22,
+	0x82, 0x02, 0x0a, 0x10, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70,
+	0x4d, 0x61, 0x78, 0x12, 0x29, 0x0a, 0x11, 0x6f, 0x6e, 0x65, 0x5f, 0x72, 0x65, 0x70, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x34,
+	0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x22, 0x6c, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x5b, 0x0a, 0x05, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19,
+	0x14, 0x62, 0x12, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x59, 0x40, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x20, 0x0a,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0x8a, 0xb2,
+	0x19, 0x06, 0x52, 0x04, 0x10, 0x00, 0x20, 0x64, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22,
+	0xce, 0x03, 0x0a, 0x16, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x21, 0x85, 0xeb, 0x51, 0xb8, 0xfe, 0x87, 0xc3, 0x40, 0x52, 0x0c, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x44, 0x0a, 0x0d, 0x65, 0x78, 0x65,
+	0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63,
+	0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0x8a, 0xb2, 0x19, 0x04, 0x72, 0x02, 0x08,
+	0x01, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x3c, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x18, 0x8a, 0xb2, 0x19, 0x14, 0x62, 0x12, 0x11, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x40, 0x48, 0x00, 0x52,
+	0x09, 0x62, 0x61, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x42, 0x08, 0x8a,
+	0xb2, 0x19, 0x04, 0x7a, 0x02, 0x10, 0x14, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12,
+	0x47, 0x0a, 0x0f, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x01, 0x42, 0x1e, 0x8a, 0xb2, 0x19, 0x1a, 0x7a, 0x18,
+	0x10, 0x0a, 0x1a, 0x14, 0x62, 0x12, 0x09, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x0e, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x5f, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70,
+	0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x39, 0x0a, 0x09, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb7, 0x01, 0x0a, 0x08,
+	0x50, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x70, 0x65, 0x72, 0x63, 0x65,
+	0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x63, 0x68, 0x69, 0x65,
+	0x76, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0e, 0x61, 0x63, 0x68, 0x69, 0x65, 0x76, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x65, 0x78, 0x61, 0x63, 0x74, 0x12, 0x2d, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x69,
+	0x64, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x53, 0x69, 0x64, 0x65, 0x22, 0xaf, 0x01, 0x0a, 0x17, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x34, 0x0a, 0x0b, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0a, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x72, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x62, 0x61, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x50,
+	0x6c, 0x61, 0x74, 0x65, 0x53, 0x65, 0x74, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0xa5, 0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52,
+	0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x4f, 0x52,
+	0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x4e,
+	0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52,
+	0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0x8d, 0x01, 0x0a, 0x0a, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a,
+	0x0a, 0x16, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49,
+	0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x4e, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54,
+	0x59, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x02,
+	0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x41,
+	0x44, 0x56, 0x41, 0x4e, 0x43, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x46,
+	0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x42, 0x45, 0x41, 0x53, 0x54, 0x10, 0x04, 0x2a,
+	0x90, 0x01, 0x0a, 0x0b, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x43, 0x48, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42,
+	0x41, 0x43, 0x4b, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x47, 0x53, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x48, 0x4f, 0x55, 0x4c, 0x44, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x08,
+	0x0a, 0x04, 0x41, 0x52, 0x4d, 0x53, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x42, 0x53, 0x10,
+	0x06, 0x12, 0x08, 0x0a, 0x04, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x47,
+	0x4c, 0x55, 0x54, 0x45, 0x53, 0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x52, 0x44, 0x49,
+	0x4f, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x42, 0x4f, 0x44, 0x59,
+	0x10, 0x0a, 0x2a, 0xef, 0x01, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a,
+	0x14, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58, 0x45, 0x52, 0x43,
+	0x49, 0x53, 0x45, 0x5f, 0x53, 0x51, 0x55, 0x41, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45,
+	0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x46, 0x54,
+	0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x44,
+	0x55, 0x4d, 0x42, 0x42, 0x45, 0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x4f, 0x55, 0x4c, 0x44, 0x45, 0x52,
+	0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x50,
+	0x55, 0x4c, 0x4c, 0x5f, 0x55, 0x50, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x45, 0x52,
+	0x43, 0x49, 0x53, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x53, 0x45, 0x10,
+	0x06, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x4e,
+	0x45, 0x5f, 0x48, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12,
+	0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x50, 0x55,
+	0x4c, 0x4c, 0x10, 0x08, 0x2a, 0x51, 0x0a, 0x0a, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x55, 0x4e, 0x49,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x55, 0x4e, 0x49, 0x54, 0x5f, 0x4b,
+	0x47, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x55, 0x4e,
+	0x49, 0x54, 0x5f, 0x4c, 0x42, 0x10, 0x02, 0x2a, 0xb6, 0x01, 0x0a, 0x10, 0x4f, 0x6e, 0x65, 0x52,
+	0x65, 0x70, 0x4d, 0x61, 0x78, 0x46, 0x6f, 0x72, 0x6d, 0x75, 0x6c, 0x61, 0x12, 0x23, 0x0a, 0x1f,
+	0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x55, 0x4c, 0x41, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d, 0x41, 0x58,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x55, 0x4c, 0x41, 0x5f, 0x45, 0x50, 0x4c, 0x45, 0x59, 0x10, 0x01,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x55, 0x4c, 0x41, 0x5f, 0x42, 0x52, 0x5a, 0x59, 0x43, 0x4b, 0x49, 0x10,
+	0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d, 0x41, 0x58,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x55, 0x4c, 0x41, 0x5f, 0x4c, 0x4f, 0x4d, 0x42, 0x41, 0x52, 0x44,
+	0x49, 0x10, 0x03, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d,
+	0x41, 0x58, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x55, 0x4c, 0x41, 0x5f, 0x52, 0x50, 0x45, 0x10, 0x04,
+	0x2a, 0x70, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x1c, 0x0a, 0x18, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41,
+	0x59, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52,
+	0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48,
+	0x10, 0x03, 0x2a, 0x9f, 0x01, 0x0a, 0x13, 0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x0a, 0x21, 0x56, 0x4f,
+	0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x4c, 0x41,
+	0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52,
+	0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x42, 0x41, 0x4c,
+	0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x49, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x56, 0x4f, 0x4c, 0x55, 0x4d, 0x45, 0x5f, 0x42,
+	0x41, 0x4c, 0x41, 0x4e, 0x43, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4f, 0x56,
+	0x45, 0x52, 0x10, 0x03, 0x2a, 0x82, 0x02, 0x0a, 0x0a, 0x53, 0x6b, 0x69, 0x70, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53,
+	0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x14, 0x0a, 0x10, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x53, 0x4f, 0x52, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x53,
+	0x49, 0x43, 0x4b, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x4a, 0x55, 0x52, 0x59, 0x10, 0x04, 0x12, 0x16, 0x0a,
+	0x12, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41,
+	0x56, 0x45, 0x4c, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45,
+	0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x57, 0x45, 0x41, 0x54, 0x48, 0x45, 0x52, 0x10, 0x06, 0x12, 0x1a,
+	0x0a, 0x16, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f,
+	0x54, 0x49, 0x56, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x4b,
+	0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10,
+	0x08, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4b, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x49, 0x53, 0x53, 0x45, 0x44, 0x10, 0x09, 0x2a, 0xd8, 0x01, 0x0a, 0x09, 0x44, 0x61,
+	0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x41, 0x59, 0x5f, 0x4f,
+	0x46, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57,
+	0x45, 0x45, 0x4b, 0x5f, 0x4d, 0x4f, 0x4e, 0x44, 0x41, 0x59, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13,
+	0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x5f, 0x54, 0x55, 0x45, 0x53,
+	0x44, 0x41, 0x59, 0x10, 0x02, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f,
+	0x57, 0x45, 0x45, 0x4b, 0x5f, 0x57, 0x45, 0x44, 0x4e, 0x45, 0x53, 0x44, 0x41, 0x59, 0x10, 0x03,
+	0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x5f,
+	0x54, 0x48, 0x55, 0x52, 0x53, 0x44, 0x41, 0x59, 0x10, 0x04, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x41,
+	0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x5f, 0x46, 0x52, 0x49, 0x44, 0x41, 0x59,
+	0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57, 0x45, 0x45,
+	0x4b, 0x5f, 0x53, 0x41, 0x54, 0x55, 0x52, 0x44, 0x41, 0x59, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12,
+	0x44, 0x41, 0x59, 0x5f, 0x4f, 0x46, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x5f, 0x53, 0x55, 0x4e, 0x44,
+	0x41, 0x59, 0x10, 0x07, 0x2a, 0x51, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f,
+	0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f,
+	0x4c, 0x49, 0x4e, 0x45, 0x41, 0x52, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x4f, 0x47,
+	0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x44,
+	0x4f, 0x55, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x2a, 0xc0, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a,
+	0x1b, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x26,
+	0x0a, 0x22, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43, 0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x57, 0x45,
+	0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x43,
+	0x52, 0x45, 0x41, 0x53, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x53, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19,
+	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x45, 0x41, 0x54, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x44, 0x45, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x04, 0x2a, 0xab, 0x01, 0x0a, 0x0c, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x49,
+	0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10,
+	0x01, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4d,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x4f,
+	0x4e, 0x47, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x48, 0x45, 0x56, 0x59, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16,
+	0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x49,
+	0x54, 0x4e, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x05, 0x2a, 0x76, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x03,
+	0x2a, 0x57, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x16, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x42, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x50,
+	0x45, 0x52, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x2a, 0xbd, 0x01, 0x0a, 0x10, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22,
+	0x0a, 0x1e, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44,
+	0x10, 0x03, 0x12, 0x25, 0x0a, 0x21, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43,
+	0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x50, 0x45, 0x52, 0x53, 0x4f, 0x4e,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x57,
+	0x45, 0x49, 0x47, 0x48, 0x54, 0x10, 0x01, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x52, 0x53, 0x4f,
+	0x4e, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x52, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4f, 0x4e, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x32, 0xc0, 0x21,
+	0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x67, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31,
+	0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x6c, 0x0a, 0x0d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x32, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x69, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x96, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x48,
+	0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47,
+	0x65, 0x74, 0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x73, 0x3a, 0x68, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79,
+	0x12, 0x76, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65,
+	0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x6e, 0x65, 0x52, 0x65, 0x70,
+	0x4d, 0x61, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x4f, 0x6e,
+	0x65, 0x52, 0x65, 0x70, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x6e, 0x65,
+	0x2d, 0x72, 0x65, 0x70, 0x2d, 0x6d, 0x61, 0x78, 0x12, 0x73, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x20, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2f, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x8e, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x27, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75,
+	0x73, 0x63, 0x6c, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f,
+	0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x2d, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x70,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x67, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x3a, 0x01, 0x2a, 0x22, 0x0c, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x60, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x61, 0x0a, 0x0c, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e,
+	0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x6c,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12,
+	0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x32, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x91, 0x01, 0x0a,
+	0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20,
+	0x3a, 0x01, 0x2a, 0x1a, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x69, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61,
+	0x6d, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x2a, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x77, 0x0a, 0x0c, 0x41,
+	0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24,
+	0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x73, 0x2f, 0x7b, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x3a, 0x61,
+	0x70, 0x70, 0x6c, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
+	0x4e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x4e, 0x65, 0x78,
+	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x4e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x12, 0x1c, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x65,
+	0x78, 0x74, 0x2d, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x61, 0x0a, 0x0c, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x12,
+	0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x83, 0x01,
+	0x0a, 0x11, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x12, 0x21, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x21, 0x3a, 0x01, 0x2a, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x3a, 0x72, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x43, 0x61,
+	0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x3a,
+	0x69, 0x63, 0x73, 0x12, 0x53, 0x0a, 0x0e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x70, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x3a, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x30, 0x01, 0x12, 0x7f, 0x0a, 0x13, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01,
+	0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x3a,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x7f, 0x0a, 0x13, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
+	0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a,
+	0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x7f, 0x0a, 0x13,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d,
+	0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
+	0x73, 0x3a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x63, 0x0a,
+	0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1d,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x57, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76,
+	0x31, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x3a, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28,
+	0x01, 0x30, 0x01, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f,
+	0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x64,
+	0x79, 0x2d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x81,
+	0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x12, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x64, 0x79,
+	0x2d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x12, 0x82, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e, 0x77, 0x6f,
+	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x2d, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x8d, 0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x32, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6f, 0x64, 0x79, 0x2d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x12, 0x8a, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
+	0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x2a, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6f,
+	0x64, 0x79, 0x2d, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x12, 0x7b, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x77, 0x6f, 0x72,
+	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6f, 0x64, 0x79,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x62, 0x6f, 0x64, 0x79, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x23, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
+	0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x2d, 0x73, 0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x75, 0x0a, 0x0f, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x77,
+	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x50, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x3a, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x42, 0x1d, 0x5a, 0x18, 0x67, 0x6f, 0x6c, 0x76, 0x32, 0x2d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xd8, 0x01, 0x01, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_workout_proto_rawDescOnce sync.Once
+	file_proto_workout_proto_rawDescData = file_proto_workout_proto_rawDesc
+)
+
+func file_proto_workout_proto_rawDescGZIP() []byte {
+	file_proto_workout_proto_rawDescOnce.Do(func() {
+		file_proto_workout_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_workout_proto_rawDescData)
+	})
+	return file_proto_workout_proto_rawDescData
+}
+
+var file_proto_workout_proto_enumTypes = make([]protoimpl.EnumInfo, 17)
+var file_proto_workout_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
+var file_proto_workout_proto_goTypes = []interface{}{
+	(WorkoutStatus)(0),                       // 0: workout.WorkoutStatus
+	(Difficulty)(0),                          // 1: workout.Difficulty
+	(MuscleGroup)(0),                         // 2: workout.MuscleGroup
+	(ExerciseType)(0),                        // 3: workout.ExerciseType
+	(WeightUnit)(0),                          // 4: workout.WeightUnit
+	(OneRepMaxFormula)(0),                    // 5: workout.OneRepMaxFormula
+	(StatsPeriod)(0),                         // 6: workout.StatsPeriod
+	(VolumeBalanceStatus)(0),                 // 7: workout.VolumeBalanceStatus
+	(SkipReason)(0),                          // 8: workout.SkipReason
+	(DayOfWeek)(0),                           // 9: workout.DayOfWeek
+	(ProgressionScheme)(0),                   // 10: workout.ProgressionScheme
+	(ProgressionAction)(0),                   // 11: workout.ProgressionAction
+	(ImportFormat)(0),                        // 12: workout.ImportFormat
+	(ExportFormat)(0),                        // 13: workout.ExportFormat
+	(BatchMode)(0),                           // 14: workout.BatchMode
+	(WorkoutEventType)(0),                    // 15: workout.WorkoutEventType
+	(PersonalRecordType)(0),                  // 16: workout.PersonalRecordType
+	(*Workout)(nil),                          // 17: workout.Workout
+	(*WorkoutDisplayName)(nil),               // 18: workout.WorkoutDisplayName
+	(*CreateWorkoutRequest)(nil),             // 19: workout.CreateWorkoutRequest
+	(*CreateWorkoutResponse)(nil),            // 20: workout.CreateWorkoutResponse
+	(*GetWorkoutRequest)(nil),                // 21: workout.GetWorkoutRequest
+	(*GetWorkoutResponse)(nil),               // 22: workout.GetWorkoutResponse
+	(*UpdateWorkoutRequest)(nil),             // 23: workout.UpdateWorkoutRequest
+	(*UpdateWorkoutResponse)(nil),            // 24: workout.UpdateWorkoutResponse
+	(*DeleteWorkoutRequest)(nil),             // 25: workout.DeleteWorkoutRequest
+	(*DeleteWorkoutResponse)(nil),            // 26: workout.DeleteWorkoutResponse
+	(*ListWorkoutsRequest)(nil),              // 27: workout.ListWorkoutsRequest
+	(*ListWorkoutsResponse)(nil),             // 28: workout.ListWorkoutsResponse
+	(*IntensityRule)(nil),                    // 29: workout.IntensityRule
+	(*GetHighIntensityWorkoutsRequest)(nil),  // 30: workout.GetHighIntensityWorkoutsRequest
+	(*HighIntensityMatch)(nil),               // 31: workout.HighIntensityMatch
+	(*GetHighIntensityWorkoutsResponse)(nil), // 32: workout.GetHighIntensityWorkoutsResponse
+	(*CalculateOneRepMaxRequest)(nil),        // 33: workout.CalculateOneRepMaxRequest
+	(*OneRepMaxEstimate)(nil),                // 34: workout.OneRepMaxEstimate
+	(*CalculateOneRepMaxResponse)(nil),       // 35: workout.CalculateOneRepMaxResponse
+	(*GetTrainingStatsRequest)(nil),          // 36: workout.GetTrainingStatsRequest
+	(*MuscleGroupSets)(nil),                  // 37: workout.MuscleGroupSets
+	(*TrainingStatsBucket)(nil),              // 38: workout.TrainingStatsBucket
+	(*GetTrainingStatsResponse)(nil),         // 39: workout.GetTrainingStatsResponse
+	(*VolumeTarget)(nil),                     // 40: workout.VolumeTarget
+	(*GetMuscleBalanceReportRequest)(nil),    // 41: workout.GetMuscleBalanceReportRequest
+	(*MuscleGroupVolume)(nil),                // 42: workout.MuscleGroupVolume
+	(*GetMuscleBalanceReportResponse)(nil),   // 43: workout.GetMuscleBalanceReportResponse
+	(*GetConsistencyRequest)(nil),            // 44: workout.GetConsistencyRequest
+	(*Streak)(nil),                           // 45: workout.Streak
+	(*SkipReasonCount)(nil),                  // 46: workout.SkipReasonCount
+	(*SkipReasonBucket)(nil),                 // 47: workout.SkipReasonBucket
+	(*HeatmapDay)(nil),                       // 48: workout.HeatmapDay
+	(*GetConsistencyResponse)(nil),           // 49: workout.GetConsistencyResponse
+	(*SetScheme)(nil),                        // 50: workout.SetScheme
+	(*WeekScheme)(nil),                       // 51: workout.WeekScheme
+	(*TemplateExercise)(nil),                 // 52: workout.TemplateExercise
+	(*WorkoutTemplate)(nil),                  // 53: workout.WorkoutTemplate
+	(*Program)(nil),                          // 54: workout.Program
+	(*CreateProgramRequest)(nil),             // 55: workout.CreateProgramRequest
+	(*CreateProgramResponse)(nil),            // 56: workout.CreateProgramResponse
+	(*GetProgramRequest)(nil),                // 57: workout.GetProgramRequest
+	(*GetProgramResponse)(nil),               // 58: workout.GetProgramResponse
+	(*ListProgramsRequest)(nil),              // 59: workout.ListProgramsRequest
+	(*ListProgramsResponse)(nil),             // 60: workout.ListProgramsResponse
+	(*UpdateProgramRequest)(nil),             // 61: workout.UpdateProgramRequest
+	(*UpdateProgramResponse)(nil),            // 62: workout.UpdateProgramResponse
+	(*UpdateProgramTemplatesRequest)(nil),    // 63: workout.UpdateProgramTemplatesRequest
+	(*UpdateProgramTemplatesResponse)(nil),   // 64: workout.UpdateProgramTemplatesResponse
+	(*DeleteProgramRequest)(nil),             // 65: workout.DeleteProgramRequest
+	(*DeleteProgramResponse)(nil),            // 66: workout.DeleteProgramResponse
+	(*TrainingMax)(nil),                      // 67: workout.TrainingMax
+	(*ApplyProgramRequest)(nil),              // 68: workout.ApplyProgramRequest
+	(*ApplyProgramResponse)(nil),             // 69: workout.ApplyProgramResponse
+	(*SuggestNextWorkoutRequest)(nil),        // 70: workout.SuggestNextWorkoutRequest
+	(*SuggestNextWorkoutResponse)(nil),       // 71: workout.SuggestNextWorkoutResponse
+	(*ListCalendarRequest)(nil),              // 72: workout.ListCalendarRequest
+	(*CalendarDay)(nil),                      // 73: workout.CalendarDay
+	(*ListCalendarResponse)(nil),             // 74: workout.ListCalendarResponse
+	(*RescheduleWorkoutRequest)(nil),         // 75: workout.RescheduleWorkoutRequest
+	(*RescheduleWorkoutResponse)(nil),        // 76: workout.RescheduleWorkoutResponse
+	(*ExportICalendarRequest)(nil),           // 77: workout.ExportICalendarRequest
+	(*ExportICalendarResponse)(nil),          // 78: workout.ExportICalendarResponse
+	(*ImportOptions)(nil),                    // 79: workout.ImportOptions
+	(*ImportWorkoutsRequest)(nil),            // 80: workout.ImportWorkoutsRequest
+	(*ImportRowError)(nil),                   // 81: workout.ImportRowError
+	(*ImportWorkoutsResponse)(nil),           // 82: workout.ImportWorkoutsResponse
+	(*ExportWorkoutsRequest)(nil),            // 83: workout.ExportWorkoutsRequest
+	(*ExportWorkoutsResponse)(nil),           // 84: workout.ExportWorkoutsResponse
+	(*BatchCreateWorkoutsRequest)(nil),       // 85: workout.BatchCreateWorkoutsRequest
+	(*BatchUpdateWorkoutsRequest)(nil),       // 86: workout.BatchUpdateWorkoutsRequest
+	(*BatchDeleteWorkoutsRequest)(nil),       // 87: workout.BatchDeleteWorkoutsRequest
+	(*BatchItemResult)(nil),                  // 88: workout.BatchItemResult
+	(*BatchWorkoutsResponse)(nil),            // 89: workout.BatchWorkoutsResponse
+	(*WatchWorkoutsRequest)(nil),             // 90: workout.WatchWorkoutsRequest
+	(*WorkoutEvent)(nil),                     // 91: workout.WorkoutEvent
+	(*TrackWorkoutRequest)(nil),              // 92: workout.TrackWorkoutRequest
+	(*StartTracking)(nil),                    // 93: workout.StartTracking
+	(*CompleteSet)(nil),                      // 94: workout.CompleteSet
+	(*AddNote)(nil),                          // 95: workout.AddNote
+	(*PauseTracking)(nil),                    // 96: workout.PauseTracking
+	(*WorkoutSet)(nil),                       // 97: workout.WorkoutSet
+	(*TrackWorkoutResponse)(nil),             // 98: workout.TrackWorkoutResponse
+	(*TrackingStarted)(nil),                  // 99: workout.TrackingStarted
+	(*SetLogged)(nil),                        // 100: workout.SetLogged
+	(*RestTimerExpired)(nil),                 // 101: workout.RestTimerExpired
+	(*PersonalRecord)(nil),                   // 102: workout.PersonalRecord
+	(*NoteSaved)(nil),                        // 103: workout.NoteSaved
+	(*TrackingPaused)(nil),                   // 104: workout.TrackingPaused
+	(*TrackingCompleted)(nil),                // 105: workout.TrackingCompleted
+	(*BodyMeasurement)(nil),                  // 106: workout.BodyMeasurement
+	(*CreateBodyMeasurementRequest)(nil),     // 107: workout.CreateBodyMeasurementRequest
+	(*CreateBodyMeasurementResponse)(nil),    // 108: workout.CreateBodyMeasurementResponse
+	(*GetBodyMeasurementRequest)(nil),        // 109: workout.GetBodyMeasurementRequest
+	(*GetBodyMeasurementResponse)(nil),       // 110: workout.GetBodyMeasurementResponse
+	(*ListBodyMeasurementsRequest)(nil),      // 111: workout.ListBodyMeasurementsRequest
+	(*ListBodyMeasurementsResponse)(nil),     // 112: workout.ListBodyMeasurementsResponse
+	(*UpdateBodyMeasurementRequest)(nil),     // 113: workout.UpdateBodyMeasurementRequest
+	(*UpdateBodyMeasurementResponse)(nil),    // 114: workout.UpdateBodyMeasurementResponse
+	(*DeleteBodyMeasurementRequest)(nil),     // 115: workout.DeleteBodyMeasurementRequest
+	(*DeleteBodyMeasurementResponse)(nil),    // 116: workout.DeleteBodyMeasurementResponse
+	(*GetBodyweightTrendRequest)(nil),        // 117: workout.GetBodyweightTrendRequest
+	(*BodyweightTrendPoint)(nil),             // 118: workout.BodyweightTrendPoint
+	(*GetBodyweightTrendResponse)(nil),       // 119: workout.GetBodyweightTrendResponse
+	(*GetRelativeStrengthRequest)(nil),       // 120: workout.GetRelativeStrengthRequest
+	(*RelativeStrength)(nil),                 // 121: workout.RelativeStrength
+	(*GetRelativeStrengthResponse)(nil),      // 122: workout.GetRelativeStrengthResponse
+	(*Plate)(nil),                            // 123: workout.Plate
+	(*CalculatePlatesRequest)(nil),           // 124: workout.CalculatePlatesRequest
+	(*PlateLoad)(nil),                        // 125: workout.PlateLoad
+	(*PlateSet)(nil),                         // 126: workout.PlateSet
+	(*CalculatePlatesResponse)(nil),          // 127: workout.CalculatePlatesResponse
+	nil,                                      // 128: workout.ImportOptions.ColumnMappingEntry
+}
+var file_proto_workout_proto_depIdxs = []int32{
+	3,   // 0: workout.Workout.exercise_type:type_name -> workout.ExerciseType
+	0,   // 1: workout.Workout.status:type_name -> workout.WorkoutStatus
+	1,   // 2: workout.Workout.difficulty:type_name -> workout.Difficulty
+	2,   // 3: workout.Workout.muscle_group:type_name -> workout.MuscleGroup
+	5,   // 4: workout.Workout.one_rep_max_formula:type_name -> workout.OneRepMaxFormula
+	8,   // 5: workout.Workout.skip_reason:type_name -> workout.SkipReason
+	18,  // 6: workout.Workout.display_name:type_name -> workout.WorkoutDisplayName
+	4,   // 7: workout.Workout.weight_unit:type_name -> workout.WeightUnit
+	3,   // 8: workout.CreateWorkoutRequest.exercise_type:type_name -> workout.ExerciseType
+	1,   // 9: workout.CreateWorkoutRequest.difficulty:type_name -> workout.Difficulty
+	2,   // 10: workout.CreateWorkoutRequest.muscle_group:type_name -> workout.MuscleGroup
+	4,   // 11: workout.CreateWorkoutRequest.weight_unit:type_name -> workout.WeightUnit
+	17,  // 12: workout.CreateWorkoutResponse.workout:type_name -> workout.Workout
+	17,  // 13: workout.GetWorkoutResponse.workout:type_name -> workout.Workout
+	3,   // 14: workout.UpdateWorkoutRequest.exercise_type:type_name -> workout.ExerciseType
+	0,   // 15: workout.UpdateWorkoutRequest.status:type_name -> workout.WorkoutStatus
+	1,   // 16: workout.UpdateWorkoutRequest.difficulty:type_name -> workout.Difficulty
+	2,   // 17: workout.UpdateWorkoutRequest.muscle_group:type_name -> workout.MuscleGroup
+	8,   // 18: workout.UpdateWorkoutRequest.skip_reason:type_name -> workout.SkipReason
+	4,   // 19: workout.UpdateWorkoutRequest.weight_unit:type_name -> workout.WeightUnit
+	17,  // 20: workout.UpdateWorkoutResponse.workout:type_name -> workout.Workout
+	0,   // 21: workout.ListWorkoutsRequest.status_filter:type_name -> workout.WorkoutStatus
+	1,   // 22: workout.ListWorkoutsRequest.difficulty_filter:type_name -> workout.Difficulty
+	2,   // 23: workout.ListWorkoutsRequest.muscle_group_filter:type_name -> workout.MuscleGroup
+	17,  // 24: workout.ListWorkoutsResponse.workouts:type_name -> workout.Workout
+	3,   // 25: workout.IntensityRule.exercise_type:type_name -> workout.ExerciseType
+	1,   // 26: workout.IntensityRule.min_difficulty:type_name -> workout.Difficulty
+	29,  // 27: workout.GetHighIntensityWorkoutsRequest.rules:type_name -> workout.IntensityRule
+	4,   // 28: workout.GetHighIntensityWorkoutsRequest.weight_unit:type_name -> workout.WeightUnit
+	17,  // 29: workout.HighIntensityMatch.workout:type_name -> workout.Workout
+	17,  // 30: workout.GetHighIntensityWorkoutsResponse.workouts:type_name -> workout.Workout
+	31,  // 31: workout.GetHighIntensityWorkoutsResponse.matches:type_name -> workout.HighIntensityMatch
+	5,   // 32: workout.CalculateOneRepMaxRequest.formula:type_name -> workout.OneRepMaxFormula
+	5,   // 33: workout.OneRepMaxEstimate.formula:type_name -> workout.OneRepMaxFormula
+	34,  // 34: workout.CalculateOneRepMaxResponse.estimates:type_name -> workout.OneRepMaxEstimate
+	6,   // 35: workout.GetTrainingStatsRequest.period:type_name -> workout.StatsPeriod
+	2,   // 36: workout.MuscleGroupSets.muscle_group:type_name -> workout.MuscleGroup
+	37,  // 37: workout.TrainingStatsBucket.sets_by_muscle_group:type_name -> workout.MuscleGroupSets
+	38,  // 38: workout.GetTrainingStatsResponse.buckets:type_name -> workout.TrainingStatsBucket
+	4,   // 39: workout.GetTrainingStatsResponse.weight_unit:type_name -> workout.WeightUnit
+	2,   // 40: workout.VolumeTarget.muscle_group:type_name -> workout.MuscleGroup
+	40,  // 41: workout.GetMuscleBalanceReportRequest.targets:type_name -> workout.VolumeTarget
+	2,   // 42: workout.MuscleGroupVolume.muscle_group:type_name -> workout.MuscleGroup
+	40,  // 43: workout.MuscleGroupVolume.target:type_name -> workout.VolumeTarget
+	7,   // 44: workout.MuscleGroupVolume.status:type_name -> workout.VolumeBalanceStatus
+	42,  // 45: workout.GetMuscleBalanceReportResponse.muscle_groups:type_name -> workout.MuscleGroupVolume
+	6,   // 46: workout.GetConsistencyRequest.streak_unit:type_name -> workout.StatsPeriod
+	6,   // 47: workout.GetConsistencyRequest.skip_reason_period:type_name -> workout.StatsPeriod
+	8,   // 48: workout.SkipReasonCount.reason:type_name -> workout.SkipReason
+	46,  // 49: workout.SkipReasonBucket.counts:type_name -> workout.SkipReasonCount
+	45,  // 50: workout.GetConsistencyResponse.current_streak:type_name -> workout.Streak
+	45,  // 51: workout.GetConsistencyResponse.longest_streak:type_name -> workout.Streak
+	6,   // 52: workout.GetConsistencyResponse.streak_unit:type_name -> workout.StatsPeriod
+	47,  // 53: workout.GetConsistencyResponse.skip_reasons:type_name -> workout.SkipReasonBucket
+	48,  // 54: workout.GetConsistencyResponse.heatmap:type_name -> workout.HeatmapDay
+	50,  // 55: workout.WeekScheme.sets:type_name -> workout.SetScheme
+	3,   // 56: workout.TemplateExercise.exercise_type:type_name -> workout.ExerciseType
+	2,   // 57: workout.TemplateExercise.muscle_group:type_name -> workout.MuscleGroup
+	1,   // 58: workout.TemplateExercise.difficulty:type_name -> workout.Difficulty
+	51,  // 59: workout.TemplateExercise.weeks:type_name -> workout.WeekScheme
+	9,   // 60: workout.WorkoutTemplate.day_of_week:type_name -> workout.DayOfWeek
+	52,  // 61: workout.WorkoutTemplate.exercises:type_name -> workout.TemplateExercise
+	53,  // 62: workout.Program.templates:type_name -> workout.WorkoutTemplate
+	53,  // 63: workout.CreateProgramRequest.templates:type_name -> workout.WorkoutTemplate
+	54,  // 64: workout.CreateProgramResponse.program:type_name -> workout.Program
+	54,  // 65: workout.GetProgramResponse.program:type_name -> workout.Program
+	54,  // 66: workout.ListProgramsResponse.programs:type_name -> workout.Program
+	54,  // 67: workout.UpdateProgramResponse.program:type_name -> workout.Program
+	53,  // 68: workout.UpdateProgramTemplatesRequest.templates:type_name -> workout.WorkoutTemplate
+	54,  // 69: workout.UpdateProgramTemplatesResponse.program:type_name -> workout.Program
+	3,   // 70: workout.TrainingMax.exercise_type:type_name -> workout.ExerciseType
+	67,  // 71: workout.ApplyProgramRequest.training_maxes:type_name -> workout.TrainingMax
+	4,   // 72: workout.ApplyProgramRequest.weight_unit:type_name -> workout.WeightUnit
+	17,  // 73: workout.ApplyProgramResponse.workouts:type_name -> workout.Workout
+	3,   // 74: workout.SuggestNextWorkoutRequest.exercise_type:type_name -> workout.ExerciseType
+	3,   // 75: workout.SuggestNextWorkoutResponse.exercise_type:type_name -> workout.ExerciseType
+	10,  // 76: workout.SuggestNextWorkoutResponse.scheme:type_name -> workout.ProgressionScheme
+	11,  // 77: workout.SuggestNextWorkoutResponse.action:type_name -> workout.ProgressionAction
+	17,  // 78: workout.SuggestNextWorkoutResponse.last_workout:type_name -> workout.Workout
+	4,   // 79: workout.SuggestNextWorkoutResponse.weight_unit:type_name -> workout.WeightUnit
+	17,  // 80: workout.CalendarDay.workouts:type_name -> workout.Workout
+	73,  // 81: workout.ListCalendarResponse.days:type_name -> workout.CalendarDay
+	17,  // 82: workout.RescheduleWorkoutResponse.workout:type_name -> workout.Workout
+	12,  // 83: workout.ImportOptions.format:type_name -> workout.ImportFormat
+	128, // 84: workout.ImportOptions.column_mapping:type_name -> workout.ImportOptions.ColumnMappingEntry
+	79,  // 85: workout.ImportWorkoutsRequest.options:type_name -> workout.ImportOptions
+	81,  // 86: workout.ImportWorkoutsResponse.errors:type_name -> workout.ImportRowError
+	81,  // 87: workout.ImportWorkoutsResponse.skipped:type_name -> workout.ImportRowError
+	0,   // 88: workout.ExportWorkoutsRequest.status_filter:type_name -> workout.WorkoutStatus
+	1,   // 89: workout.ExportWorkoutsRequest.difficulty_filter:type_name -> workout.Difficulty
+	2,   // 90: workout.ExportWorkoutsRequest.muscle_group_filter:type_name -> workout.MuscleGroup
+	13,  // 91: workout.ExportWorkoutsRequest.format:type_name -> workout.ExportFormat
+	4,   // 92: workout.ExportWorkoutsRequest.weight_unit:type_name -> workout.WeightUnit
+	17,  // 93: workout.ExportWorkoutsResponse.workouts:type_name -> workout.Workout
+	19,  // 94: workout.BatchCreateWorkoutsRequest.workouts:type_name -> workout.CreateWorkoutRequest
+	14,  // 95: workout.BatchCreateWorkoutsRequest.mode:type_name -> workout.BatchMode
+	23,  // 96: workout.BatchUpdateWorkoutsRequest.workouts:type_name -> workout.UpdateWorkoutRequest
+	14,  // 97: workout.BatchUpdateWorkoutsRequest.mode:type_name -> workout.BatchMode
+	14,  // 98: workout.BatchDeleteWorkoutsRequest.mode:type_name -> workout.BatchMode
+	17,  // 99: workout.BatchItemResult.workout:type_name -> workout.Workout
+	88,  // 100: workout.BatchWorkoutsResponse.results:type_name -> workout.BatchItemResult
+	15,  // 101: workout.WatchWorkoutsRequest.event_types:type_name -> workout.WorkoutEventType
+	3,   // 102: workout.WatchWorkoutsRequest.exercise_types:type_name -> workout.ExerciseType
+	0,   // 103: workout.WatchWorkoutsRequest.statuses:type_name -> workout.WorkoutStatus
+	15,  // 104: workout.WorkoutEvent.type:type_name -> workout.WorkoutEventType
+	17,  // 105: workout.WorkoutEvent.workout:type_name -> workout.Workout
+	0,   // 106: workout.WorkoutEvent.previous_status:type_name -> workout.WorkoutStatus
+	93,  // 107: workout.TrackWorkoutRequest.start:type_name -> workout.StartTracking
+	94,  // 108: workout.TrackWorkoutRequest.set_completed:type_name -> workout.CompleteSet
+	95,  // 109: workout.TrackWorkoutRequest.note:type_name -> workout.AddNote
+	96,  // 110: workout.TrackWorkoutRequest.pause:type_name -> workout.PauseTracking
+	4,   // 111: workout.CompleteSet.weight_unit:type_name -> workout.WeightUnit
+	4,   // 112: workout.WorkoutSet.weight_unit:type_name -> workout.WeightUnit
+	99,  // 113: workout.TrackWorkoutResponse.started:type_name -> workout.TrackingStarted
+	100, // 114: workout.TrackWorkoutResponse.set_logged:type_name -> workout.SetLogged
+	101, // 115: workout.TrackWorkoutResponse.rest_timer_expired:type_name -> workout.RestTimerExpired
+	102, // 116: workout.TrackWorkoutResponse.personal_record:type_name -> workout.PersonalRecord
+	103, // 117: workout.TrackWorkoutResponse.note_saved:type_name -> workout.NoteSaved
+	104, // 118: workout.TrackWorkoutResponse.paused:type_name -> workout.TrackingPaused
+	105, // 119: workout.TrackWorkoutResponse.completed:type_name -> workout.TrackingCompleted
+	17,  // 120: workout.TrackingStarted.workout:type_name -> workout.Workout
+	97,  // 121: workout.TrackingStarted.sets:type_name -> workout.WorkoutSet
+	97,  // 122: workout.SetLogged.set:type_name -> workout.WorkoutSet
+	16,  // 123: workout.PersonalRecord.type:type_name -> workout.PersonalRecordType
+	3,   // 124: workout.PersonalRecord.exercise_type:type_name -> workout.ExerciseType
+	4,   // 125: workout.PersonalRecord.weight_unit:type_name -> workout.WeightUnit
+	17,  // 126: workout.TrackingCompleted.workout:type_name -> workout.Workout
+	4,   // 127: workout.TrackingCompleted.weight_unit:type_name -> workout.WeightUnit
+	4,   // 128: workout.BodyMeasurement.weight_unit:type_name -> workout.WeightUnit
+	4,   // 129: workout.CreateBodyMeasurementRequest.weight_unit:type_name -> workout.WeightUnit
+	106, // 130: workout.CreateBodyMeasurementResponse.measurement:type_name -> workout.BodyMeasurement
+	106, // 131: workout.GetBodyMeasurementResponse.measurement:type_name -> workout.BodyMeasurement
+	106, // 132: workout.ListBodyMeasurementsResponse.measurements:type_name -> workout.BodyMeasurement
+	4,   // 133: workout.UpdateBodyMeasurementRequest.weight_unit:type_name -> workout.WeightUnit
+	106, // 134: workout.UpdateBodyMeasurementResponse.measurement:type_name -> workout.BodyMeasurement
+	118, // 135: workout.GetBodyweightTrendResponse.points:type_name -> workout.BodyweightTrendPoint
+	4,   // 136: workout.GetBodyweightTrendResponse.weight_unit:type_name -> workout.WeightUnit
+	3,   // 137: workout.GetRelativeStrengthRequest.exercise_type:type_name -> workout.ExerciseType
+	17,  // 138: workout.RelativeStrength.workout:type_name -> workout.Workout
+	4,   // 139: workout.RelativeStrength.weight_unit:type_name -> workout.WeightUnit
+	121, // 140: workout.GetRelativeStrengthResponse.results:type_name -> workout.RelativeStrength
+	4,   // 141: workout.CalculatePlatesRequest.weight_unit:type_name -> workout.WeightUnit
+	3,   // 142: workout.CalculatePlatesRequest.exercise_type:type_name -> workout.ExerciseType
+	123, // 143: workout.CalculatePlatesRequest.plates:type_name -> workout.Plate
+	125, // 144: workout.PlateSet.per_side:type_name -> workout.PlateLoad
+	4,   // 145: workout.CalculatePlatesResponse.weight_unit:type_name -> workout.WeightUnit
+	126, // 146: workout.CalculatePlatesResponse.sets:type_name -> workout.PlateSet
+	19,  // 147: workout.WorkoutService.CreateWorkout:input_type -> workout.CreateWorkoutRequest
+	21,  // 148: workout.WorkoutService.GetWorkout:input_type -> workout.GetWorkoutRequest
+	23,  // 149: workout.WorkoutService.UpdateWorkout:input_type -> workout.UpdateWorkoutRequest
+	25,  // 150: workout.WorkoutService.DeleteWorkout:input_type -> workout.DeleteWorkoutRequest
+	27,  // 151: workout.WorkoutService.ListWorkouts:input_type -> workout.ListWorkoutsRequest
+	30,  // 152: workout.WorkoutService.GetHighIntensityWorkouts:input_type -> workout.GetHighIntensityWorkoutsRequest
+	33,  // 153: workout.WorkoutService.CalculateOneRepMax:input_type -> workout.CalculateOneRepMaxRequest
+	36,  // 154: workout.WorkoutService.GetTrainingStats:input_type -> workout.GetTrainingStatsRequest
+	41,  // 155: workout.WorkoutService.GetMuscleBalanceReport:input_type -> workout.GetMuscleBalanceReportRequest
+	44,  // 156: workout.WorkoutService.GetConsistency:input_type -> workout.GetConsistencyRequest
+	55,  // 157: workout.WorkoutService.CreateProgram:input_type -> workout.CreateProgramRequest
+	57,  // 158: workout.WorkoutService.GetProgram:input_type -> workout.GetProgramRequest
+	59,  // 159: workout.WorkoutService.ListPrograms:input_type -> workout.ListProgramsRequest
+	61,  // 160: workout.WorkoutService.UpdateProgram:input_type -> workout.UpdateProgramRequest
+	63,  // 161: workout.WorkoutService.UpdateProgramTemplates:input_type -> workout.UpdateProgramTemplatesRequest
+	65,  // 162: workout.WorkoutService.DeleteProgram:input_type -> workout.DeleteProgramRequest
+	68,  // 163: workout.WorkoutService.ApplyProgram:input_type -> workout.ApplyProgramRequest
+	70,  // 164: workout.WorkoutService.SuggestNextWorkout:input_type -> workout.SuggestNextWorkoutRequest
+	72,  // 165: workout.WorkoutService.ListCalendar:input_type -> workout.ListCalendarRequest
+	75,  // 166: workout.WorkoutService.RescheduleWorkout:input_type -> workout.RescheduleWorkoutRequest
+	77,  // 167: workout.WorkoutService.ExportICalendar:input_type -> workout.ExportICalendarRequest
+	80,  // 168: workout.WorkoutService.ImportWorkouts:input_type -> workout.ImportWorkoutsRequest
+	83,  // 169: workout.WorkoutService.ExportWorkouts:input_type -> workout.ExportWorkoutsRequest
+	85,  // 170: workout.WorkoutService.BatchCreateWorkouts:input_type -> workout.BatchCreateWorkoutsRequest
+	86,  // 171: workout.WorkoutService.BatchUpdateWorkouts:input_type -> workout.BatchUpdateWorkoutsRequest
+	87,  // 172: workout.WorkoutService.BatchDeleteWorkouts:input_type -> workout.BatchDeleteWorkoutsRequest
+	90,  // 173: workout.WorkoutService.WatchWorkouts:input_type -> workout.WatchWorkoutsRequest
+	92,  // 174: workout.WorkoutService.TrackWorkout:input_type -> workout.TrackWorkoutRequest
+	107, // 175: workout.WorkoutService.CreateBodyMeasurement:input_type -> workout.CreateBodyMeasurementRequest
+	109, // 176: workout.WorkoutService.GetBodyMeasurement:input_type -> workout.GetBodyMeasurementRequest
+	111, // 177: workout.WorkoutService.ListBodyMeasurements:input_type -> workout.ListBodyMeasurementsRequest
+	113, // 178: workout.WorkoutService.UpdateBodyMeasurement:input_type -> workout.UpdateBodyMeasurementRequest
+	115, // 179: workout.WorkoutService.DeleteBodyMeasurement:input_type -> workout.DeleteBodyMeasurementRequest
+	117, // 180: workout.WorkoutService.GetBodyweightTrend:input_type -> workout.GetBodyweightTrendRequest
+	120, // 181: workout.WorkoutService.GetRelativeStrength:input_type -> workout.GetRelativeStrengthRequest
+	124, // 182: workout.WorkoutService.CalculatePlates:input_type -> workout.CalculatePlatesRequest
+	20,  // 183: workout.WorkoutService.CreateWorkout:output_type -> workout.CreateWorkoutResponse
+	22,  // 184: workout.WorkoutService.GetWorkout:output_type -> workout.GetWorkoutResponse
+	24,  // 185: workout.WorkoutService.UpdateWorkout:output_type -> workout.UpdateWorkoutResponse
+	26,  // 186: workout.WorkoutService.DeleteWorkout:output_type -> workout.DeleteWorkoutResponse
+	28,  // 187: workout.WorkoutService.ListWorkouts:output_type -> workout.ListWorkoutsResponse
+	32,  // 188: workout.WorkoutService.GetHighIntensityWorkouts:output_type -> workout.GetHighIntensityWorkoutsResponse
+	35,  // 189: workout.WorkoutService.CalculateOneRepMax:output_type -> workout.CalculateOneRepMaxResponse
+	39,  // 190: workout.WorkoutService.GetTrainingStats:output_type -> workout.GetTrainingStatsResponse
+	43,  // 191: workout.WorkoutService.GetMuscleBalanceReport:output_type -> workout.GetMuscleBalanceReportResponse
+	49,  // 192: workout.WorkoutService.GetConsistency:output_type -> workout.GetConsistencyResponse
+	56,  // 193: workout.WorkoutService.CreateProgram:output_type -> workout.CreateProgramResponse
+	58,  // 194: workout.WorkoutService.GetProgram:output_type -> workout.GetProgramResponse
+	60,  // 195: workout.WorkoutService.ListPrograms:output_type -> workout.ListProgramsResponse
+	62,  // 196: workout.WorkoutService.UpdateProgram:output_type -> workout.UpdateProgramResponse
+	64,  // 197: workout.WorkoutService.UpdateProgramTemplates:output_type -> workout.UpdateProgramTemplatesResponse
+	66,  // 198: workout.WorkoutService.DeleteProgram:output_type -> workout.DeleteProgramResponse
+	69,  // 199: workout.WorkoutService.ApplyProgram:output_type -> workout.ApplyProgramResponse
+	71,  // 200: workout.WorkoutService.SuggestNextWorkout:output_type -> workout.SuggestNextWorkoutResponse
+	74,  // 201: workout.WorkoutService.ListCalendar:output_type -> workout.ListCalendarResponse
+	76,  // 202: workout.WorkoutService.RescheduleWorkout:output_type -> workout.RescheduleWorkoutResponse
+	78,  // 203: workout.WorkoutService.ExportICalendar:output_type -> workout.ExportICalendarResponse
+	82,  // 204: workout.WorkoutService.ImportWorkouts:output_type -> workout.ImportWorkoutsResponse
+	84,  // 205: workout.WorkoutService.ExportWorkouts:output_type -> workout.ExportWorkoutsResponse
+	89,  // 206: workout.WorkoutService.BatchCreateWorkouts:output_type -> workout.BatchWorkoutsResponse
+	89,  // 207: workout.WorkoutService.BatchUpdateWorkouts:output_type -> workout.BatchWorkoutsResponse
+	89,  // 208: workout.WorkoutService.BatchDeleteWorkouts:output_type -> workout.BatchWorkoutsResponse
+	91,  // 209: workout.WorkoutService.WatchWorkouts:output_type -> workout.WorkoutEvent
+	98,  // 210: workout.WorkoutService.TrackWorkout:output_type -> workout.TrackWorkoutResponse
+	108, // 211: workout.WorkoutService.CreateBodyMeasurement:output_type -> workout.CreateBodyMeasurementResponse
+	110, // 212: workout.WorkoutService.GetBodyMeasurement:output_type -> workout.GetBodyMeasurementResponse
+	112, // 213: workout.WorkoutService.ListBodyMeasurements:output_type -> workout.ListBodyMeasurementsResponse
+	114, // 214: workout.WorkoutService.UpdateBodyMeasurement:output_type -> workout.UpdateBodyMeasurementResponse
+	116, // 215: workout.WorkoutService.DeleteBodyMeasurement:output_type -> workout.DeleteBodyMeasurementResponse
+	119, // 216: workout.WorkoutService.GetBodyweightTrend:output_type -> workout.GetBodyweightTrendResponse
+	122, // 217: workout.WorkoutService.GetRelativeStrength:output_type -> workout.GetRelativeStrengthResponse
+	127, // 218: workout.WorkoutService.CalculatePlates:output_type -> workout.CalculatePlatesResponse
+	183, // [183:219] is the sub-list for method output_type
+	147, // [147:183] is the sub-list for method input_type
+	147, // [147:147] is the sub-list for extension type_name
+	147, // [147:147] is the sub-list for extension extendee
+	0,   // [0:147] is the sub-list for field type_name
+}
+
+func init() { file_proto_workout_proto_init() }
+func file_proto_workout_proto_init() {
+	if File_proto_workout_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_workout_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Workout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkoutDisplayName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListWorkoutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IntensityRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHighIntensityWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HighIntensityMatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHighIntensityWorkoutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateOneRepMaxRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneRepMaxEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculateOneRepMaxResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrainingStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuscleGroupSets); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingStatsBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTrainingStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VolumeTarget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMuscleBalanceReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuscleGroupVolume); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMuscleBalanceReportResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsistencyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Streak); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipReasonCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SkipReasonBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConsistencyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetScheme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WeekScheme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TemplateExercise); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkoutTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Program); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProgramsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProgramsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProgramTemplatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateProgramTemplatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrainingMax); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProgramRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyProgramResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestNextWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuggestNextWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalendarDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportICalendarRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportICalendarResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportRowError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportWorkoutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportWorkoutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchCreateWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchDeleteWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItemResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchWorkoutsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchWorkoutsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkoutEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackWorkoutRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartTracking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddNote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseTracking); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkoutSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackWorkoutResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackingStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestTimerExpired); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PersonalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NoteSaved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackingPaused); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackingCompleted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BodyMeasurement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBodyMeasurementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBodyMeasurementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetHighIntensityWorkoutsResponse.ProtoReflect.Descriptor instead.
-func (*GetHighIntensityWorkoutsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_workout_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetHighIntensityWorkoutsResponse) GetWorkouts() []*Workout {
-	if x != nil {
-		return x.Workouts
-	}
-	return nil
-}
-
-func (x *GetHighIntensityWorkoutsResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *GetHighIntensityWorkoutsResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-var File_proto_workout_proto protoreflect.FileDescriptor
-
-var file_proto_workout_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xcc,
-	0x03, 0x0a, 0x07, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78,
-	0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x15, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72,
-	0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66,
-	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77,
-	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74,
-	0x79, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x37, 0x0a,
-	0x0c, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75,
-	0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65,
-	0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xb8, 0x02,
-	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69,
-	0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e,
-	0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a, 0x64,
-	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x75, 0x73,
-	0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x22, 0x40, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0xf8,
-	0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x78, 0x65, 0x72, 0x63,
-	0x69, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15,
-	0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0c, 0x65, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e,
-	0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x0a,
-	0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x0c, 0x6d, 0x75,
-	0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c,
-	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x6d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x04, 0x73, 0x65, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x65, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x77,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x15, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x26, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64,
-	0x22, 0x31, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x0d, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x11, 0x64, 0x69, 0x66, 0x66,
-	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x69,
-	0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x52, 0x10, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
-	0x75, 0x6c, 0x74, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x13, 0x6d, 0x75,
-	0x73, 0x63, 0x6c, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x2e, 0x4d, 0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x11, 0x6d,
-	0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x22, 0x7f, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x21, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65,
-	0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67, 0x68,
-	0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x08, 0x77, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x77, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x08, 0x77,
-	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f,
-	0x74, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2a, 0xa5, 0x01, 0x0a, 0x0d, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x1e, 0x0a, 0x1a, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02,
-	0x12, 0x1c, 0x0a, 0x18, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x12, 0x1a,
-	0x0a, 0x16, 0x57, 0x4f, 0x52, 0x4b, 0x4f, 0x55, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x53, 0x4b, 0x49, 0x50, 0x50, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x8d, 0x01, 0x0a, 0x0a, 0x44,
-	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x44, 0x49, 0x46,
-	0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
-	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55,
-	0x4c, 0x54, 0x59, 0x5f, 0x42, 0x45, 0x47, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1b,
-	0x0a, 0x17, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x49, 0x4e, 0x54,
-	0x45, 0x52, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44,
-	0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c, 0x54, 0x59, 0x5f, 0x41, 0x44, 0x56, 0x41, 0x4e, 0x43,
-	0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x44, 0x49, 0x46, 0x46, 0x49, 0x43, 0x55, 0x4c,
-	0x54, 0x59, 0x5f, 0x42, 0x45, 0x41, 0x53, 0x54, 0x10, 0x04, 0x2a, 0x90, 0x01, 0x0a, 0x0b, 0x4d,
-	0x75, 0x73, 0x63, 0x6c, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x43,
-	0x48, 0x45, 0x53, 0x54, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x42, 0x41, 0x43, 0x4b, 0x10, 0x02,
-	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x45, 0x47, 0x53, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x48,
-	0x4f, 0x55, 0x4c, 0x44, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x52, 0x4d,
-	0x53, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x42, 0x53, 0x10, 0x06, 0x12, 0x08, 0x0a, 0x04,
-	0x43, 0x4f, 0x52, 0x45, 0x10, 0x07, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4c, 0x55, 0x54, 0x45, 0x53,
-	0x10, 0x08, 0x12, 0x0a, 0x0a, 0x06, 0x43, 0x41, 0x52, 0x44, 0x49, 0x4f, 0x10, 0x09, 0x12, 0x0d,
-	0x0a, 0x09, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x42, 0x4f, 0x44, 0x59, 0x10, 0x0a, 0x2a, 0xef, 0x01,
-	0x0a, 0x0c, 0x45, 0x78, 0x65, 0x72, 0x63, 0x69, 0x73, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
-	0x0a, 0x14, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58, 0x45, 0x52,
-	0x43, 0x49, 0x53, 0x45, 0x5f, 0x42, 0x45, 0x4e, 0x43, 0x48, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53,
-	0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x53,
-	0x51, 0x55, 0x41, 0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49,
-	0x53, 0x45, 0x5f, 0x44, 0x45, 0x41, 0x44, 0x4c, 0x49, 0x46, 0x54, 0x10, 0x03, 0x12, 0x1e, 0x0a,
-	0x1a, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x44, 0x55, 0x4d, 0x42, 0x42, 0x45,
-	0x4c, 0x4c, 0x5f, 0x53, 0x48, 0x4f, 0x55, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x14, 0x0a,
-	0x10, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x55,
-	0x50, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f,
-	0x53, 0x49, 0x44, 0x45, 0x5f, 0x52, 0x41, 0x49, 0x53, 0x45, 0x10, 0x06, 0x12, 0x19, 0x0a, 0x15,
-	0x45, 0x58, 0x45, 0x52, 0x43, 0x49, 0x53, 0x45, 0x5f, 0x4f, 0x4e, 0x45, 0x5f, 0x48, 0x41, 0x4e,
-	0x44, 0x5f, 0x52, 0x4f, 0x57, 0x10, 0x07, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x58, 0x45, 0x52, 0x43,
-	0x49, 0x53, 0x45, 0x5f, 0x48, 0x49, 0x47, 0x48, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0x08, 0x32,
-	0x85, 0x04, 0x0a, 0x0e, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74,
-	0x12, 0x1a, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f,
-	0x72, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x77,
-	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x1d, 0x2e, 0x77, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f,
-	0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x77, 0x6f, 0x72, 0x6b,
-	0x6f, 0x75, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67,
-	0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75,
-	0x74, 0x73, 0x12, 0x28, 0x2e, 0x77, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74,
-	0x48, 0x69, 0x67, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72,
-	0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x77,
-	0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x69, 0x67, 0x68, 0x49, 0x6e,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x79, 0x57, 0x6f, 0x72, 0x6b, 0x6f, 0x75, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1d, 0x5a, 0x18, 0x67, 0x6f, 0x6c, 0x76, 0x32,
-	0x2d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0xd8, 0x01, 0x01, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_proto_workout_proto_rawDescOnce sync.Once
-	file_proto_workout_proto_rawDescData = file_proto_workout_proto_rawDesc
-)
-
-func file_proto_workout_proto_rawDescGZIP() []byte {
-	file_proto_workout_proto_rawDescOnce.Do(func() {
-		file_proto_workout_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_workout_proto_rawDescData)
-	})
-	return file_proto_workout_proto_rawDescData
-}
-
-var file_proto_workout_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_workout_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_proto_workout_proto_goTypes = []interface{}{
-	(WorkoutStatus)(0),                       // 0: workout.WorkoutStatus
-	(Difficulty)(0),                          // 1: workout.Difficulty
-	(MuscleGroup)(0),                         // 2: workout.MuscleGroup
-	(ExerciseType)(0),                        // 3: workout.ExerciseType
-	(*Workout)(nil),                          // 4: workout.Workout
-	(*CreateWorkoutRequest)(nil),             // 5: workout.CreateWorkoutRequest
-	(*CreateWorkoutResponse)(nil),            // 6: workout.CreateWorkoutResponse
-	(*GetWorkoutRequest)(nil),                // 7: workout.GetWorkoutRequest
-	(*GetWorkoutResponse)(nil),               // 8: workout.GetWorkoutResponse
-	(*UpdateWorkoutRequest)(nil),             // 9: workout.UpdateWorkoutRequest
-	(*UpdateWorkoutResponse)(nil),            // 10: workout.UpdateWorkoutResponse
-	(*DeleteWorkoutRequest)(nil),             // 11: workout.DeleteWorkoutRequest
-	(*DeleteWorkoutResponse)(nil),            // 12: workout.DeleteWorkoutResponse
-	(*ListWorkoutsRequest)(nil),              // 13: workout.ListWorkoutsRequest
-	(*ListWorkoutsResponse)(nil),             // 14: workout.ListWorkoutsResponse
-	(*GetHighIntensityWorkoutsRequest)(nil),  // 15: workout.GetHighIntensityWorkoutsRequest
-	(*GetHighIntensityWorkoutsResponse)(nil), // 16: workout.GetHighIntensityWorkoutsResponse
-}
-var file_proto_workout_proto_depIdxs = []int32{
-	3,  // 0: workout.Workout.exercise_type:type_name -> workout.ExerciseType
-	0,  // 1: workout.Workout.status:type_name -> workout.WorkoutStatus
-	1,  // 2: workout.Workout.difficulty:type_name -> workout.Difficulty
-	2,  // 3: workout.Workout.muscle_group:type_name -> workout.MuscleGroup
-	3,  // 4: workout.CreateWorkoutRequest.exercise_type:type_name -> workout.ExerciseType
-	1,  // 5: workout.CreateWorkoutRequest.difficulty:type_name -> workout.Difficulty
-	2,  // 6: workout.CreateWorkoutRequest.muscle_group:type_name -> workout.MuscleGroup
-	4,  // 7: workout.CreateWorkoutResponse.workout:type_name -> workout.Workout
-	4,  // 8: workout.GetWorkoutResponse.workout:type_name -> workout.Workout
-	3,  // 9: workout.UpdateWorkoutRequest.exercise_type:type_name -> workout.ExerciseType
-	0,  // 10: workout.UpdateWorkoutRequest.status:type_name -> workout.WorkoutStatus
-	1,  // 11: workout.UpdateWorkoutRequest.difficulty:type_name -> workout.Difficulty
-	2,  // 12: workout.UpdateWorkoutRequest.muscle_group:type_name -> workout.MuscleGroup
-	4,  // 13: workout.UpdateWorkoutResponse.workout:type_name -> workout.Workout
-	0,  // 14: workout.ListWorkoutsRequest.status_filter:type_name -> workout.WorkoutStatus
-	1,  // 15: workout.ListWorkoutsRequest.difficulty_filter:type_name -> workout.Difficulty
-	2,  // 16: workout.ListWorkoutsRequest.muscle_group_filter:type_name -> workout.MuscleGroup
-	4,  // 17: workout.ListWorkoutsResponse.workouts:type_name -> workout.Workout
-	4,  // 18: workout.GetHighIntensityWorkoutsResponse.workouts:type_name -> workout.Workout
-	5,  // 19: workout.WorkoutService.CreateWorkout:input_type -> workout.CreateWorkoutRequest
-	7,  // 20: workout.WorkoutService.GetWorkout:input_type -> workout.GetWorkoutRequest
-	9,  // 21: workout.WorkoutService.UpdateWorkout:input_type -> workout.UpdateWorkoutRequest
-	11, // 22: workout.WorkoutService.DeleteWorkout:input_type -> workout.DeleteWorkoutRequest
-	13, // 23: workout.WorkoutService.ListWorkouts:input_type -> workout.ListWorkoutsRequest
-	15, // 24: workout.WorkoutService.GetHighIntensityWorkouts:input_type -> workout.GetHighIntensityWorkoutsRequest
-	6,  // 25: workout.WorkoutService.CreateWorkout:output_type -> workout.CreateWorkoutResponse
-	8,  // 26: workout.WorkoutService.GetWorkout:output_type -> workout.GetWorkoutResponse
-	10, // 27: workout.WorkoutService.UpdateWorkout:output_type -> workout.UpdateWorkoutResponse
-	12, // 28: workout.WorkoutService.DeleteWorkout:output_type -> workout.DeleteWorkoutResponse
-	14, // 29: workout.WorkoutService.ListWorkouts:output_type -> workout.ListWorkoutsResponse
-	16, // 30: workout.WorkoutService.GetHighIntensityWorkouts:output_type -> workout.GetHighIntensityWorkoutsResponse
-	25, // [25:31] is the sub-list for method output_type
-	19, // [19:25] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
-}
-
-func init() { file_proto_workout_proto_init() }
-func file_proto_workout_proto_init() {
-	if File_proto_workout_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_workout_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Workout); i {
+		file_proto_workout_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBodyMeasurementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,8 +12282,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkoutRequest); i {
+		file_proto_workout_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBodyMeasurementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,8 +12294,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateWorkoutResponse); i {
+		file_proto_workout_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBodyMeasurementsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,8 +12306,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkoutRequest); i {
+		file_proto_workout_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBodyMeasurementsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,8 +12318,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWorkoutResponse); i {
+		file_proto_workout_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBodyMeasurementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1530,8 +12330,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkoutRequest); i {
+		file_proto_workout_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBodyMeasurementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1542,8 +12342,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateWorkoutResponse); i {
+		file_proto_workout_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBodyMeasurementRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1554,8 +12354,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkoutRequest); i {
+		file_proto_workout_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBodyMeasurementResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1566,8 +12366,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteWorkoutResponse); i {
+		file_proto_workout_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBodyweightTrendRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1578,8 +12378,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkoutsRequest); i {
+		file_proto_workout_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BodyweightTrendPoint); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1590,8 +12390,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListWorkoutsResponse); i {
+		file_proto_workout_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBodyweightTrendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1602,8 +12402,8 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHighIntensityWorkoutsRequest); i {
+		file_proto_workout_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelativeStrengthRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1614,8 +12414,80 @@ func file_proto_workout_proto_init() {
 				return nil
 			}
 		}
-		file_proto_workout_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetHighIntensityWorkoutsResponse); i {
+		file_proto_workout_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelativeStrength); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRelativeStrengthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculatePlatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlateLoad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlateSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_workout_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculatePlatesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1627,13 +12499,40 @@ func file_proto_workout_proto_init() {
 			}
 		}
 	}
+	file_proto_workout_proto_msgTypes[2].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[6].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[24].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[44].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[63].OneofWrappers = []interface{}{
+		(*ImportWorkoutsRequest_Options)(nil),
+		(*ImportWorkoutsRequest_Chunk)(nil),
+	}
+	file_proto_workout_proto_msgTypes[66].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[75].OneofWrappers = []interface{}{
+		(*TrackWorkoutRequest_Start)(nil),
+		(*TrackWorkoutRequest_SetCompleted)(nil),
+		(*TrackWorkoutRequest_Note)(nil),
+		(*TrackWorkoutRequest_Pause)(nil),
+	}
+	file_proto_workout_proto_msgTypes[81].OneofWrappers = []interface{}{
+		(*TrackWorkoutResponse_Started)(nil),
+		(*TrackWorkoutResponse_SetLogged)(nil),
+		(*TrackWorkoutResponse_RestTimerExpired)(nil),
+		(*TrackWorkoutResponse_PersonalRecord)(nil),
+		(*TrackWorkoutResponse_NoteSaved)(nil),
+		(*TrackWorkoutResponse_Paused)(nil),
+		(*TrackWorkoutResponse_Completed)(nil),
+		(*TrackWorkoutResponse_Error)(nil),
+	}
+	file_proto_workout_proto_msgTypes[96].OneofWrappers = []interface{}{}
+	file_proto_workout_proto_msgTypes[107].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_workout_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   13,
+			NumEnums:      17,
+			NumMessages:   112,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,6 +25,36 @@ const (
 	WorkoutService_DeleteWorkout_FullMethodName            = "/workout.WorkoutService/DeleteWorkout"
 	WorkoutService_ListWorkouts_FullMethodName             = "/workout.WorkoutService/ListWorkouts"
 	WorkoutService_GetHighIntensityWorkouts_FullMethodName = "/workout.WorkoutService/GetHighIntensityWorkouts"
+	WorkoutService_CalculateOneRepMax_FullMethodName       = "/workout.WorkoutService/CalculateOneRepMax"
+	WorkoutService_GetTrainingStats_FullMethodName         = "/workout.WorkoutService/GetTrainingStats"
+	WorkoutService_GetMuscleBalanceReport_FullMethodName   = "/workout.WorkoutService/GetMuscleBalanceReport"
+	WorkoutService_GetConsistency_FullMethodName           = "/workout.WorkoutService/GetConsistency"
+	WorkoutService_CreateProgram_FullMethodName            = "/workout.WorkoutService/CreateProgram"
+	WorkoutService_GetProgram_FullMethodName               = "/workout.WorkoutService/GetProgram"
+	WorkoutService_ListPrograms_FullMethodName             = "/workout.WorkoutService/ListPrograms"
+	WorkoutService_UpdateProgram_FullMethodName            = "/workout.WorkoutService/UpdateProgram"
+	WorkoutService_UpdateProgramTemplates_FullMethodName   = "/workout.WorkoutService/UpdateProgramTemplates"
+	WorkoutService_DeleteProgram_FullMethodName            = "/workout.WorkoutService/DeleteProgram"
+	WorkoutService_ApplyProgram_FullMethodName             = "/workout.WorkoutService/ApplyProgram"
+	WorkoutService_SuggestNextWorkout_FullMethodName       = "/workout.WorkoutService/SuggestNextWorkout"
+	WorkoutService_ListCalendar_FullMethodName             = "/workout.WorkoutService/ListCalendar"
+	WorkoutService_RescheduleWorkout_FullMethodName        = "/workout.WorkoutService/RescheduleWorkout"
+	WorkoutService_ExportICalendar_FullMethodName          = "/workout.WorkoutService/ExportICalendar"
+	WorkoutService_ImportWorkouts_FullMethodName           = "/workout.WorkoutService/ImportWorkouts"
+	WorkoutService_ExportWorkouts_FullMethodName           = "/workout.WorkoutService/ExportWorkouts"
+	WorkoutService_BatchCreateWorkouts_FullMethodName      = "/workout.WorkoutService/BatchCreateWorkouts"
+	WorkoutService_BatchUpdateWorkouts_FullMethodName      = "/workout.WorkoutService/BatchUpdateWorkouts"
+	WorkoutService_BatchDeleteWorkouts_FullMethodName      = "/workout.WorkoutService/BatchDeleteWorkouts"
+	WorkoutService_WatchWorkouts_FullMethodName            = "/workout.WorkoutService/WatchWorkouts"
+	WorkoutService_TrackWorkout_FullMethodName             = "/workout.WorkoutService/TrackWorkout"
+	WorkoutService_CreateBodyMeasurement_FullMethodName    = "/workout.WorkoutService/CreateBodyMeasurement"
+	WorkoutService_GetBodyMeasurement_FullMethodName       = "/workout.WorkoutService/GetBodyMeasurement"
+	WorkoutService_ListBodyMeasurements_FullMethodName     = "/workout.WorkoutService/ListBodyMeasurements"
+	WorkoutService_UpdateBodyMeasurement_FullMethodName    = "/workout.WorkoutService/UpdateBodyMeasurement"
+	WorkoutService_DeleteBodyMeasurement_FullMethodName    = "/workout.WorkoutService/DeleteBodyMeasurement"
+	WorkoutService_GetBodyweightTrend_FullMethodName       = "/workout.WorkoutService/GetBodyweightTrend"
+	WorkoutService_GetRelativeStrength_FullMethodName      = "/workout.WorkoutService/GetRelativeStrength"
+	WorkoutService_CalculatePlates_FullMethodName          = "/workout.WorkoutService/CalculatePlates"
 )
 
 // WorkoutServiceClient is the client API for WorkoutService service.
@@ -43,6 +73,70 @@ type WorkoutServiceClient interface {
 	ListWorkouts(ctx context.Context, in *ListWorkoutsRequest, opts ...grpc.CallOption) (*ListWorkoutsResponse, error)
 	// 高強度ワークアウト一覧を取得
 	GetHighIntensityWorkouts(ctx context.Context, in *GetHighIntensityWorkoutsRequest, opts ...grpc.CallOption) (*GetHighIntensityWorkoutsResponse, error)
+	// 推定1RMを計算
+	CalculateOneRepMax(ctx context.Context, in *CalculateOneRepMaxRequest, opts ...grpc.CallOption) (*CalculateOneRepMaxResponse, error)
+	// 期間ごとのトレーニング統計を取得
+	GetTrainingStats(ctx context.Context, in *GetTrainingStatsRequest, opts ...grpc.CallOption) (*GetTrainingStatsResponse, error)
+	// 筋肉群ごとの週あたりボリュームバランスを取得
+	GetMuscleBalanceReport(ctx context.Context, in *GetMuscleBalanceReportRequest, opts ...grpc.CallOption) (*GetMuscleBalanceReportResponse, error)
+	// ストリーク・予定の実施率・スキップ理由・ヒートマップを取得
+	GetConsistency(ctx context.Context, in *GetConsistencyRequest, opts ...grpc.CallOption) (*GetConsistencyResponse, error)
+	// トレーニングプログラムを作成
+	CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...grpc.CallOption) (*CreateProgramResponse, error)
+	// トレーニングプログラムを取得（バージョン指定可）
+	GetProgram(ctx context.Context, in *GetProgramRequest, opts ...grpc.CallOption) (*GetProgramResponse, error)
+	// トレーニングプログラム一覧を取得
+	ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...grpc.CallOption) (*ListProgramsResponse, error)
+	// トレーニングプログラムの名前・説明を更新
+	UpdateProgram(ctx context.Context, in *UpdateProgramRequest, opts ...grpc.CallOption) (*UpdateProgramResponse, error)
+	// テンプレートを編集し、新しいバージョンとして保存
+	UpdateProgramTemplates(ctx context.Context, in *UpdateProgramTemplatesRequest, opts ...grpc.CallOption) (*UpdateProgramTemplatesResponse, error)
+	// トレーニングプログラムを削除
+	DeleteProgram(ctx context.Context, in *DeleteProgramRequest, opts ...grpc.CallOption) (*DeleteProgramResponse, error)
+	// プログラムから今後N週間分の予定のワークアウトを生成
+	ApplyProgram(ctx context.Context, in *ApplyProgramRequest, opts ...grpc.CallOption) (*ApplyProgramResponse, error)
+	// 完了履歴から次回のワークアウトの重量・回数を提案
+	SuggestNextWorkout(ctx context.Context, in *SuggestNextWorkoutRequest, opts ...grpc.CallOption) (*SuggestNextWorkoutResponse, error)
+	// 期間内のワークアウトを日ごとにまとめて取得（カレンダー表示用）
+	ListCalendar(ctx context.Context, in *ListCalendarRequest, opts ...grpc.CallOption) (*ListCalendarResponse, error)
+	// 予定のワークアウトの実施予定日時を変更
+	RescheduleWorkout(ctx context.Context, in *RescheduleWorkoutRequest, opts ...grpc.CallOption) (*RescheduleWorkoutResponse, error)
+	// 予定のワークアウトをiCalendar（.ics）形式で出力
+	ExportICalendar(ctx context.Context, in *ExportICalendarRequest, opts ...grpc.CallOption) (*ExportICalendarResponse, error)
+	// CSV・JSON Lines・他のアプリのエクスポートからワークアウトを一括インポート（クライアントストリーミング）
+	// 最初のメッセージでオプションを送り、続けてファイルの内容を分割して送る
+	// クライアントストリーミングのためREST/JSONゲートウェイでは公開しない（gRPCのみ）
+	ImportWorkouts(ctx context.Context, opts ...grpc.CallOption) (WorkoutService_ImportWorkoutsClient, error)
+	// 条件に一致する全てのワークアウトをバッチごとに送信（サーバーストリーミング）
+	// DBから一定件数ずつ読み込むため、件数が多くてもサーバーのメモリ使用量は一定
+	ExportWorkouts(ctx context.Context, in *ExportWorkoutsRequest, opts ...grpc.CallOption) (WorkoutService_ExportWorkoutsClient, error)
+	// 複数のワークアウトを一括作成・更新・削除（最大1000件、結果は項目ごとにステータスコード付きで返す）
+	BatchCreateWorkouts(ctx context.Context, in *BatchCreateWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error)
+	BatchUpdateWorkouts(ctx context.Context, in *BatchUpdateWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error)
+	BatchDeleteWorkouts(ctx context.Context, in *BatchDeleteWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error)
+	// ワークアウトの作成・更新・削除・ステータス変更を発生順に送信し続ける（サーバーストリーミング）
+	// 切断された場合は最後に受け取ったイベントのresume_tokenを指定すると続きから受け取れる
+	WatchWorkouts(ctx context.Context, in *WatchWorkoutsRequest, opts ...grpc.CallOption) (WorkoutService_WatchWorkoutsClient, error)
+	// 実施中のワークアウトのセットを記録し、休憩タイマーの終了と自己ベスト更新を通知する（双方向ストリーミング）
+	// 最初のメッセージで対象のワークアウトを指定する。クライアントが送信を終えるとワークアウトを完了にする
+	// 双方向ストリーミングのためREST/JSONゲートウェイでは公開しない（gRPCのみ）
+	TrackWorkout(ctx context.Context, opts ...grpc.CallOption) (WorkoutService_TrackWorkoutClient, error)
+	// 体重・身体測定を記録
+	CreateBodyMeasurement(ctx context.Context, in *CreateBodyMeasurementRequest, opts ...grpc.CallOption) (*CreateBodyMeasurementResponse, error)
+	// 体重・身体測定の記録を取得
+	GetBodyMeasurement(ctx context.Context, in *GetBodyMeasurementRequest, opts ...grpc.CallOption) (*GetBodyMeasurementResponse, error)
+	// 測定日が期間内の体重・身体測定の記録を取得
+	ListBodyMeasurements(ctx context.Context, in *ListBodyMeasurementsRequest, opts ...grpc.CallOption) (*ListBodyMeasurementsResponse, error)
+	// 体重・身体測定の記録を更新
+	UpdateBodyMeasurement(ctx context.Context, in *UpdateBodyMeasurementRequest, opts ...grpc.CallOption) (*UpdateBodyMeasurementResponse, error)
+	// 体重・身体測定の記録を削除
+	DeleteBodyMeasurement(ctx context.Context, in *DeleteBodyMeasurementRequest, opts ...grpc.CallOption) (*DeleteBodyMeasurementResponse, error)
+	// 体重の推移（測定日ごとの体重と移動平均）を取得
+	GetBodyweightTrend(ctx context.Context, in *GetBodyweightTrendRequest, opts ...grpc.CallOption) (*GetBodyweightTrendResponse, error)
+	// 完了したワークアウトの重量・推定1RMの体重比を取得（同じ日に体重を測定したワークアウトのみ）
+	GetRelativeStrength(ctx context.Context, in *GetRelativeStrengthRequest, opts ...grpc.CallOption) (*GetRelativeStrengthResponse, error)
+	// バーベル種目で目標重量にするために片側に付けるプレートを計算（ウォームアップセットも含められる）
+	CalculatePlates(ctx context.Context, in *CalculatePlatesRequest, opts ...grpc.CallOption) (*CalculatePlatesResponse, error)
 }
 
 type workoutServiceClient struct {
@@ -107,6 +201,369 @@ func (c *workoutServiceClient) GetHighIntensityWorkouts(ctx context.Context, in
 	return out, nil
 }
 
+func (c *workoutServiceClient) CalculateOneRepMax(ctx context.Context, in *CalculateOneRepMaxRequest, opts ...grpc.CallOption) (*CalculateOneRepMaxResponse, error) {
+	out := new(CalculateOneRepMaxResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CalculateOneRepMax_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetTrainingStats(ctx context.Context, in *GetTrainingStatsRequest, opts ...grpc.CallOption) (*GetTrainingStatsResponse, error) {
+	out := new(GetTrainingStatsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetTrainingStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetMuscleBalanceReport(ctx context.Context, in *GetMuscleBalanceReportRequest, opts ...grpc.CallOption) (*GetMuscleBalanceReportResponse, error) {
+	out := new(GetMuscleBalanceReportResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetMuscleBalanceReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetConsistency(ctx context.Context, in *GetConsistencyRequest, opts ...grpc.CallOption) (*GetConsistencyResponse, error) {
+	out := new(GetConsistencyResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetConsistency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CreateProgram(ctx context.Context, in *CreateProgramRequest, opts ...grpc.CallOption) (*CreateProgramResponse, error) {
+	out := new(CreateProgramResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CreateProgram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetProgram(ctx context.Context, in *GetProgramRequest, opts ...grpc.CallOption) (*GetProgramResponse, error) {
+	out := new(GetProgramResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetProgram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ListPrograms(ctx context.Context, in *ListProgramsRequest, opts ...grpc.CallOption) (*ListProgramsResponse, error) {
+	out := new(ListProgramsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ListPrograms_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) UpdateProgram(ctx context.Context, in *UpdateProgramRequest, opts ...grpc.CallOption) (*UpdateProgramResponse, error) {
+	out := new(UpdateProgramResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_UpdateProgram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) UpdateProgramTemplates(ctx context.Context, in *UpdateProgramTemplatesRequest, opts ...grpc.CallOption) (*UpdateProgramTemplatesResponse, error) {
+	out := new(UpdateProgramTemplatesResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_UpdateProgramTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) DeleteProgram(ctx context.Context, in *DeleteProgramRequest, opts ...grpc.CallOption) (*DeleteProgramResponse, error) {
+	out := new(DeleteProgramResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_DeleteProgram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ApplyProgram(ctx context.Context, in *ApplyProgramRequest, opts ...grpc.CallOption) (*ApplyProgramResponse, error) {
+	out := new(ApplyProgramResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ApplyProgram_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) SuggestNextWorkout(ctx context.Context, in *SuggestNextWorkoutRequest, opts ...grpc.CallOption) (*SuggestNextWorkoutResponse, error) {
+	out := new(SuggestNextWorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_SuggestNextWorkout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ListCalendar(ctx context.Context, in *ListCalendarRequest, opts ...grpc.CallOption) (*ListCalendarResponse, error) {
+	out := new(ListCalendarResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ListCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) RescheduleWorkout(ctx context.Context, in *RescheduleWorkoutRequest, opts ...grpc.CallOption) (*RescheduleWorkoutResponse, error) {
+	out := new(RescheduleWorkoutResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_RescheduleWorkout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ExportICalendar(ctx context.Context, in *ExportICalendarRequest, opts ...grpc.CallOption) (*ExportICalendarResponse, error) {
+	out := new(ExportICalendarResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ExportICalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ImportWorkouts(ctx context.Context, opts ...grpc.CallOption) (WorkoutService_ImportWorkoutsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkoutService_ServiceDesc.Streams[0], WorkoutService_ImportWorkouts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workoutServiceImportWorkoutsClient{stream}
+	return x, nil
+}
+
+type WorkoutService_ImportWorkoutsClient interface {
+	Send(*ImportWorkoutsRequest) error
+	CloseAndRecv() (*ImportWorkoutsResponse, error)
+	grpc.ClientStream
+}
+
+type workoutServiceImportWorkoutsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workoutServiceImportWorkoutsClient) Send(m *ImportWorkoutsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workoutServiceImportWorkoutsClient) CloseAndRecv() (*ImportWorkoutsResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportWorkoutsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workoutServiceClient) ExportWorkouts(ctx context.Context, in *ExportWorkoutsRequest, opts ...grpc.CallOption) (WorkoutService_ExportWorkoutsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkoutService_ServiceDesc.Streams[1], WorkoutService_ExportWorkouts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workoutServiceExportWorkoutsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkoutService_ExportWorkoutsClient interface {
+	Recv() (*ExportWorkoutsResponse, error)
+	grpc.ClientStream
+}
+
+type workoutServiceExportWorkoutsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workoutServiceExportWorkoutsClient) Recv() (*ExportWorkoutsResponse, error) {
+	m := new(ExportWorkoutsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workoutServiceClient) BatchCreateWorkouts(ctx context.Context, in *BatchCreateWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error) {
+	out := new(BatchWorkoutsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_BatchCreateWorkouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) BatchUpdateWorkouts(ctx context.Context, in *BatchUpdateWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error) {
+	out := new(BatchWorkoutsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_BatchUpdateWorkouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) BatchDeleteWorkouts(ctx context.Context, in *BatchDeleteWorkoutsRequest, opts ...grpc.CallOption) (*BatchWorkoutsResponse, error) {
+	out := new(BatchWorkoutsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_BatchDeleteWorkouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) WatchWorkouts(ctx context.Context, in *WatchWorkoutsRequest, opts ...grpc.CallOption) (WorkoutService_WatchWorkoutsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkoutService_ServiceDesc.Streams[2], WorkoutService_WatchWorkouts_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workoutServiceWatchWorkoutsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WorkoutService_WatchWorkoutsClient interface {
+	Recv() (*WorkoutEvent, error)
+	grpc.ClientStream
+}
+
+type workoutServiceWatchWorkoutsClient struct {
+	grpc.ClientStream
+}
+
+func (x *workoutServiceWatchWorkoutsClient) Recv() (*WorkoutEvent, error) {
+	m := new(WorkoutEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workoutServiceClient) TrackWorkout(ctx context.Context, opts ...grpc.CallOption) (WorkoutService_TrackWorkoutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WorkoutService_ServiceDesc.Streams[3], WorkoutService_TrackWorkout_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workoutServiceTrackWorkoutClient{stream}
+	return x, nil
+}
+
+type WorkoutService_TrackWorkoutClient interface {
+	Send(*TrackWorkoutRequest) error
+	Recv() (*TrackWorkoutResponse, error)
+	grpc.ClientStream
+}
+
+type workoutServiceTrackWorkoutClient struct {
+	grpc.ClientStream
+}
+
+func (x *workoutServiceTrackWorkoutClient) Send(m *TrackWorkoutRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workoutServiceTrackWorkoutClient) Recv() (*TrackWorkoutResponse, error) {
+	m := new(TrackWorkoutResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *workoutServiceClient) CreateBodyMeasurement(ctx context.Context, in *CreateBodyMeasurementRequest, opts ...grpc.CallOption) (*CreateBodyMeasurementResponse, error) {
+	out := new(CreateBodyMeasurementResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CreateBodyMeasurement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetBodyMeasurement(ctx context.Context, in *GetBodyMeasurementRequest, opts ...grpc.CallOption) (*GetBodyMeasurementResponse, error) {
+	out := new(GetBodyMeasurementResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetBodyMeasurement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) ListBodyMeasurements(ctx context.Context, in *ListBodyMeasurementsRequest, opts ...grpc.CallOption) (*ListBodyMeasurementsResponse, error) {
+	out := new(ListBodyMeasurementsResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_ListBodyMeasurements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) UpdateBodyMeasurement(ctx context.Context, in *UpdateBodyMeasurementRequest, opts ...grpc.CallOption) (*UpdateBodyMeasurementResponse, error) {
+	out := new(UpdateBodyMeasurementResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_UpdateBodyMeasurement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) DeleteBodyMeasurement(ctx context.Context, in *DeleteBodyMeasurementRequest, opts ...grpc.CallOption) (*DeleteBodyMeasurementResponse, error) {
+	out := new(DeleteBodyMeasurementResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_DeleteBodyMeasurement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetBodyweightTrend(ctx context.Context, in *GetBodyweightTrendRequest, opts ...grpc.CallOption) (*GetBodyweightTrendResponse, error) {
+	out := new(GetBodyweightTrendResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetBodyweightTrend_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) GetRelativeStrength(ctx context.Context, in *GetRelativeStrengthRequest, opts ...grpc.CallOption) (*GetRelativeStrengthResponse, error) {
+	out := new(GetRelativeStrengthResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_GetRelativeStrength_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workoutServiceClient) CalculatePlates(ctx context.Context, in *CalculatePlatesRequest, opts ...grpc.CallOption) (*CalculatePlatesResponse, error) {
+	out := new(CalculatePlatesResponse)
+	err := c.cc.Invoke(ctx, WorkoutService_CalculatePlates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WorkoutServiceServer is the server API for WorkoutService service.
 // All implementations must embed UnimplementedWorkoutServiceServer
 // for forward compatibility
@@ -123,6 +580,70 @@ type WorkoutServiceServer interface {
 	ListWorkouts(context.Context, *ListWorkoutsRequest) (*ListWorkoutsResponse, error)
 	// 高強度ワークアウト一覧を取得
 	GetHighIntensityWorkouts(context.Context, *GetHighIntensityWorkoutsRequest) (*GetHighIntensityWorkoutsResponse, error)
+	// 推定1RMを計算
+	CalculateOneRepMax(context.Context, *CalculateOneRepMaxRequest) (*CalculateOneRepMaxResponse, error)
+	// 期間ごとのトレーニング統計を取得
+	GetTrainingStats(context.Context, *GetTrainingStatsRequest) (*GetTrainingStatsResponse, error)
+	// 筋肉群ごとの週あたりボリュームバランスを取得
+	GetMuscleBalanceReport(context.Context, *GetMuscleBalanceReportRequest) (*GetMuscleBalanceReportResponse, error)
+	// ストリーク・予定の実施率・スキップ理由・ヒートマップを取得
+	GetConsistency(context.Context, *GetConsistencyRequest) (*GetConsistencyResponse, error)
+	// トレーニングプログラムを作成
+	CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error)
+	// トレーニングプログラムを取得（バージョン指定可）
+	GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error)
+	// トレーニングプログラム一覧を取得
+	ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error)
+	// トレーニングプログラムの名前・説明を更新
+	UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error)
+	// テンプレートを編集し、新しいバージョンとして保存
+	UpdateProgramTemplates(context.Context, *UpdateProgramTemplatesRequest) (*UpdateProgramTemplatesResponse, error)
+	// トレーニングプログラムを削除
+	DeleteProgram(context.Context, *DeleteProgramRequest) (*DeleteProgramResponse, error)
+	// プログラムから今後N週間分の予定のワークアウトを生成
+	ApplyProgram(context.Context, *ApplyProgramRequest) (*ApplyProgramResponse, error)
+	// 完了履歴から次回のワークアウトの重量・回数を提案
+	SuggestNextWorkout(context.Context, *SuggestNextWorkoutRequest) (*SuggestNextWorkoutResponse, error)
+	// 期間内のワークアウトを日ごとにまとめて取得（カレンダー表示用）
+	ListCalendar(context.Context, *ListCalendarRequest) (*ListCalendarResponse, error)
+	// 予定のワークアウトの実施予定日時を変更
+	RescheduleWorkout(context.Context, *RescheduleWorkoutRequest) (*RescheduleWorkoutResponse, error)
+	// 予定のワークアウトをiCalendar（.ics）形式で出力
+	ExportICalendar(context.Context, *ExportICalendarRequest) (*ExportICalendarResponse, error)
+	// CSV・JSON Lines・他のアプリのエクスポートからワークアウトを一括インポート（クライアントストリーミング）
+	// 最初のメッセージでオプションを送り、続けてファイルの内容を分割して送る
+	// クライアントストリーミングのためREST/JSONゲートウェイでは公開しない（gRPCのみ）
+	ImportWorkouts(WorkoutService_ImportWorkoutsServer) error
+	// 条件に一致する全てのワークアウトをバッチごとに送信（サーバーストリーミング）
+	// DBから一定件数ずつ読み込むため、件数が多くてもサーバーのメモリ使用量は一定
+	ExportWorkouts(*ExportWorkoutsRequest, WorkoutService_ExportWorkoutsServer) error
+	// 複数のワークアウトを一括作成・更新・削除（最大1000件、結果は項目ごとにステータスコード付きで返す）
+	BatchCreateWorkouts(context.Context, *BatchCreateWorkoutsRequest) (*BatchWorkoutsResponse, error)
+	BatchUpdateWorkouts(context.Context, *BatchUpdateWorkoutsRequest) (*BatchWorkoutsResponse, error)
+	BatchDeleteWorkouts(context.Context, *BatchDeleteWorkoutsRequest) (*BatchWorkoutsResponse, error)
+	// ワークアウトの作成・更新・削除・ステータス変更を発生順に送信し続ける（サーバーストリーミング）
+	// 切断された場合は最後に受け取ったイベントのresume_tokenを指定すると続きから受け取れる
+	WatchWorkouts(*WatchWorkoutsRequest, WorkoutService_WatchWorkoutsServer) error
+	// 実施中のワークアウトのセットを記録し、休憩タイマーの終了と自己ベスト更新を通知する（双方向ストリーミング）
+	// 最初のメッセージで対象のワークアウトを指定する。クライアントが送信を終えるとワークアウトを完了にする
+	// 双方向ストリーミングのためREST/JSONゲートウェイでは公開しない（gRPCのみ）
+	TrackWorkout(WorkoutService_TrackWorkoutServer) error
+	// 体重・身体測定を記録
+	CreateBodyMeasurement(context.Context, *CreateBodyMeasurementRequest) (*CreateBodyMeasurementResponse, error)
+	// 体重・身体測定の記録を取得
+	GetBodyMeasurement(context.Context, *GetBodyMeasurementRequest) (*GetBodyMeasurementResponse, error)
+	// 測定日が期間内の体重・身体測定の記録を取得
+	ListBodyMeasurements(context.Context, *ListBodyMeasurementsRequest) (*ListBodyMeasurementsResponse, error)
+	// 体重・身体測定の記録を更新
+	UpdateBodyMeasurement(context.Context, *UpdateBodyMeasurementRequest) (*UpdateBodyMeasurementResponse, error)
+	// 体重・身体測定の記録を削除
+	DeleteBodyMeasurement(context.Context, *DeleteBodyMeasurementRequest) (*DeleteBodyMeasurementResponse, error)
+	// 体重の推移（測定日ごとの体重と移動平均）を取得
+	GetBodyweightTrend(context.Context, *GetBodyweightTrendRequest) (*GetBodyweightTrendResponse, error)
+	// 完了したワークアウトの重量・推定1RMの体重比を取得（同じ日に体重を測定したワークアウトのみ）
+	GetRelativeStrength(context.Context, *GetRelativeStrengthRequest) (*GetRelativeStrengthResponse, error)
+	// バーベル種目で目標重量にするために片側に付けるプレートを計算（ウォームアップセットも含められる）
+	CalculatePlates(context.Context, *CalculatePlatesRequest) (*CalculatePlatesResponse, error)
 	mustEmbedUnimplementedWorkoutServiceServer()
 }
 
@@ -148,6 +669,96 @@ func (UnimplementedWorkoutServiceServer) ListWorkouts(context.Context, *ListWork
 func (UnimplementedWorkoutServiceServer) GetHighIntensityWorkouts(context.Context, *GetHighIntensityWorkoutsRequest) (*GetHighIntensityWorkoutsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHighIntensityWorkouts not implemented")
 }
+func (UnimplementedWorkoutServiceServer) CalculateOneRepMax(context.Context, *CalculateOneRepMaxRequest) (*CalculateOneRepMaxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateOneRepMax not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetTrainingStats(context.Context, *GetTrainingStatsRequest) (*GetTrainingStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrainingStats not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetMuscleBalanceReport(context.Context, *GetMuscleBalanceReportRequest) (*GetMuscleBalanceReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuscleBalanceReport not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetConsistency(context.Context, *GetConsistencyRequest) (*GetConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsistency not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CreateProgram(context.Context, *CreateProgramRequest) (*CreateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProgram not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetProgram(context.Context, *GetProgramRequest) (*GetProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProgram not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ListPrograms(context.Context, *ListProgramsRequest) (*ListProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPrograms not implemented")
+}
+func (UnimplementedWorkoutServiceServer) UpdateProgram(context.Context, *UpdateProgramRequest) (*UpdateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgram not implemented")
+}
+func (UnimplementedWorkoutServiceServer) UpdateProgramTemplates(context.Context, *UpdateProgramTemplatesRequest) (*UpdateProgramTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProgramTemplates not implemented")
+}
+func (UnimplementedWorkoutServiceServer) DeleteProgram(context.Context, *DeleteProgramRequest) (*DeleteProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProgram not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ApplyProgram(context.Context, *ApplyProgramRequest) (*ApplyProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyProgram not implemented")
+}
+func (UnimplementedWorkoutServiceServer) SuggestNextWorkout(context.Context, *SuggestNextWorkoutRequest) (*SuggestNextWorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestNextWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ListCalendar(context.Context, *ListCalendarRequest) (*ListCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCalendar not implemented")
+}
+func (UnimplementedWorkoutServiceServer) RescheduleWorkout(context.Context, *RescheduleWorkoutRequest) (*RescheduleWorkoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ExportICalendar(context.Context, *ExportICalendarRequest) (*ExportICalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportICalendar not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ImportWorkouts(WorkoutService_ImportWorkoutsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ExportWorkouts(*ExportWorkoutsRequest, WorkoutService_ExportWorkoutsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) BatchCreateWorkouts(context.Context, *BatchCreateWorkoutsRequest) (*BatchWorkoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) BatchUpdateWorkouts(context.Context, *BatchUpdateWorkoutsRequest) (*BatchWorkoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) BatchDeleteWorkouts(context.Context, *BatchDeleteWorkoutsRequest) (*BatchWorkoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchDeleteWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) WatchWorkouts(*WatchWorkoutsRequest, WorkoutService_WatchWorkoutsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWorkouts not implemented")
+}
+func (UnimplementedWorkoutServiceServer) TrackWorkout(WorkoutService_TrackWorkoutServer) error {
+	return status.Errorf(codes.Unimplemented, "method TrackWorkout not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CreateBodyMeasurement(context.Context, *CreateBodyMeasurementRequest) (*CreateBodyMeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBodyMeasurement not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetBodyMeasurement(context.Context, *GetBodyMeasurementRequest) (*GetBodyMeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBodyMeasurement not implemented")
+}
+func (UnimplementedWorkoutServiceServer) ListBodyMeasurements(context.Context, *ListBodyMeasurementsRequest) (*ListBodyMeasurementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBodyMeasurements not implemented")
+}
+func (UnimplementedWorkoutServiceServer) UpdateBodyMeasurement(context.Context, *UpdateBodyMeasurementRequest) (*UpdateBodyMeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBodyMeasurement not implemented")
+}
+func (UnimplementedWorkoutServiceServer) DeleteBodyMeasurement(context.Context, *DeleteBodyMeasurementRequest) (*DeleteBodyMeasurementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBodyMeasurement not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetBodyweightTrend(context.Context, *GetBodyweightTrendRequest) (*GetBodyweightTrendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBodyweightTrend not implemented")
+}
+func (UnimplementedWorkoutServiceServer) GetRelativeStrength(context.Context, *GetRelativeStrengthRequest) (*GetRelativeStrengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRelativeStrength not implemented")
+}
+func (UnimplementedWorkoutServiceServer) CalculatePlates(context.Context, *CalculatePlatesRequest) (*CalculatePlatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculatePlates not implemented")
+}
 func (UnimplementedWorkoutServiceServer) mustEmbedUnimplementedWorkoutServiceServer() {}
 
 // UnsafeWorkoutServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -269,10 +880,572 @@ func _WorkoutService_GetHighIntensityWorkouts_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
-// WorkoutService_ServiceDesc is the grpc.ServiceDesc for WorkoutService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WorkoutService_ServiceDesc = grpc.ServiceDesc{
+func _WorkoutService_CalculateOneRepMax_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateOneRepMaxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CalculateOneRepMax(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CalculateOneRepMax_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CalculateOneRepMax(ctx, req.(*CalculateOneRepMaxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetTrainingStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrainingStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetTrainingStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetTrainingStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetTrainingStats(ctx, req.(*GetTrainingStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetMuscleBalanceReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuscleBalanceReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetMuscleBalanceReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetMuscleBalanceReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetMuscleBalanceReport(ctx, req.(*GetMuscleBalanceReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetConsistency(ctx, req.(*GetConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CreateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CreateProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CreateProgram(ctx, req.(*CreateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetProgram(ctx, req.(*GetProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ListPrograms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ListPrograms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ListPrograms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ListPrograms(ctx, req.(*ListProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_UpdateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).UpdateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_UpdateProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).UpdateProgram(ctx, req.(*UpdateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_UpdateProgramTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProgramTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).UpdateProgramTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_UpdateProgramTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).UpdateProgramTemplates(ctx, req.(*UpdateProgramTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_DeleteProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).DeleteProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_DeleteProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).DeleteProgram(ctx, req.(*DeleteProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ApplyProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ApplyProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ApplyProgram_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ApplyProgram(ctx, req.(*ApplyProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_SuggestNextWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestNextWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).SuggestNextWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_SuggestNextWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).SuggestNextWorkout(ctx, req.(*SuggestNextWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ListCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ListCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ListCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ListCalendar(ctx, req.(*ListCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_RescheduleWorkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleWorkoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).RescheduleWorkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_RescheduleWorkout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).RescheduleWorkout(ctx, req.(*RescheduleWorkoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ExportICalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportICalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ExportICalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ExportICalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ExportICalendar(ctx, req.(*ExportICalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ImportWorkouts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkoutServiceServer).ImportWorkouts(&workoutServiceImportWorkoutsServer{stream})
+}
+
+type WorkoutService_ImportWorkoutsServer interface {
+	SendAndClose(*ImportWorkoutsResponse) error
+	Recv() (*ImportWorkoutsRequest, error)
+	grpc.ServerStream
+}
+
+type workoutServiceImportWorkoutsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workoutServiceImportWorkoutsServer) SendAndClose(m *ImportWorkoutsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workoutServiceImportWorkoutsServer) Recv() (*ImportWorkoutsRequest, error) {
+	m := new(ImportWorkoutsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _WorkoutService_ExportWorkouts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportWorkoutsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkoutServiceServer).ExportWorkouts(m, &workoutServiceExportWorkoutsServer{stream})
+}
+
+type WorkoutService_ExportWorkoutsServer interface {
+	Send(*ExportWorkoutsResponse) error
+	grpc.ServerStream
+}
+
+type workoutServiceExportWorkoutsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workoutServiceExportWorkoutsServer) Send(m *ExportWorkoutsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkoutService_BatchCreateWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateWorkoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).BatchCreateWorkouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_BatchCreateWorkouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).BatchCreateWorkouts(ctx, req.(*BatchCreateWorkoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_BatchUpdateWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateWorkoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).BatchUpdateWorkouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_BatchUpdateWorkouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).BatchUpdateWorkouts(ctx, req.(*BatchUpdateWorkoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_BatchDeleteWorkouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteWorkoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).BatchDeleteWorkouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_BatchDeleteWorkouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).BatchDeleteWorkouts(ctx, req.(*BatchDeleteWorkoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_WatchWorkouts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWorkoutsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WorkoutServiceServer).WatchWorkouts(m, &workoutServiceWatchWorkoutsServer{stream})
+}
+
+type WorkoutService_WatchWorkoutsServer interface {
+	Send(*WorkoutEvent) error
+	grpc.ServerStream
+}
+
+type workoutServiceWatchWorkoutsServer struct {
+	grpc.ServerStream
+}
+
+func (x *workoutServiceWatchWorkoutsServer) Send(m *WorkoutEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _WorkoutService_TrackWorkout_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkoutServiceServer).TrackWorkout(&workoutServiceTrackWorkoutServer{stream})
+}
+
+type WorkoutService_TrackWorkoutServer interface {
+	Send(*TrackWorkoutResponse) error
+	Recv() (*TrackWorkoutRequest, error)
+	grpc.ServerStream
+}
+
+type workoutServiceTrackWorkoutServer struct {
+	grpc.ServerStream
+}
+
+func (x *workoutServiceTrackWorkoutServer) Send(m *TrackWorkoutResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workoutServiceTrackWorkoutServer) Recv() (*TrackWorkoutRequest, error) {
+	m := new(TrackWorkoutRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _WorkoutService_CreateBodyMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBodyMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CreateBodyMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CreateBodyMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CreateBodyMeasurement(ctx, req.(*CreateBodyMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetBodyMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBodyMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetBodyMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetBodyMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetBodyMeasurement(ctx, req.(*GetBodyMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_ListBodyMeasurements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBodyMeasurementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).ListBodyMeasurements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_ListBodyMeasurements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).ListBodyMeasurements(ctx, req.(*ListBodyMeasurementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_UpdateBodyMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBodyMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).UpdateBodyMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_UpdateBodyMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).UpdateBodyMeasurement(ctx, req.(*UpdateBodyMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_DeleteBodyMeasurement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBodyMeasurementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).DeleteBodyMeasurement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_DeleteBodyMeasurement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).DeleteBodyMeasurement(ctx, req.(*DeleteBodyMeasurementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetBodyweightTrend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBodyweightTrendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetBodyweightTrend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetBodyweightTrend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetBodyweightTrend(ctx, req.(*GetBodyweightTrendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_GetRelativeStrength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelativeStrengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).GetRelativeStrength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_GetRelativeStrength_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).GetRelativeStrength(ctx, req.(*GetRelativeStrengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkoutService_CalculatePlates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePlatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkoutServiceServer).CalculatePlates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkoutService_CalculatePlates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkoutServiceServer).CalculatePlates(ctx, req.(*CalculatePlatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkoutService_ServiceDesc is the grpc.ServiceDesc for WorkoutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkoutService_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "workout.WorkoutService",
 	HandlerType: (*WorkoutServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -300,7 +1473,133 @@ var WorkoutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHighIntensityWorkouts",
 			Handler:    _WorkoutService_GetHighIntensityWorkouts_Handler,
 		},
+		{
+			MethodName: "CalculateOneRepMax",
+			Handler:    _WorkoutService_CalculateOneRepMax_Handler,
+		},
+		{
+			MethodName: "GetTrainingStats",
+			Handler:    _WorkoutService_GetTrainingStats_Handler,
+		},
+		{
+			MethodName: "GetMuscleBalanceReport",
+			Handler:    _WorkoutService_GetMuscleBalanceReport_Handler,
+		},
+		{
+			MethodName: "GetConsistency",
+			Handler:    _WorkoutService_GetConsistency_Handler,
+		},
+		{
+			MethodName: "CreateProgram",
+			Handler:    _WorkoutService_CreateProgram_Handler,
+		},
+		{
+			MethodName: "GetProgram",
+			Handler:    _WorkoutService_GetProgram_Handler,
+		},
+		{
+			MethodName: "ListPrograms",
+			Handler:    _WorkoutService_ListPrograms_Handler,
+		},
+		{
+			MethodName: "UpdateProgram",
+			Handler:    _WorkoutService_UpdateProgram_Handler,
+		},
+		{
+			MethodName: "UpdateProgramTemplates",
+			Handler:    _WorkoutService_UpdateProgramTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteProgram",
+			Handler:    _WorkoutService_DeleteProgram_Handler,
+		},
+		{
+			MethodName: "ApplyProgram",
+			Handler:    _WorkoutService_ApplyProgram_Handler,
+		},
+		{
+			MethodName: "SuggestNextWorkout",
+			Handler:    _WorkoutService_SuggestNextWorkout_Handler,
+		},
+		{
+			MethodName: "ListCalendar",
+			Handler:    _WorkoutService_ListCalendar_Handler,
+		},
+		{
+			MethodName: "RescheduleWorkout",
+			Handler:    _WorkoutService_RescheduleWorkout_Handler,
+		},
+		{
+			MethodName: "ExportICalendar",
+			Handler:    _WorkoutService_ExportICalendar_Handler,
+		},
+		{
+			MethodName: "BatchCreateWorkouts",
+			Handler:    _WorkoutService_BatchCreateWorkouts_Handler,
+		},
+		{
+			MethodName: "BatchUpdateWorkouts",
+			Handler:    _WorkoutService_BatchUpdateWorkouts_Handler,
+		},
+		{
+			MethodName: "BatchDeleteWorkouts",
+			Handler:    _WorkoutService_BatchDeleteWorkouts_Handler,
+		},
+		{
+			MethodName: "CreateBodyMeasurement",
+			Handler:    _WorkoutService_CreateBodyMeasurement_Handler,
+		},
+		{
+			MethodName: "GetBodyMeasurement",
+			Handler:    _WorkoutService_GetBodyMeasurement_Handler,
+		},
+		{
+			MethodName: "ListBodyMeasurements",
+			Handler:    _WorkoutService_ListBodyMeasurements_Handler,
+		},
+		{
+			MethodName: "UpdateBodyMeasurement",
+			Handler:    _WorkoutService_UpdateBodyMeasurement_Handler,
+		},
+		{
+			MethodName: "DeleteBodyMeasurement",
+			Handler:    _WorkoutService_DeleteBodyMeasurement_Handler,
+		},
+		{
+			MethodName: "GetBodyweightTrend",
+			Handler:    _WorkoutService_GetBodyweightTrend_Handler,
+		},
+		{
+			MethodName: "GetRelativeStrength",
+			Handler:    _WorkoutService_GetRelativeStrength_Handler,
+		},
+		{
+			MethodName: "CalculatePlates",
+			Handler:    _WorkoutService_CalculatePlates_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportWorkouts",
+			Handler:       _WorkoutService_ImportWorkouts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportWorkouts",
+			Handler:       _WorkoutService_ExportWorkouts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchWorkouts",
+			Handler:       _WorkoutService_WatchWorkouts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TrackWorkout",
+			Handler:       _WorkoutService_TrackWorkout_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/workout.proto",
 }
@@ -0,0 +1,168 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+	"golv2-learning-app/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchCreateWorkouts 複数のワークアウトを一括作成（プレゼンテーション層）
+func (s *GRPCServer) BatchCreateWorkouts(ctx context.Context, req *proto.BatchCreateWorkoutsRequest) (*proto.BatchWorkoutsResponse, error) {
+	s.log(ctx).Debug("ワークアウトを一括作成中", slog.Int("items", len(req.Workouts)), slog.String("mode", req.Mode.String()))
+	locale := requestLocale(ctx)
+	mode := convertProtoBatchMode(req.Mode)
+	if len(req.Workouts) > usecase.MaxBatchItems {
+		return nil, status.Error(codes.InvalidArgument, locale.Message(i18n.MsgBatchTooLarge, usecase.MaxBatchItems, len(req.Workouts)))
+	}
+
+	// 制約に違反する項目・予定日時を解析できない項目はユースケース層に渡さず、その場で失敗にする
+	reqs := make([]usecase.CreateWorkoutRequest, 0, len(req.Workouts))
+	indexes := make([]int, 0, len(req.Workouts))
+	invalid := make(map[int]error)
+	for i, w := range req.Workouts {
+		if err := validation.Validate(w); err != nil {
+			invalid[i] = fmt.Errorf("%w: %v", usecase.ErrBatchInvalid, err)
+			continue
+		}
+		usecaseReq, err := s.convertProtoCreateWorkoutRequest(ctx, w)
+		if err != nil {
+			invalid[i] = fmt.Errorf("%w: %v", usecase.ErrBatchInvalid, err)
+			continue
+		}
+		reqs = append(reqs, usecaseReq)
+		indexes = append(indexes, i)
+	}
+	if len(invalid) > 0 && (mode == usecase.BatchAtomic || len(reqs) == 0) {
+		return batchResponse(locale, s.weightUnit(ctx), mergeBatchItems(len(req.Workouts), invalid, nil, nil), false), nil
+	}
+
+	result, err := s.manager(ctx).BatchCreateWorkouts(reqs, mode)
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgBatchCreateFailed, err), err)
+	}
+	return batchResponse(locale, s.weightUnit(ctx), mergeBatchItems(len(req.Workouts), invalid, result.Items, indexes), result.Committed), nil
+}
+
+// BatchUpdateWorkouts 複数のワークアウトを一括更新（プレゼンテーション層）
+func (s *GRPCServer) BatchUpdateWorkouts(ctx context.Context, req *proto.BatchUpdateWorkoutsRequest) (*proto.BatchWorkoutsResponse, error) {
+	s.log(ctx).Debug("ワークアウトを一括更新中", slog.Int("items", len(req.Workouts)), slog.String("mode", req.Mode.String()))
+	locale := requestLocale(ctx)
+	mode := convertProtoBatchMode(req.Mode)
+
+	// 制約に違反する項目はユースケース層に渡さず、その場で失敗にする
+	reqs := make([]usecase.UpdateWorkoutRequest, 0, len(req.Workouts))
+	indexes := make([]int, 0, len(req.Workouts))
+	invalid := make(map[int]error)
+	for i, w := range req.Workouts {
+		if err := validation.Validate(w); err != nil {
+			invalid[i] = fmt.Errorf("%w: %v", usecase.ErrBatchInvalid, err)
+			continue
+		}
+		reqs = append(reqs, s.convertProtoUpdateWorkoutRequest(ctx, w))
+		indexes = append(indexes, i)
+	}
+	if len(invalid) > 0 && (mode == usecase.BatchAtomic || len(reqs) == 0) {
+		return batchResponse(locale, s.weightUnit(ctx), mergeBatchItems(len(req.Workouts), invalid, nil, nil), false), nil
+	}
+
+	result, err := s.manager(ctx).BatchUpdateWorkouts(reqs, mode)
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgBatchUpdateFailed, err), err)
+	}
+	return batchResponse(locale, s.weightUnit(ctx), mergeBatchItems(len(req.Workouts), invalid, result.Items, indexes), result.Committed), nil
+}
+
+// BatchDeleteWorkouts 複数のワークアウトを一括削除（プレゼンテーション層）
+func (s *GRPCServer) BatchDeleteWorkouts(ctx context.Context, req *proto.BatchDeleteWorkoutsRequest) (*proto.BatchWorkoutsResponse, error) {
+	s.log(ctx).Debug("ワークアウトを一括削除中", slog.Int("items", len(req.Ids)), slog.String("mode", req.Mode.String()))
+
+	ids := make([]domain.WorkoutID, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		ids = append(ids, domain.WorkoutID(id))
+	}
+
+	result, err := s.manager(ctx).BatchDeleteWorkouts(ids, convertProtoBatchMode(req.Mode))
+	if err != nil {
+		return nil, localizedStatusError(requestLocale(ctx).Message(i18n.MsgBatchDeleteFailed, err), err)
+	}
+	return batchResponse(requestLocale(ctx), s.weightUnit(ctx), result.Items, result.Committed), nil
+}
+
+// mergeBatchItems サーバー側で失敗にした項目とユースケース層の結果をリクエストの順番に並べる
+// indexesはユースケース層に渡した各項目の元の位置。invalidがあるアトミックモードではitemsはnilで、残りは中断扱い
+func mergeBatchItems(total int, invalid map[int]error, items []*usecase.BatchItemResult, indexes []int) []*usecase.BatchItemResult {
+	merged := make([]*usecase.BatchItemResult, total)
+	for i, err := range invalid {
+		merged[i] = &usecase.BatchItemResult{Index: i, Err: err}
+	}
+	for j, item := range items {
+		item.Index = indexes[j]
+		merged[item.Index] = item
+	}
+	for i, item := range merged {
+		if item == nil {
+			merged[i] = &usecase.BatchItemResult{Index: i, Err: usecase.ErrBatchAborted}
+		}
+	}
+	return merged
+}
+
+// batchResponse 項目ごとの結果をレスポンスに変換
+func batchResponse(locale i18n.Locale, unit domain.WeightUnit, items []*usecase.BatchItemResult, committed bool) *proto.BatchWorkoutsResponse {
+	resp := &proto.BatchWorkoutsResponse{
+		Results:   make([]*proto.BatchItemResult, 0, len(items)),
+		Committed: committed,
+	}
+	for _, item := range items {
+		result := &proto.BatchItemResult{
+			Index: int32(item.Index),
+			Code:  int32(batchItemCode(item.Err)),
+			Id:    int64(item.ID),
+		}
+		if item.Err != nil {
+			result.Message = item.Err.Error()
+			resp.FailureCount++
+		} else {
+			resp.SuccessCount++
+			if item.Workout != nil {
+				result.Workout = convertToProtoWorkout(item.Workout, locale, unit)
+			}
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	switch {
+	case resp.FailureCount == 0:
+		resp.Message = locale.Message(i18n.MsgBatchSucceeded, resp.SuccessCount)
+	case !committed:
+		resp.Message = locale.Message(i18n.MsgBatchRolledBack, resp.FailureCount)
+	default:
+		resp.Message = locale.Message(i18n.MsgBatchPartial, resp.SuccessCount, resp.FailureCount)
+	}
+	return resp
+}
+
+// batchItemCode 項目ごとのエラーをgRPCのステータスコードに変換
+func batchItemCode(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	return statusCode(err)
+}
+
+// convertProtoBatchMode protoのBatchModeをusecase.BatchModeに変換
+func convertProtoBatchMode(mode proto.BatchMode) usecase.BatchMode {
+	if mode == proto.BatchMode_BATCH_MODE_PER_ITEM {
+		return usecase.BatchPerItem
+	}
+	return usecase.BatchAtomic
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// TestBatchCreateWorkouts テーブル駆動テストで項目ごとのステータスコードをテスト
+func TestBatchCreateWorkouts(t *testing.T) {
+	valid := &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(5), Reps: protobuf.Int32(5), Weight: 100}
+	badSchedule := &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, ScheduledFor: "tomorrow"}
+	noExercise := &proto.CreateWorkoutRequest{Sets: protobuf.Int32(3)}
+
+	tests := []struct {
+		name          string
+		req           *proto.BatchCreateWorkoutsRequest
+		wantCodes     []codes.Code
+		wantCommitted bool
+		description   string
+	}{
+		{
+			name:          "正常系: アトミック",
+			req:           &proto.BatchCreateWorkoutsRequest{Workouts: []*proto.CreateWorkoutRequest{valid, valid}},
+			wantCodes:     []codes.Code{codes.OK, codes.OK},
+			wantCommitted: true,
+			description:   "モード未指定はアトミック",
+		},
+		{
+			name:        "異常系: アトミックで予定日時が不正",
+			req:         &proto.BatchCreateWorkoutsRequest{Workouts: []*proto.CreateWorkoutRequest{valid, badSchedule}},
+			wantCodes:   []codes.Code{codes.Aborted, codes.InvalidArgument},
+			description: "サーバー側で失敗にした項目があればユースケース層を呼ばない",
+		},
+		{
+			name: "正常系: 項目ごと",
+			req: &proto.BatchCreateWorkoutsRequest{
+				Workouts: []*proto.CreateWorkoutRequest{badSchedule, valid, noExercise, valid},
+				Mode:     proto.BatchMode_BATCH_MODE_PER_ITEM,
+			},
+			wantCodes:     []codes.Code{codes.InvalidArgument, codes.OK, codes.InvalidArgument, codes.OK},
+			wantCommitted: true,
+			description:   "結果はリクエストと同じ順番",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewGRPCServer(usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository()))
+
+			resp, err := server.BatchCreateWorkouts(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("BatchCreateWorkouts() error = %v", err)
+			}
+			if len(resp.Results) != len(tt.wantCodes) {
+				t.Fatalf("Expected %d results, got %d: %s", len(tt.wantCodes), len(resp.Results), resp.Message)
+			}
+			for i, want := range tt.wantCodes {
+				got := resp.Results[i]
+				if got.Index != int32(i) || codes.Code(got.Code) != want {
+					t.Errorf("Result %d: expected index %d code %s, got index %d code %s (%s)", i, i, want, got.Index, codes.Code(got.Code), got.Message)
+				}
+				if want == codes.OK && (got.Workout == nil || got.Id == 0) {
+					t.Errorf("Result %d: expected created workout, got %+v", i, got)
+				}
+			}
+			if resp.Committed != tt.wantCommitted {
+				t.Errorf("Expected committed=%t, got %t", tt.wantCommitted, resp.Committed)
+			}
+		})
+	}
+}
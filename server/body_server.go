@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+)
+
+// CreateBodyMeasurement 体重・身体測定を記録
+func (s *GRPCServer) CreateBodyMeasurement(ctx context.Context, req *proto.CreateBodyMeasurementRequest) (*proto.CreateBodyMeasurementResponse, error) {
+	s.log(ctx).Debug("体重・身体測定を記録中", slog.String("date", req.Date))
+
+	date, err := parseDateParamIn(req.Date, false, s.workoutManager.Location())
+	if err != nil {
+		return nil, invalidArgumentError("date", err)
+	}
+
+	measurement, err := s.manager(ctx).CreateBodyMeasurement(usecase.CreateBodyMeasurementRequest{
+		Date:           date,
+		Bodyweight:     s.inputWeightUnit(ctx, req.WeightUnit).ToKilograms(req.Bodyweight),
+		BodyFatPercent: req.BodyFatPercent,
+		Waist:          req.Waist,
+		Chest:          req.Chest,
+		Arm:            req.Arm,
+		Notes:          req.Notes,
+	})
+	if err != nil {
+		return nil, statusError("failed to create body measurement", err)
+	}
+
+	return &proto.CreateBodyMeasurementResponse{
+		Measurement: convertToProtoBodyMeasurement(measurement, s.weightUnit(ctx)),
+		Message:     requestLocale(ctx).Message(i18n.MsgBodyRecorded, measurement.Date.Format(dateLayout)),
+	}, nil
+}
+
+// GetBodyMeasurement 体重・身体測定の記録を取得
+func (s *GRPCServer) GetBodyMeasurement(ctx context.Context, req *proto.GetBodyMeasurementRequest) (*proto.GetBodyMeasurementResponse, error) {
+	measurement, err := s.manager(ctx).GetBodyMeasurement(domain.BodyMeasurementID(req.Id))
+	if err != nil {
+		return nil, statusError("failed to get body measurement", err)
+	}
+	return &proto.GetBodyMeasurementResponse{
+		Measurement: convertToProtoBodyMeasurement(measurement, s.weightUnit(ctx)),
+	}, nil
+}
+
+// ListBodyMeasurements 測定日が期間内の体重・身体測定の記録を取得
+func (s *GRPCServer) ListBodyMeasurements(ctx context.Context, req *proto.ListBodyMeasurementsRequest) (*proto.ListBodyMeasurementsResponse, error) {
+	loc := s.workoutManager.Location()
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	measurements, err := s.manager(ctx).ListBodyMeasurements(usecase.ListBodyMeasurementsRequest{
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+	})
+	if err != nil {
+		return nil, statusError("failed to list body measurements", err)
+	}
+
+	unit := s.weightUnit(ctx)
+	protoMeasurements := make([]*proto.BodyMeasurement, 0, len(measurements))
+	for _, m := range measurements {
+		protoMeasurements = append(protoMeasurements, convertToProtoBodyMeasurement(m, unit))
+	}
+	return &proto.ListBodyMeasurementsResponse{
+		Measurements: protoMeasurements,
+		Message:      requestLocale(ctx).Message(i18n.MsgBodyList, len(measurements)),
+	}, nil
+}
+
+// UpdateBodyMeasurement 体重・身体測定の記録を更新
+func (s *GRPCServer) UpdateBodyMeasurement(ctx context.Context, req *proto.UpdateBodyMeasurementRequest) (*proto.UpdateBodyMeasurementResponse, error) {
+	s.log(ctx).Debug("体重・身体測定の記録を更新中", slog.Int64("measurement_id", req.Id))
+
+	var date *time.Time
+	if req.Date != nil {
+		var err error
+		date, err = parseDateParamIn(*req.Date, false, s.workoutManager.Location())
+		if err != nil {
+			return nil, invalidArgumentError("date", err)
+		}
+	}
+
+	var bodyweight *float64
+	if req.Bodyweight != nil {
+		kg := s.inputWeightUnit(ctx, req.WeightUnit).ToKilograms(*req.Bodyweight)
+		bodyweight = &kg
+	}
+
+	measurement, err := s.manager(ctx).UpdateBodyMeasurement(usecase.UpdateBodyMeasurementRequest{
+		ID:             domain.BodyMeasurementID(req.Id),
+		Date:           date,
+		Bodyweight:     bodyweight,
+		BodyFatPercent: req.BodyFatPercent,
+		Waist:          req.Waist,
+		Chest:          req.Chest,
+		Arm:            req.Arm,
+		Notes:          req.Notes,
+	})
+	if err != nil {
+		return nil, statusError("failed to update body measurement", err)
+	}
+
+	return &proto.UpdateBodyMeasurementResponse{
+		Measurement: convertToProtoBodyMeasurement(measurement, s.weightUnit(ctx)),
+		Message:     requestLocale(ctx).Message(i18n.MsgBodyUpdated, measurement.Date.Format(dateLayout)),
+	}, nil
+}
+
+// DeleteBodyMeasurement 体重・身体測定の記録を削除
+func (s *GRPCServer) DeleteBodyMeasurement(ctx context.Context, req *proto.DeleteBodyMeasurementRequest) (*proto.DeleteBodyMeasurementResponse, error) {
+	s.log(ctx).Debug("体重・身体測定の記録を削除中", slog.Int64("measurement_id", req.Id))
+
+	if err := s.manager(ctx).DeleteBodyMeasurement(domain.BodyMeasurementID(req.Id)); err != nil {
+		return nil, statusError("failed to delete body measurement", err)
+	}
+	return &proto.DeleteBodyMeasurementResponse{
+		Message: requestLocale(ctx).Message(i18n.MsgBodyDeleted),
+	}, nil
+}
+
+// GetBodyweightTrend 体重の推移（測定日ごとの体重と移動平均）を取得
+func (s *GRPCServer) GetBodyweightTrend(ctx context.Context, req *proto.GetBodyweightTrendRequest) (*proto.GetBodyweightTrendResponse, error) {
+	s.log(ctx).Debug("体重の推移を取得中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo), slog.Int("window_days", int(req.WindowDays)))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	points, err := s.manager(ctx).GetBodyweightTrend(usecase.GetBodyweightTrendRequest{
+		DateFrom:   dateFrom,
+		DateTo:     dateTo,
+		WindowDays: int(req.WindowDays),
+		Location:   loc,
+	})
+	if err != nil {
+		return nil, statusError("failed to get bodyweight trend", err)
+	}
+
+	unit := s.weightUnit(ctx)
+	protoPoints := make([]*proto.BodyweightTrendPoint, 0, len(points))
+	for _, p := range points {
+		protoPoints = append(protoPoints, &proto.BodyweightTrendPoint{
+			Date:          p.Date.Format(dateLayout),
+			Bodyweight:    unit.FromKilogramsRounded(p.Bodyweight),
+			MovingAverage: unit.FromKilogramsRounded(p.MovingAverage),
+			Samples:       int32(p.Samples),
+		})
+	}
+
+	locale := requestLocale(ctx)
+	resp := &proto.GetBodyweightTrendResponse{
+		Points:     protoPoints,
+		Message:    locale.Message(i18n.MsgBodyweightEmpty),
+		WeightUnit: convertToProtoWeightUnit(unit),
+	}
+	if len(points) > 0 {
+		resp.Change = unit.FromKilogramsRounded(points[len(points)-1].MovingAverage - points[0].MovingAverage)
+		resp.Message = locale.Message(i18n.MsgBodyweightTrend, len(points), resp.Change, unit.Key())
+	}
+	return resp, nil
+}
+
+// GetRelativeStrength 完了したワークアウトの重量・推定1RMの体重比を取得
+func (s *GRPCServer) GetRelativeStrength(ctx context.Context, req *proto.GetRelativeStrengthRequest) (*proto.GetRelativeStrengthResponse, error) {
+	s.log(ctx).Debug("体重比を取得中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo), slog.String("exercise_type", req.ExerciseType.String()))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	results, err := s.manager(ctx).GetRelativeStrength(usecase.GetRelativeStrengthRequest{
+		DateFrom:     dateFrom,
+		DateTo:       dateTo,
+		ExerciseType: convertProtoExerciseType(req.ExerciseType),
+		Location:     loc,
+	})
+	if err != nil {
+		return nil, statusError("failed to get relative strength", err)
+	}
+
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	var best float64
+	protoResults := make([]*proto.RelativeStrength, 0, len(results))
+	for _, r := range results {
+		if r.WeightRatio > best {
+			best = r.WeightRatio
+		}
+		protoResults = append(protoResults, &proto.RelativeStrength{
+			Workout:        convertToProtoWorkout(r.Workout, locale, unit),
+			Bodyweight:     unit.FromKilogramsRounded(r.Bodyweight),
+			WeightRatio:    r.WeightRatio,
+			OneRepMax:      unit.FromKilogramsRounded(r.OneRepMax),
+			OneRepMaxRatio: r.OneRepMaxRatio,
+			WeightUnit:     convertToProtoWeightUnit(unit),
+		})
+	}
+
+	message := locale.Message(i18n.MsgRelativeStrEmpty)
+	if len(results) > 0 {
+		message = locale.Message(i18n.MsgRelativeStrength, len(results), best)
+	}
+	return &proto.GetRelativeStrengthResponse{
+		Results: protoResults,
+		Message: message,
+	}, nil
+}
+
+// convertToProtoBodyMeasurement 体重・身体測定の記録の変換（domain → proto、体重はunitの単位）
+func convertToProtoBodyMeasurement(m *domain.BodyMeasurement, unit domain.WeightUnit) *proto.BodyMeasurement {
+	return &proto.BodyMeasurement{
+		Id:             int64(m.ID),
+		Date:           m.Date.Format(dateLayout),
+		Bodyweight:     unit.FromKilogramsRounded(m.Bodyweight),
+		BodyFatPercent: m.BodyFatPercent,
+		Waist:          m.Waist,
+		Chest:          m.Chest,
+		Arm:            m.Arm,
+		Notes:          m.Notes,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      m.UpdatedAt.Format(time.RFC3339),
+		WeightUnit:     convertToProtoWeightUnit(unit),
+	}
+}
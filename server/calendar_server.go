@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListCalendar 期間内のワークアウトを日ごとにまとめて取得
+func (s *GRPCServer) ListCalendar(ctx context.Context, req *proto.ListCalendarRequest) (*proto.ListCalendarResponse, error) {
+	s.log(ctx).Debug("カレンダーを取得中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo), slog.String("timezone", req.Timezone))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	calendar, err := s.manager(ctx).ListCalendar(usecase.ListCalendarRequest{
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, statusError("failed to list calendar", err)
+	}
+
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	days := make([]*proto.CalendarDay, 0, len(calendar.Days))
+	planned := 0
+	for _, day := range calendar.Days {
+		workouts := make([]*proto.Workout, 0, len(day.Workouts))
+		for _, w := range day.Workouts {
+			workouts = append(workouts, convertToProtoWorkout(w, locale, unit))
+		}
+		days = append(days, &proto.CalendarDay{
+			Date:           day.Date.Format(dateLayout),
+			Workouts:       workouts,
+			PlannedCount:   int32(day.CountByStatus(domain.WorkoutStatusPlanned)),
+			CompletedCount: int32(day.CountByStatus(domain.WorkoutStatusCompleted)),
+			SkippedCount:   int32(day.CountByStatus(domain.WorkoutStatusSkipped)),
+		})
+		planned += day.CountByStatus(domain.WorkoutStatusPlanned)
+	}
+
+	return &proto.ListCalendarResponse{
+		Days:     days,
+		Timezone: calendar.Location.String(),
+		Message:  locale.Message(i18n.MsgCalendarListed, len(days), planned),
+	}, nil
+}
+
+// RescheduleWorkout 予定のワークアウトの実施予定日時を変更
+func (s *GRPCServer) RescheduleWorkout(ctx context.Context, req *proto.RescheduleWorkoutRequest) (*proto.RescheduleWorkoutResponse, error) {
+	s.log(ctx).Debug("予定を変更中", slog.Int64(logging.KeyWorkoutID, int64(req.Id)), slog.String("scheduled_for", req.ScheduledFor))
+
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+
+	// 日付のみの指定は元の予定のタイムゾーンで解釈する
+	timezone := req.Timezone
+	if timezone == "" && req.Id > 0 {
+		if workout, err := s.manager(ctx).GetWorkout(domain.WorkoutID(req.Id)); err == nil {
+			timezone = workout.ScheduledTimezone
+		}
+	}
+
+	parsed, err := s.parseScheduleParam(req.ScheduledFor, timezone)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, locale.Message(i18n.MsgRescheduleFailed, err))
+	}
+	var scheduledFor time.Time // 未指定の場合はゼロ値のままユースケース層でエラーにする
+	if parsed != nil {
+		scheduledFor = *parsed
+	}
+
+	workout, err := s.manager(ctx).RescheduleWorkout(usecase.RescheduleWorkoutRequest{
+		ID:           domain.WorkoutID(req.Id),
+		ScheduledFor: scheduledFor,
+		Timezone:     timezone,
+	})
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgRescheduleFailed, err), err)
+	}
+
+	protoWorkout := convertToProtoWorkout(workout, locale, unit)
+	return &proto.RescheduleWorkoutResponse{
+		Workout: protoWorkout,
+		Message: locale.Message(i18n.MsgRescheduled, locale.ExerciseType(workout.ExerciseType), protoWorkout.ScheduledFor),
+	}, nil
+}
+
+// resolveLocation タイムゾーン名を解決する（空の場合はユーザー設定のタイムゾーン）
+func (s *GRPCServer) resolveLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return s.workoutManager.Location(), nil
+	}
+	return time.LoadLocation(timezone)
+}
+
+// parseScheduleParam 予定日時を解析する（日付のみの場合は指定したタイムゾーンの0時）
+func (s *GRPCServer) parseScheduleParam(value, timezone string) (*time.Time, error) {
+	loc, err := s.resolveLocation(timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	return parseDateParamIn(value, false, loc)
+}
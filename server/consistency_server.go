@@ -0,0 +1,192 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+)
+
+// GetConsistency ストリーク・予定の実施率・スキップ理由・ヒートマップを取得
+func (s *GRPCServer) GetConsistency(ctx context.Context, req *proto.GetConsistencyRequest) (*proto.GetConsistencyResponse, error) {
+	s.log(ctx).Debug("継続状況を取得中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo), slog.String("timezone", req.Timezone))
+
+	loc := s.workoutManager.Location()
+	if req.Timezone != "" {
+		var err error
+		loc, err = time.LoadLocation(req.Timezone)
+		if err != nil {
+			return nil, invalidArgumentError("timezone", err)
+		}
+	}
+
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	streakUnit := domain.StatsPeriodDay
+	if req.StreakUnit != proto.StatsPeriod_STATS_PERIOD_UNSPECIFIED {
+		streakUnit = convertProtoStatsPeriod(req.StreakUnit)
+	}
+
+	report, err := s.manager(ctx).GetConsistency(usecase.GetConsistencyRequest{
+		DateFrom:         dateFrom,
+		DateTo:           dateTo,
+		StreakUnit:       streakUnit,
+		SkipReasonPeriod: convertProtoStatsPeriod(req.SkipReasonPeriod),
+		Location:         loc,
+	})
+	if err != nil {
+		return nil, statusError("failed to get consistency", err)
+	}
+
+	locale := requestLocale(ctx)
+	skipReasons := make([]*proto.SkipReasonBucket, 0, len(report.SkipReasons))
+	for _, bucket := range report.SkipReasons {
+		skipReasons = append(skipReasons, convertToProtoSkipReasonBucket(bucket, locale))
+	}
+	heatmap := make([]*proto.HeatmapDay, 0, len(report.Heatmap))
+	for _, day := range report.Heatmap {
+		heatmap = append(heatmap, &proto.HeatmapDay{
+			Date:           day.Date.Format(dateLayout),
+			CompletedCount: int32(day.CompletedCount),
+			TotalVolume:    day.TotalVolume,
+		})
+	}
+
+	return &proto.GetConsistencyResponse{
+		CurrentStreak:  convertToProtoStreak(report.CurrentStreak),
+		LongestStreak:  convertToProtoStreak(report.LongestStreak),
+		StreakUnit:     convertToProtoStatsPeriod(report.StreakUnit),
+		DueCount:       int32(report.DueCount),
+		CompletedCount: int32(report.CompletedCount),
+		SkippedCount:   int32(report.SkippedCount),
+		MissedCount:    int32(report.MissedCount),
+		AdherenceRate:  report.AdherenceRate(),
+		SkipReasons:    skipReasons,
+		Heatmap:        heatmap,
+		Timezone:       report.Location.String(),
+		Message:        consistencyMessage(locale, report),
+	}, nil
+}
+
+// consistencyMessage ストリークと実施率をまとめたメッセージ
+func consistencyMessage(locale i18n.Locale, report *domain.ConsistencyReport) string {
+	unit := locale.Message(i18n.MsgStreakUnitDay)
+	if report.StreakUnit == domain.StatsPeriodWeek {
+		unit = locale.Message(i18n.MsgStreakUnitWeek)
+	}
+	if report.CurrentStreak.Length == 0 {
+		return locale.Message(i18n.MsgStreakBroken, report.LongestStreak.Length, unit)
+	}
+	return locale.Message(i18n.MsgStreakActive,
+		report.CurrentStreak.Length, unit, report.LongestStreak.Length, unit, report.AdherenceRate()*100)
+}
+
+// convertToProtoStreak ストリークの変換（domain → proto）
+func convertToProtoStreak(streak domain.Streak) *proto.Streak {
+	if streak.Length == 0 {
+		return &proto.Streak{}
+	}
+	return &proto.Streak{
+		Length: int32(streak.Length),
+		Start:  streak.Start.Format(dateLayout),
+		End:    streak.End.Format(dateLayout),
+	}
+}
+
+// convertToProtoSkipReasonBucket スキップ理由の集計の変換（domain → proto）
+func convertToProtoSkipReasonBucket(bucket *domain.SkipReasonBucket, locale i18n.Locale) *proto.SkipReasonBucket {
+	reasons := make([]domain.SkipReason, 0, len(bucket.Counts))
+	for reason := range bucket.Counts {
+		reasons = append(reasons, reason)
+	}
+	sort.Slice(reasons, func(i, j int) bool { return reasons[i] < reasons[j] })
+
+	counts := make([]*proto.SkipReasonCount, 0, len(reasons))
+	for _, reason := range reasons {
+		counts = append(counts, &proto.SkipReasonCount{
+			Reason:      convertToProtoSkipReason(reason),
+			Count:       int32(bucket.Counts[reason]),
+			DisplayName: locale.SkipReason(reason),
+		})
+	}
+	return &proto.SkipReasonBucket{
+		PeriodStart: bucket.PeriodStart.Format(dateLayout),
+		Counts:      counts,
+	}
+}
+
+// convertToProtoStatsPeriod 集計単位の変換（domain → proto）
+func convertToProtoStatsPeriod(period domain.StatsPeriod) proto.StatsPeriod {
+	switch period {
+	case domain.StatsPeriodDay:
+		return proto.StatsPeriod_STATS_PERIOD_DAY
+	case domain.StatsPeriodWeek:
+		return proto.StatsPeriod_STATS_PERIOD_WEEK
+	case domain.StatsPeriodMonth:
+		return proto.StatsPeriod_STATS_PERIOD_MONTH
+	default:
+		return proto.StatsPeriod_STATS_PERIOD_UNSPECIFIED
+	}
+}
+
+func convertToProtoSkipReason(reason domain.SkipReason) proto.SkipReason {
+	switch reason {
+	case domain.SkipReasonSore:
+		return proto.SkipReason_SKIP_REASON_SORE
+	case domain.SkipReasonNoTime:
+		return proto.SkipReason_SKIP_REASON_NO_TIME
+	case domain.SkipReasonSick:
+		return proto.SkipReason_SKIP_REASON_SICK
+	case domain.SkipReasonInjury:
+		return proto.SkipReason_SKIP_REASON_INJURY
+	case domain.SkipReasonTravel:
+		return proto.SkipReason_SKIP_REASON_TRAVEL
+	case domain.SkipReasonWeather:
+		return proto.SkipReason_SKIP_REASON_WEATHER
+	case domain.SkipReasonMotivation:
+		return proto.SkipReason_SKIP_REASON_MOTIVATION
+	case domain.SkipReasonOther:
+		return proto.SkipReason_SKIP_REASON_OTHER
+	case domain.SkipReasonMissed:
+		return proto.SkipReason_SKIP_REASON_MISSED
+	default:
+		return proto.SkipReason_SKIP_REASON_UNSPECIFIED
+	}
+}
+
+func convertProtoSkipReason(reason proto.SkipReason) domain.SkipReason {
+	switch reason {
+	case proto.SkipReason_SKIP_REASON_SORE:
+		return domain.SkipReasonSore
+	case proto.SkipReason_SKIP_REASON_NO_TIME:
+		return domain.SkipReasonNoTime
+	case proto.SkipReason_SKIP_REASON_SICK:
+		return domain.SkipReasonSick
+	case proto.SkipReason_SKIP_REASON_INJURY:
+		return domain.SkipReasonInjury
+	case proto.SkipReason_SKIP_REASON_TRAVEL:
+		return domain.SkipReasonTravel
+	case proto.SkipReason_SKIP_REASON_WEATHER:
+		return domain.SkipReasonWeather
+	case proto.SkipReason_SKIP_REASON_MOTIVATION:
+		return domain.SkipReasonMotivation
+	case proto.SkipReason_SKIP_REASON_OTHER:
+		return domain.SkipReasonOther
+	case proto.SkipReason_SKIP_REASON_MISSED:
+		return domain.SkipReasonMissed
+	default:
+		return domain.SkipReasonUnspecified
+	}
+}
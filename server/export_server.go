@@ -0,0 +1,125 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase/exporter"
+)
+
+// ExportWorkouts 条件に一致するワークアウトをバッチごとにストリームで送信
+func (s *GRPCServer) ExportWorkouts(req *proto.ExportWorkoutsRequest, stream proto.WorkoutService_ExportWorkoutsServer) error {
+	s.log(stream.Context()).Debug("エクスポート中", slog.String("format", req.Format.String()))
+
+	filter, err := s.convertProtoExportFilter(stream.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	// PROTO以外はファイルの内容をバッチごとにchunkとして送る
+	var (
+		buf    bytes.Buffer
+		writer *exporter.Writer
+	)
+	if format, ok := convertProtoExportFormat(req.Format); ok {
+		writer, err = exporter.NewWriter(&buf, format)
+		if err != nil {
+			return invalidArgumentError("format", err)
+		}
+	}
+
+	locale := requestLocale(stream.Context())
+	unit := s.weightUnit(stream.Context())
+	exported := 0
+	sendChunk := func() error {
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			return nil
+		}
+		defer buf.Reset()
+		return stream.Send(&proto.ExportWorkoutsResponse{Chunk: bytes.Clone(buf.Bytes()), ExportedCount: int32(exported)})
+	}
+
+	count, err := s.manager(stream.Context()).ExportWorkouts(filter, int(req.BatchSize), func(batch []*domain.Workout) error {
+		exported += len(batch)
+		if writer != nil {
+			if err := writer.Write(batch); err != nil {
+				return err
+			}
+			return sendChunk()
+		}
+
+		workouts := make([]*proto.Workout, 0, len(batch))
+		for _, workout := range batch {
+			workouts = append(workouts, convertToProtoWorkout(workout, locale, unit))
+		}
+		return stream.Send(&proto.ExportWorkoutsResponse{Workouts: workouts, ExportedCount: int32(exported)})
+	})
+	if err != nil {
+		return statusError("failed to export workouts", err)
+	}
+	// 0件の場合もCSVのヘッダーは送る
+	if writer != nil {
+		if err := sendChunk(); err != nil {
+			return statusError("failed to export workouts", err)
+		}
+	}
+
+	s.log(stream.Context()).Info("ワークアウトをエクスポートしました", slog.Int("workouts", count))
+	return nil
+}
+
+// convertProtoExportFilter エクスポートリクエストの条件をWorkoutFilterに変換（重量はkgに換算）
+func (s *GRPCServer) convertProtoExportFilter(ctx context.Context, req *proto.ExportWorkoutsRequest) (domain.WorkoutFilter, error) {
+	unit := s.inputWeightUnit(ctx, req.WeightUnit)
+	var filter domain.WorkoutFilter
+	if req.MinWeight != nil {
+		minWeight := unit.ToKilograms(*req.MinWeight)
+		filter.MinWeight = &minWeight
+	}
+	if req.MaxWeight != nil {
+		maxWeight := unit.ToKilograms(*req.MaxWeight)
+		filter.MaxWeight = &maxWeight
+	}
+	if req.StatusFilter != proto.WorkoutStatus_WORKOUT_STATUS_UNSPECIFIED {
+		status := convertProtoWorkoutStatus(req.StatusFilter)
+		filter.Status = &status
+	}
+	if req.DifficultyFilter != proto.Difficulty_DIFFICULTY_UNSPECIFIED {
+		difficulty := convertProtoDifficulty(req.DifficultyFilter)
+		filter.Difficulty = &difficulty
+	}
+	if req.MuscleGroupFilter != proto.MuscleGroup_UNSPECIFIED {
+		muscleGroup := convertProtoMuscleGroup(req.MuscleGroupFilter)
+		filter.MuscleGroup = &muscleGroup
+	}
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return filter, invalidArgumentError("timezone", err)
+	}
+	if filter.DateFrom, err = parseDateParamIn(req.DateFrom, false, loc); err != nil {
+		return filter, invalidArgumentError("date_from", err)
+	}
+	if filter.DateTo, err = parseDateParamIn(req.DateTo, true, loc); err != nil {
+		return filter, invalidArgumentError("date_to", err)
+	}
+	return filter, nil
+}
+
+// convertProtoExportFormat protoのExportFormatをexporter.Formatに変換（PROTOの場合はfalse）
+func convertProtoExportFormat(format proto.ExportFormat) (exporter.Format, bool) {
+	switch format {
+	case proto.ExportFormat_EXPORT_FORMAT_JSONL:
+		return exporter.FormatJSONL, true
+	case proto.ExportFormat_EXPORT_FORMAT_CSV:
+		return exporter.FormatCSV, true
+	default:
+		return exporter.FormatJSONL, false
+	}
+}
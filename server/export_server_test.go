@@ -0,0 +1,108 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc"
+)
+
+// exportStream 送信したメッセージを記録するテスト用のストリーム
+type exportStream struct {
+	grpc.ServerStream
+	responses []*proto.ExportWorkoutsResponse
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(resp *proto.ExportWorkoutsResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
+
+// TestExportWorkouts テーブル駆動テストで形式ごとのバッチ送信をテスト
+func TestExportWorkouts(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          *proto.ExportWorkoutsRequest
+		wantMessages int
+		wantLines    int // JSONL・CSVの場合の行数
+		wantErr      bool
+		description  string
+	}{
+		{
+			name:         "正常系: PROTO",
+			req:          &proto.ExportWorkoutsRequest{BatchSize: 2},
+			wantMessages: 3,
+			description:  "5件を2件ずつ送信",
+		},
+		{
+			name:         "正常系: CSV",
+			req:          &proto.ExportWorkoutsRequest{Format: proto.ExportFormat_EXPORT_FORMAT_CSV, BatchSize: 2},
+			wantMessages: 3,
+			wantLines:    6,
+			description:  "連結するとヘッダー付きのCSVになる",
+		},
+		{
+			name:         "正常系: CSV（0件）",
+			req:          &proto.ExportWorkoutsRequest{Format: proto.ExportFormat_EXPORT_FORMAT_CSV, StatusFilter: proto.WorkoutStatus_WORKOUT_STATUS_SKIPPED},
+			wantMessages: 1,
+			wantLines:    1,
+			description:  "一致しなくてもヘッダーは送る",
+		},
+		{
+			name:        "異常系: 不正な日付",
+			req:         &proto.ExportWorkoutsRequest{DateFrom: "yesterday"},
+			wantErr:     true,
+			description: "日付を解析できない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			for i := 0; i < 5; i++ {
+				if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100}); err != nil {
+					t.Fatalf("Failed to create workout: %v", err)
+				}
+			}
+			server := NewGRPCServer(manager)
+			stream := &exportStream{}
+
+			err := server.ExportWorkouts(tt.req, stream)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExportWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(stream.responses) != tt.wantMessages {
+				t.Fatalf("Expected %d messages, got %d", tt.wantMessages, len(stream.responses))
+			}
+
+			var data bytes.Buffer
+			workouts := 0
+			for _, resp := range stream.responses {
+				data.Write(resp.Chunk)
+				workouts += len(resp.Workouts)
+			}
+			if tt.wantLines > 0 {
+				if lines := strings.Count(data.String(), "\n"); lines != tt.wantLines {
+					t.Errorf("Expected %d lines, got %d:\n%s", tt.wantLines, lines, data.String())
+				}
+			} else if workouts != 5 {
+				t.Errorf("Expected 5 workouts, got %d", workouts)
+			}
+		})
+	}
+}
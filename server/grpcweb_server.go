@@ -0,0 +1,47 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/improbable-eng/grpc-web/go/grpcweb"
+	"google.golang.org/grpc"
+)
+
+// corsMaxAge プリフライトの結果をブラウザがキャッシュする秒数
+const corsMaxAge = int(10 * time.Minute / time.Second)
+
+// newGRPCWebServer grpc.ServerをgRPC-Web（Content-Type: application/grpc-web, application/grpc-web-text）で呼び出せるようにする
+// HTTP/1.1では送信をストリーミングできないため、クライアント・双方向ストリーミングはWebSocket
+// （Sec-WebSocket-Protocol: grpc-websockets）で受け付ける
+func newGRPCWebServer(grpcServer *grpc.Server, allowOrigin func(origin string) bool) *grpcweb.WrappedGrpcServer {
+	return grpcweb.WrapServer(grpcServer,
+		grpcweb.WithOriginFunc(allowOrigin),
+		grpcweb.WithWebsockets(true),
+		grpcweb.WithWebsocketOriginFunc(func(req *http.Request) bool {
+			host, err := grpcweb.WebsocketRequestOrigin(req)
+			if err != nil {
+				return false
+			}
+			// 同じオリジンからの接続、または許可したオリジンからの接続のみ受け付ける
+			return host == req.Host || allowOrigin(req.Header.Get("Origin"))
+		}),
+	)
+}
+
+// isGRPCWebRequest gRPC-Webのリクエスト・プリフライト・WebSocketの接続かどうか
+func isGRPCWebRequest(web *grpcweb.WrappedGrpcServer, r *http.Request) bool {
+	return web.IsGrpcWebRequest(r) || web.IsAcceptableGrpcCorsRequest(r) || web.IsGrpcWebSocketRequest(r)
+}
+
+// originMatcher 許可するオリジンの一覧からCORSの判定関数を作成
+// "*" を含む場合は全てのオリジンを許可し、空の場合は他のオリジンからの呼び出しを許可しない
+func originMatcher(allowedOrigins []string) func(origin string) bool {
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		allowed[origin] = true
+	}
+	return func(origin string) bool {
+		return allowed["*"] || allowed[origin]
+	}
+}
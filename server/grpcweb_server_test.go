@@ -0,0 +1,365 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/proto"
+
+	"google.golang.org/grpc/codes"
+	protobuf "google.golang.org/protobuf/proto"
+	"nhooyr.io/websocket"
+)
+
+// testOrigin ブラウザのクライアントを配信しているオリジン
+const testOrigin = "https://trainer.example"
+
+// grpcWebFrame メッセージをgRPC-Webのフレーム（フラグ1バイト + 長さ4バイト + 本体）にする
+func grpcWebFrame(t *testing.T, msg protobuf.Message) []byte {
+	t.Helper()
+	payload, err := protobuf.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	frame := make([]byte, 5, 5+len(payload))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(payload)))
+	return append(frame, payload...)
+}
+
+// grpcWebResult gRPC-Webのレスポンスを読み取った結果
+type grpcWebResult struct {
+	messages [][]byte
+	trailers map[string]string // trailersがない（trailers-only）の場合はレスポンスヘッダー
+	complete bool              // trailerのフレームまで受け取った
+}
+
+// code grpc-statusをステータスコードに変換
+func (r *grpcWebResult) code(t *testing.T) codes.Code {
+	t.Helper()
+	code, err := strconv.Atoi(r.trailers["grpc-status"])
+	if err != nil {
+		t.Fatalf("Invalid grpc-status: %v", r.trailers)
+	}
+	return codes.Code(code)
+}
+
+// readFrames 受け取ったバイト列から、揃っているフレームを全て取り出す
+func (r *grpcWebResult) readFrames(buf *bytes.Buffer) {
+	for buf.Len() >= 5 {
+		header := buf.Bytes()[:5]
+		length := int(binary.BigEndian.Uint32(header[1:]))
+		if buf.Len() < 5+length {
+			return
+		}
+		flag := header[0]
+		buf.Next(5)
+		payload := buf.Next(length)
+		if flag&0x80 == 0 {
+			r.messages = append(r.messages, append([]byte(nil), payload...))
+			continue
+		}
+		// MSBが1のフレームはヘッダー形式のtrailer
+		for _, line := range strings.Split(string(payload), "\r\n") {
+			if key, value, ok := strings.Cut(line, ":"); ok {
+				r.trailers[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+			}
+		}
+		r.complete = true
+	}
+}
+
+// decodeGRPCWebText grpc-web-textのレスポンスをデコードする
+// サーバーはフラッシュごとにパディング付きのbase64を書くため、パディングの位置で区切ってデコードする
+func decodeGRPCWebText(t *testing.T, body string) []byte {
+	t.Helper()
+	var decoded []byte
+	for body != "" {
+		end := len(body)
+		if i := strings.IndexByte(body, '='); i >= 0 {
+			end = (i/4 + 1) * 4
+		}
+		chunk, err := base64.StdEncoding.DecodeString(body[:end])
+		if err != nil {
+			t.Fatalf("Failed to decode grpc-web-text: %v", err)
+		}
+		decoded = append(decoded, chunk...)
+		body = body[end:]
+	}
+	return decoded
+}
+
+// callGRPCWeb gRPC-Webでメソッドを呼び出す（ブラウザのクライアントと同じリクエスト）
+func callGRPCWeb(t *testing.T, baseURL, method string, req protobuf.Message, text bool) *grpcWebResult {
+	t.Helper()
+	body := grpcWebFrame(t, req)
+	contentType := "application/grpc-web+proto"
+	if text {
+		body = []byte(base64.StdEncoding.EncodeToString(body))
+		contentType = "application/grpc-web-text"
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, baseURL+"/workout.WorkoutService/"+method, bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	httpReq.Header.Set("Content-Type", contentType)
+	httpReq.Header.Set("X-Grpc-Web", "1")
+	httpReq.Header.Set("Origin", testOrigin)
+
+	resp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected HTTP 200, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Access-Control-Allow-Origin"); got != testOrigin {
+		t.Errorf("Expected Access-Control-Allow-Origin %s, got %q", testOrigin, got)
+	}
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if text {
+		raw = decodeGRPCWebText(t, string(raw))
+	}
+
+	result := &grpcWebResult{trailers: map[string]string{}}
+	result.readFrames(bytes.NewBuffer(raw))
+	if !result.complete {
+		for _, key := range []string{"grpc-status", "grpc-message"} {
+			result.trailers[key] = resp.Header.Get(key)
+		}
+	}
+	return result
+}
+
+// TestGRPCWeb_Unary テーブル駆動テストでgRPC-Web（バイナリ・テキスト形式）の単項RPCをテスト
+func TestGRPCWeb_Unary(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		req         protobuf.Message
+		text        bool
+		wantCode    codes.Code
+		description string
+	}{
+		{
+			name:        "正常系: ワークアウトを取得",
+			method:      "GetWorkout",
+			req:         &proto.GetWorkoutRequest{Id: 1},
+			wantCode:    codes.OK,
+			description: "application/grpc-web+proto",
+		},
+		{
+			name:        "正常系: テキスト形式",
+			method:      "GetWorkout",
+			req:         &proto.GetWorkoutRequest{Id: 1},
+			text:        true,
+			wantCode:    codes.OK,
+			description: "application/grpc-web-text（base64）",
+		},
+		{
+			name:        "異常系: 存在しないワークアウト",
+			method:      "GetWorkout",
+			req:         &proto.GetWorkoutRequest{Id: 999},
+			wantCode:    codes.NotFound,
+			description: "エラーはgrpc-statusで返す",
+		},
+		{
+			name:        "異常系: 日付の形式が不正",
+			method:      "GetTrainingStats",
+			req:         &proto.GetTrainingStatsRequest{DateFrom: "yesterday"},
+			wantCode:    codes.InvalidArgument,
+			description: "REST/JSONと同じステータスコード",
+		},
+	}
+
+	server, _ := newHTTPTestServer(t, testOrigin)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := callGRPCWeb(t, server.URL, tt.method, tt.req, tt.text)
+			if code := result.code(t); code != tt.wantCode {
+				t.Fatalf("Expected %s, got %s (%s)", tt.wantCode, code, result.trailers["grpc-message"])
+			}
+			if tt.wantCode != codes.OK {
+				return
+			}
+			if len(result.messages) != 1 {
+				t.Fatalf("Expected 1 message, got %d", len(result.messages))
+			}
+			resp := &proto.GetWorkoutResponse{}
+			if err := protobuf.Unmarshal(result.messages[0], resp); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if resp.Workout.GetId() != 1 || resp.Workout.GetExerciseType() != proto.ExerciseType_EXERCISE_SQUAT {
+				t.Errorf("Unexpected workout: %v", resp.Workout)
+			}
+		})
+	}
+}
+
+// TestGRPCWeb_ServerStreaming サーバーストリーミングはバッチごとのフレームとして受け取れる
+func TestGRPCWeb_ServerStreaming(t *testing.T) {
+	server, manager := newHTTPTestServer(t, testOrigin)
+	newInProgressWorkout(t, manager)
+	newInProgressWorkout(t, manager)
+
+	result := callGRPCWeb(t, server.URL, "ExportWorkouts", &proto.ExportWorkoutsRequest{Format: proto.ExportFormat_EXPORT_FORMAT_PROTO, BatchSize: 2}, false)
+	if code := result.code(t); code != codes.OK {
+		t.Fatalf("Expected OK, got %s (%s)", code, result.trailers["grpc-message"])
+	}
+	if len(result.messages) != 2 {
+		t.Fatalf("Expected 2 batches, got %d", len(result.messages))
+	}
+	last := &proto.ExportWorkoutsResponse{}
+	if err := protobuf.Unmarshal(result.messages[1], last); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if last.ExportedCount != 3 || len(last.Workouts) != 1 {
+		t.Errorf("Expected the last batch to hold workout 3 of 3, got %d workouts (count %d)", len(last.Workouts), last.ExportedCount)
+	}
+}
+
+// TestGRPCWeb_WebSocket 双方向ストリーミングはWebSocketで呼び出せる
+func TestGRPCWeb_WebSocket(t *testing.T) {
+	server, manager := newHTTPTestServer(t, testOrigin)
+	id := newInProgressWorkout(t, manager)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http")+"/workout.WorkoutService/TrackWorkout", &websocket.DialOptions{
+		Subprotocols: []string{"grpc-websockets"},
+		HTTPHeader:   http.Header{"Origin": {testOrigin}},
+	})
+	if err != nil {
+		t.Fatalf("Dial() error = %v", err)
+	}
+	defer conn.Close(websocket.StatusNormalClosure, "")
+
+	// 最初のメッセージはリクエストヘッダー、以降は先頭1バイト（0: データ, 1: 送信終了）+ フレーム
+	write := func(msg []byte) {
+		t.Helper()
+		if err := conn.Write(ctx, websocket.MessageBinary, msg); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+	}
+	write([]byte("content-type: application/grpc-web+proto\r\nx-grpc-web: 1\r\n"))
+	send := func(req *proto.TrackWorkoutRequest) {
+		t.Helper()
+		write(append([]byte{0}, grpcWebFrame(t, req)...))
+	}
+
+	result := &grpcWebResult{trailers: map[string]string{}}
+	var buf bytes.Buffer
+	recv := func() *proto.TrackWorkoutResponse {
+		t.Helper()
+		for len(result.messages) == 0 {
+			if result.complete {
+				t.Fatalf("Stream closed: %v", result.trailers)
+			}
+			_, data, err := conn.Read(ctx)
+			if err != nil {
+				t.Fatalf("Read() error = %v", err)
+			}
+			buf.Write(data)
+			result.readFrames(&buf)
+			// レスポンスヘッダーのフレームは読み飛ばす
+			if result.complete && result.trailers["grpc-status"] == "" {
+				result.complete = false
+			}
+		}
+		resp := &proto.TrackWorkoutResponse{}
+		if err := protobuf.Unmarshal(result.messages[0], resp); err != nil {
+			t.Fatalf("Unmarshal() error = %v", err)
+		}
+		result.messages = result.messages[1:]
+		return resp
+	}
+
+	send(&proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Start{Start: &proto.StartTracking{WorkoutId: int32(id), RestSeconds: 60}}})
+	if resp := recv(); resp.GetStarted() == nil {
+		t.Fatalf("Expected started event, got %v", resp)
+	}
+	send(setCompleted(5, 100))
+	if resp := recv(); resp.GetSetLogged().GetSet().GetSetNumber() != 1 {
+		t.Fatalf("Expected set 1 to be logged, got %v", resp)
+	}
+	for _, want := range []proto.PersonalRecordType{proto.PersonalRecordType_PERSONAL_RECORD_TYPE_WEIGHT, proto.PersonalRecordType_PERSONAL_RECORD_TYPE_ONE_REP_MAX} {
+		if resp := recv(); resp.GetPersonalRecord().GetType() != want {
+			t.Fatalf("Expected %s record, got %v", want, resp)
+		}
+	}
+
+	write([]byte{1})
+	if resp := recv(); resp.GetCompleted().GetSetCount() != 1 {
+		t.Fatalf("Expected completed workout with 1 set, got %v", resp)
+	}
+}
+
+// TestGRPCWeb_CORS 許可したオリジンからは全てのRPCのプリフライトが通り、REST/JSONにもCORSのヘッダーが付く
+func TestGRPCWeb_CORS(t *testing.T) {
+	var paths []string
+	for _, method := range proto.WorkoutService_ServiceDesc.Methods {
+		paths = append(paths, "/workout.WorkoutService/"+method.MethodName)
+	}
+	for _, stream := range proto.WorkoutService_ServiceDesc.Streams {
+		paths = append(paths, "/workout.WorkoutService/"+stream.StreamName)
+	}
+
+	tests := []struct {
+		name        string
+		origin      string
+		wantAllowed bool
+		description string
+	}{
+		{
+			name:        "正常系: 許可したオリジン",
+			origin:      testOrigin,
+			wantAllowed: true,
+			description: "ブラウザのクライアントから全てのRPCを呼び出せる",
+		},
+		{
+			name:        "異常系: 許可していないオリジン",
+			origin:      "https://evil.example",
+			wantAllowed: false,
+			description: "Access-Control-Allow-Originを返さない",
+		},
+	}
+
+	server, _ := newHTTPTestServer(t, testOrigin)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := func(req *http.Request) {
+				t.Helper()
+				req.Header.Set("Origin", tt.origin)
+				resp, err := http.DefaultClient.Do(req)
+				if err != nil {
+					t.Fatalf("Do() error = %v", err)
+				}
+				resp.Body.Close()
+				if allowed := resp.Header.Get("Access-Control-Allow-Origin") == tt.origin; allowed != tt.wantAllowed {
+					t.Errorf("%s %s: expected allowed=%v, got headers %v", req.Method, req.URL.Path, tt.wantAllowed, resp.Header)
+				}
+			}
+
+			for _, path := range paths {
+				req, _ := http.NewRequest(http.MethodOptions, server.URL+path, nil)
+				req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+				req.Header.Set("Access-Control-Request-Headers", "content-type,x-grpc-web,x-user-agent")
+				check(req)
+			}
+
+			req, _ := http.NewRequest(http.MethodGet, server.URL+"/v1/workouts/1", nil)
+			check(req)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"golv2-learning-app/logging"
+	"golv2-learning-app/proto"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/rs/cors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// OpenAPIPath OpenAPIドキュメントを返すパス
+const OpenAPIPath = "/openapi.json"
+
+// W3C Trace Contextのヘッダー（REST/JSONの呼び出し元のトレースをgRPCサーバーに引き継ぐ）
+const (
+	traceparentHeader = "traceparent"
+	tracestateHeader  = "tracestate"
+)
+
+// HTTPServer ブラウザ・HTTPクライアント向けのサーバー
+// REST/JSONはgRPCサーバーに中継し、gRPC-Webは同じポートでgRPCサーバーのハンドラーを直接呼び出す
+type HTTPServer struct {
+	grpcEndpoint   string      // REST/JSONの中継先のgRPCサーバー（例: localhost:50051）
+	grpcServer     *GRPCServer // gRPC-Webで呼び出すサーバー
+	allowedOrigins []string    // 他のオリジンからの呼び出しを許可するオリジン（"*" は全て許可）
+}
+
+// NewHTTPServer 新しいHTTPサーバーを作成
+func NewHTTPServer(grpcEndpoint string, grpcServer *GRPCServer, allowedOrigins []string) *HTTPServer {
+	return &HTTPServer{
+		grpcEndpoint:   grpcEndpoint,
+		grpcServer:     grpcServer,
+		allowedOrigins: allowedOrigins,
+	}
+}
+
+// Start サーバーを起動
+func (h *HTTPServer) Start(ctx context.Context, port int) error {
+	conn, err := grpc.DialContext(ctx, h.grpcEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return fmt.Errorf("failed to dial gRPC server: %v", err)
+	}
+	defer conn.Close()
+
+	logger := h.grpcServer.logger
+	handler, err := NewHTTPHandler(ctx, proto.NewWorkoutServiceClient(conn), h.grpcServer.NewServer(), h.allowedOrigins, logger)
+	if err != nil {
+		return err
+	}
+	httpServer := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	logger.Info("REST/JSON・gRPC-Webサーバーを起動しました",
+		slog.Int("port", port), slog.String("grpc_endpoint", h.grpcEndpoint),
+		slog.String("openapi", OpenAPIPath), slog.Any("allowed_origins", h.allowedOrigins))
+
+	return httpServer.ListenAndServe()
+}
+
+// NewHTTPHandler REST/JSONとgRPC-Webを受け付けるhttp.Handlerを作成
+// REST/JSONはgRPCクライアントに中継し、エラーはgRPCのステータスコードからHTTPステータスに変換して
+// {"code", "message", "details"} の形式で返す
+// gRPC-WebはgrpcServerで処理する。allowedOriginsのオリジンからはCORSで両方を呼び出せる
+// X-Request-IdヘッダーはgRPCのメタデータとして中継し、レスポンスでも同じヘッダーで返す
+func NewHTTPHandler(ctx context.Context, client proto.WorkoutServiceClient, grpcServer *grpc.Server, allowedOrigins []string, logger *slog.Logger) (http.Handler, error) {
+	gateway := runtime.NewServeMux(
+		// フィールド名はprotoと同じsnake_caseで返し、未設定のフィールドも省略しない
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions:   protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true},
+			UnmarshalOptions: protojson.UnmarshalOptions{DiscardUnknown: true},
+		}),
+		runtime.WithErrorHandler(httpErrorHandler(logger)),
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeaderMatcher),
+	)
+	if err := proto.RegisterWorkoutServiceHandlerClient(ctx, gateway, client); err != nil {
+		return nil, fmt.Errorf("failed to register gateway: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(OpenAPIPath, serveOpenAPI)
+	mux.Handle("/", gateway)
+
+	allowOrigin := originMatcher(allowedOrigins)
+	rest := cors.New(cors.Options{
+		AllowOriginFunc: allowOrigin,
+		AllowedMethods:  []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
+		AllowedHeaders:  []string{"Content-Type", "Authorization", RequestIDHeader, WeightUnitHeader, traceparentHeader, tracestateHeader},
+		ExposedHeaders:  []string{RequestIDHeader},
+		MaxAge:          corsMaxAge,
+	}).Handler(mux)
+	web := newGRPCWebServer(grpcServer, allowOrigin)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isGRPCWebRequest(web, r) {
+			web.ServeHTTP(w, r)
+			return
+		}
+		rest.ServeHTTP(w, r)
+	}), nil
+}
+
+// httpErrorHandler サーバー側のエラー（5xx）をログに残してから、標準の形式でエラーを返す
+// HTTPステータスは runtime.HTTPStatusFromCode で決まる（NotFound → 404, InvalidArgument → 400 など）
+func httpErrorHandler(logger *slog.Logger) runtime.ErrorHandlerFunc {
+	return func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+		if code := runtime.HTTPStatusFromCode(status.Code(err)); code >= http.StatusInternalServerError {
+			logger.ErrorContext(ctx, "HTTPリクエストの処理に失敗しました",
+				slog.String("http_method", r.Method), slog.String("path", r.URL.Path),
+				slog.String(logging.KeyRequestID, r.Header.Get(RequestIDHeader)),
+				slog.Int("status", code), slog.String(logging.KeyError, err.Error()))
+		}
+		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+	}
+}
+
+// incomingHeaderMatcher X-Request-Id・X-Weight-UnitヘッダーとトレースコンテキストのヘッダーをgRPCのメタデータとして中継する
+// それ以外のヘッダーはgrpc-gatewayの標準の規則に従う
+func incomingHeaderMatcher(key string) (string, bool) {
+	for _, header := range []string{RequestIDHeader, WeightUnitHeader, traceparentHeader, tracestateHeader} {
+		if strings.EqualFold(key, header) {
+			return header, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// outgoingHeaderMatcher レスポンスのメタデータのうちリクエストIDはX-Request-Idヘッダーで返す
+// それ以外は標準と同じく Grpc-Metadata- を付けて返す
+func outgoingHeaderMatcher(key string) (string, bool) {
+	if key == RequestIDHeader {
+		return RequestIDHeader, true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// serveOpenAPI proto から生成したOpenAPIドキュメントを返す
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(proto.OpenAPIDocument)
+}
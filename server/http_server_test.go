@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+)
+
+// newHTTPTestServer bufconnのgRPCサーバーに中継するゲートウェイを起動する
+// gRPC-Webは同じWorkoutManagerを使うgRPCサーバーで処理する
+func newHTTPTestServer(t *testing.T, allowedOrigins ...string) (*httptest.Server, *usecase.WorkoutManager) {
+	t.Helper()
+	manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	manager.SetBodyMeasurementRepository(repository.NewMockBodyMeasurementRepository())
+	if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100}); err != nil {
+		t.Fatalf("CreateWorkout() error = %v", err)
+	}
+	handler, err := NewHTTPHandler(context.Background(), newBufconnClient(t, manager), NewGRPCServer(manager).NewServer(), allowedOrigins, logging.Discard())
+	if err != nil {
+		t.Fatalf("NewHTTPHandler() error = %v", err)
+	}
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return server, manager
+}
+
+// TestHTTPGateway テーブル駆動テストでREST/JSONのルーティングとHTTPステータスの変換をテスト
+func TestHTTPGateway(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantStatus  int
+		wantBody    string // レスポンスに含まれるべき文字列
+		description string
+	}{
+		{
+			name:        "正常系: ワークアウトを取得",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/1",
+			wantStatus:  http.StatusOK,
+			wantBody:    `"exercise_type":"EXERCISE_SQUAT"`,
+			description: "フィールド名はprotoと同じsnake_case",
+		},
+		{
+			name:        "正常系: ワークアウトを作成",
+			method:      http.MethodPost,
+			path:        "/v1/workouts",
+			body:        `{"exercise_type":"EXERCISE_DEADLIFT","sets":3,"reps":5,"weight":140}`,
+			wantStatus:  http.StatusOK,
+			wantBody:    `"id":2`,
+			description: "リクエストボディ全体をCreateWorkoutRequestとして解釈",
+		},
+		{
+			name:        "正常系: 一覧を取得",
+			method:      http.MethodGet,
+			path:        "/v1/workouts?status_filter=WORKOUT_STATUS_PLANNED",
+			wantStatus:  http.StatusOK,
+			wantBody:    `"workouts":[`,
+			description: "クエリパラメータでフィルタを指定",
+		},
+		{
+			name:        "異常系: 存在しないワークアウト",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/999",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    fmt.Sprintf(`"code":%d`, codes.NotFound),
+			description: "domain.ErrNotFound → NotFound → 404",
+		},
+		{
+			name:        "異常系: IDが0",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/0",
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    fmt.Sprintf(`"code":%d`, codes.InvalidArgument),
+			description: "domain.ErrInvalidArgument → InvalidArgument → 400",
+		},
+		{
+			name:        "異常系: int32を超える身体測定のID",
+			method:      http.MethodGet,
+			path:        "/v1/body-measurements/3000000000",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    fmt.Sprintf(`"code":%d`, codes.NotFound),
+			description: "IDはBIGINTと同じint64のため、パスの解析で失敗せず存在しないIDとして扱う",
+		},
+		{
+			name:        "異常系: 存在しないワークアウトを更新",
+			method:      http.MethodPatch,
+			path:        "/v1/workouts/999",
+			body:        `{"exercise_type":"EXERCISE_SQUAT","notes":"updated"}`,
+			wantStatus:  http.StatusNotFound,
+			wantBody:    "ワークアウト更新に失敗しました",
+			description: "失敗した理由（ローカライズ済み）はステータスのmessageに入る",
+		},
+		{
+			name:        "異常系: 存在しないワークアウトを削除",
+			method:      http.MethodDelete,
+			path:        "/v1/workouts/999",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    fmt.Sprintf(`"code":%d`, codes.NotFound),
+			description: "削除の失敗も200ではなく404",
+		},
+		{
+			name:        "異常系: 種目を指定せずに更新",
+			method:      http.MethodPatch,
+			path:        "/v1/workouts/1",
+			body:        `{"notes":"updated"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    fmt.Sprintf(`"code":%d`, codes.InvalidArgument),
+			description: "更新の入力値の検証エラーも400",
+		},
+		{
+			name:        "異常系: 日付の形式が不正",
+			method:      http.MethodGet,
+			path:        "/v1/stats/training?date_from=yesterday",
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    "invalid date_from",
+			description: "解釈できないパラメータは400",
+		},
+		{
+			name:        "異常系: JSONが不正",
+			method:      http.MethodPost,
+			path:        "/v1/workouts",
+			body:        `{"sets":`,
+			wantStatus:  http.StatusBadRequest,
+			description: "ボディを解釈できない場合も同じ形式のエラー",
+		},
+		{
+			name:        "異常系: 存在しないパス",
+			method:      http.MethodGet,
+			path:        "/v1/unknown",
+			wantStatus:  http.StatusNotFound,
+			wantBody:    `"message":"Not Found"`,
+			description: "ルーティングのエラーも同じ形式のエラー",
+		},
+		{
+			name:        "正常系: OpenAPIドキュメント",
+			method:      http.MethodGet,
+			path:        OpenAPIPath,
+			wantStatus:  http.StatusOK,
+			wantBody:    `"/v1/workouts/{id}"`,
+			description: "protoから生成したドキュメントを返す",
+		},
+	}
+
+	server, _ := newHTTPTestServer(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d: %s", tt.wantStatus, resp.StatusCode, body)
+			}
+			if !strings.Contains(string(body), tt.wantBody) {
+				t.Errorf("Expected body to contain %s, got %s", tt.wantBody, body)
+			}
+			if resp.StatusCode >= http.StatusBadRequest {
+				var errBody struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				}
+				if err := json.Unmarshal(body, &errBody); err != nil || errBody.Code == 0 || errBody.Message == "" {
+					t.Errorf("Expected {code, message} error body, got %s (err=%v)", body, err)
+				}
+			}
+		})
+	}
+}
+
+// TestStatusCode テーブル駆動テストでエラーの種類からステータスコードへの変換をテスト
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		want        codes.Code
+		description string
+	}{
+		{
+			name:        "存在しない",
+			err:         fmt.Errorf("failed to get workout: %w", fmt.Errorf("workout %w: id=1", domain.ErrNotFound)),
+			want:        codes.NotFound,
+			description: "ラップされていても判定できる",
+		},
+		{
+			name:        "入力値が不正",
+			err:         fmt.Errorf("%w: weeks must be positive", domain.ErrInvalidArgument),
+			want:        codes.InvalidArgument,
+			description: "バリデーションエラー",
+		},
+		{
+			name:        "実施中でない",
+			err:         usecase.ErrWorkoutNotInProgress,
+			want:        codes.FailedPrecondition,
+			description: "状態を変えてから再実行する",
+		},
+		{
+			name:        "タイムアウト",
+			err:         context.DeadlineExceeded,
+			want:        codes.DeadlineExceeded,
+			description: "コンテキストのエラーはそのまま",
+		},
+		{
+			name:        "変換済み",
+			err:         invalidArgumentError("date_from", fmt.Errorf("expected YYYY-MM-DD")),
+			want:        codes.InvalidArgument,
+			description: "gRPCのエラーはコードを変えない",
+		},
+		{
+			name:        "その他",
+			err:         fmt.Errorf("connection refused"),
+			want:        codes.Internal,
+			description: "分類できないエラーはサーバー側の問題として扱う",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := statusCode(tt.err); got != tt.want {
+				t.Errorf("statusCode() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	icalProductID          = "-//golv2-learning-app//Workout Calendar//JA"
+	icalUIDDomain          = "golv2-learning-app"
+	icalDateLayout         = "20060102"
+	icalUTCLayout          = "20060102T150405Z"
+	icalMaxLineOctets      = 75 // RFC 5545 3.1: 1行の最大オクテット数（改行を除く）
+	defaultWorkoutDuration = 60 * time.Minute
+)
+
+// ExportICalendar 予定のワークアウトをiCalendar（RFC 5545）形式で出力
+func (s *GRPCServer) ExportICalendar(ctx context.Context, req *proto.ExportICalendarRequest) (*proto.ExportICalendarResponse, error) {
+	s.log(ctx).Debug("iCalendarを出力中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo), slog.String("timezone", req.Timezone), slog.String("language", req.Language))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+	locale, err := parseICalLanguage(ctx, req.Language)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.DurationMinutes < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration_minutes: %d", req.DurationMinutes)
+	}
+	duration := defaultWorkoutDuration
+	if req.DurationMinutes > 0 {
+		duration = time.Duration(req.DurationMinutes) * time.Minute
+	}
+
+	workouts, err := s.manager(ctx).ListScheduledWorkouts(usecase.ListScheduledWorkoutsRequest{
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		IncludeDone: req.IncludeDone,
+	})
+	if err != nil {
+		return nil, statusError("failed to list scheduled workouts", err)
+	}
+
+	ics := renderICalendar(workouts, icalOptions{
+		Location:   loc,
+		Locale:     locale,
+		WeightUnit: s.weightUnit(ctx),
+		Duration:   duration,
+		Now:        time.Now(),
+	})
+
+	return &proto.ExportICalendarResponse{
+		Ics:        ics,
+		Filename:   fmt.Sprintf("workouts-%s.ics", time.Now().In(loc).Format(icalDateLayout)),
+		EventCount: int32(len(workouts)),
+		Message:    requestLocale(ctx).Message(i18n.MsgICalExported, len(workouts)),
+	}, nil
+}
+
+// parseICalLanguage 言語指定を解析する（空の場合はリクエストのaccept-language）
+func parseICalLanguage(ctx context.Context, language string) (i18n.Locale, error) {
+	if language == "" {
+		return requestLocale(ctx), nil
+	}
+	return i18n.Parse(language)
+}
+
+// icalOptions iCalendar出力のオプション
+type icalOptions struct {
+	Location   *time.Location    // カレンダー名に付けるタイムゾーン（X-WR-TIMEZONE）
+	Locale     i18n.Locale       // 種目名・説明の言語（空の場合は日本語）
+	WeightUnit domain.WeightUnit // 重量の単位（ゼロ値はkg）
+	Duration   time.Duration     // 時刻指定の予定の長さ
+	Now        time.Time         // DTSTAMPに使用する出力日時
+}
+
+// renderICalendar ワークアウトをVEVENTとしてVCALENDARに出力する（CRLF改行・75オクテットで折り返し）
+func renderICalendar(workouts []*domain.Workout, opts icalOptions) []byte {
+	if opts.Locale == "" {
+		opts.Locale = i18n.DefaultLocale
+	}
+
+	var buf bytes.Buffer
+	writeICalLine(&buf, "BEGIN:VCALENDAR")
+	writeICalLine(&buf, "VERSION:2.0")
+	writeICalLine(&buf, "PRODID:"+icalProductID)
+	writeICalLine(&buf, "CALSCALE:GREGORIAN")
+	writeICalLine(&buf, "METHOD:PUBLISH")
+	writeICalLine(&buf, "X-WR-CALNAME:"+escapeICalText(opts.Locale.Message(i18n.MsgICalCalendarName)))
+	if opts.Location != nil {
+		writeICalLine(&buf, "X-WR-TIMEZONE:"+opts.Location.String())
+	}
+	for _, w := range workouts {
+		if w.ScheduledFor == nil {
+			continue
+		}
+		writeICalEvent(&buf, w, opts)
+	}
+	writeICalLine(&buf, "END:VCALENDAR")
+	return buf.Bytes()
+}
+
+// writeICalEvent ワークアウト1件をVEVENTとして出力する
+// UIDはワークアウトIDから決まるため、再インポート時は重複せずに既存の予定が更新される
+func writeICalEvent(buf *bytes.Buffer, w *domain.Workout, opts icalOptions) {
+	writeICalLine(buf, "BEGIN:VEVENT")
+	writeICalLine(buf, "UID:"+icalUID(w.ID))
+	writeICalLine(buf, "DTSTAMP:"+opts.Now.UTC().Format(icalUTCLayout))
+
+	// 日付のみの予定（予定のタイムゾーンで0時）は終日の予定にする
+	start := w.ScheduledFor.In(w.ScheduledLocation(opts.Location))
+	if start.Equal(domain.StatsPeriodDay.BucketStart(start)) {
+		writeICalLine(buf, "DTSTART;VALUE=DATE:"+start.Format(icalDateLayout))
+		writeICalLine(buf, "DTEND;VALUE=DATE:"+start.AddDate(0, 0, 1).Format(icalDateLayout))
+	} else {
+		writeICalLine(buf, "DTSTART:"+start.UTC().Format(icalUTCLayout))
+		writeICalLine(buf, "DTEND:"+start.Add(opts.Duration).UTC().Format(icalUTCLayout))
+	}
+
+	if !w.UpdatedAt.IsZero() {
+		writeICalLine(buf, "LAST-MODIFIED:"+w.UpdatedAt.UTC().Format(icalUTCLayout))
+		// 更新のたびに増える値として作成からの経過秒数を使う（カレンダーアプリが変更を検知できるように）
+		if seq := int64(w.UpdatedAt.Sub(w.CreatedAt) / time.Second); seq > 0 && !w.CreatedAt.IsZero() {
+			writeICalLine(buf, fmt.Sprintf("SEQUENCE:%d", seq))
+		}
+	}
+
+	writeICalLine(buf, "SUMMARY:"+escapeICalText(icalSummary(w, opts.Locale, opts.WeightUnit)))
+	writeICalLine(buf, "DESCRIPTION:"+escapeICalText(icalDescription(w, opts.Locale, opts.WeightUnit)))
+	if w.Status == domain.WorkoutStatusSkipped {
+		writeICalLine(buf, "STATUS:CANCELLED")
+	} else {
+		writeICalLine(buf, "STATUS:CONFIRMED")
+	}
+	writeICalLine(buf, "TRANSP:OPAQUE")
+	writeICalLine(buf, "END:VEVENT")
+}
+
+// icalUID ワークアウトIDから常に同じUIDを生成する
+func icalUID(id domain.WorkoutID) string {
+	return fmt.Sprintf("workout-%d@%s", id, icalUIDDomain)
+}
+
+// icalSummary 予定のタイトル（例: "ベンチプレス 3×10 60.0kg"）
+func icalSummary(w *domain.Workout, locale i18n.Locale, unit domain.WeightUnit) string {
+	summary := locale.ExerciseType(w.ExerciseType)
+	if w.Sets > 0 && w.Reps > 0 {
+		summary += fmt.Sprintf(" %d×%d", w.Sets, w.Reps)
+	}
+	if w.Weight > 0 {
+		summary += fmt.Sprintf(" %.1f%s", w.PlateWeightIn(unit), unit.Key())
+	}
+	if w.Status == domain.WorkoutStatusCompleted {
+		summary = "✅ " + summary
+	}
+	return summary
+}
+
+// icalDescription 予定の説明（セット数・レップ数・重量・メモ）
+func icalDescription(w *domain.Workout, locale i18n.Locale, unit domain.WeightUnit) string {
+	lines := []string{
+		fmt.Sprintf("%s: %d", locale.Message(i18n.MsgICalSets), w.Sets),
+		fmt.Sprintf("%s: %d", locale.Message(i18n.MsgICalReps), w.Reps),
+		fmt.Sprintf("%s: %.1f%s", locale.Message(i18n.MsgICalWeight), w.PlateWeightIn(unit), unit.Key()),
+	}
+	if w.Description != "" {
+		lines = append(lines, "", w.Description)
+	}
+	if w.Notes != "" {
+		lines = append(lines, fmt.Sprintf("%s: %s", locale.Message(i18n.MsgICalNotes), w.Notes))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// icalTextEscaper TEXT型の値でエスケープが必要な文字（RFC 5545 3.3.11）
+var icalTextEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+)
+
+// escapeICalText TEXT型の値をエスケープする
+func escapeICalText(text string) string {
+	return icalTextEscaper.Replace(text)
+}
+
+// writeICalLine 1行を出力する（75オクテットを超える場合はUTF-8の文字境界で折り返す）
+func writeICalLine(buf *bytes.Buffer, line string) {
+	limit := icalMaxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = icalMaxLineOctets - 1 // 継続行は先頭の空白を含めて75オクテット
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+)
+
+// TestRenderICalendar テーブル駆動テストでiCalendarの出力をテスト
+func TestRenderICalendar(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+	timed := time.Date(2024, 1, 2, 18, 30, 0, 0, tokyo)
+	allDay := time.Date(2024, 1, 3, 0, 0, 0, 0, tokyo)
+
+	tests := []struct {
+		name        string
+		workout     *domain.Workout
+		locale      i18n.Locale
+		wantLines   []string
+		description string
+	}{
+		{
+			name: "正常系: 時刻指定の予定",
+			workout: &domain.Workout{
+				ID: 12, ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusPlanned,
+				Sets: 3, Reps: 10, Weight: 60, ScheduledFor: &timed, ScheduledTimezone: "Asia/Tokyo",
+				CreatedAt: created, UpdatedAt: created.Add(90 * time.Second),
+			},
+			wantLines: []string{
+				"UID:workout-12@golv2-learning-app",
+				"DTSTAMP:20240101T000000Z",
+				"DTSTART:20240102T093000Z",
+				"DTEND:20240102T103000Z",
+				"SEQUENCE:90",
+				"SUMMARY:ベンチプレス 3×10 60.0kg",
+				`DESCRIPTION:セット数: 3\nレップ数: 10\n重量: 60.0kg`,
+				"STATUS:CONFIRMED",
+			},
+			description: "UTCで出力し、作成からの経過秒数をSEQUENCEにする",
+		},
+		{
+			name: "正常系: 日付のみの予定は終日",
+			workout: &domain.Workout{
+				ID: 13, ExerciseType: domain.Squat, Status: domain.WorkoutStatusSkipped,
+				Sets: 5, Reps: 5, Weight: 100, ScheduledFor: &allDay, ScheduledTimezone: "Asia/Tokyo",
+				Notes: "膝が痛い; 様子見, 無理しない",
+			},
+			locale: i18n.English,
+			wantLines: []string{
+				"DTSTART;VALUE=DATE:20240103",
+				"DTEND;VALUE=DATE:20240104",
+				"SUMMARY:Squat 5×5 100.0kg",
+				`DESCRIPTION:Sets: 5\nReps: 5\nWeight: 100.0kg\nNotes: 膝が痛い\; 様子見\, 無理しない`,
+				"STATUS:CANCELLED",
+			},
+			description: "予定のタイムゾーンで0時なら終日、スキップはCANCELLED、英語表記とエスケープ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ics := renderICalendar([]*domain.Workout{tt.workout}, icalOptions{
+				Location: tokyo,
+				Locale:   tt.locale,
+				Duration: defaultWorkoutDuration,
+				Now:      now,
+			})
+
+			if !bytes.HasPrefix(ics, []byte("BEGIN:VCALENDAR\r\nVERSION:2.0\r\n")) || !bytes.HasSuffix(ics, []byte("END:VCALENDAR\r\n")) {
+				t.Fatalf("Expected VCALENDAR with CRLF line endings, got %q", ics)
+			}
+			// 折り返しを戻してから行単位で比較する
+			lines := strings.Split(strings.ReplaceAll(string(ics), "\r\n ", ""), "\r\n")
+			for _, want := range tt.wantLines {
+				found := false
+				for _, line := range lines {
+					if line == want {
+						found = true
+						break
+					}
+				}
+				if !found {
+					t.Errorf("Expected line %q in:\n%s", want, ics)
+				}
+			}
+
+			// 同じワークアウトは何度出力しても同じUIDになる
+			again := renderICalendar([]*domain.Workout{tt.workout}, icalOptions{Location: tokyo, Duration: defaultWorkoutDuration, Now: now.Add(time.Hour)})
+			if !bytes.Contains(again, []byte("UID:"+icalUID(tt.workout.ID)+"\r\n")) {
+				t.Errorf("Expected stable UID %s", icalUID(tt.workout.ID))
+			}
+		})
+	}
+}
+
+// TestWriteICalLine 75オクテットでの折り返しをテスト
+func TestWriteICalLine(t *testing.T) {
+	tests := []struct {
+		name        string
+		line        string
+		wantLines   int
+		description string
+	}{
+		{
+			name:        "正常系: 短い行",
+			line:        "SUMMARY:Squat",
+			wantLines:   1,
+			description: "折り返さない",
+		},
+		{
+			name:        "正常系: ASCIIの長い行",
+			line:        "DESCRIPTION:" + strings.Repeat("a", 150),
+			wantLines:   3,
+			description: "75オクテット、継続行は空白を含めて75オクテット",
+		},
+		{
+			name:        "正常系: マルチバイト文字",
+			line:        "SUMMARY:" + strings.Repeat("ベンチプレス", 10),
+			wantLines:   3,
+			description: "UTF-8の文字の途中では折り返さない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writeICalLine(&buf, tt.line)
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+			if len(lines) != tt.wantLines {
+				t.Errorf("Expected %d lines, got %d: %q", tt.wantLines, len(lines), lines)
+			}
+			for i, line := range lines {
+				if len(line) > icalMaxLineOctets {
+					t.Errorf("Line %d exceeds %d octets: %d", i, icalMaxLineOctets, len(line))
+				}
+				if i > 0 && !strings.HasPrefix(line, " ") {
+					t.Errorf("Continuation line %d must start with a space: %q", i, line)
+				}
+				if !utf8.ValidString(line) {
+					t.Errorf("Line %d splits a UTF-8 character: %q", i, line)
+				}
+			}
+			if unfolded := strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n ", ""); unfolded != tt.line {
+				t.Errorf("Unfolded line mismatch: got %q, want %q", unfolded, tt.line)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+	"golv2-learning-app/usecase/importer"
+	"golv2-learning-app/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxImportBytes 1回のインポートで受け付けるファイルの最大サイズ
+const maxImportBytes = 32 << 20
+
+// ImportWorkouts ファイルの内容をストリームで受け取り、ワークアウトを一括インポート
+// メッセージごとにprotoの制約を検証し、違反があればINVALID_ARGUMENTで終了する
+// ファイル全体を処理できない場合もエラーのステータスで終了し、レスポンスでは行ごとのエラーのみを返す
+func (s *GRPCServer) ImportWorkouts(stream proto.WorkoutService_ImportWorkoutsServer) error {
+	locale := requestLocale(stream.Context())
+	var (
+		options *proto.ImportOptions
+		data    bytes.Buffer
+	)
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := validation.Validate(req); err != nil {
+			return validationStatus(err)
+		}
+
+		switch payload := req.Payload.(type) {
+		case *proto.ImportWorkoutsRequest_Options:
+			if options != nil || data.Len() > 0 {
+				return status.Error(codes.InvalidArgument, locale.Message(i18n.MsgImportOptionsLate))
+			}
+			options = payload.Options
+		case *proto.ImportWorkoutsRequest_Chunk:
+			if data.Len()+len(payload.Chunk) > maxImportBytes {
+				return status.Error(codes.InvalidArgument, locale.Message(i18n.MsgImportTooLarge, maxImportBytes>>20))
+			}
+			data.Write(payload.Chunk)
+		}
+	}
+	if options == nil {
+		options = &proto.ImportOptions{}
+	}
+	s.log(stream.Context()).Debug("インポート中", slog.String("format", options.Format.String()), slog.Int("bytes", data.Len()), slog.Bool("dry_run", options.DryRun))
+
+	loc, err := s.resolveLocation(options.Timezone)
+	if err != nil {
+		return invalidArgumentError("timezone", err)
+	}
+
+	result, err := s.manager(stream.Context()).ImportWorkouts(usecase.ImportWorkoutsRequest{
+		Data:          &data,
+		Format:        convertProtoImportFormat(options.Format),
+		ColumnMapping: options.ColumnMapping,
+		Location:      loc,
+		DryRun:        options.DryRun,
+	})
+	if err != nil {
+		return statusError("failed to import workouts", err)
+	}
+
+	return stream.SendAndClose(&proto.ImportWorkoutsResponse{
+		TotalRows:      int32(result.TotalRows),
+		ImportedCount:  int32(len(result.Workouts)),
+		Errors:         convertToProtoImportRowErrors(result.Errors),
+		DryRun:         result.DryRun,
+		Committed:      result.Committed,
+		Message:        importMessage(locale, result),
+		Skipped:        convertToProtoImportRowErrors(result.Skipped),
+		DuplicateCount: int32(result.Duplicates),
+	})
+}
+
+// convertToProtoImportRowErrors 行ごとのエラーをprotoに変換
+func convertToProtoImportRowErrors(rowErrors []*importer.RowError) []*proto.ImportRowError {
+	converted := make([]*proto.ImportRowError, 0, len(rowErrors))
+	for _, e := range rowErrors {
+		converted = append(converted, &proto.ImportRowError{Line: int32(e.Line), Message: e.Err.Error()})
+	}
+	return converted
+}
+
+// importMessage インポート結果のメッセージ
+func importMessage(locale i18n.Locale, result *usecase.ImportWorkoutsResult) string {
+	switch {
+	case len(result.Errors) > 0:
+		return locale.Message(i18n.MsgImportRejected, result.TotalRows, len(result.Errors))
+	case result.DryRun:
+		return locale.Message(i18n.MsgImportDryRun, len(result.Workouts), importExclusions(locale, result))
+	default:
+		return locale.Message(i18n.MsgImportDone, len(result.Workouts), importExclusions(locale, result))
+	}
+}
+
+// importExclusions 取り込まなかった件数の補足（なければ空）
+func importExclusions(locale i18n.Locale, result *usecase.ImportWorkoutsResult) string {
+	if result.Duplicates == 0 && len(result.Skipped) == 0 {
+		return ""
+	}
+	return locale.Message(i18n.MsgImportExclusions, result.Duplicates, len(result.Skipped))
+}
+
+// convertProtoImportFormat protoのImportFormatをimporter.Formatに変換
+func convertProtoImportFormat(format proto.ImportFormat) importer.Format {
+	switch format {
+	case proto.ImportFormat_IMPORT_FORMAT_JSONL:
+		return importer.FormatJSONL
+	case proto.ImportFormat_IMPORT_FORMAT_STRONG:
+		return importer.FormatStrong
+	case proto.ImportFormat_IMPORT_FORMAT_HEVY:
+		return importer.FormatHevy
+	case proto.ImportFormat_IMPORT_FORMAT_FITNOTES:
+		return importer.FormatFitNotes
+	default:
+		return importer.FormatCSV
+	}
+}
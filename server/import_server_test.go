@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestImportWorkouts_Validation インポートのメッセージごとの制約の検証とエラーのステータスをテスト
+func TestImportWorkouts_Validation(t *testing.T) {
+	validRow := `{"exercise_type":2,"sets":5,"reps":5,"weight":100}` + "\n"
+
+	tests := []struct {
+		name        string
+		options     *proto.ImportOptions
+		data        string
+		optionsLast bool // オプションをファイルの内容の後に送信する
+		wantCode    codes.Code
+		wantErrors  int // レスポンスで返す行ごとのエラーの件数
+		description string
+	}{
+		{
+			name:        "正常系: 制約を満たすオプション",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat_IMPORT_FORMAT_JSONL, Timezone: "Asia/Tokyo", DryRun: true},
+			data:        validRow,
+			wantCode:    codes.OK,
+			description: "検証を通ればインポートする",
+		},
+		{
+			name:        "正常系: 行ごとのエラー",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat_IMPORT_FORMAT_JSONL},
+			data:        validRow + `{"exercise_type":2,"sets":0,"reps":5,"weight":100}` + "\n",
+			wantCode:    codes.OK,
+			wantErrors:  1,
+			description: "行ごとのエラーはステータスOKのレスポンスで返す",
+		},
+		{
+			name:        "異常系: 定義されていない形式",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat(99)},
+			data:        validRow,
+			wantCode:    codes.InvalidArgument,
+			description: "古いクライアント・誤った数値の形式は拒否する",
+		},
+		{
+			name:        "異常系: タイムゾーンが長すぎる",
+			options:     &proto.ImportOptions{Timezone: string(make([]byte, 65))},
+			data:        validRow,
+			wantCode:    codes.InvalidArgument,
+			description: "タイムゾーンは64文字まで",
+		},
+		{
+			name:        "異常系: 不明なタイムゾーン",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat_IMPORT_FORMAT_JSONL, Timezone: "Mars/Olympus"},
+			data:        validRow,
+			wantCode:    codes.InvalidArgument,
+			description: "ステータスOKのレスポンスではなくINVALID_ARGUMENTで返す",
+		},
+		{
+			name:        "異常系: ファイルを解析できない",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat_IMPORT_FORMAT_CSV},
+			data:        "sets,reps\n5,5\n",
+			wantCode:    codes.InvalidArgument,
+			description: "必須の列がないなどファイル全体の誤りはINVALID_ARGUMENT",
+		},
+		{
+			name:        "異常系: オプションが後から送信された",
+			options:     &proto.ImportOptions{Format: proto.ImportFormat_IMPORT_FORMAT_JSONL},
+			data:        validRow,
+			optionsLast: true,
+			wantCode:    codes.InvalidArgument,
+			description: "オプションは最初のメッセージで送信する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository()))
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			stream, err := client.ImportWorkouts(ctx)
+			if err != nil {
+				t.Fatalf("ImportWorkouts() error = %v", err)
+			}
+			messages := []*proto.ImportWorkoutsRequest{
+				{Payload: &proto.ImportWorkoutsRequest_Options{Options: tt.options}},
+				{Payload: &proto.ImportWorkoutsRequest_Chunk{Chunk: []byte(tt.data)}},
+			}
+			if tt.optionsLast {
+				messages[0], messages[1] = messages[1], messages[0]
+			}
+			for _, msg := range messages {
+				if err := stream.Send(msg); err != nil {
+					t.Fatalf("Send() error = %v", err)
+				}
+			}
+			resp, err := stream.CloseAndRecv()
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("Expected %s, got %v", tt.wantCode, err)
+			}
+			if err == nil && len(resp.Errors) != tt.wantErrors {
+				t.Errorf("Expected %d row errors, got %v", tt.wantErrors, resp.Errors)
+			}
+		})
+	}
+}
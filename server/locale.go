@@ -0,0 +1,28 @@
+package server
+
+import (
+	"context"
+
+	"golv2-learning-app/i18n"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// localeMetadataKeys 言語を指定するメタデータのキー（優先順）
+// REST/JSONゲートウェイ経由の場合、Accept-Languageヘッダーは "grpcgateway-" の接頭辞付きで渡される
+var localeMetadataKeys = []string{"accept-language", "grpcgateway-accept-language"}
+
+// requestLocale リクエストのメタデータ accept-language から応答の言語を決める
+// 指定がない場合や未対応の言語のみの場合は日本語
+func requestLocale(ctx context.Context) i18n.Locale {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return i18n.DefaultLocale
+	}
+	for _, key := range localeMetadataKeys {
+		if values := md.Get(key); len(values) > 0 {
+			return i18n.ParseAcceptLanguage(values[0])
+		}
+	}
+	return i18n.DefaultLocale
+}
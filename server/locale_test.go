@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// TestRequestLocale テーブル駆動テストでaccept-languageによるメッセージ・表示名の言語の切り替えをテスト
+func TestRequestLocale(t *testing.T) {
+	tests := []struct {
+		name            string
+		acceptLanguage  string
+		wantMessage     string
+		wantDisplayName *proto.WorkoutDisplayName
+		description     string
+	}{
+		{
+			name:        "正常系: 未指定",
+			wantMessage: "🎉 ワークアウト「スクワット」が作成されました！",
+			wantDisplayName: &proto.WorkoutDisplayName{
+				ExerciseType: "スクワット", MuscleGroup: "脚", Difficulty: "上級者", Status: "予定",
+			},
+			description: "指定がなければ日本語",
+		},
+		{
+			name:           "正常系: 英語",
+			acceptLanguage: "en-US,en;q=0.9,ja;q=0.8",
+			wantMessage:    "🎉 Workout \"Squat\" created!",
+			wantDisplayName: &proto.WorkoutDisplayName{
+				ExerciseType: "Squat", MuscleGroup: "Legs", Difficulty: "Advanced", Status: "Planned",
+			},
+			description: "メッセージ・表示名とも英語",
+		},
+		{
+			name:           "異常系: 未対応の言語",
+			acceptLanguage: "fr",
+			wantMessage:    "🎉 ワークアウト「スクワット」が作成されました！",
+			wantDisplayName: &proto.WorkoutDisplayName{
+				ExerciseType: "スクワット", MuscleGroup: "脚", Difficulty: "上級者", Status: "予定",
+			},
+			description: "対応している言語がなければ日本語",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newBufconnClient(t, usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository()))
+			ctx := context.Background()
+			if tt.acceptLanguage != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", tt.acceptLanguage)
+			}
+
+			resp, err := client.CreateWorkout(ctx, &proto.CreateWorkoutRequest{
+				ExerciseType: proto.ExerciseType_EXERCISE_SQUAT,
+				Difficulty:   proto.Difficulty_DIFFICULTY_ADVANCED,
+				MuscleGroup:  proto.MuscleGroup_LEGS,
+			})
+			if err != nil {
+				t.Fatalf("CreateWorkout() error = %v", err)
+			}
+			if resp.Message != tt.wantMessage {
+				t.Errorf("Expected message %q, got %q", tt.wantMessage, resp.Message)
+			}
+			got := resp.GetWorkout().GetDisplayName()
+			if got.GetExerciseType() != tt.wantDisplayName.ExerciseType || got.GetMuscleGroup() != tt.wantDisplayName.MuscleGroup ||
+				got.GetDifficulty() != tt.wantDisplayName.Difficulty || got.GetStatus() != tt.wantDisplayName.Status || got.GetSkipReason() != "" {
+				t.Errorf("Expected display name %v, got %v", tt.wantDisplayName, got)
+			}
+		})
+	}
+}
+
+// TestHTTPGateway_AcceptLanguage REST/JSONではAccept-Languageヘッダーで言語を指定できることをテスト
+func TestHTTPGateway_AcceptLanguage(t *testing.T) {
+	server, _ := newHTTPTestServer(t)
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/workouts", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("Accept-Language", "en")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		Workouts []struct {
+			DisplayName struct {
+				ExerciseType string `json:"exercise_type"`
+			} `json:"display_name"`
+		} `json:"workouts"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !strings.HasPrefix(body.Message, "📋 Workouts (1):") {
+		t.Errorf("Expected English summary, got %q", body.Message)
+	}
+	if len(body.Workouts) != 1 || body.Workouts[0].DisplayName.ExerciseType != "Squat" {
+		t.Errorf("Expected display_name Squat, got %+v", body.Workouts)
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader リクエストIDを受け渡すメタデータ（HTTPヘッダー）のキー
+// クライアントが指定しない場合はサーバーで生成し、レスポンスヘッダーで返す
+const RequestIDHeader = "x-request-id"
+
+// unaryLoggingInterceptor リクエストIDとメソッド名を付与したロガーをコンテキストに保存し、
+// 処理が終わったらステータスコードと処理時間をログに出力する
+func (s *GRPCServer) unaryLoggingInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, logger := s.requestLogger(ctx, info.FullMethod)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	logRPC(ctx, logger, start, err)
+	return resp, err
+}
+
+// streamLoggingInterceptor ストリーミングRPC用のunaryLoggingInterceptor
+func (s *GRPCServer) streamLoggingInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, logger := s.requestLogger(stream.Context(), info.FullMethod)
+	start := time.Now()
+	err := handler(srv, &loggingServerStream{ServerStream: stream, ctx: ctx})
+	logRPC(ctx, logger, start, err)
+	return err
+}
+
+// requestLogger リクエストIDを決めてレスポンスヘッダーに設定し、属性を付与したロガーをコンテキストに保存する
+func (s *GRPCServer) requestLogger(ctx context.Context, method string) (context.Context, *slog.Logger) {
+	requestID := incomingRequestID(ctx)
+	// レスポンスヘッダーを送信済みなどで設定できなくても処理は続ける
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, requestID))
+
+	logger := s.logger.With(slog.String(logging.KeyRequestID, requestID), slog.String(logging.KeyMethod, method))
+	// トレースを記録している場合は、ログからトレースを探せるようにトレースIDも付与する
+	if spanContext := trace.SpanContextFromContext(ctx); spanContext.IsSampled() {
+		logger = logger.With(slog.String(logging.KeyTraceID, spanContext.TraceID().String()))
+	}
+	return logging.NewContext(ctx, logger), logger
+}
+
+// incomingRequestID クライアントが指定したリクエストID（なければ新たに生成）
+func incomingRequestID(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDHeader); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return newRequestID()
+}
+
+// newRequestID ランダムな16桁の16進数のリクエストID
+func newRequestID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	return hex.EncodeToString(b)
+}
+
+// logRPC RPCの結果を出力する。サーバー側の問題（Internalなど）はError、それ以外はInfo
+func logRPC(ctx context.Context, logger *slog.Logger, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.Unavailable:
+		level = slog.LevelError
+	}
+	attrs := []slog.Attr{
+		slog.String("code", code.String()),
+		slog.Duration(logging.KeyDuration, time.Since(start)),
+	}
+	if err != nil {
+		attrs = append(attrs, slog.String(logging.KeyError, status.Convert(err).Message()))
+	}
+	logger.LogAttrs(ctx, level, "RPCを処理しました", attrs...)
+}
+
+// loggingServerStream Contextだけを差し替えたServerStream
+type loggingServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *loggingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// manager リクエストのコンテキスト（属性を付与したロガー・RPCのスパン）で処理するWorkoutManagerを返す
+func (s *GRPCServer) manager(ctx context.Context) *usecase.WorkoutManager {
+	return s.workoutManager.WithContext(ctx)
+}
+
+// log リクエストの属性を付与したロガーを返す（インターセプターを通らない呼び出しではサーバーのロガー）
+func (s *GRPCServer) log(ctx context.Context) *slog.Logger {
+	if logger, ok := logging.FromContext(ctx); ok {
+		return logger
+	}
+	return s.logger
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// syncBuffer 複数のゴルーチンから書き込まれるログを受け取るバッファ
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// entries 出力されたJSONログを1行ずつ解析する
+func (b *syncBuffer) entries(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("Unmarshal() error = %v: %s", err, line)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// TestLoggingInterceptor テーブル駆動テストでリクエストIDの付与とRPCのログをテスト
+func TestLoggingInterceptor(t *testing.T) {
+	tests := []struct {
+		name          string
+		requestID     string // クライアントが指定するリクエストID（空ならサーバーで生成）
+		call          func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error
+		wantMethod    string
+		wantCode      string
+		wantWorkoutID bool // ユースケース層のログにworkout_idが出力されるか
+		description   string
+	}{
+		{
+			name:          "正常系: リクエストIDを指定",
+			requestID:     "req-123",
+			call:          completeWorkout(1),
+			wantMethod:    proto.WorkoutService_UpdateWorkout_FullMethodName,
+			wantCode:      "OK",
+			wantWorkoutID: true,
+			description:   "指定したIDがユースケース層のログまで引き継がれる",
+		},
+		{
+			name:          "正常系: リクエストIDを生成",
+			call:          completeWorkout(1),
+			wantMethod:    proto.WorkoutService_UpdateWorkout_FullMethodName,
+			wantCode:      "OK",
+			wantWorkoutID: true,
+			description:   "指定がなければサーバーで生成してレスポンスヘッダーで返す",
+		},
+		{
+			name:      "異常系: 存在しないワークアウト",
+			requestID: "req-404",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+				_, err := client.GetWorkout(ctx, &proto.GetWorkoutRequest{Id: 999}, opts...)
+				return err
+			},
+			wantMethod:  proto.WorkoutService_GetWorkout_FullMethodName,
+			wantCode:    "NotFound",
+			description: "エラーもステータスコード付きで出力される",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf syncBuffer
+			logger, err := logging.New(&buf, "debug", "json")
+			if err != nil {
+				t.Fatalf("logging.New() error = %v", err)
+			}
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat}); err != nil {
+				t.Fatalf("CreateWorkout() error = %v", err)
+			}
+			server := NewGRPCServer(manager)
+			server.SetLogger(logger)
+			client := newBufconnServerClient(t, server)
+
+			ctx := context.Background()
+			if tt.requestID != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, tt.requestID)
+			}
+			var header metadata.MD
+			_ = tt.call(ctx, client, grpc.Header(&header))
+
+			requestID := tt.requestID
+			if got := header.Get(RequestIDHeader); len(got) != 1 || got[0] == "" {
+				t.Fatalf("Expected %s response header, got %v", RequestIDHeader, got)
+			} else if requestID == "" {
+				requestID = got[0]
+			} else if got[0] != requestID {
+				t.Errorf("Expected response header %s, got %s", requestID, got[0])
+			}
+
+			var rpcLogged, workoutLogged bool
+			for _, entry := range buf.entries(t) {
+				if entry[logging.KeyRequestID] != requestID {
+					t.Errorf("Expected request_id %s on every entry, got %v", requestID, entry)
+				}
+				if entry["msg"] == "RPCを処理しました" {
+					rpcLogged = true
+					if entry["code"] != tt.wantCode || entry[logging.KeyMethod] != tt.wantMethod {
+						t.Errorf("Expected code %s for %s, got %v", tt.wantCode, tt.wantMethod, entry)
+					}
+					if _, ok := entry[logging.KeyDuration]; !ok {
+						t.Errorf("Expected duration attribute, got %v", entry)
+					}
+				}
+				if entry[logging.KeyOp] == "UpdateWorkout" && entry[logging.KeyWorkoutID] == float64(1) {
+					workoutLogged = true
+				}
+			}
+			if !rpcLogged {
+				t.Error("Expected the interceptor to log the RPC")
+			}
+			if workoutLogged != tt.wantWorkoutID {
+				t.Errorf("Expected usecase log with workout_id = %t, got %t", tt.wantWorkoutID, workoutLogged)
+			}
+		})
+	}
+}
+
+// completeWorkout ワークアウトを完了にするUpdateWorkoutの呼び出し
+func completeWorkout(id int32) func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+	return func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+		_, err := client.UpdateWorkout(ctx, &proto.UpdateWorkoutRequest{
+			Id:           id,
+			ExerciseType: proto.ExerciseType_EXERCISE_SQUAT,
+			Status:       proto.WorkoutStatus_WORKOUT_STATUS_COMPLETED,
+		}, opts...)
+		return err
+	}
+}
+
+// TestHTTPGateway_RequestID REST/JSONでもX-Request-Idヘッダーでリクエストを追跡できることをテスト
+func TestHTTPGateway_RequestID(t *testing.T) {
+	server, _ := newHTTPTestServer(t)
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/v1/workouts/1", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("X-Request-Id", "req-http")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	resp.Body.Close()
+
+	if got := resp.Header.Get("X-Request-Id"); got != "req-http" {
+		t.Errorf("Expected X-Request-Id req-http, got %q", got)
+	}
+}
@@ -0,0 +1,60 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPCの種類（grpc_typeラベル）
+const (
+	rpcTypeUnary        = "unary"
+	rpcTypeClientStream = "client_stream"
+	rpcTypeServerStream = "server_stream"
+	rpcTypeBidiStream   = "bidi_stream"
+)
+
+// unaryMetricsInterceptor RPCの件数・ステータスコード・処理時間をメトリクスに記録する
+func (s *GRPCServer) unaryMetricsInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	service, method := splitFullMethod(info.FullMethod)
+	s.metrics.RPCStarted(rpcTypeUnary, service, method)
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	s.metrics.RPCHandled(rpcTypeUnary, service, method, status.Code(err).String(), time.Since(start))
+	return resp, err
+}
+
+// streamMetricsInterceptor ストリーミングRPC用のunaryMetricsInterceptor（処理時間はストリームの開始から終了まで）
+func (s *GRPCServer) streamMetricsInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	service, method := splitFullMethod(info.FullMethod)
+	rpcType := streamRPCType(info)
+	s.metrics.RPCStarted(rpcType, service, method)
+	start := time.Now()
+	err := handler(srv, stream)
+	s.metrics.RPCHandled(rpcType, service, method, status.Code(err).String(), time.Since(start))
+	return err
+}
+
+// splitFullMethod "/workout.WorkoutService/CreateWorkout" をサービス名とメソッド名に分ける
+func splitFullMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+// streamRPCType ストリーミングRPCの種類
+func streamRPCType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return rpcTypeBidiStream
+	case info.IsClientStream:
+		return rpcTypeClientStream
+	default:
+		return rpcTypeServerStream
+	}
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/metrics"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc"
+)
+
+// TestMetricsInterceptor テーブル駆動テストでRPCとワークアウトの件数のメトリクスをテスト
+func TestMetricsInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error
+		wantLines   []string
+		description string
+	}{
+		{
+			name: "正常系: 作成",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+				_, err := client.CreateWorkout(ctx, &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_BENCH_PRESS}, opts...)
+				return err
+			},
+			wantLines: []string{
+				`grpc_server_handled_total{grpc_code="OK",grpc_method="CreateWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+				`golv2_workouts_created_total 2`,
+			},
+			description: "作成件数は事前に作成した1件を含む",
+		},
+		{
+			name: "正常系: 完了",
+			call: completeWorkout(1),
+			wantLines: []string{
+				`grpc_server_handled_total{grpc_code="OK",grpc_method="UpdateWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+				`golv2_workouts_completed_total 1`,
+			},
+			description: "ステータスが完了に変わると完了件数が増える",
+		},
+		{
+			name: "異常系: 存在しないワークアウト",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+				_, err := client.GetWorkout(ctx, &proto.GetWorkoutRequest{Id: 999}, opts...)
+				return err
+			},
+			wantLines: []string{
+				`grpc_server_handled_total{grpc_code="NotFound",grpc_method="GetWorkout",grpc_service="workout.WorkoutService",grpc_type="unary"} 1`,
+			},
+			description: "エラーのステータスコードごとに数える",
+		},
+		{
+			name: "正常系: サーバーストリーミング",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient, opts ...grpc.CallOption) error {
+				stream, err := client.ExportWorkouts(ctx, &proto.ExportWorkoutsRequest{}, opts...)
+				if err != nil {
+					return err
+				}
+				for {
+					if _, err := stream.Recv(); errors.Is(err, io.EOF) {
+						return nil
+					} else if err != nil {
+						return err
+					}
+				}
+			},
+			wantLines: []string{
+				`grpc_server_started_total{grpc_method="ExportWorkouts",grpc_service="workout.WorkoutService",grpc_type="server_stream"} 1`,
+				`grpc_server_handled_total{grpc_code="OK",grpc_method="ExportWorkouts",grpc_service="workout.WorkoutService",grpc_type="server_stream"} 1`,
+			},
+			description: "ストリーミングRPCは種類を区別して記録する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := metrics.New()
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			manager.SetMetrics(m)
+			if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat}); err != nil {
+				t.Fatalf("CreateWorkout() error = %v", err)
+			}
+			server := NewGRPCServer(manager)
+			server.SetMetrics(m)
+			client := newBufconnServerClient(t, server)
+
+			_ = tt.call(context.Background(), client)
+
+			rec := httptest.NewRecorder()
+			m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metrics.Path, nil))
+			body := rec.Body.String()
+			for _, want := range tt.wantLines {
+				if !strings.Contains(body, want+"\n") {
+					t.Errorf("Expected line %q in:\n%s", want, body)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+	"golv2-learning-app/usecase/plates"
+)
+
+// CalculatePlates バーベル種目で目標重量にするために片側に付けるプレートを計算
+func (s *GRPCServer) CalculatePlates(ctx context.Context, req *proto.CalculatePlatesRequest) (*proto.CalculatePlatesResponse, error) {
+	s.log(ctx).Debug("プレートの組み合わせを計算中", slog.Float64("target_weight", req.TargetWeight))
+
+	warmupPercents := req.WarmupPercents
+	if len(warmupPercents) == 0 && req.IncludeWarmups {
+		warmupPercents = plates.DefaultWarmupPercents
+	}
+	inventory := make([]domain.Plate, 0, len(req.Plates))
+	for _, p := range req.Plates {
+		inventory = append(inventory, domain.Plate{Weight: p.Weight, Count: int(p.Count)})
+	}
+
+	calculation, err := s.manager(ctx).CalculatePlates(usecase.CalculatePlatesRequest{
+		ExerciseType:   convertProtoExerciseType(req.ExerciseType),
+		TargetWeight:   req.TargetWeight,
+		WeightUnit:     s.inputWeightUnit(ctx, req.WeightUnit),
+		BarWeight:      req.BarWeight,
+		Plates:         inventory,
+		WarmupPercents: warmupPercents,
+	})
+	if err != nil {
+		return nil, statusError("failed to calculate plates", err)
+	}
+
+	sets := make([]*proto.PlateSet, 0, len(calculation.Sets))
+	for _, set := range calculation.Sets {
+		sets = append(sets, convertToProtoPlateSet(set))
+	}
+	return &proto.CalculatePlatesResponse{
+		WeightUnit: convertToProtoWeightUnit(calculation.Unit),
+		BarWeight:  calculation.BarWeight,
+		Sets:       sets,
+		Message:    platesMessage(requestLocale(ctx), calculation),
+	}, nil
+}
+
+// platesMessage メインセットのプレートの組み合わせのメッセージを作成
+func platesMessage(locale i18n.Locale, calculation *domain.PlateCalculation) string {
+	mainSet := calculation.Sets[len(calculation.Sets)-1]
+	unit := calculation.Unit.Key()
+
+	loads := locale.Message(i18n.MsgPlatesBarOnly)
+	if len(mainSet.PerSide) > 0 {
+		parts := make([]string, 0, len(mainSet.PerSide))
+		for _, load := range mainSet.PerSide {
+			parts = append(parts, fmt.Sprintf("%g%s×%d", load.Weight, unit, load.Count))
+		}
+		loads = strings.Join(parts, " + ")
+	}
+
+	if mainSet.Exact() {
+		return locale.Message(i18n.MsgPlatesExact, mainSet.Target, unit, loads)
+	}
+	return locale.Message(i18n.MsgPlatesNearest, mainSet.Target, unit, mainSet.Achieved, unit, loads)
+}
+
+// convertToProtoPlateSet 1セット分のプレートの組み合わせの変換（domain → proto）
+func convertToProtoPlateSet(set *domain.PlateLoading) *proto.PlateSet {
+	perSide := make([]*proto.PlateLoad, 0, len(set.PerSide))
+	for _, load := range set.PerSide {
+		perSide = append(perSide, &proto.PlateLoad{Weight: load.Weight, Count: int32(load.Count)})
+	}
+	return &proto.PlateSet{
+		Percent:        set.Percent,
+		TargetWeight:   set.Target,
+		AchievedWeight: set.Achieved,
+		Exact:          set.Exact(),
+		PerSide:        perSide,
+	}
+}
@@ -0,0 +1,262 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateProgram トレーニングプログラムを作成
+func (s *GRPCServer) CreateProgram(ctx context.Context, req *proto.CreateProgramRequest) (*proto.CreateProgramResponse, error) {
+	locale := requestLocale(ctx)
+	s.log(ctx).Debug("プログラムを作成中", slog.String("name", req.Name))
+
+	program, err := s.manager(ctx).CreateProgram(usecase.CreateProgramRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Templates:   convertProtoTemplates(req.Templates),
+	})
+	if err != nil {
+		return nil, localizedStatusError(s.buildErrorMessage(locale, i18n.MsgOpCreateProgram, req.Name, err.Error()), err)
+	}
+
+	return &proto.CreateProgramResponse{
+		Program: convertToProtoProgram(program),
+		Message: locale.Message(i18n.MsgProgramCreated, program.Name),
+	}, nil
+}
+
+// GetProgram トレーニングプログラムを取得（バージョン指定可）
+func (s *GRPCServer) GetProgram(ctx context.Context, req *proto.GetProgramRequest) (*proto.GetProgramResponse, error) {
+	s.log(ctx).Debug("プログラムを取得中", slog.Int64("program_id", int64(req.Id)), slog.Int("version", int(req.Version)))
+
+	program, err := s.manager(ctx).GetProgram(domain.ProgramID(req.Id), int(req.Version))
+	if err != nil {
+		return nil, statusError("failed to get program", err)
+	}
+
+	return &proto.GetProgramResponse{
+		Program: convertToProtoProgram(program),
+		Message: requestLocale(ctx).Message(i18n.MsgProgramSummary, program.Name, program.Version, len(program.Templates)),
+	}, nil
+}
+
+// ListPrograms トレーニングプログラム一覧を取得
+func (s *GRPCServer) ListPrograms(ctx context.Context, req *proto.ListProgramsRequest) (*proto.ListProgramsResponse, error) {
+	programs, err := s.manager(ctx).ListPrograms()
+	if err != nil {
+		return nil, statusError("failed to list programs", err)
+	}
+
+	protoPrograms := make([]*proto.Program, 0, len(programs))
+	for _, program := range programs {
+		protoPrograms = append(protoPrograms, convertToProtoProgram(program))
+	}
+
+	return &proto.ListProgramsResponse{
+		Programs: protoPrograms,
+		Message:  requestLocale(ctx).Message(i18n.MsgProgramList, len(programs)),
+	}, nil
+}
+
+// UpdateProgram トレーニングプログラムの名前・説明を更新
+func (s *GRPCServer) UpdateProgram(ctx context.Context, req *proto.UpdateProgramRequest) (*proto.UpdateProgramResponse, error) {
+	s.log(ctx).Debug("プログラムを更新中", slog.Int64("program_id", int64(req.Id)))
+
+	program, err := s.manager(ctx).UpdateProgram(usecase.UpdateProgramRequest{
+		ID:          domain.ProgramID(req.Id),
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, localizedStatusError(requestLocale(ctx).Message(i18n.MsgProgramUpdFailed, err), err)
+	}
+
+	return &proto.UpdateProgramResponse{
+		Program: convertToProtoProgram(program),
+		Message: requestLocale(ctx).Message(i18n.MsgProgramUpdated, program.Name),
+	}, nil
+}
+
+// UpdateProgramTemplates テンプレートを編集し、新しいバージョンとして保存
+func (s *GRPCServer) UpdateProgramTemplates(ctx context.Context, req *proto.UpdateProgramTemplatesRequest) (*proto.UpdateProgramTemplatesResponse, error) {
+	s.log(ctx).Debug("プログラムのテンプレートを更新中", slog.Int64("program_id", int64(req.Id)))
+
+	program, err := s.manager(ctx).UpdateProgramTemplates(domain.ProgramID(req.Id), convertProtoTemplates(req.Templates))
+	if err != nil {
+		return nil, localizedStatusError(requestLocale(ctx).Message(i18n.MsgTemplateUpdFailed, err), err)
+	}
+
+	return &proto.UpdateProgramTemplatesResponse{
+		Program: convertToProtoProgram(program),
+		Message: requestLocale(ctx).Message(i18n.MsgTemplatesSaved, program.Name, program.Version),
+	}, nil
+}
+
+// DeleteProgram トレーニングプログラムを削除
+func (s *GRPCServer) DeleteProgram(ctx context.Context, req *proto.DeleteProgramRequest) (*proto.DeleteProgramResponse, error) {
+	s.log(ctx).Debug("プログラムを削除中", slog.Int64("program_id", int64(req.Id)))
+
+	if err := s.manager(ctx).DeleteProgram(domain.ProgramID(req.Id)); err != nil {
+		return nil, localizedStatusError(requestLocale(ctx).Message(i18n.MsgProgramDelFailed, err), err)
+	}
+
+	return &proto.DeleteProgramResponse{
+		Message: requestLocale(ctx).Message(i18n.MsgProgramDeleted),
+	}, nil
+}
+
+// ApplyProgram プログラムから今後N週間分の予定のワークアウトを生成
+func (s *GRPCServer) ApplyProgram(ctx context.Context, req *proto.ApplyProgramRequest) (*proto.ApplyProgramResponse, error) {
+	s.log(ctx).Debug("プログラムを適用中", slog.Int64("program_id", int64(req.ProgramId)), slog.Int("weeks", int(req.Weeks)))
+
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	startDate := time.Now()
+	if req.StartDate != "" {
+		parsed, err := parseDateParamIn(req.StartDate, false, s.workoutManager.Location())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, locale.Message(i18n.MsgInvalidStartDate, err))
+		}
+		startDate = *parsed
+	}
+
+	inputUnit := s.inputWeightUnit(ctx, req.WeightUnit)
+	trainingMaxes := make(map[domain.ExerciseType]float64, len(req.TrainingMaxes))
+	for _, tm := range req.TrainingMaxes {
+		trainingMaxes[convertProtoExerciseType(tm.ExerciseType)] = inputUnit.ToKilograms(tm.Weight)
+	}
+
+	workouts, err := s.manager(ctx).ApplyProgram(usecase.ApplyProgramRequest{
+		ProgramID:     domain.ProgramID(req.ProgramId),
+		Version:       int(req.Version),
+		StartDate:     startDate,
+		Weeks:         int(req.Weeks),
+		TrainingMaxes: trainingMaxes,
+		WeightUnit:    inputUnit,
+	})
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgProgramApplyFail, err), err)
+	}
+
+	protoWorkouts := make([]*proto.Workout, 0, len(workouts))
+	for _, workout := range workouts {
+		protoWorkouts = append(protoWorkouts, convertToProtoWorkout(workout, locale, unit))
+	}
+
+	return &proto.ApplyProgramResponse{
+		Workouts: protoWorkouts,
+		Message:  locale.Message(i18n.MsgProgramApplied, req.Weeks, len(workouts)),
+	}, nil
+}
+
+// convertToProtoProgram プログラムの変換（domain → proto）
+func convertToProtoProgram(program *domain.Program) *proto.Program {
+	templates := make([]*proto.WorkoutTemplate, 0, len(program.Templates))
+	for _, t := range program.Templates {
+		exercises := make([]*proto.TemplateExercise, 0, len(t.Exercises))
+		for _, e := range t.Exercises {
+			weeks := make([]*proto.WeekScheme, 0, len(e.Weeks))
+			for _, schemes := range e.Weeks {
+				sets := make([]*proto.SetScheme, 0, len(schemes))
+				for _, scheme := range schemes {
+					sets = append(sets, &proto.SetScheme{
+						Sets:                 int32(scheme.Sets),
+						Reps:                 int32(scheme.Reps),
+						Weight:               scheme.Weight,
+						PercentOfTrainingMax: scheme.PercentOfTrainingMax,
+					})
+				}
+				weeks = append(weeks, &proto.WeekScheme{Sets: sets})
+			}
+			exercises = append(exercises, &proto.TemplateExercise{
+				ExerciseType: convertToProtoExerciseType(e.ExerciseType),
+				MuscleGroup:  convertToProtoMuscleGroup(e.MuscleGroup),
+				Difficulty:   convertToProtoDifficulty(e.Difficulty),
+				Notes:        e.Notes,
+				Weeks:        weeks,
+			})
+		}
+		templates = append(templates, &proto.WorkoutTemplate{
+			Id:        int32(t.ID),
+			Name:      t.Name,
+			DayOfWeek: convertToProtoDayOfWeek(t.DayOfWeek),
+			Exercises: exercises,
+		})
+	}
+
+	return &proto.Program{
+		Id:          int32(program.ID),
+		Name:        program.Name,
+		Description: program.Description,
+		Version:     int32(program.Version),
+		Templates:   templates,
+		CreatedAt:   program.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   program.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// convertProtoTemplates テンプレートの変換（proto → domain）
+func convertProtoTemplates(protoTemplates []*proto.WorkoutTemplate) []*domain.WorkoutTemplate {
+	templates := make([]*domain.WorkoutTemplate, 0, len(protoTemplates))
+	for _, t := range protoTemplates {
+		exercises := make([]domain.TemplateExercise, 0, len(t.Exercises))
+		for _, e := range t.Exercises {
+			weeks := make([][]domain.SetScheme, 0, len(e.Weeks))
+			for _, week := range e.Weeks {
+				schemes := make([]domain.SetScheme, 0, len(week.Sets))
+				for _, scheme := range week.Sets {
+					schemes = append(schemes, domain.SetScheme{
+						Sets:                 int(scheme.Sets),
+						Reps:                 int(scheme.Reps),
+						Weight:               scheme.Weight,
+						PercentOfTrainingMax: scheme.PercentOfTrainingMax,
+					})
+				}
+				weeks = append(weeks, schemes)
+			}
+			exercises = append(exercises, domain.TemplateExercise{
+				ExerciseType: convertProtoExerciseType(e.ExerciseType),
+				MuscleGroup:  convertProtoMuscleGroup(e.MuscleGroup),
+				Difficulty:   convertProtoDifficulty(e.Difficulty),
+				Notes:        e.Notes,
+				Weeks:        weeks,
+			})
+		}
+		templates = append(templates, &domain.WorkoutTemplate{
+			Name:      t.Name,
+			DayOfWeek: convertProtoDayOfWeek(t.DayOfWeek),
+			Exercises: exercises,
+		})
+	}
+	return templates
+}
+
+// convertToProtoDayOfWeek 曜日の変換（domain → proto、protoは月曜=1〜日曜=7）
+func convertToProtoDayOfWeek(day time.Weekday) proto.DayOfWeek {
+	if day == time.Sunday {
+		return proto.DayOfWeek_DAY_OF_WEEK_SUNDAY
+	}
+	return proto.DayOfWeek(day)
+}
+
+// convertProtoDayOfWeek 曜日の変換（proto → domain）
+// 未指定の場合は範囲外の値を返し、バリデーションでエラーにする
+func convertProtoDayOfWeek(day proto.DayOfWeek) time.Weekday {
+	switch {
+	case day == proto.DayOfWeek_DAY_OF_WEEK_SUNDAY:
+		return time.Sunday
+	case day >= proto.DayOfWeek_DAY_OF_WEEK_MONDAY && day <= proto.DayOfWeek_DAY_OF_WEEK_SATURDAY:
+		return time.Weekday(day)
+	default:
+		return -1
+	}
+}
@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+)
+
+// SuggestNextWorkout 完了履歴から次回のワークアウトの重量・回数を提案
+func (s *GRPCServer) SuggestNextWorkout(ctx context.Context, req *proto.SuggestNextWorkoutRequest) (*proto.SuggestNextWorkoutResponse, error) {
+	exerciseType := convertProtoExerciseType(req.ExerciseType)
+	s.log(ctx).Debug("次回のワークアウトを提案中", slog.String("exercise_type", exerciseType.Key()))
+
+	suggestReq := usecase.SuggestNextWorkoutRequest{ExerciseType: exerciseType}
+	if req.ProgramId != 0 {
+		programID := domain.ProgramID(req.ProgramId)
+		suggestReq.ProgramID = &programID
+	}
+
+	suggestion, err := s.manager(ctx).SuggestNextWorkout(suggestReq)
+	if err != nil {
+		return nil, statusError("failed to suggest next workout", err)
+	}
+
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	weight := suggestedWeight(suggestion.Weight, unit)
+	resp := &proto.SuggestNextWorkoutResponse{
+		ExerciseType:        req.ExerciseType,
+		Scheme:              convertToProtoProgressionScheme(suggestion.Rule.Scheme),
+		Action:              convertToProtoProgressionAction(suggestion.Action),
+		Sets:                int32(suggestion.Sets),
+		Reps:                int32(suggestion.Reps),
+		Weight:              weight,
+		ConsecutiveFailures: int32(suggestion.ConsecutiveFailures),
+		Message:             suggestionMessage(locale, suggestion, weight, unit),
+		WeightUnit:          convertToProtoWeightUnit(unit),
+	}
+	if suggestion.LastWorkout != nil {
+		resp.LastWorkout = convertToProtoWorkout(suggestion.LastWorkout, locale, unit)
+	}
+	return resp, nil
+}
+
+// suggestedWeight 提案する重量(kg)を応答の単位に変換
+// 漸進的過負荷の重量はkgの刻みで計算するため、kg以外の単位ではその単位のプレートで組める重量に丸める
+func suggestedWeight(kg float64, unit domain.WeightUnit) float64 {
+	if unit == domain.WeightUnitKilogram {
+		return unit.FromKilogramsRounded(kg)
+	}
+	return unit.RoundToPlates(unit.FromKilograms(kg))
+}
+
+// suggestionMessage 提案内容のメッセージを作成（weightは応答の単位の重量）
+func suggestionMessage(locale i18n.Locale, suggestion *domain.ProgressionSuggestion, weight float64, unit domain.WeightUnit) string {
+	name := locale.ExerciseType(suggestion.ExerciseType)
+	switch suggestion.Action {
+	case domain.ProgressionActionBaseline:
+		return locale.Message(i18n.MsgSuggestBaseline, name)
+	case domain.ProgressionActionIncreaseWeight:
+		return locale.Message(i18n.MsgSuggestWeight, name, weight, unit.Key(), suggestion.Reps, suggestion.Sets)
+	case domain.ProgressionActionIncreaseReps:
+		return locale.Message(i18n.MsgSuggestReps, name, weight, unit.Key(), suggestion.Reps, suggestion.Sets)
+	case domain.ProgressionActionDeload:
+		return locale.Message(i18n.MsgSuggestDeload, name, suggestion.Rule.DeloadAfter, weight, unit.Key())
+	default:
+		return locale.Message(i18n.MsgSuggestRepeat,
+			name, weight, unit.Key(), suggestion.Reps, suggestion.Sets, suggestion.ConsecutiveFailures)
+	}
+}
+
+// convertToProtoProgressionScheme 進め方の変換（domain → proto）
+func convertToProtoProgressionScheme(scheme domain.ProgressionScheme) proto.ProgressionScheme {
+	switch scheme {
+	case domain.ProgressionDouble:
+		return proto.ProgressionScheme_PROGRESSION_SCHEME_DOUBLE
+	default:
+		return proto.ProgressionScheme_PROGRESSION_SCHEME_LINEAR
+	}
+}
+
+// convertToProtoProgressionAction 提案内容の変換（domain → proto）
+func convertToProtoProgressionAction(action domain.ProgressionAction) proto.ProgressionAction {
+	switch action {
+	case domain.ProgressionActionIncreaseWeight:
+		return proto.ProgressionAction_PROGRESSION_ACTION_INCREASE_WEIGHT
+	case domain.ProgressionActionIncreaseReps:
+		return proto.ProgressionAction_PROGRESSION_ACTION_INCREASE_REPS
+	case domain.ProgressionActionRepeat:
+		return proto.ProgressionAction_PROGRESSION_ACTION_REPEAT
+	case domain.ProgressionActionDeload:
+		return proto.ProgressionAction_PROGRESSION_ACTION_DELOAD
+	default:
+		return proto.ProgressionAction_PROGRESSION_ACTION_BASELINE
+	}
+}
@@ -0,0 +1,132 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+)
+
+// dateLayout 日付のみのリクエストパラメータの形式
+const dateLayout = "2006-01-02"
+
+// GetTrainingStats 期間ごとのトレーニング統計を取得
+func (s *GRPCServer) GetTrainingStats(ctx context.Context, req *proto.GetTrainingStatsRequest) (*proto.GetTrainingStatsResponse, error) {
+	s.log(ctx).Debug("トレーニング統計を取得中", slog.String("period", req.Period.String()))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	buckets, err := s.manager(ctx).GetTrainingStats(usecase.GetTrainingStatsRequest{
+		Period:   convertProtoStatsPeriod(req.Period),
+		DateFrom: dateFrom,
+		DateTo:   dateTo,
+		Location: loc,
+	})
+	if err != nil {
+		return nil, statusError("failed to get training stats", err)
+	}
+
+	unit := s.weightUnit(ctx)
+	var totalVolume float64
+	protoBuckets := make([]*proto.TrainingStatsBucket, 0, len(buckets))
+	for _, b := range buckets {
+		totalVolume += b.TotalVolume
+		protoBuckets = append(protoBuckets, convertToProtoStatsBucket(b, unit))
+	}
+	totalVolume = unit.FromKilogramsRounded(totalVolume)
+
+	locale := requestLocale(ctx)
+	message := locale.Message(i18n.MsgStatsSummary, len(buckets), totalVolume, unit.Key())
+	if len(buckets) == 0 {
+		message = locale.Message(i18n.MsgStatsEmpty)
+	}
+
+	return &proto.GetTrainingStatsResponse{
+		Buckets:     protoBuckets,
+		TotalVolume: totalVolume,
+		Message:     message,
+		WeightUnit:  convertToProtoWeightUnit(unit),
+	}, nil
+}
+
+// parseDateParamIn 日付パラメータ（YYYY-MM-DD または RFC3339）を解析する
+// 日付のみの指定は指定したタイムゾーンの0時とし、endOfDay が true の場合は、その日を含むように翌日0時を返す
+func parseDateParamIn(value string, endOfDay bool, loc *time.Location) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return &t, nil
+	}
+	t, err := time.ParseInLocation(dateLayout, value, loc)
+	if err != nil {
+		return nil, fmt.Errorf("expected YYYY-MM-DD or RFC3339: %q", value)
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t, nil
+}
+
+// convertToProtoStatsBucket 統計の変換（domain → proto、ボリュームはunitの単位）
+func convertToProtoStatsBucket(b *domain.TrainingStatsBucket, unit domain.WeightUnit) *proto.TrainingStatsBucket {
+	muscleGroups := make([]domain.MuscleGroup, 0, len(b.SetsByMuscleGroup))
+	for mg := range b.SetsByMuscleGroup {
+		muscleGroups = append(muscleGroups, mg)
+	}
+	sort.Slice(muscleGroups, func(i, j int) bool { return muscleGroups[i] < muscleGroups[j] })
+
+	setsByMuscleGroup := make([]*proto.MuscleGroupSets, 0, len(muscleGroups))
+	for _, mg := range muscleGroups {
+		setsByMuscleGroup = append(setsByMuscleGroup, &proto.MuscleGroupSets{
+			MuscleGroup: convertToProtoMuscleGroup(mg),
+			Sets:        int32(b.SetsByMuscleGroup[mg]),
+		})
+	}
+
+	protoBucket := &proto.TrainingStatsBucket{
+		PeriodStart:       b.PeriodStart.Format(dateLayout),
+		WorkoutCount:      int32(b.WorkoutCount),
+		CompletedCount:    int32(b.CompletedCount),
+		SkippedCount:      int32(b.SkippedCount),
+		CompletionRate:    b.CompletionRate(),
+		SkipRate:          b.SkipRate(),
+		TotalVolume:       unit.FromKilogramsRounded(b.TotalVolume),
+		TotalSets:         int32(b.TotalSets),
+		SetsByMuscleGroup: setsByMuscleGroup,
+	}
+	// domainの難易度は0始まり、protoは1始まりのため補正する
+	if b.WorkoutCount > 0 {
+		protoBucket.AverageDifficulty = b.AverageDifficulty + 1
+	}
+	return protoBucket
+}
+
+// convertProtoStatsPeriod 集計単位の変換（proto → domain）
+func convertProtoStatsPeriod(period proto.StatsPeriod) domain.StatsPeriod {
+	switch period {
+	case proto.StatsPeriod_STATS_PERIOD_DAY:
+		return domain.StatsPeriodDay
+	case proto.StatsPeriod_STATS_PERIOD_MONTH:
+		return domain.StatsPeriodMonth
+	default:
+		return domain.StatsPeriodWeek
+	}
+}
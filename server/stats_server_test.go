@@ -0,0 +1,99 @@
+package server
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/usecase"
+)
+
+// TestGetTrainingStats_Location テーブル駆動テストで日付の解釈と集計期間の区切りにユーザーのタイムゾーンを使うことをテスト
+func TestGetTrainingStats_Location(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	// 東京では1月2日の朝、UTCでは1月1日の夜
+	scheduledFor := time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		wantStatus  int
+		wantBody    []string
+		description string
+	}{
+		{
+			name:        "正常系: ユーザー設定のタイムゾーン",
+			method:      http.MethodGet,
+			path:        "/v1/stats/training?period=STATS_PERIOD_DAY&date_from=2024-01-02&date_to=2024-01-02",
+			wantStatus:  http.StatusOK,
+			wantBody:    []string{`"period_start":"2024-01-02"`, `"workout_count":1`},
+			description: "日付は東京の0時として解釈し、東京の日付で集計する",
+		},
+		{
+			name:        "正常系: タイムゾーンを指定",
+			method:      http.MethodGet,
+			path:        "/v1/stats/training?period=STATS_PERIOD_DAY&date_from=2024-01-01&date_to=2024-01-01&timezone=UTC",
+			wantStatus:  http.StatusOK,
+			wantBody:    []string{`"period_start":"2024-01-01"`, `"workout_count":1`},
+			description: "指定したタイムゾーンはユーザー設定より優先",
+		},
+		{
+			name:        "異常系: 不明なタイムゾーン",
+			method:      http.MethodGet,
+			path:        "/v1/stats/training?timezone=Mars/Olympus",
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    []string{"timezone"},
+			description: "解釈できないタイムゾーンは400",
+		},
+		{
+			name:        "異常系: ボリュームバランスの不明なタイムゾーン",
+			method:      http.MethodPost,
+			path:        "/v1/stats/muscle-balance",
+			body:        `{"date_from":"2024-01-01","timezone":"Mars/Olympus"}`,
+			wantStatus:  http.StatusBadRequest,
+			wantBody:    []string{"timezone"},
+			description: "ボリュームバランスも同じタイムゾーンで日付を解釈する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, manager := newHTTPTestServer(t)
+			manager.SetLocation(tokyo)
+			if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.BenchPress, Sets: 3, Reps: 10, Weight: 60, ScheduledFor: &scheduledFor}); err != nil {
+				t.Fatalf("CreateWorkout() error = %v", err)
+			}
+
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+
+			if resp.StatusCode != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d: %s", tt.wantStatus, resp.StatusCode, body)
+			}
+			for _, want := range tt.wantBody {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Expected body to contain %s, got %s", want, body)
+				}
+			}
+		})
+	}
+}
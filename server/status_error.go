@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusCode usecase・リポジトリのエラーをgRPCのステータスコードに変換
+// REST/JSONゲートウェイはこのステータスコードからHTTPステータスを決めるため、
+// エラーの種類ごとのコードはここにまとめる
+func statusCode(err error) codes.Code {
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, domain.ErrNotFound), errors.Is(err, usecase.ErrBatchNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrInvalidArgument), errors.Is(err, usecase.ErrBatchInvalid),
+		errors.Is(err, usecase.ErrInvalidTrackingInput):
+		return codes.InvalidArgument
+	case errors.Is(err, usecase.ErrWorkoutNotInProgress):
+		// ステータスを実施中（IN_PROGRESS）に更新してから記録を開始する
+		return codes.FailedPrecondition
+	case errors.Is(err, usecase.ErrBatchAborted):
+		return codes.Aborted
+	case errors.Is(err, domain.ErrAlreadyExists):
+		return codes.AlreadyExists
+	default:
+		return codes.Internal
+	}
+}
+
+// statusError 処理名を付けて、エラーの種類に応じたステータスコードのgRPCエラーに変換
+func statusError(op string, err error) error {
+	return status.Errorf(statusCode(err), "%s: %v", op, err)
+}
+
+// localizedStatusError エラーの種類に応じたステータスコードで、利用者向けのメッセージ（ローカライズ済み）をgRPCエラーにする
+func localizedStatusError(message string, err error) error {
+	return status.Error(statusCode(err), message)
+}
+
+// invalidArgumentError リクエストの値を解釈できない場合のエラー
+func invalidArgumentError(field string, err error) error {
+	return status.Errorf(codes.InvalidArgument, "invalid %s: %v", field, err)
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/metadata"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+// TestTracing テーブル駆動テストでRPC・ユースケース・DBクエリのスパンの親子関係をテスト
+func TestTracing(t *testing.T) {
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	tests := []struct {
+		name        string
+		setupMock   func(mock sqlmock.Sqlmock)
+		call        func(ctx context.Context, client proto.WorkoutServiceClient) error
+		incoming    bool     // 呼び出し元のトレースコンテキスト（traceparent）を送るか
+		wantTree    []string // ルートから順に親子関係にあるスパン
+		wantError   bool     // ユースケースのスパンにエラーが記録されるか
+		description string
+	}{
+		{
+			name: "正常系: 取得",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workouts` WHERE `workouts`.`id` = ?")).WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type"}).AddRow(1, domain.Squat))
+			},
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.GetWorkout(ctx, &proto.GetWorkoutRequest{Id: 1})
+				return err
+			},
+			wantTree:    []string{"workout.WorkoutService/GetWorkout", "WorkoutManager.GetWorkout", "gorm.query workouts"},
+			description: "RPC → ユースケース → DBクエリの順に子のスパンになる",
+		},
+		{
+			name: "正常系: 一覧",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workouts`")).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "exercise_type", "sets", "reps"}).AddRow(1, domain.Squat, 5, 5))
+			},
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.ListWorkouts(ctx, &proto.ListWorkoutsRequest{})
+				return err
+			},
+			incoming:    true,
+			wantTree:    []string{"workout.WorkoutService/ListWorkouts", "WorkoutManager.ListWorkouts", "gorm.query workouts"},
+			description: "呼び出し元のトレースの続きとして記録する",
+		},
+		{
+			name: "異常系: DBエラー",
+			setupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `workouts`")).WillReturnError(errors.New("connection lost"))
+			},
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.ListWorkouts(ctx, &proto.ListWorkoutsRequest{})
+				return err
+			},
+			wantTree:    []string{"workout.WorkoutService/ListWorkouts", "WorkoutManager.ListWorkouts", "gorm.query workouts"},
+			wantError:   true,
+			description: "失敗したユースケースのスパンにはエラーを記録する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatalf("Failed to create sqlmock: %v", err)
+			}
+			defer sqlDB.Close()
+			db, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}), &gorm.Config{})
+			if err != nil {
+				t.Fatalf("Failed to open gorm connection: %v", err)
+			}
+
+			recorder := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+			if err := repository.RegisterTracing(db, tp); err != nil {
+				t.Fatalf("RegisterTracing() error = %v", err)
+			}
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewGORMRepository(db))
+			manager.SetTracerProvider(tp)
+			server := NewGRPCServer(manager)
+			server.SetTracerProvider(tp)
+			client := newBufconnServerClient(t, server)
+
+			ctx := context.Background()
+			if tt.incoming {
+				ctx = metadata.AppendToOutgoingContext(ctx, "traceparent", traceparent)
+			}
+			tt.setupMock(mock)
+			_ = tt.call(ctx, client)
+
+			// RPCのスパンはレスポンスの送信後に終了するため、記録されるまで待つ
+			spans := endedSpans(recorder, tt.wantTree[0])
+			var parent sdktrace.ReadOnlySpan
+			for _, name := range tt.wantTree {
+				span, ok := spans[name]
+				if !ok {
+					t.Fatalf("Expected span %q, got %v", name, spanNames(recorder.Ended()))
+				}
+				if parent != nil && span.Parent().SpanID() != parent.SpanContext().SpanID() {
+					t.Errorf("Expected %q to be a child of %q", name, parent.Name())
+				}
+				parent = span
+			}
+
+			root := spans[tt.wantTree[0]]
+			if got := root.SpanContext().TraceID().String(); tt.incoming && got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+				t.Errorf("Expected trace ID from traceparent, got %s", got)
+			}
+			if got := spans[tt.wantTree[1]].Status().Code == codes.Error; got != tt.wantError {
+				t.Errorf("Expected usecase span error = %t, got %t", tt.wantError, got)
+			}
+		})
+	}
+}
+
+// endedSpans 名前がnameのスパンが終了するまで待ち、終了したスパンを名前ごとに返す
+func endedSpans(recorder *tracetest.SpanRecorder, name string) map[string]sdktrace.ReadOnlySpan {
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		for _, span := range recorder.Ended() {
+			spans[span.Name()] = span
+		}
+		if _, ok := spans[name]; ok {
+			break
+		}
+	}
+	return spans
+}
+
+// spanNames 記録されたスパンの名前
+func spanNames(spans []sdktrace.ReadOnlySpan) []string {
+	names := make([]string, 0, len(spans))
+	for _, span := range spans {
+		names = append(names, span.Name())
+	}
+	return names
+}
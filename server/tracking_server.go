@@ -0,0 +1,237 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+	"golv2-learning-app/validation"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TrackWorkout 実施中のワークアウトのセット・メモ・一時停止をストリームで受け取り、
+// 休憩タイマーの終了と自己ベストの更新をストリームで返す
+// クライアントが送信を終えたらワークアウトを完了にする。途中で切断された場合は実施中のまま残す
+func (s *GRPCServer) TrackWorkout(stream proto.WorkoutService_TrackWorkoutServer) error {
+	locale := requestLocale(stream.Context())
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, locale.Message(i18n.MsgTrackStartFirst))
+	}
+	if err != nil {
+		return err
+	}
+	start := first.GetStart()
+	if start == nil {
+		return status.Error(codes.InvalidArgument, locale.Message(i18n.MsgTrackStartFirst))
+	}
+	if err := validation.Validate(first); err != nil {
+		return validationStatus(err)
+	}
+	s.log(stream.Context()).Debug("ワークアウトの記録を開始", slog.Int64(logging.KeyWorkoutID, int64(start.WorkoutId)))
+
+	session, err := s.manager(stream.Context()).StartTracking(usecase.StartTrackingRequest{
+		WorkoutID:    domain.WorkoutID(start.WorkoutId),
+		RestDuration: time.Duration(start.RestSeconds) * time.Second,
+	})
+	if err != nil {
+		return statusError("failed to track workout", err)
+	}
+	defer session.Close()
+
+	// 応答の重量は全てこの単位（ワークアウト・セット・自己ベスト・合計ボリューム）
+	unit := s.weightUnit(stream.Context())
+	if err := stream.Send(&proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_Started{Started: &proto.TrackingStarted{
+		Workout:     convertToProtoWorkout(session.Workout(), locale, unit),
+		Sets:        convertToProtoWorkoutSets(session.Sets(), unit),
+		RestSeconds: int32(session.RestDuration() / time.Second),
+	}}}); err != nil {
+		return err
+	}
+
+	// 受信は別のゴルーチンで行い、送信はこのゴルーチンだけで行う（ストリームへの同時送信はできないため）
+	requests := make(chan *proto.TrackWorkoutRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			select {
+			case requests <- req:
+			case <-stream.Context().Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		var responses []*proto.TrackWorkoutResponse
+		select {
+		case <-stream.Context().Done():
+			s.log(stream.Context()).Info("ワークアウトの記録が中断されました（実施中のまま）", slog.Int64(logging.KeyWorkoutID, int64(start.WorkoutId)))
+			return status.FromContextError(stream.Context().Err()).Err()
+		case err := <-recvErr:
+			if !errors.Is(err, io.EOF) {
+				return err
+			}
+			return s.finishTracking(stream, session, unit)
+		case expiry := <-session.RestTimerExpired():
+			responses = append(responses, &proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_RestTimerExpired{RestTimerExpired: &proto.RestTimerExpired{
+				SetNumber:   int32(expiry.SetNumber),
+				RestSeconds: int32(expiry.Rest / time.Second),
+			}}})
+		case req := <-requests:
+			responses, err = handleTrackingRequest(session, req, locale, unit)
+			if err != nil {
+				return statusError("failed to track workout", err)
+			}
+		}
+		for _, resp := range responses {
+			if err := stream.Send(resp); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// handleTrackingRequest クライアントのイベントを記録し、返すイベントを組み立てる
+// 内容が不正なイベント（protoの制約違反を含む）はerrorイベントを返して記録を続け、保存に失敗した場合はエラーを返す
+// localeはerrorイベントのメッセージの言語、unitは応答の重量の単位（セットの重量の単位を省略した場合の入力の単位）
+func handleTrackingRequest(session *usecase.TrackingSession, req *proto.TrackWorkoutRequest, locale i18n.Locale, unit domain.WeightUnit) ([]*proto.TrackWorkoutResponse, error) {
+	var responses []*proto.TrackWorkoutResponse
+	if err := validation.Validate(req); err != nil {
+		err = fmt.Errorf("%w: %v", usecase.ErrInvalidTrackingInput, err)
+		return []*proto.TrackWorkoutResponse{{Event: &proto.TrackWorkoutResponse_Error{Error: err.Error()}}}, nil
+	}
+
+	var err error
+	switch event := req.Event.(type) {
+	case *proto.TrackWorkoutRequest_SetCompleted:
+		var result *usecase.SetResult
+		inputUnit := unit
+		if event.SetCompleted.WeightUnit != proto.WeightUnit_WEIGHT_UNIT_UNSPECIFIED {
+			inputUnit = convertProtoWeightUnit(event.SetCompleted.WeightUnit)
+		}
+		result, err = session.CompleteSet(usecase.CompleteSetRequest{
+			Reps:       int(event.SetCompleted.Reps),
+			Weight:     event.SetCompleted.Weight,
+			WeightUnit: inputUnit,
+			RPE:        event.SetCompleted.Rpe,
+		})
+		if err == nil {
+			responses = append(responses, convertToProtoSetLogged(result, unit))
+			for _, record := range result.PersonalRecords {
+				responses = append(responses, convertToProtoPersonalRecord(record, unit))
+			}
+		}
+	case *proto.TrackWorkoutRequest_Note:
+		err = session.AddNote(event.Note.Text)
+		if err == nil {
+			responses = append(responses, &proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_NoteSaved{NoteSaved: &proto.NoteSaved{
+				Notes: session.Workout().Notes,
+			}}})
+		}
+	case *proto.TrackWorkoutRequest_Pause:
+		var remaining time.Duration
+		if event.Pause.Paused {
+			remaining = session.Pause()
+		} else {
+			remaining = session.Resume()
+		}
+		responses = append(responses, &proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_Paused{Paused: &proto.TrackingPaused{
+			Paused:               event.Pause.Paused,
+			RestRemainingSeconds: int32(remaining.Round(time.Second) / time.Second),
+		}}})
+	case *proto.TrackWorkoutRequest_Start:
+		err = fmt.Errorf("%w: %s", usecase.ErrInvalidTrackingInput, locale.Message(i18n.MsgTrackStartTwice))
+	default:
+		err = fmt.Errorf("%w: %s", usecase.ErrInvalidTrackingInput, locale.Message(i18n.MsgTrackNoEvent))
+	}
+
+	if errors.Is(err, usecase.ErrInvalidTrackingInput) {
+		return []*proto.TrackWorkoutResponse{{Event: &proto.TrackWorkoutResponse_Error{Error: err.Error()}}}, nil
+	}
+	return responses, err
+}
+
+// finishTracking ワークアウトを完了にして結果を送信する
+func (s *GRPCServer) finishTracking(stream proto.WorkoutService_TrackWorkoutServer, session *usecase.TrackingSession, unit domain.WeightUnit) error {
+	workout, err := session.Finish()
+	if err != nil {
+		return statusError("failed to track workout", err)
+	}
+	s.log(stream.Context()).Debug("ワークアウトの記録を終了しました", slog.Int64(logging.KeyWorkoutID, int64(workout.ID)), slog.Int("sets", len(session.Sets())))
+	return stream.Send(&proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_Completed{Completed: &proto.TrackingCompleted{
+		Workout:     convertToProtoWorkout(workout, requestLocale(stream.Context()), unit),
+		SetCount:    int32(len(session.Sets())),
+		TotalVolume: unit.FromKilogramsRounded(session.TotalVolume()),
+		WeightUnit:  convertToProtoWeightUnit(unit),
+	}}})
+}
+
+// convertToProtoWorkoutSets domain.WorkoutSetのスライスをprotoに変換
+func convertToProtoWorkoutSets(sets []*domain.WorkoutSet, unit domain.WeightUnit) []*proto.WorkoutSet {
+	converted := make([]*proto.WorkoutSet, 0, len(sets))
+	for _, set := range sets {
+		converted = append(converted, convertToProtoWorkoutSet(set, unit))
+	}
+	return converted
+}
+
+// convertToProtoWorkoutSet domain.WorkoutSetをprotoのWorkoutSetに変換（重量はunitの単位）
+func convertToProtoWorkoutSet(set *domain.WorkoutSet, unit domain.WeightUnit) *proto.WorkoutSet {
+	return &proto.WorkoutSet{
+		Id:          int64(set.ID),
+		WorkoutId:   int32(set.WorkoutID),
+		SetNumber:   int32(set.SetNumber),
+		Reps:        int32(set.Reps),
+		Weight:      unit.FromKilogramsRounded(set.Weight),
+		Rpe:         set.RPE,
+		CompletedAt: set.CompletedAt.Format(time.RFC3339),
+		WeightUnit:  convertToProtoWeightUnit(unit),
+	}
+}
+
+// convertToProtoSetLogged セットの記録結果をprotoのイベントに変換
+func convertToProtoSetLogged(result *usecase.SetResult, unit domain.WeightUnit) *proto.TrackWorkoutResponse {
+	logged := &proto.SetLogged{Set: convertToProtoWorkoutSet(result.Set, unit)}
+	if result.RestUntil != nil {
+		logged.RestUntil = result.RestUntil.Format(time.RFC3339)
+	}
+	return &proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_SetLogged{SetLogged: logged}}
+}
+
+// convertToProtoPersonalRecord domain.PersonalRecordをprotoのイベントに変換（記録はunitの単位）
+func convertToProtoPersonalRecord(record *domain.PersonalRecord, unit domain.WeightUnit) *proto.TrackWorkoutResponse {
+	return &proto.TrackWorkoutResponse{Event: &proto.TrackWorkoutResponse_PersonalRecord{PersonalRecord: &proto.PersonalRecord{
+		Type:         convertToProtoPersonalRecordType(record.Type),
+		ExerciseType: convertToProtoExerciseType(record.ExerciseType),
+		Value:        unit.FromKilogramsRounded(record.Value),
+		Previous:     unit.FromKilogramsRounded(record.Previous),
+		SetNumber:    int32(record.Set.SetNumber),
+		WeightUnit:   convertToProtoWeightUnit(unit),
+	}}}
+}
+
+func convertToProtoPersonalRecordType(recordType domain.PersonalRecordType) proto.PersonalRecordType {
+	switch recordType {
+	case domain.PersonalRecordWeight:
+		return proto.PersonalRecordType_PERSONAL_RECORD_TYPE_WEIGHT
+	case domain.PersonalRecordOneRepMax:
+		return proto.PersonalRecordType_PERSONAL_RECORD_TYPE_ONE_REP_MAX
+	default:
+		return proto.PersonalRecordType_PERSONAL_RECORD_TYPE_UNSPECIFIED
+	}
+}
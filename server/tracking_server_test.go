@@ -0,0 +1,348 @@
+package server
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// newInProgressWorkout 実施中のワークアウトを作成する
+func newInProgressWorkout(t *testing.T, manager *usecase.WorkoutManager) domain.WorkoutID {
+	t.Helper()
+	workout, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100})
+	if err != nil {
+		t.Fatalf("CreateWorkout() error = %v", err)
+	}
+	inProgress := domain.WorkoutStatusInProgress
+	if err := manager.UpdateWorkout(usecase.UpdateWorkoutRequest{ID: workout.ID, ExerciseType: domain.Squat, Status: &inProgress}); err != nil {
+		t.Fatalf("UpdateWorkout() error = %v", err)
+	}
+	return workout.ID
+}
+
+// startTracking 記録を開始し、開始イベントを受け取る
+func startTracking(t *testing.T, ctx context.Context, client proto.WorkoutServiceClient, id domain.WorkoutID, restSeconds int32) (proto.WorkoutService_TrackWorkoutClient, *proto.TrackingStarted) {
+	t.Helper()
+	stream, err := client.TrackWorkout(ctx)
+	if err != nil {
+		t.Fatalf("TrackWorkout() error = %v", err)
+	}
+	send(t, stream, &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Start{Start: &proto.StartTracking{WorkoutId: int32(id), RestSeconds: restSeconds}}})
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Failed to start tracking: %v", err)
+	}
+	if resp.GetStarted() == nil {
+		t.Fatalf("Expected started event, got %v", resp)
+	}
+	return stream, resp.GetStarted()
+}
+
+func send(t *testing.T, stream proto.WorkoutService_TrackWorkoutClient, req *proto.TrackWorkoutRequest) {
+	t.Helper()
+	if err := stream.Send(req); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+}
+
+func setCompleted(reps int32, weight float64) *proto.TrackWorkoutRequest {
+	return &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_SetCompleted{SetCompleted: &proto.CompleteSet{Reps: reps, Weight: weight}}}
+}
+
+// TestTrackWorkout セットの記録から休憩タイマー・自己ベストの通知、完了までの一連の流れをテスト
+func TestTrackWorkout(t *testing.T) {
+	manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	id := newInProgressWorkout(t, manager)
+	client := newBufconnClient(t, manager)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, started := startTracking(t, ctx, client, id, 1)
+	if started.RestSeconds != 1 || len(started.Sets) != 0 || started.Workout.Status != proto.WorkoutStatus_WORKOUT_STATUS_IN_PROGRESS {
+		t.Errorf("Unexpected started event: %v", started)
+	}
+
+	// 初めての記録なので重量と推定1RMの両方が自己ベスト
+	send(t, stream, setCompleted(5, 100))
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if logged := resp.GetSetLogged(); logged == nil || logged.Set.SetNumber != 1 || logged.RestUntil == "" {
+		t.Errorf("Expected set 1 with rest timer, got %v", resp)
+	}
+	for _, want := range []proto.PersonalRecordType{proto.PersonalRecordType_PERSONAL_RECORD_TYPE_WEIGHT, proto.PersonalRecordType_PERSONAL_RECORD_TYPE_ONE_REP_MAX} {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if record := resp.GetPersonalRecord(); record == nil || record.Type != want || record.Previous != 0 || record.SetNumber != 1 {
+			t.Errorf("Expected %s record, got %v", want, resp)
+		}
+	}
+
+	// 不正なセットはエラーを返して記録を続ける
+	send(t, stream, setCompleted(0, 100))
+	if resp, err := stream.Recv(); err != nil || resp.GetError() == "" {
+		t.Fatalf("Expected error event, got %v (err=%v)", resp, err)
+	}
+
+	send(t, stream, &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Note{Note: &proto.AddNote{Text: "膝の向きを意識"}}})
+	if resp, err := stream.Recv(); err != nil || resp.GetNoteSaved().GetNotes() != "膝の向きを意識" {
+		t.Fatalf("Expected note saved, got %v (err=%v)", resp, err)
+	}
+
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if expired := resp.GetRestTimerExpired(); expired == nil || expired.SetNumber != 1 || expired.RestSeconds != 1 {
+		t.Fatalf("Expected rest timer expiry for set 1, got %v", resp)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	completed := resp.GetCompleted()
+	if completed == nil || completed.SetCount != 1 || completed.TotalVolume != 500 ||
+		completed.Workout.Status != proto.WorkoutStatus_WORKOUT_STATUS_COMPLETED || completed.Workout.Sets != 1 {
+		t.Fatalf("Expected completed workout with 1 set, got %v", resp)
+	}
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("Expected EOF after completion, got %v", err)
+	}
+}
+
+// TestTrackWorkout_Pounds ユーザーの単位がlbの場合、セット・自己ベスト・合計ボリュームも全てlbで送受信することをテスト
+func TestTrackWorkout_Pounds(t *testing.T) {
+	manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	manager.SetWeightUnit(domain.WeightUnitPound)
+	id := newInProgressWorkout(t, manager)
+	client := newBufconnClient(t, manager)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, started := startTracking(t, ctx, client, id, 60)
+	if started.Workout.WeightUnit != proto.WeightUnit_WEIGHT_UNIT_LB {
+		t.Errorf("Expected workout in lb, got %v", started.Workout)
+	}
+
+	// 単位を省略したセットの重量はユーザーの単位（lb）
+	send(t, stream, setCompleted(5, 225))
+	resp, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if set := resp.GetSetLogged().GetSet(); set.GetWeight() != 225 || set.GetWeightUnit() != proto.WeightUnit_WEIGHT_UNIT_LB {
+		t.Errorf("Expected set of 225 lb, got %v", resp)
+	}
+	resp, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	if record := resp.GetPersonalRecord(); record.GetValue() != 225 || record.GetWeightUnit() != proto.WeightUnit_WEIGHT_UNIT_LB {
+		t.Errorf("Expected weight record of 225 lb, got %v", resp)
+	}
+	if _, err := stream.Recv(); err != nil { // 推定1RMの自己ベスト
+		t.Fatalf("Recv() error = %v", err)
+	}
+
+	// 単位を指定したセットはkgとして記録し、応答はlbで返す
+	send(t, stream, &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_SetCompleted{SetCompleted: &proto.CompleteSet{
+		Reps: 5, Weight: 100, WeightUnit: proto.WeightUnit_WEIGHT_UNIT_KG,
+	}}})
+	if resp, err := stream.Recv(); err != nil || resp.GetSetLogged().GetSet().GetWeight() != 220.46 {
+		t.Fatalf("Expected set of 220.46 lb, got %v (err=%v)", resp, err)
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("CloseSend() error = %v", err)
+	}
+	for {
+		resp, err = stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if resp.GetCompleted() != nil {
+			break
+		}
+	}
+	completed := resp.GetCompleted()
+	if completed.WeightUnit != proto.WeightUnit_WEIGHT_UNIT_LB || completed.TotalVolume != 2227.31 {
+		t.Errorf("Expected total volume of 2227.31 lb, got %v", completed)
+	}
+}
+
+// TestTrackWorkout_Disconnect 切断してもワークアウトは実施中のまま残り、再接続すると続きから記録できる
+func TestTrackWorkout_Disconnect(t *testing.T) {
+	manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	id := newInProgressWorkout(t, manager)
+	client := newBufconnClient(t, manager)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	firstCtx, disconnect := context.WithCancel(ctx)
+	stream, _ := startTracking(t, firstCtx, client, id, 60)
+	send(t, stream, &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Pause{Pause: &proto.PauseTracking{Paused: true}}})
+	if resp, err := stream.Recv(); err != nil || !resp.GetPaused().GetPaused() {
+		t.Fatalf("Expected paused event, got %v (err=%v)", resp, err)
+	}
+	send(t, stream, setCompleted(5, 80))
+	if resp, err := stream.Recv(); err != nil || resp.GetSetLogged().GetRestUntil() != "" {
+		t.Fatalf("Expected set logged without rest timer while paused, got %v (err=%v)", resp, err)
+	}
+	disconnect()
+
+	// 記録済みのセットは切断前に保存されている
+	stream, started := startTracking(t, ctx, client, id, 60)
+	if started.Workout.Status != proto.WorkoutStatus_WORKOUT_STATUS_IN_PROGRESS || len(started.Sets) != 1 || started.Sets[0].Weight != 80 {
+		t.Fatalf("Expected the in-progress workout with 1 recorded set, got %v", started)
+	}
+	send(t, stream, setCompleted(5, 85))
+	if resp, err := stream.Recv(); err != nil || resp.GetSetLogged().GetSet().GetSetNumber() != 2 {
+		t.Fatalf("Expected set 2 to be logged, got %v (err=%v)", resp, err)
+	}
+}
+
+// TestTrackWorkout_Errors テーブル駆動テストで記録を開始できない場合のステータスコードをテスト
+func TestTrackWorkout_Errors(t *testing.T) {
+	tests := []struct {
+		name        string
+		first       *proto.TrackWorkoutRequest
+		wantCode    codes.Code
+		description string
+	}{
+		{
+			name:        "異常系: startがない",
+			first:       setCompleted(5, 100),
+			wantCode:    codes.InvalidArgument,
+			description: "最初のメッセージはstart",
+		},
+		{
+			name:        "異常系: 実施中でない",
+			first:       &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Start{Start: &proto.StartTracking{WorkoutId: 2}}},
+			wantCode:    codes.FailedPrecondition,
+			description: "予定のワークアウトは記録できない",
+		},
+		{
+			name:        "異常系: 休憩時間が負",
+			first:       &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Start{Start: &proto.StartTracking{WorkoutId: 1, RestSeconds: -1}}},
+			wantCode:    codes.InvalidArgument,
+			description: "休憩時間は0以上",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			newInProgressWorkout(t, manager)
+			if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100}); err != nil {
+				t.Fatalf("CreateWorkout() error = %v", err)
+			}
+			client := newBufconnClient(t, manager)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			stream, err := client.TrackWorkout(ctx)
+			if err != nil {
+				t.Fatalf("TrackWorkout() error = %v", err)
+			}
+			send(t, stream, tt.first)
+			if _, err := stream.Recv(); status.Code(err) != tt.wantCode {
+				t.Errorf("Expected %s, got %v", tt.wantCode, err)
+			}
+		})
+	}
+}
+
+// TestTrackWorkout_Locale テーブル駆動テストでaccept-languageに応じた言語でエラーを返すことをテスト
+func TestTrackWorkout_Locale(t *testing.T) {
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		startFirst     bool // startを送信してから2件目のイベントを送信する
+		event          *proto.TrackWorkoutRequest
+		wantMessage    string
+		description    string
+	}{
+		{
+			name:           "正常系: startがない（英語）",
+			acceptLanguage: "en",
+			event:          setCompleted(5, 100),
+			wantMessage:    "send start in the first message",
+			description:    "最初のメッセージの誤りはリクエストの言語のINVALID_ARGUMENTで終了",
+		},
+		{
+			name:           "正常系: startがない（日本語）",
+			acceptLanguage: "ja",
+			event:          setCompleted(5, 100),
+			wantMessage:    "最初のメッセージでstartを送信してください",
+			description:    "既定の言語",
+		},
+		{
+			name:           "正常系: 2回目のstart（英語）",
+			acceptLanguage: "en",
+			startFirst:     true,
+			event:          &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_Start{Start: &proto.StartTracking{WorkoutId: 1}}},
+			wantMessage:    "start must be sent once, in the first message",
+			description:    "記録中の誤りはリクエストの言語のerrorイベントを返して記録を続ける",
+		},
+		{
+			name:           "正常系: イベントの指定がない（英語）",
+			acceptLanguage: "en",
+			startFirst:     true,
+			event:          &proto.TrackWorkoutRequest{},
+			wantMessage:    "no event specified",
+			description:    "空のメッセージもerrorイベント",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			id := newInProgressWorkout(t, manager)
+			client := newBufconnClient(t, manager)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			ctx = metadata.AppendToOutgoingContext(ctx, "accept-language", tt.acceptLanguage)
+
+			if !tt.startFirst {
+				stream, err := client.TrackWorkout(ctx)
+				if err != nil {
+					t.Fatalf("TrackWorkout() error = %v", err)
+				}
+				send(t, stream, tt.event)
+				_, err = stream.Recv()
+				if st := status.Convert(err); st.Code() != codes.InvalidArgument || st.Message() != tt.wantMessage {
+					t.Errorf("Expected INVALID_ARGUMENT %q, got %v", tt.wantMessage, err)
+				}
+				return
+			}
+
+			stream, _ := startTracking(t, ctx, client, id, 0)
+			send(t, stream, tt.event)
+			resp, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("Recv() error = %v", err)
+			}
+			if !strings.HasSuffix(resp.GetError(), tt.wantMessage) {
+				t.Errorf("Expected error event ending with %q, got %v", tt.wantMessage, resp)
+			}
+		})
+	}
+}
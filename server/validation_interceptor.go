@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"errors"
+
+	"golv2-learning-app/validation"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// unaryValidationInterceptor リクエストをprotoの制約（validate.proto）で検証し、違反があればハンドラーを呼ばずに
+// 全ての違反をINVALID_ARGUMENT（詳細はgoogle.rpc.BadRequest）で返す
+func unaryValidationInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if msg, ok := req.(proto.Message); ok {
+		if err := validation.Validate(msg); err != nil {
+			return nil, validationStatus(err)
+		}
+	}
+	return handler(ctx, req)
+}
+
+// streamValidationInterceptor サーバーストリーミングRPCのリクエストを検証する
+// クライアント・双方向ストリーミングは不正なメッセージの扱いがRPCごとに異なるため、ハンドラーでメッセージごとに検証する
+// ImportWorkoutsはINVALID_ARGUMENTで終了し、TrackWorkoutは最初のstartのみINVALID_ARGUMENTで終了して、
+// 以降のイベントはerrorイベントを返して記録を続ける
+func streamValidationInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		return handler(srv, stream)
+	}
+	return handler(srv, &validatingServerStream{ServerStream: stream})
+}
+
+// validatingServerStream 受信したメッセージを検証するServerStream
+type validatingServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *validatingServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		if err := validation.Validate(msg); err != nil {
+			return validationStatus(err)
+		}
+	}
+	return nil
+}
+
+// validationStatus 制約違反をフィールドごとの違反を含むgRPCのエラーに変換
+// REST/JSONでは "details" にフィールドごとの違反が入る
+func validationStatus(err error) error {
+	var validationErr *validation.Error
+	if !errors.As(err, &validationErr) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range validationErr.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	st, detailErr := status.New(codes.InvalidArgument, err.Error()).WithDetails(badRequest)
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return st.Err()
+}
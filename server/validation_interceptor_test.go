@@ -0,0 +1,189 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// TestValidationInterceptor テーブル駆動テストでprotoの制約に違反するリクエストの拒否をテスト
+func TestValidationInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		call        func(ctx context.Context, client proto.WorkoutServiceClient) error
+		wantCode    codes.Code
+		wantFields  []string
+		wantCount   int // 呼び出し後のワークアウト数
+		description string
+	}{
+		{
+			name: "正常系: 制約の範囲内",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.CreateWorkout(ctx, &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(5), Reps: protobuf.Int32(5), Weight: 100})
+				return err
+			},
+			wantCode:    codes.OK,
+			wantCount:   1,
+			description: "ハンドラーが呼ばれて作成される",
+		},
+		{
+			name: "異常系: 複数の違反",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.CreateWorkout(ctx, &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(500), Weight: 10000})
+				return err
+			},
+			wantCode:    codes.InvalidArgument,
+			wantFields:  []string{"sets", "weight"},
+			description: "MySQLのCHECK制約で失敗する前に、全ての違反をまとめて返す",
+		},
+		{
+			name: "異常系: 作成でsets・repsに0を指定",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.CreateWorkout(ctx, &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(0), Reps: protobuf.Int32(0)})
+				return err
+			},
+			wantCode:    codes.InvalidArgument,
+			wantFields:  []string{"sets", "reps"},
+			description: "省略時はデフォルト値だが、明示的な0は保存できないため境界で拒否する",
+		},
+		{
+			name: "異常系: 更新でsetsに0を指定",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.UpdateWorkout(ctx, &proto.UpdateWorkoutRequest{Id: 1, ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(0)})
+				return err
+			},
+			wantCode:    codes.InvalidArgument,
+			wantFields:  []string{"sets"},
+			description: "省略時は変更しないが、明示的な0は黙って無視せずに拒否する",
+		},
+		{
+			name: "異常系: ID未指定",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				_, err := client.GetWorkout(ctx, &proto.GetWorkoutRequest{})
+				return err
+			},
+			wantCode:    codes.InvalidArgument,
+			wantFields:  []string{"id"},
+			description: "IDは1以上",
+		},
+		{
+			name: "正常系: 一括作成は項目ごとに検証",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				resp, err := client.BatchCreateWorkouts(ctx, &proto.BatchCreateWorkoutsRequest{
+					Workouts: []*proto.CreateWorkoutRequest{
+						{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT},
+						{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Reps: protobuf.Int32(5000)},
+					},
+					Mode: proto.BatchMode_BATCH_MODE_PER_ITEM,
+				})
+				if err != nil {
+					return err
+				}
+				if got := resp.Results[1].Code; got != int32(codes.InvalidArgument) || !strings.Contains(resp.Results[1].Message, "reps") {
+					t.Errorf("Expected INVALID_ARGUMENT for reps, got %d %q", got, resp.Results[1].Message)
+				}
+				return nil
+			},
+			wantCode:    codes.OK,
+			wantCount:   1,
+			description: "違反した項目だけを失敗にし、残りは作成する",
+		},
+		{
+			name: "異常系: サーバーストリーミング",
+			call: func(ctx context.Context, client proto.WorkoutServiceClient) error {
+				stream, err := client.WatchWorkouts(ctx, &proto.WatchWorkoutsRequest{EventTypes: []proto.WorkoutEventType{proto.WorkoutEventType(99)}})
+				if err != nil {
+					return err
+				}
+				_, err = stream.Recv()
+				return err
+			},
+			wantCode:    codes.InvalidArgument,
+			wantFields:  []string{"event_types[0]"},
+			description: "ストリームの最初のリクエストも検証する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			client := newBufconnServerClient(t, NewGRPCServer(manager))
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			err := tt.call(ctx, client)
+			st := status.Convert(err)
+			if st.Code() != tt.wantCode {
+				t.Fatalf("Expected code %v, got %v (%v)", tt.wantCode, st.Code(), err)
+			}
+			if tt.wantFields != nil {
+				if got := fieldViolations(st); !reflect.DeepEqual(got, tt.wantFields) {
+					t.Errorf("Expected field violations %v, got %v", tt.wantFields, got)
+				}
+			}
+			if count, _ := manager.GetWorkoutCount(); count != tt.wantCount {
+				t.Errorf("Expected %d workouts, got %d", tt.wantCount, count)
+			}
+		})
+	}
+}
+
+// fieldViolations エラーの詳細に含まれる違反したフィールド
+func fieldViolations(st *status.Status) []string {
+	var fields []string
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	return fields
+}
+
+// TestHTTPGateway_Validation REST/JSONでは制約違反を400で返し、detailsにフィールドごとの違反を入れることをテスト
+func TestHTTPGateway_Validation(t *testing.T) {
+	server, _ := newHTTPTestServer(t)
+	resp, err := http.Post(server.URL+"/v1/workouts", "application/json",
+		strings.NewReader(`{"exercise_type":"EXERCISE_SQUAT","sets":500,"reps":5,"weight":100}`))
+	if err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", resp.StatusCode)
+	}
+	var body struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Details []struct {
+			FieldViolations []struct {
+				Field       string `json:"field"`
+				Description string `json:"description"`
+			} `json:"field_violations"`
+		} `json:"details"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if len(body.Details) != 1 || len(body.Details[0].FieldViolations) != 1 || body.Details[0].FieldViolations[0].Field != "sets" {
+		t.Fatalf("Expected a field violation for sets, got %+v", body)
+	}
+	if want := "less than or equal to 100"; !strings.Contains(body.Details[0].FieldViolations[0].Description, want) {
+		t.Errorf("Expected description to contain %q, got %q", want, body.Details[0].FieldViolations[0].Description)
+	}
+}
@@ -0,0 +1,126 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetMuscleBalanceReport 筋肉群ごとの週あたりボリュームバランスを取得
+func (s *GRPCServer) GetMuscleBalanceReport(ctx context.Context, req *proto.GetMuscleBalanceReportRequest) (*proto.GetMuscleBalanceReportResponse, error) {
+	s.log(ctx).Debug("ボリュームバランスを取得中", slog.String("date_from", req.DateFrom), slog.String("date_to", req.DateTo))
+
+	loc, err := s.resolveLocation(req.Timezone)
+	if err != nil {
+		return nil, invalidArgumentError("timezone", err)
+	}
+	dateFrom, err := parseDateParamIn(req.DateFrom, false, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_from", err)
+	}
+	dateTo, err := parseDateParamIn(req.DateTo, true, loc)
+	if err != nil {
+		return nil, invalidArgumentError("date_to", err)
+	}
+
+	var targets map[domain.MuscleGroup]domain.VolumeTarget
+	if len(req.Targets) > 0 {
+		targets = make(map[domain.MuscleGroup]domain.VolumeTarget, len(req.Targets))
+		for _, t := range req.Targets {
+			mg := convertProtoMuscleGroup(t.MuscleGroup)
+			if mg == domain.Unspecified {
+				return nil, status.Error(codes.InvalidArgument, "volume target requires muscle_group")
+			}
+			targets[mg] = domain.VolumeTarget{MinWeeklySets: t.MinWeeklySets, MaxWeeklySets: t.MaxWeeklySets}
+		}
+	}
+
+	report, err := s.manager(ctx).GetMuscleBalanceReport(usecase.GetMuscleBalanceReportRequest{
+		DateFrom:        dateFrom,
+		DateTo:          dateTo,
+		SecondaryWeight: req.SecondaryWeight,
+		Targets:         targets,
+	})
+	if err != nil {
+		return nil, statusError("failed to get muscle balance report", err)
+	}
+
+	locale := requestLocale(ctx)
+	protoVolumes := make([]*proto.MuscleGroupVolume, 0, len(report.Muscles))
+	for _, mv := range report.Muscles {
+		protoVolumes = append(protoVolumes, convertToProtoMuscleGroupVolume(mv, locale))
+	}
+
+	return &proto.GetMuscleBalanceReportResponse{
+		MuscleGroups:    protoVolumes,
+		Weeks:           report.Weeks,
+		SecondaryWeight: report.SecondaryWeight,
+		Message:         balanceMessage(locale, report),
+	}, nil
+}
+
+// balanceMessage 過多・不足の筋肉群をまとめたメッセージ（例: "胸が多すぎ、背中が不足しています"）
+func balanceMessage(locale i18n.Locale, report *domain.MuscleBalanceReport) string {
+	var over, under []string
+	for _, mv := range report.Muscles {
+		switch mv.Status {
+		case domain.VolumeOver:
+			over = append(over, locale.MuscleGroup(mv.MuscleGroup))
+		case domain.VolumeUnder:
+			under = append(under, locale.MuscleGroup(mv.MuscleGroup))
+		}
+	}
+
+	switch {
+	case len(over) > 0 && len(under) > 0:
+		return locale.Message(i18n.MsgBalanceOverUnder, locale.Join(over), locale.Join(under))
+	case len(over) > 0:
+		return locale.Message(i18n.MsgBalanceOver, locale.Join(over))
+	case len(under) > 0:
+		return locale.Message(i18n.MsgBalanceUnder, locale.Join(under))
+	default:
+		return locale.Message(i18n.MsgBalanceOK)
+	}
+}
+
+// convertToProtoMuscleGroupVolume 筋肉群ごとのボリュームの変換（domain → proto）
+func convertToProtoMuscleGroupVolume(mv *domain.MuscleVolume, locale i18n.Locale) *proto.MuscleGroupVolume {
+	protoVolume := &proto.MuscleGroupVolume{
+		MuscleGroup:   convertToProtoMuscleGroup(mv.MuscleGroup),
+		PrimarySets:   mv.PrimarySets,
+		SecondarySets: mv.SecondarySets,
+		WeightedSets:  mv.WeightedSets,
+		WeeklySets:    mv.WeeklySets,
+		Status:        convertToProtoVolumeBalanceStatus(mv.Status),
+		DisplayName:   locale.MuscleGroup(mv.MuscleGroup),
+	}
+	if mv.Target != nil {
+		protoVolume.Target = &proto.VolumeTarget{
+			MuscleGroup:   convertToProtoMuscleGroup(mv.MuscleGroup),
+			MinWeeklySets: mv.Target.MinWeeklySets,
+			MaxWeeklySets: mv.Target.MaxWeeklySets,
+		}
+	}
+	return protoVolume
+}
+
+// convertToProtoVolumeBalanceStatus ボリューム判定の変換（domain → proto）
+func convertToProtoVolumeBalanceStatus(status domain.VolumeBalanceStatus) proto.VolumeBalanceStatus {
+	switch status {
+	case domain.VolumeUnder:
+		return proto.VolumeBalanceStatus_VOLUME_BALANCE_STATUS_UNDER
+	case domain.VolumeWithin:
+		return proto.VolumeBalanceStatus_VOLUME_BALANCE_STATUS_WITHIN
+	case domain.VolumeOver:
+		return proto.VolumeBalanceStatus_VOLUME_BALANCE_STATUS_OVER
+	default:
+		return proto.VolumeBalanceStatus_VOLUME_BALANCE_STATUS_UNSPECIFIED
+	}
+}
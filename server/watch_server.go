@@ -0,0 +1,131 @@
+package server
+
+import (
+	"errors"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+	"golv2-learning-app/usecase/eventbus"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// WatchWorkouts ワークアウトの変更イベントをクライアントが切断するまでストリームで送信
+// 送信が詰まるとイベントバス側のバッファがあふれて購読が打ち切られるため、遅いクライアントが他に影響しない
+func (s *GRPCServer) WatchWorkouts(req *proto.WatchWorkoutsRequest, stream proto.WorkoutService_WatchWorkoutsServer) error {
+	s.log(stream.Context()).Debug("ワークアウトの変更を購読中", slog.Any("event_types", req.EventTypes), slog.Any("workout_ids", req.WorkoutIds))
+
+	sub, err := s.manager(stream.Context()).WatchWorkouts(usecase.WatchWorkoutsRequest{
+		Filter:      convertProtoWorkoutEventFilter(req),
+		ResumeToken: req.ResumeToken,
+	})
+	if err != nil {
+		return watchError(err)
+	}
+	defer sub.Close()
+	locale := requestLocale(stream.Context())
+	unit := s.weightUnit(stream.Context())
+
+	// 購読の開始をクライアントに知らせる（ヘッダーを受け取った後の変更は取りこぼさない）
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			s.log(stream.Context()).Debug("ワークアウトの変更の購読を終了しました")
+			return nil
+		case <-sub.Done():
+			return watchError(sub.Err())
+		case event := <-sub.Events():
+			if err := stream.Send(s.convertToProtoWorkoutEvent(event, locale, unit)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchError 購読できない・打ち切られた理由をクライアントが再接続の方法を判断できるステータスコードに変換
+func watchError(err error) error {
+	switch {
+	case errors.Is(err, eventbus.ErrSlowConsumer):
+		// 最後に受け取ったイベントのresume_tokenで再接続すれば取りこぼさない
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, eventbus.ErrResumeExpired):
+		// 取りこぼしがあるため、ListWorkoutsで取得し直してから購読する必要がある
+		return status.Error(codes.OutOfRange, err.Error())
+	case errors.Is(err, eventbus.ErrInvalidToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return statusError("failed to watch workouts", err)
+	}
+}
+
+// convertProtoWorkoutEventFilter 購読リクエストのフィルタをdomain.WorkoutEventFilterに変換
+func convertProtoWorkoutEventFilter(req *proto.WatchWorkoutsRequest) domain.WorkoutEventFilter {
+	filter := domain.WorkoutEventFilter{}
+	for _, eventType := range req.EventTypes {
+		filter.Types = append(filter.Types, convertProtoWorkoutEventType(eventType))
+	}
+	for _, id := range req.WorkoutIds {
+		filter.WorkoutIDs = append(filter.WorkoutIDs, domain.WorkoutID(id))
+	}
+	for _, exerciseType := range req.ExerciseTypes {
+		filter.ExerciseTypes = append(filter.ExerciseTypes, convertProtoExerciseType(exerciseType))
+	}
+	for _, workoutStatus := range req.Statuses {
+		filter.Statuses = append(filter.Statuses, convertProtoWorkoutStatus(workoutStatus))
+	}
+	return filter
+}
+
+// convertToProtoWorkoutEvent domain.WorkoutEventをprotoのWorkoutEventに変換
+func (s *GRPCServer) convertToProtoWorkoutEvent(event domain.WorkoutEvent, locale i18n.Locale, unit domain.WeightUnit) *proto.WorkoutEvent {
+	protoEvent := &proto.WorkoutEvent{
+		Type:        convertToProtoWorkoutEventType(event.Type),
+		Workout:     convertToProtoWorkout(event.Workout, locale, unit),
+		OccurredAt:  event.OccurredAt.Format(time.RFC3339),
+		ResumeToken: s.workoutManager.EventToken(event),
+	}
+	if event.PreviousStatus != nil {
+		protoEvent.PreviousStatus = convertToProtoWorkoutStatus(*event.PreviousStatus)
+	}
+	return protoEvent
+}
+
+func convertToProtoWorkoutEventType(eventType domain.WorkoutEventType) proto.WorkoutEventType {
+	switch eventType {
+	case domain.WorkoutEventCreated:
+		return proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED
+	case domain.WorkoutEventUpdated:
+		return proto.WorkoutEventType_WORKOUT_EVENT_TYPE_UPDATED
+	case domain.WorkoutEventDeleted:
+		return proto.WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED
+	case domain.WorkoutEventStatusChanged:
+		return proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED
+	default:
+		return proto.WorkoutEventType_WORKOUT_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
+func convertProtoWorkoutEventType(eventType proto.WorkoutEventType) domain.WorkoutEventType {
+	switch eventType {
+	case proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED:
+		return domain.WorkoutEventCreated
+	case proto.WorkoutEventType_WORKOUT_EVENT_TYPE_UPDATED:
+		return domain.WorkoutEventUpdated
+	case proto.WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED:
+		return domain.WorkoutEventDeleted
+	case proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED:
+		return domain.WorkoutEventStatusChanged
+	default:
+		return domain.WorkoutEventUnspecified
+	}
+}
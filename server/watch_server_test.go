@@ -0,0 +1,200 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/usecase"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newBufconnClient メモリ上のコネクションでgRPCサーバーを起動し、クライアントを返す
+func newBufconnClient(t *testing.T, manager *usecase.WorkoutManager) proto.WorkoutServiceClient {
+	t.Helper()
+	return newBufconnServerClient(t, NewGRPCServer(manager))
+}
+
+// newBufconnServerClient インターセプターを含めて設定済みのサーバーをbufconnで起動する
+func newBufconnServerClient(t *testing.T, server *GRPCServer) proto.WorkoutServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1024 * 1024)
+	grpcServer := server.NewServer()
+	go func() { _ = grpcServer.Serve(lis) }()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to dial bufnet: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		grpcServer.Stop()
+	})
+	return proto.NewWorkoutServiceClient(conn)
+}
+
+// watch 購読を開始し、サーバー側で購読が始まるまで待つ
+func watch(t *testing.T, ctx context.Context, client proto.WorkoutServiceClient, req *proto.WatchWorkoutsRequest) proto.WorkoutService_WatchWorkoutsClient {
+	t.Helper()
+	stream, err := client.WatchWorkouts(ctx, req)
+	if err != nil {
+		t.Fatalf("WatchWorkouts() error = %v", err)
+	}
+	if _, err := stream.Header(); err != nil {
+		t.Fatalf("Failed to start watching: %v", err)
+	}
+	return stream
+}
+
+// TestWatchWorkouts テーブル駆動テストでフィルタごとに届くイベントをテスト
+func TestWatchWorkouts(t *testing.T) {
+	tests := []struct {
+		name        string
+		req         *proto.WatchWorkoutsRequest
+		wantTypes   []proto.WorkoutEventType
+		description string
+	}{
+		{
+			name: "正常系: フィルタなし",
+			req:  &proto.WatchWorkoutsRequest{},
+			wantTypes: []proto.WorkoutEventType{
+				proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED,
+				proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED,
+				proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED,
+				proto.WorkoutEventType_WORKOUT_EVENT_TYPE_UPDATED,
+				proto.WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED,
+			},
+			description: "全ての変更が発生順に届く",
+		},
+		{
+			name: "正常系: 種類で絞り込み",
+			req: &proto.WatchWorkoutsRequest{
+				EventTypes: []proto.WorkoutEventType{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED},
+			},
+			wantTypes:   []proto.WorkoutEventType{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED},
+			description: "ステータス変更のみ",
+		},
+		{
+			name: "正常系: 種目で絞り込み",
+			req: &proto.WatchWorkoutsRequest{
+				ExerciseTypes: []proto.ExerciseType{proto.ExerciseType_EXERCISE_DEADLIFT},
+			},
+			wantTypes:   []proto.WorkoutEventType{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED},
+			description: "デッドリフトの作成のみ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			client := newBufconnClient(t, manager)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			stream := watch(t, ctx, client, tt.req)
+
+			changeWorkouts(t, manager)
+
+			for i, want := range tt.wantTypes {
+				event, err := stream.Recv()
+				if err != nil {
+					t.Fatalf("Event %d: Recv() error = %v", i, err)
+				}
+				if event.Type != want {
+					t.Errorf("Event %d: expected %s, got %s", i, want, event.Type)
+				}
+				if event.Workout == nil || event.ResumeToken == "" {
+					t.Errorf("Event %d: expected workout and resume token, got %+v", i, event)
+				}
+				if want == proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED &&
+					(event.PreviousStatus != proto.WorkoutStatus_WORKOUT_STATUS_PLANNED || event.Workout.Status != proto.WorkoutStatus_WORKOUT_STATUS_COMPLETED) {
+					t.Errorf("Event %d: expected PLANNED -> COMPLETED, got %s -> %s", i, event.PreviousStatus, event.Workout.Status)
+				}
+			}
+		})
+	}
+}
+
+// TestWatchWorkouts_Resume 切断後に再開トークンで続きから受け取れることをテスト
+func TestWatchWorkouts_Resume(t *testing.T) {
+	manager := usecase.NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	client := newBufconnClient(t, manager)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	firstCtx, disconnect := context.WithCancel(ctx)
+	stream := watch(t, firstCtx, client, &proto.WatchWorkoutsRequest{})
+	changeWorkouts(t, manager)
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	disconnect()
+
+	// 切断中の変更も取りこぼさない
+	if err := manager.DeleteWorkout(2); err != nil {
+		t.Fatalf("DeleteWorkout() error = %v", err)
+	}
+
+	resumed := watch(t, ctx, client, &proto.WatchWorkoutsRequest{ResumeToken: first.ResumeToken})
+	want := []struct {
+		eventType proto.WorkoutEventType
+		id        int32
+	}{
+		{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_CREATED, 2},
+		{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_STATUS_CHANGED, 1},
+		{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_UPDATED, 1},
+		{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED, 1},
+		{proto.WorkoutEventType_WORKOUT_EVENT_TYPE_DELETED, 2},
+	}
+	for i, w := range want {
+		event, err := resumed.Recv()
+		if err != nil {
+			t.Fatalf("Event %d: Recv() error = %v", i, err)
+		}
+		if event.Type != w.eventType || event.Workout.Id != w.id {
+			t.Errorf("Event %d: expected %s of %d, got %s of %d", i, w.eventType, w.id, event.Type, event.Workout.Id)
+		}
+	}
+
+	broken, err := client.WatchWorkouts(ctx, &proto.WatchWorkoutsRequest{ResumeToken: "broken"})
+	if err != nil {
+		t.Fatalf("WatchWorkouts() error = %v", err)
+	}
+	if _, err := broken.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument for a broken token, got %v", err)
+	}
+}
+
+// changeWorkouts 2件作成し、1件目を完了・メモ更新・削除する（イベントは5件）
+func changeWorkouts(t *testing.T, manager *usecase.WorkoutManager) {
+	t.Helper()
+	for _, exerciseType := range []domain.ExerciseType{domain.BenchPress, domain.Deadlift} {
+		if _, err := manager.CreateWorkout(usecase.CreateWorkoutRequest{ExerciseType: exerciseType, Sets: 3, Reps: 5, Weight: 100}); err != nil {
+			t.Fatalf("CreateWorkout() error = %v", err)
+		}
+	}
+	completed := domain.WorkoutStatusCompleted
+	notes := "フォーム良好"
+	for _, req := range []usecase.UpdateWorkoutRequest{
+		{ID: 1, ExerciseType: domain.BenchPress, Status: &completed},
+		{ID: 1, ExerciseType: domain.BenchPress, Notes: &notes},
+	} {
+		if err := manager.UpdateWorkout(req); err != nil {
+			t.Fatalf("UpdateWorkout() error = %v", err)
+		}
+	}
+	if err := manager.DeleteWorkout(1); err != nil {
+		t.Fatalf("DeleteWorkout() error = %v", err)
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/proto"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// WeightUnitHeader 応答の重量の単位（"kg" / "lb"）を指定するメタデータ（HTTPヘッダー）のキー
+const WeightUnitHeader = "x-weight-unit"
+
+// weightUnit リクエストのメタデータ x-weight-unit から応答の重量の単位を決める
+// 指定がない場合や未対応の単位の場合はユーザー設定の単位
+func (s *GRPCServer) weightUnit(ctx context.Context) domain.WeightUnit {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return s.workoutManager.WeightUnit()
+	}
+	if values := md.Get(WeightUnitHeader); len(values) > 0 {
+		if unit, err := domain.ParseWeightUnit(strings.ToLower(strings.TrimSpace(values[0]))); err == nil {
+			return unit
+		}
+	}
+	return s.workoutManager.WeightUnit()
+}
+
+// inputWeightUnit リクエストのweightの単位（未指定の場合は応答と同じ単位）
+func (s *GRPCServer) inputWeightUnit(ctx context.Context, unit proto.WeightUnit) domain.WeightUnit {
+	if unit == proto.WeightUnit_WEIGHT_UNIT_UNSPECIFIED {
+		return s.weightUnit(ctx)
+	}
+	return convertProtoWeightUnit(unit)
+}
+
+// convertProtoWeightUnit 重量の単位の変換（proto → domain、未指定の場合はkg）
+func convertProtoWeightUnit(unit proto.WeightUnit) domain.WeightUnit {
+	if unit == proto.WeightUnit_WEIGHT_UNIT_LB {
+		return domain.WeightUnitPound
+	}
+	return domain.WeightUnitKilogram
+}
+
+// convertToProtoWeightUnit 重量の単位の変換（domain → proto）
+func convertToProtoWeightUnit(unit domain.WeightUnit) proto.WeightUnit {
+	switch unit {
+	case domain.WeightUnitKilogram:
+		return proto.WeightUnit_WEIGHT_UNIT_KG
+	case domain.WeightUnitPound:
+		return proto.WeightUnit_WEIGHT_UNIT_LB
+	default:
+		return proto.WeightUnit_WEIGHT_UNIT_UNSPECIFIED
+	}
+}
@@ -0,0 +1,210 @@
+package server
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/usecase"
+)
+
+// TestWeightUnit テーブル駆動テストでX-Weight-Unitヘッダー・ユーザー設定による重量の単位の換算をテスト
+func TestWeightUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		path        string
+		body        string
+		header      string // X-Weight-Unitヘッダー（空の場合は指定しない）
+		userUnit    domain.WeightUnit
+		setup       func(t *testing.T, manager *usecase.WorkoutManager)
+		wantBody    []string
+		description string
+	}{
+		{
+			name:        "正常系: 指定なしはユーザー設定の単位",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/1",
+			userUnit:    domain.WeightUnitPound,
+			wantBody:    []string{`"weight":220.46,`, `"weight_unit":"WEIGHT_UNIT_LB"`, `"plate_weight":220`},
+			description: "100kgを換算して返し、表示用の値は5lb刻みに丸める（220.46lb → 220lb）",
+		},
+		{
+			name:        "正常系: ヘッダーでkgを指定",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/1",
+			header:      "kg",
+			userUnit:    domain.WeightUnitPound,
+			wantBody:    []string{`"weight":100`, `"weight_unit":"WEIGHT_UNIT_KG"`},
+			description: "ヘッダーはユーザー設定より優先",
+		},
+		{
+			name:        "正常系: lbで作成してkgで取得",
+			method:      http.MethodPost,
+			path:        "/v1/workouts",
+			body:        `{"exercise_type":"EXERCISE_BENCH_PRESS","weight":225,"weight_unit":"WEIGHT_UNIT_LB"}`,
+			header:      "kg",
+			wantBody:    []string{`"weight":102.06,`, `"weight_unit":"WEIGHT_UNIT_KG"`, `"plate_weight":102.5`},
+			description: "リクエストの単位で入力し、表示用の値は2.5kg刻みに丸める（102.06kg → 102.5kg）",
+		},
+		{
+			name:        "正常系: 単位の指定がない入力は応答と同じ単位",
+			method:      http.MethodPost,
+			path:        "/v1/workouts",
+			body:        `{"exercise_type":"EXERCISE_BENCH_PRESS","weight":135}`,
+			header:      "lb",
+			wantBody:    []string{`"weight":135,`, `"weight_unit":"WEIGHT_UNIT_LB"`, `"plate_weight":135`},
+			description: "入力した単位で返す場合は丸めない",
+		},
+		{
+			name:        "正常系: 体重をlbで記録",
+			method:      http.MethodPost,
+			path:        "/v1/body-measurements",
+			body:        `{"date":"2024-05-01","bodyweight":180}`,
+			header:      "lb",
+			wantBody:    []string{`"bodyweight":180,`, `"weight_unit":"WEIGHT_UNIT_LB"`},
+			description: "体重もヘッダーの単位で入力・表示する（kgで保存）",
+		},
+		{
+			name:        "正常系: lbで記録した体重をkgで返す",
+			method:      http.MethodPost,
+			path:        "/v1/body-measurements",
+			body:        `{"date":"2024-05-01","bodyweight":180,"weight_unit":"WEIGHT_UNIT_LB"}`,
+			header:      "kg",
+			wantBody:    []string{`"bodyweight":81.65,`, `"weight_unit":"WEIGHT_UNIT_KG"`},
+			description: "180lb = 81.65kg",
+		},
+		{
+			name:        "正常系: 高強度ルールの重量をlbで指定",
+			method:      http.MethodPost,
+			path:        "/v1/workouts:highIntensity",
+			body:        `{"rules":[{"name":"heavy","min_weight":220}]}`,
+			header:      "lb",
+			wantBody:    []string{`"matched_rule":"heavy"`},
+			description: "220lb（約99.8kg）以上なので100kgのワークアウトが一致する（kgとして扱うと一致しない）",
+		},
+		{
+			name:        "正常系: エクスポートの重量の条件をlbで指定",
+			method:      http.MethodGet,
+			path:        "/v1/workouts:export?min_weight=220",
+			header:      "lb",
+			wantBody:    []string{`"weight":220.46,`},
+			description: "220lb以上の条件で100kg（220.46lb）のワークアウトをエクスポートする",
+		},
+		{
+			name:   "正常系: 統計のボリュームをlbで返す",
+			method: http.MethodGet,
+			path:   "/v1/stats/training",
+			header: "lb",
+			setup: func(t *testing.T, manager *usecase.WorkoutManager) {
+				completed := domain.WorkoutStatusCompleted
+				if err := manager.UpdateWorkout(usecase.UpdateWorkoutRequest{ID: 1, ExerciseType: domain.Squat, Status: &completed}); err != nil {
+					t.Fatalf("UpdateWorkout() error = %v", err)
+				}
+			},
+			wantBody:    []string{`"total_volume":5511.56,`, `"weight_unit":"WEIGHT_UNIT_LB"`},
+			description: "5 × 5 × 100kg = 2500kg = 5511.56lb",
+		},
+		{
+			name:        "正常系: 未対応の単位はユーザー設定の単位",
+			method:      http.MethodGet,
+			path:        "/v1/workouts/1",
+			header:      "stone",
+			wantBody:    []string{`"weight":100`, `"weight_unit":"WEIGHT_UNIT_KG"`},
+			description: "不正なヘッダーは無視する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, manager := newHTTPTestServer(t)
+			manager.SetWeightUnit(tt.userUnit)
+			if tt.setup != nil {
+				tt.setup(t, manager)
+			}
+
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatalf("NewRequest() error = %v", err)
+			}
+			if tt.header != "" {
+				req.Header.Set("X-Weight-Unit", tt.header)
+			}
+			resp, err := http.DefaultClient.Do(req)
+			if err != nil {
+				t.Fatalf("Do() error = %v", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("ReadAll() error = %v", err)
+			}
+
+			if resp.StatusCode != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, body)
+			}
+			for _, want := range tt.wantBody {
+				if !strings.Contains(string(body), want) {
+					t.Errorf("Expected body to contain %s, got %s", want, body)
+				}
+			}
+		})
+	}
+}
+
+// TestApplyProgram_Pounds lbで指定したトレーニングマックスからlbのプレートで組める重量の予定を生成することをテスト
+func TestApplyProgram_Pounds(t *testing.T) {
+	server, manager := newHTTPTestServer(t)
+	manager.SetProgramRepository(repository.NewMockProgramRepository())
+	program, err := manager.CreateProgram(usecase.CreateProgramRequest{
+		Name: "Squat",
+		Templates: []*domain.WorkoutTemplate{
+			{Name: "Day 1", DayOfWeek: time.Monday, Exercises: []domain.TemplateExercise{
+				{ExerciseType: domain.Squat, Weeks: [][]domain.SetScheme{{{Sets: 1, Reps: 5, PercentOfTrainingMax: 0.9}}}},
+			}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("CreateProgram() error = %v", err)
+	}
+
+	body := `{"start_date":"2030-01-07","weeks":1,"training_maxes":[{"exercise_type":"EXERCISE_SQUAT","weight":315}]}`
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v1/programs/%d:apply", server.URL, program.ID), strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	req.Header.Set("X-Weight-Unit", "lb")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, respBody)
+	}
+
+	// 315lb × 0.9 = 283.5lb → 5lb刻みで285lb（kgとして扱うと約625lbになる）
+	for _, want := range []string{`"weight":285,`, `"weight_unit":"WEIGHT_UNIT_LB"`, `"plate_weight":285`} {
+		if !strings.Contains(string(respBody), want) {
+			t.Errorf("Expected body to contain %s, got %s", want, respBody)
+		}
+	}
+	workouts, err := manager.ListWorkouts(nil, nil, nil)
+	if err != nil {
+		t.Fatalf("ListWorkouts() error = %v", err)
+	}
+	for _, w := range workouts {
+		if w.ProgramID != nil && w.WeightUnit != domain.WeightUnitPound {
+			t.Errorf("Expected generated workout to be recorded in lb, got %+v", w)
+		}
+	}
+}
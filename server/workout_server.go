@@ -3,32 +3,63 @@ package server
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"strings"
 	"time"
 
 	"golv2-learning-app/domain"
+	"golv2-learning-app/i18n"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/metrics"
 	"golv2-learning-app/proto"
+	"golv2-learning-app/tracing"
 	"golv2-learning-app/usecase"
+	"golv2-learning-app/usecase/strength"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
 )
 
 // GRPCServer gRPCサーバー構造体
 type GRPCServer struct {
 	proto.UnimplementedWorkoutServiceServer
 	workoutManager *usecase.WorkoutManager
+	logger         *slog.Logger         // リクエストごとのログの出力先（デフォルトは出力しない）
+	metrics        *metrics.Metrics     // RPCのメトリクスの記録先（nilの場合は記録しない）
+	tracerProvider trace.TracerProvider // RPCのスパンの記録先（デフォルトは記録しない）
 }
 
 // NewGRPCServer 新しいgRPCサーバーを作成
 func NewGRPCServer(workoutManager *usecase.WorkoutManager) *GRPCServer {
 	return &GRPCServer{
 		workoutManager: workoutManager,
+		logger:         logging.Discard(),
+		tracerProvider: tracing.Noop(),
 	}
 }
 
+// SetLogger ログの出力先を設定
+// リクエストごとにリクエストID・メソッド名を付与してWorkoutManagerにも引き継ぐ
+func (s *GRPCServer) SetLogger(logger *slog.Logger) {
+	s.logger = logger
+}
+
+// SetMetrics RPCの件数・ステータスコード・処理時間の記録先を設定
+func (s *GRPCServer) SetMetrics(m *metrics.Metrics) {
+	s.metrics = m
+}
+
+// SetTracerProvider RPCのスパンの記録先を設定
+// 呼び出し元のトレースコンテキスト（traceparent）があれば、そのトレースの続きとして記録する
+func (s *GRPCServer) SetTracerProvider(tp trace.TracerProvider) {
+	s.tracerProvider = tp
+}
+
 // Start サーバーを起動
 func (s *GRPCServer) Start(port int) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -36,62 +67,95 @@ func (s *GRPCServer) Start(port int) error {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	proto.RegisterWorkoutServiceServer(grpcServer, s)
-	reflection.Register(grpcServer)
+	grpcServer := s.NewServer()
 
-	log.Printf("💪 筋トレアプリのgRPCサーバーが起動しました！")
-	log.Printf("🔥 ポート %d でリッスン中...", port)
-	log.Printf("🎯 Evansで接続: evans -r repl -p %d", port)
+	s.logger.Info("gRPCサーバーを起動しました", slog.Int("port", port))
 
 	return grpcServer.Serve(lis)
 }
 
+// NewServer WorkoutServiceを登録したgrpc.Serverを作成
+// gRPCのポートとgRPC-Web（HTTPサーバー）のそれぞれで使用する
+func (s *GRPCServer) NewServer() *grpc.Server {
+	grpcServer := grpc.NewServer(
+		// スパンはインターセプターより先に開始し、ログにトレースIDを付与できるようにする
+		grpc.StatsHandler(otelgrpc.NewServerHandler(
+			otelgrpc.WithTracerProvider(s.tracerProvider),
+			otelgrpc.WithPropagators(tracing.Propagator()),
+		)),
+		// 検証はログの後に行い、制約違反で拒否したリクエストもログに残す
+		grpc.ChainUnaryInterceptor(s.unaryMetricsInterceptor, s.unaryLoggingInterceptor, unaryValidationInterceptor),
+		grpc.ChainStreamInterceptor(s.streamMetricsInterceptor, s.streamLoggingInterceptor, streamValidationInterceptor),
+	)
+	proto.RegisterWorkoutServiceServer(grpcServer, s)
+	reflection.Register(grpcServer)
+	return grpcServer
+}
+
 // CreateWorkout ワークアウトを作成（プレゼンテーション層）
 func (s *GRPCServer) CreateWorkout(ctx context.Context, req *proto.CreateWorkoutRequest) (*proto.CreateWorkoutResponse, error) {
 	exerciseType := convertProtoExerciseType(req.ExerciseType)
-	log.Printf("💪 新しいワークアウトを作成中: %s", exerciseType.Japanese())
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	s.log(ctx).Debug("ワークアウトを作成中", slog.String("exercise_type", exerciseType.Key()))
 
 	// proto → usecase.CreateWorkoutRequest への変換
-	usecaseReq := usecase.CreateWorkoutRequest{
-		ExerciseType: exerciseType,
-		Description:  req.Description,
-		Difficulty:   convertProtoDifficulty(req.Difficulty),
-		MuscleGroup:  convertProtoMuscleGroup(req.MuscleGroup),
-		Sets:         req.Sets,
-		Reps:         req.Reps,
-		Weight:       req.Weight,
-		Notes:        req.Notes,
+	usecaseReq, err := s.convertProtoCreateWorkoutRequest(ctx, req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument,
+			s.buildErrorMessage(locale, i18n.MsgOpCreateWorkout, locale.ExerciseType(exerciseType), err.Error()))
 	}
 
-	workout, err := s.workoutManager.CreateWorkout(usecaseReq)
+	workout, err := s.manager(ctx).CreateWorkout(usecaseReq)
 	if err != nil {
-		return &proto.CreateWorkoutResponse{
-			Workout: nil,
-			Message: s.buildErrorMessage("ワークアウト作成", exerciseType.Japanese(), err.Error()),
-		}, nil
+		return nil, localizedStatusError(
+			s.buildErrorMessage(locale, i18n.MsgOpCreateWorkout, locale.ExerciseType(exerciseType), err.Error()), err)
 	}
 
 	// domain → proto への変換（プレゼンテーション層の責務）
-	protoWorkout := convertToProtoWorkout(workout)
+	protoWorkout := convertToProtoWorkout(workout, locale, unit)
 	return &proto.CreateWorkoutResponse{
 		Workout: protoWorkout,
-		Message: s.buildSuccessMessage("作成", exerciseType.Japanese()),
+		Message: s.buildSuccessMessage(locale, i18n.MsgOpCreated, locale.ExerciseType(exerciseType)),
 	}, nil
 }
 
+// convertProtoCreateWorkoutRequest proto → usecase.CreateWorkoutRequest への変換
+func (s *GRPCServer) convertProtoCreateWorkoutRequest(ctx context.Context, req *proto.CreateWorkoutRequest) (usecase.CreateWorkoutRequest, error) {
+	usecaseReq := usecase.CreateWorkoutRequest{
+		ExerciseType: convertProtoExerciseType(req.ExerciseType),
+		Description:  req.Description,
+		Difficulty:   convertProtoDifficulty(req.Difficulty),
+		MuscleGroup:  convertProtoMuscleGroup(req.MuscleGroup),
+		Sets:         req.GetSets(),
+		Reps:         req.GetReps(),
+		Weight:       req.Weight,
+		WeightUnit:   s.inputWeightUnit(ctx, req.WeightUnit),
+		Notes:        req.Notes,
+		Timezone:     req.Timezone,
+	}
+	if req.ScheduledFor != "" {
+		scheduledFor, err := s.parseScheduleParam(req.ScheduledFor, req.Timezone)
+		if err != nil {
+			return usecaseReq, err
+		}
+		usecaseReq.ScheduledFor = scheduledFor
+	}
+	return usecaseReq, nil
+}
+
 // GetWorkout ワークアウトを取得（プレゼンテーション層）
 func (s *GRPCServer) GetWorkout(ctx context.Context, req *proto.GetWorkoutRequest) (*proto.GetWorkoutResponse, error) {
-	log.Printf("🔍 ワークアウトを取得中: ID %d", req.Id)
+	s.log(ctx).Debug("ワークアウトを取得中", slog.Int64(logging.KeyWorkoutID, int64(req.Id)))
 
 	// ビジネスロジック層に処理を委譲
-	workout, err := s.workoutManager.GetWorkout(domain.WorkoutID(req.Id))
+	workout, err := s.manager(ctx).GetWorkout(domain.WorkoutID(req.Id))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get workout: %v", err)
+		return nil, statusError("failed to get workout", err)
 	}
 
 	// domain → proto への変換（プレゼンテーション層の責務）
-	protoWorkout := convertToProtoWorkout(workout)
+	protoWorkout := convertToProtoWorkout(workout, requestLocale(ctx), s.weightUnit(ctx))
 	return &proto.GetWorkoutResponse{
 		Workout: protoWorkout,
 	}, nil
@@ -101,78 +165,82 @@ func (s *GRPCServer) GetWorkout(ctx context.Context, req *proto.GetWorkoutReques
 func (s *GRPCServer) UpdateWorkout(ctx context.Context, req *proto.UpdateWorkoutRequest) (*proto.UpdateWorkoutResponse, error) {
 	// proto → domain への変換
 	exerciseType := convertProtoExerciseType(req.ExerciseType)
-	log.Printf("✏️ ワークアウトを更新中: ID %d (%s)", req.Id, exerciseType.Japanese())
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	s.log(ctx).Debug("ワークアウトを更新中", slog.Int64(logging.KeyWorkoutID, int64(req.Id)), slog.String("exercise_type", exerciseType.Key()))
+
+	// ビジネスロジック層に処理を委譲
+	err := s.manager(ctx).UpdateWorkout(s.convertProtoUpdateWorkoutRequest(ctx, req))
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgWorkoutUpdateFailed, err), err)
+	}
+
+	// 更新されたワークアウトを取得（表示用）
+	workout, err := s.manager(ctx).GetWorkout(domain.WorkoutID(req.Id))
+	if err != nil {
+		return nil, localizedStatusError(locale.Message(i18n.MsgWorkoutReloadFailed, err), err)
+	}
+
+	// domain → proto への変換（プレゼンテーション層の責務）
+	protoWorkout := convertToProtoWorkout(workout, locale, unit)
+	return &proto.UpdateWorkoutResponse{
+		Workout: protoWorkout,
+		Message: locale.Message(i18n.MsgWorkoutUpdated, locale.ExerciseType(exerciseType)),
+	}, nil
+}
 
-	// proto → usecase.UpdateWorkoutRequest への変換（ポインタ型）
+// convertProtoUpdateWorkoutRequest proto → usecase.UpdateWorkoutRequest への変換（ポインタ型）
+func (s *GRPCServer) convertProtoUpdateWorkoutRequest(ctx context.Context, req *proto.UpdateWorkoutRequest) usecase.UpdateWorkoutRequest {
 	description := req.Description
 	difficulty := convertProtoDifficulty(req.Difficulty)
 	muscleGroup := convertProtoMuscleGroup(req.MuscleGroup)
 	status := convertProtoWorkoutStatus(req.Status)
-	sets := int(req.Sets)
-	reps := int(req.Reps)
 	weight := req.Weight
 	notes := req.Notes
+	skipReason := convertProtoSkipReason(req.SkipReason)
 
-	usecaseReq := usecase.UpdateWorkoutRequest{
+	return usecase.UpdateWorkoutRequest{
 		ID:           domain.WorkoutID(req.Id),
-		ExerciseType: exerciseType,
+		ExerciseType: convertProtoExerciseType(req.ExerciseType),
 		Description:  &description,
 		Difficulty:   &difficulty,
 		MuscleGroup:  &muscleGroup,
 		Status:       &status,
-		Sets:         &sets,
-		Reps:         &reps,
+		Sets:         optionalCount(req.Sets),
+		Reps:         optionalCount(req.Reps),
 		Weight:       &weight,
+		WeightUnit:   s.inputWeightUnit(ctx, req.WeightUnit),
 		Notes:        &notes,
+		SkipReason:   &skipReason,
 	}
+}
 
-	// ビジネスロジック層に処理を委譲
-	err := s.workoutManager.UpdateWorkout(usecaseReq)
-	if err != nil {
-		return &proto.UpdateWorkoutResponse{
-			Workout: nil,
-			Message: fmt.Sprintf("❌ ワークアウト更新に失敗しました: %v", err),
-		}, nil
-	}
-
-	// 更新されたワークアウトを取得（表示用）
-	workout, err := s.workoutManager.GetWorkout(domain.WorkoutID(req.Id))
-	if err != nil {
-		return &proto.UpdateWorkoutResponse{
-			Workout: nil,
-			Message: fmt.Sprintf("❌ 更新されたワークアウトの取得に失敗しました: %v", err),
-		}, nil
+// optionalCount 省略されたセット数・回数は「変更しない」として扱う
+func optionalCount(value *int32) *int {
+	if value == nil {
+		return nil
 	}
-
-	// domain → proto への変換（プレゼンテーション層の責務）
-	protoWorkout := convertToProtoWorkout(workout)
-	return &proto.UpdateWorkoutResponse{
-		Workout: protoWorkout,
-		Message: fmt.Sprintf("✅ ワークアウト「%s」が更新されました！", exerciseType.Japanese()),
-	}, nil
+	count := int(*value)
+	return &count
 }
 
 // DeleteWorkout ワークアウトを削除（プレゼンテーション層）
 func (s *GRPCServer) DeleteWorkout(ctx context.Context, req *proto.DeleteWorkoutRequest) (*proto.DeleteWorkoutResponse, error) {
-	log.Printf("🗑️ ワークアウトを削除中: ID %d", req.Id)
+	s.log(ctx).Debug("ワークアウトを削除中", slog.Int64(logging.KeyWorkoutID, int64(req.Id)))
 
 	// ビジネスロジック層に処理を委譲
-	err := s.workoutManager.DeleteWorkout(domain.WorkoutID(req.Id))
+	err := s.manager(ctx).DeleteWorkout(domain.WorkoutID(req.Id))
 	if err != nil {
-		return &proto.DeleteWorkoutResponse{
-			Message: fmt.Sprintf("❌ ワークアウト削除に失敗しました: %v", err),
-		}, nil
+		return nil, localizedStatusError(requestLocale(ctx).Message(i18n.MsgWorkoutDeleteFailed, err), err)
 	}
 
 	return &proto.DeleteWorkoutResponse{
-		Message: "✅ ワークアウトが削除されました！",
+		Message: requestLocale(ctx).Message(i18n.MsgWorkoutDeleted),
 	}, nil
 }
 
 // ListWorkouts ワークアウト一覧を取得（プレゼンテーション層）
 func (s *GRPCServer) ListWorkouts(ctx context.Context, req *proto.ListWorkoutsRequest) (*proto.ListWorkoutsResponse, error) {
-	log.Printf("📋 ワークアウト一覧を取得中...")
-
 	// フィルター条件の変換（proto → domain）
 	var statusFilter *int
 	var difficultyFilter *int
@@ -191,20 +259,22 @@ func (s *GRPCServer) ListWorkouts(ctx context.Context, req *proto.ListWorkoutsRe
 		muscleGroupFilter = &muscleGroup
 	}
 
-	workouts, err := s.workoutManager.ListWorkouts(statusFilter, difficultyFilter, muscleGroupFilter)
+	workouts, err := s.manager(ctx).ListWorkouts(statusFilter, difficultyFilter, muscleGroupFilter)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list workouts: %v", err)
+		return nil, statusError("failed to list workouts", err)
 	}
 
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
 	convertedWorkouts := make([]*proto.Workout, 0, len(workouts))
 	for _, workout := range workouts {
-		convertedWorkouts = append(convertedWorkouts, convertToProtoWorkout(workout))
+		convertedWorkouts = append(convertedWorkouts, convertToProtoWorkout(workout, locale, unit))
 	}
 
 	// サマリーメッセージを生成
-	summary := s.buildWorkoutSummary(workouts)
+	summary := s.buildWorkoutSummary(locale, unit, workouts)
 
-	log.Printf("✅ %d件のワークアウトを返却します", len(convertedWorkouts))
+	s.log(ctx).Debug("ワークアウト一覧を返却します", slog.Int("workouts", len(convertedWorkouts)))
 
 	return &proto.ListWorkoutsResponse{
 		Workouts:   convertedWorkouts,
@@ -214,40 +284,19 @@ func (s *GRPCServer) ListWorkouts(ctx context.Context, req *proto.ListWorkoutsRe
 }
 
 // buildErrorMessage Go基礎技術による効率的なエラーメッセージ構築
-func (s *GRPCServer) buildErrorMessage(operation, target, errorDetail string) string {
-	var builder strings.Builder
-	// 概算容量を事前確保
-	builder.Grow(len(operation) + len(target) + len(errorDetail) + 20)
-
-	builder.WriteString("❌ ")
-	builder.WriteString(operation)
-	builder.WriteString("エラー: ")
-	builder.WriteString(target)
-	builder.WriteString(" - ")
-	builder.WriteString(errorDetail)
-
-	return builder.String()
+func (s *GRPCServer) buildErrorMessage(locale i18n.Locale, operation i18n.MessageID, target, errorDetail string) string {
+	return locale.Message(i18n.MsgOperationError, locale.Message(operation), target, errorDetail)
 }
 
 // buildSuccessMessage Go基礎技術による効率的な成功メッセージ構築
-func (s *GRPCServer) buildSuccessMessage(operation, target string) string {
-	var builder strings.Builder
-	// 概算容量を事前確保
-	builder.Grow(len(operation) + len(target) + 30)
-
-	builder.WriteString("🎉 ワークアウト「")
-	builder.WriteString(target)
-	builder.WriteString("」が")
-	builder.WriteString(operation)
-	builder.WriteString("されました！")
-
-	return builder.String()
+func (s *GRPCServer) buildSuccessMessage(locale i18n.Locale, operation i18n.MessageID, target string) string {
+	return locale.Message(i18n.MsgWorkoutSucceeded, target, locale.Message(operation))
 }
 
 // buildWorkoutSummary Go基礎技術による効率的なワークアウトサマリー構築
-func (s *GRPCServer) buildWorkoutSummary(workouts []*domain.Workout) string {
+func (s *GRPCServer) buildWorkoutSummary(locale i18n.Locale, unit domain.WeightUnit, workouts []*domain.Workout) string {
 	if len(workouts) == 0 {
-		return "📋 ワークアウトがありません"
+		return locale.Message(i18n.MsgWorkoutListEmpty)
 	}
 
 	var builder strings.Builder
@@ -255,22 +304,21 @@ func (s *GRPCServer) buildWorkoutSummary(workouts []*domain.Workout) string {
 	estimatedSize := len(workouts)*50 + 100
 	builder.Grow(estimatedSize)
 
-	builder.WriteString("📋 ワークアウト一覧 (")
-	builder.WriteString(fmt.Sprintf("%d件", len(workouts)))
-	builder.WriteString("):\n")
+	builder.WriteString(locale.Message(i18n.MsgWorkoutListHeader, len(workouts)))
+	builder.WriteString("\n")
 
 	for i, workout := range workouts {
 		if i > 0 {
 			builder.WriteString("\n")
 		}
-		builder.WriteString(fmt.Sprintf("  %d. %s (%s)", i+1, workout.ExerciseType.Japanese(), workout.MuscleGroup.Japanese()))
+		builder.WriteString(fmt.Sprintf("  %d. %s (%s)", i+1, locale.ExerciseType(workout.ExerciseType), locale.MuscleGroup(workout.MuscleGroup)))
 
 		if workout.Sets > 0 && workout.Reps > 0 {
-			builder.WriteString(fmt.Sprintf(" - %dセット×%d回", workout.Sets, workout.Reps))
+			builder.WriteString(locale.Message(i18n.MsgWorkoutSetsReps, workout.Sets, workout.Reps))
 		}
 
 		if workout.Weight > 0 {
-			builder.WriteString(fmt.Sprintf(" @ %.2fkg", workout.Weight))
+			builder.WriteString(fmt.Sprintf(" @ %.2f%s", workout.PlateWeightIn(unit), unit.Key()))
 		}
 	}
 
@@ -279,33 +327,92 @@ func (s *GRPCServer) buildWorkoutSummary(workouts []*domain.Workout) string {
 
 // GetHighIntensityWorkouts 高強度ワークアウト一覧を取得
 func (s *GRPCServer) GetHighIntensityWorkouts(ctx context.Context, req *proto.GetHighIntensityWorkoutsRequest) (*proto.GetHighIntensityWorkoutsResponse, error) {
-	log.Printf("🔥 高強度ワークアウトを取得中...")
+	s.log(ctx).Debug("高強度ワークアウトを取得中", slog.Int("rules", len(req.Rules)))
+
+	inputUnit := s.inputWeightUnit(ctx, req.WeightUnit)
+	rules := make([]domain.IntensityRule, 0, len(req.Rules))
+	for _, rule := range req.Rules {
+		rules = append(rules, convertProtoIntensityRule(rule, inputUnit))
+	}
 
-	workouts, err := s.workoutManager.GetHighIntensityWorkouts()
+	matches, err := s.manager(ctx).GetHighIntensityWorkouts(usecase.GetHighIntensityWorkoutsRequest{
+		Rules:      rules,
+		Bodyweight: inputUnit.ToKilograms(req.Bodyweight),
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get high intensity workouts: %v", err)
+		return nil, statusError("failed to get high intensity workouts", err)
 	}
 
 	// プロトコル形式に変換
-	protoWorkouts := make([]*proto.Workout, len(workouts))
-	for i, workout := range workouts {
-		protoWorkouts[i] = convertToProtoWorkout(workout)
+	locale := requestLocale(ctx)
+	unit := s.weightUnit(ctx)
+	protoWorkouts := make([]*proto.Workout, len(matches))
+	protoMatches := make([]*proto.HighIntensityMatch, len(matches))
+	for i, match := range matches {
+		protoWorkouts[i] = convertToProtoWorkout(match.Workout, locale, unit)
+		protoMatches[i] = &proto.HighIntensityMatch{
+			Workout:     protoWorkouts[i],
+			MatchedRule: match.MatchedRule,
+		}
 	}
 
-	message := fmt.Sprintf("💪 高強度ワークアウト%d件を取得しました！野獣たちの記録です", len(workouts))
-	if len(workouts) == 0 {
-		message = "😅 高強度ワークアウトがありません。もっと重いものを持ち上げましょう！"
+	message := locale.Message(i18n.MsgHighIntensityFound, len(matches))
+	if len(matches) == 0 {
+		message = locale.Message(i18n.MsgHighIntensityEmpty)
 	}
 
 	return &proto.GetHighIntensityWorkoutsResponse{
 		Workouts:   protoWorkouts,
-		TotalCount: int32(len(workouts)),
+		TotalCount: int32(len(matches)),
 		Message:    message,
+		Matches:    protoMatches,
+	}, nil
+}
+
+// CalculateOneRepMax 推定1RMを計算
+func (s *GRPCServer) CalculateOneRepMax(ctx context.Context, req *proto.CalculateOneRepMaxRequest) (*proto.CalculateOneRepMaxResponse, error) {
+	s.log(ctx).Debug("推定1RMを計算中", slog.Float64("weight", req.Weight), slog.Int("reps", int(req.Reps)))
+
+	usecaseReq := usecase.CalculateOneRepMaxRequest{
+		Weight: req.Weight,
+		Reps:   int(req.Reps),
+		RPE:    req.Rpe,
+	}
+	if req.Formula != proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED {
+		formula := convertProtoOneRepMaxFormula(req.Formula)
+		usecaseReq.Formula = &formula
+	}
+
+	locale := requestLocale(ctx)
+	estimates, err := s.manager(ctx).CalculateOneRepMax(usecaseReq)
+	if err != nil {
+		return nil, localizedStatusError(
+			s.buildErrorMessage(locale, i18n.MsgOpCalculateOneRepMax, locale.Message(i18n.MsgOneRepMaxInput, req.Weight, req.Reps), err.Error()), err)
+	}
+
+	protoEstimates := make([]*proto.OneRepMaxEstimate, 0, len(estimates))
+	for _, estimate := range estimates {
+		protoEstimates = append(protoEstimates, &proto.OneRepMaxEstimate{
+			Formula:            convertToProtoOneRepMaxFormula(estimate.Formula),
+			EstimatedOneRepMax: estimate.OneRepMax,
+		})
+	}
+
+	message := locale.Message(i18n.MsgOneRepMaxCalculated, len(estimates))
+	if len(estimates) == 0 {
+		message = locale.Message(i18n.MsgOneRepMaxNoFormula)
+	}
+
+	return &proto.CalculateOneRepMaxResponse{
+		Estimates: protoEstimates,
+		Message:   message,
 	}, nil
 }
 
 // 変換関数
-func convertToProtoWorkout(workout *domain.Workout) *proto.Workout {
+// 列挙値の表示名は locale の言語、重量・推定1RMは unit の単位で設定する
+func convertToProtoWorkout(workout *domain.Workout, locale i18n.Locale, unit domain.WeightUnit) *proto.Workout {
+	weight := workout.WeightIn(unit)
 	protoWorkout := &proto.Workout{
 		Id:           int32(workout.ID),
 		ExerciseType: convertToProtoExerciseType(workout.ExerciseType),
@@ -315,15 +422,43 @@ func convertToProtoWorkout(workout *domain.Workout) *proto.Workout {
 		MuscleGroup:  convertToProtoMuscleGroup(workout.MuscleGroup),
 		Sets:         int32(workout.Sets),
 		Reps:         int32(workout.Reps),
-		Weight:       workout.Weight,
+		Weight:       weight,
+		WeightUnit:   convertToProtoWeightUnit(unit),
+		PlateWeight:  workout.PlateWeightIn(unit),
 		Notes:        workout.Notes,
 		CreatedAt:    workout.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:    workout.UpdatedAt.Format(time.RFC3339),
+		SkipReason:   convertToProtoSkipReason(workout.SkipReason),
+		DisplayName: &proto.WorkoutDisplayName{
+			ExerciseType: locale.ExerciseType(workout.ExerciseType),
+			MuscleGroup:  locale.MuscleGroup(workout.MuscleGroup),
+			Difficulty:   locale.Difficulty(workout.Difficulty),
+			Status:       locale.WorkoutStatus(workout.Status),
+		},
+	}
+	if workout.SkipReason != domain.SkipReasonUnspecified {
+		protoWorkout.DisplayName.SkipReason = locale.SkipReason(workout.SkipReason)
 	}
 
 	if workout.CompletedAt != nil {
 		protoWorkout.CompletedAt = workout.CompletedAt.Format(time.RFC3339)
 	}
+	if workout.ScheduledFor != nil {
+		// 予定を登録したタイムゾーンのオフセットで返す
+		loc := workout.ScheduledLocation(workout.ScheduledFor.Location())
+		protoWorkout.ScheduledFor = workout.ScheduledFor.In(loc).Format(time.RFC3339)
+		protoWorkout.ScheduledTimezone = workout.ScheduledTimezone
+	}
+	if workout.ProgramID != nil {
+		protoWorkout.ProgramId = int32(*workout.ProgramID)
+		protoWorkout.ProgramVersion = int32(workout.ProgramVersion)
+	}
+
+	// 推定1RM（重量・回数が記録されている場合のみ）
+	if oneRepMax, err := strength.EstimateOneRepMax(strength.DefaultFormula, weight, workout.Reps, 0); err == nil && oneRepMax > 0 {
+		protoWorkout.EstimatedOneRepMax = oneRepMax
+		protoWorkout.OneRepMaxFormula = convertToProtoOneRepMaxFormula(strength.DefaultFormula)
+	}
 
 	return protoWorkout
 }
@@ -489,3 +624,66 @@ func convertProtoExerciseType(exerciseType proto.ExerciseType) domain.ExerciseTy
 		return domain.ExerciseUnspecified
 	}
 }
+
+// IntensityRule変換関数（proto → domain）
+// proto3のゼロ値は「条件なし」として扱う。重量・ボリュームはunitの単位からkgに換算する
+func convertProtoIntensityRule(rule *proto.IntensityRule, unit domain.WeightUnit) domain.IntensityRule {
+	domainRule := domain.IntensityRule{Name: rule.Name}
+	if rule.ExerciseType != proto.ExerciseType_EXERCISE_UNSPECIFIED {
+		exerciseType := convertProtoExerciseType(rule.ExerciseType)
+		domainRule.ExerciseType = &exerciseType
+	}
+	if rule.MinDifficulty != proto.Difficulty_DIFFICULTY_UNSPECIFIED {
+		difficulty := convertProtoDifficulty(rule.MinDifficulty)
+		domainRule.MinDifficulty = &difficulty
+	}
+	if rule.MinWeight > 0 {
+		minWeight := unit.ToKilograms(rule.MinWeight)
+		domainRule.MinWeight = &minWeight
+	}
+	if rule.MinBodyweightRatio > 0 {
+		domainRule.MinBodyweightRatio = &rule.MinBodyweightRatio
+	}
+	if rule.MinPercentOfOneRepMax > 0 {
+		domainRule.MinPercentOfOneRepMax = &rule.MinPercentOfOneRepMax
+	}
+	if rule.MinVolume > 0 {
+		minVolume := unit.ToKilograms(rule.MinVolume)
+		domainRule.MinVolume = &minVolume
+	}
+	if rule.MinSets > 0 {
+		minSets := int(rule.MinSets)
+		domainRule.MinSets = &minSets
+	}
+	return domainRule
+}
+
+// OneRepMaxFormula変換関数（domain → proto）
+func convertToProtoOneRepMaxFormula(formula strength.Formula) proto.OneRepMaxFormula {
+	switch formula {
+	case strength.FormulaEpley:
+		return proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_EPLEY
+	case strength.FormulaBrzycki:
+		return proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_BRZYCKI
+	case strength.FormulaLombardi:
+		return proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_LOMBARDI
+	case strength.FormulaRPE:
+		return proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_RPE
+	default:
+		return proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_UNSPECIFIED
+	}
+}
+
+// OneRepMaxFormula変換関数（proto → domain）
+func convertProtoOneRepMaxFormula(formula proto.OneRepMaxFormula) strength.Formula {
+	switch formula {
+	case proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_BRZYCKI:
+		return strength.FormulaBrzycki
+	case proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_LOMBARDI:
+		return strength.FormulaLombardi
+	case proto.OneRepMaxFormula_ONE_REP_MAX_FORMULA_RPE:
+		return strength.FormulaRPE
+	default:
+		return strength.FormulaEpley
+	}
+}
@@ -13,22 +13,95 @@ CREATE TABLE IF NOT EXISTS workouts (
     exercise_type INT NOT NULL DEFAULT 0 COMMENT '0:未指定, 1:ベンチプレス, 2:スクワット, 3:デッドリフト, 4:ショルダープレス, 5:懸垂, 6:サイドレイズ, 7:ワンハンドロー, 8:ハイプル',
     description TEXT,
     status TINYINT NOT NULL DEFAULT 0 COMMENT '0:予定, 1:実行中, 2:完了, 3:スキップ',
-    difficulty TINYINT NOT NULL DEFAULT 1 COMMENT '1:初心者, 2:中級者, 3:上級者, 4:化け物',
+    difficulty TINYINT NOT NULL DEFAULT 0 COMMENT '0:初心者, 1:中級者, 2:上級者, 3:化け物（domain.Difficultyと同じ）',
     muscle_group BIGINT NOT NULL DEFAULT 0 COMMENT '0:未指定, 1:胸, 2:背中, 3:脚, 4:肩, 5:腕, 6:腹筋, 7:体幹, 8:臀部, 9:有酸素, 10:全身',
     sets INT UNSIGNED DEFAULT 3,
     reps INT UNSIGNED DEFAULT 10,
-    weight DECIMAL(6,2) DEFAULT 0.00,
+    weight DECIMAL(10,4) DEFAULT 0.0000 COMMENT '重量(kg)。ポンドで入力した重量を換算しても入力値に戻せる精度',
+    weight_unit TINYINT NOT NULL DEFAULT 0 COMMENT '重量を入力した単位 0:kg, 1:lb',
     notes TEXT,
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
     updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
     completed_at TIMESTAMP NULL,
+    skip_reason TINYINT NOT NULL DEFAULT 0 COMMENT '0:未指定, 1:筋肉痛, 2:時間がない, 3:体調不良, 4:怪我, 5:出張・旅行, 6:天候, 7:やる気が出ない, 8:その他, 9:未実施（自動スキップ）',
+    scheduled_for TIMESTAMP NULL COMMENT '実施予定日時',
+    scheduled_timezone VARCHAR(64) NOT NULL DEFAULT '' COMMENT '予定を登録したタイムゾーン（IANA名）',
+    program_id BIGINT NULL COMMENT '生成元のプログラム',
+    program_version INT NOT NULL DEFAULT 0 COMMENT '生成元のプログラムのバージョン',
+    import_key VARCHAR(64) NOT NULL DEFAULT '' COMMENT '他のアプリから取り込んだ記録の識別子（再インポート時の重複排除用）',
+    import_key_unique VARCHAR(64) AS (NULLIF(import_key, '')) STORED COMMENT '一意制約用の取り込み識別子（取り込んでいない記録はNULL）',
     
     -- データ整合性制約
     CHECK (status >= 0 AND status <= 3),
-    CHECK (difficulty >= 1 AND difficulty <= 4),
+    CHECK (difficulty >= 0 AND difficulty <= 3),
+    CHECK (skip_reason >= 0 AND skip_reason <= 9),
     CHECK (sets > 0 AND sets <= 100),
     CHECK (reps > 0 AND reps <= 1000),
-    CHECK (weight >= 0 AND weight <= 9999.99)
+    CHECK (weight >= 0 AND weight <= 9999.99),
+    CHECK (weight_unit >= 0 AND weight_unit <= 1)
+);
+
+-- 実施中のワークアウトで記録したセット（TrackWorkoutで1セットずつ保存）
+CREATE TABLE IF NOT EXISTS workout_sets (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    workout_id BIGINT NOT NULL,
+    set_number INT NOT NULL COMMENT 'ワークアウト内で1から始まる連番',
+    reps INT UNSIGNED NOT NULL,
+    weight DECIMAL(6,2) NOT NULL DEFAULT 0.00,
+    rpe DECIMAL(3,1) NOT NULL DEFAULT 0.0 COMMENT '0:未記録',
+    completed_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CHECK (reps > 0 AND reps <= 1000),
+    CHECK (weight >= 0 AND weight <= 9999.99),
+    CHECK (rpe >= 0 AND rpe <= 10),
+    FOREIGN KEY (workout_id) REFERENCES workouts(id) ON DELETE CASCADE,
+    UNIQUE INDEX idx_workout_sets_number (workout_id, set_number)
+);
+
+-- トレーニングプログラム（5/3/1、PPLなど）
+CREATE TABLE IF NOT EXISTS programs (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    description TEXT,
+    version INT NOT NULL DEFAULT 1 COMMENT 'テンプレートを編集するたびに1つ増える',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
+
+-- ワークアウトテンプレート（プログラムのバージョンごとに保存し、過去のバージョンは変更しない）
+CREATE TABLE IF NOT EXISTS workout_templates (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    program_id BIGINT NOT NULL,
+    version INT NOT NULL,
+    position INT NOT NULL DEFAULT 0 COMMENT 'プログラム内での並び順',
+    name VARCHAR(100) NOT NULL,
+    day_of_week TINYINT NOT NULL COMMENT '0:日, 1:月, 2:火, 3:水, 4:木, 5:金, 6:土',
+    exercises JSON NOT NULL COMMENT '種目と週ごとのセット構成',
+
+    CHECK (day_of_week >= 0 AND day_of_week <= 6),
+    FOREIGN KEY (program_id) REFERENCES programs(id) ON DELETE CASCADE,
+    INDEX idx_templates_program_version (program_id, version, position)
+);
+
+-- 体重・身体測定の記録（測定しなかった項目は0）
+CREATE TABLE IF NOT EXISTS body_measurements (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    measured_on DATE NOT NULL COMMENT '測定日',
+    bodyweight DECIMAL(5,2) NOT NULL DEFAULT 0.00 COMMENT '体重(kg)',
+    body_fat_percent DECIMAL(4,1) NOT NULL DEFAULT 0.0 COMMENT '体脂肪率(%)',
+    waist DECIMAL(4,1) NOT NULL DEFAULT 0.0 COMMENT 'ウエスト(cm)',
+    chest DECIMAL(4,1) NOT NULL DEFAULT 0.0 COMMENT '胸囲(cm)',
+    arm DECIMAL(4,1) NOT NULL DEFAULT 0.0 COMMENT '上腕囲(cm)',
+    notes TEXT,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    CHECK (bodyweight >= 0 AND bodyweight <= 500),
+    CHECK (body_fat_percent >= 0 AND body_fat_percent <= 100),
+    CHECK (waist >= 0 AND waist <= 300),
+    CHECK (chest >= 0 AND chest <= 300),
+    CHECK (arm >= 0 AND arm <= 300),
+    INDEX idx_body_measurements_date (measured_on)
 );
 
 -- 効率的なインデックス戦略（パフォーマンス最適化版）
@@ -53,53 +126,61 @@ CREATE INDEX idx_workouts_created_range ON workouts(created_at, status);
 -- 6. 統計クエリ用の複合インデックス
 CREATE INDEX idx_workouts_stats ON workouts(status, muscle_group, weight, created_at);
 
+-- 7. 予定日・生成元プログラムのインデックス
+CREATE INDEX idx_workouts_scheduled ON workouts(scheduled_for, status);
+CREATE INDEX idx_workouts_program ON workouts(program_id, program_version);
+
+-- 8. 取り込み識別子の一意インデックス（再インポート時の重複チェック、同時に取り込んだ場合の重複防止）
+-- 空文字（取り込んでいない記録）はNULLにして一意制約の対象外にする
+CREATE UNIQUE INDEX idx_workouts_import_key ON workouts(import_key_unique);
+
 -- 楽しいサンプルデータ（より多様なデータでチューニング効果を確認）
 -- exercise_type: 1=ベンチプレス, 2=スクワット, 3=デッドリフト, 4=ショルダープレス, 5=懸垂, 6=サイドレイズ, 7=ワンハンドロー, 8=ハイプル
 INSERT INTO workouts (exercise_type, description, status, difficulty, muscle_group, sets, reps, weight, notes) VALUES
 -- 胸のワークアウト (muscle_group = 1)
-(1, '胸をバキバキに鍛える！モテ男への第一歩', 0, 2, 1, 3, 10, 60.00, '今日は調子がいい！💪'),
-(1, '上部胸筋を重点的に鍛える', 0, 3, 1, 4, 8, 45.00, '上部胸筋が効いてる！'),
-(1, '下部胸筋を鍛えて厚みを出す', 0, 3, 1, 3, 12, 50.00, '下部胸筋がバキバキ！'),
-(1, '胸の内側を集中的に鍛える', 0, 2, 1, 3, 15, 20.00, '胸の内側が効いてる！'),
+(1, '胸をバキバキに鍛える！モテ男への第一歩', 0, 1, 1, 3, 10, 60.00, '今日は調子がいい！💪'),
+(1, '上部胸筋を重点的に鍛える', 0, 2, 1, 4, 8, 45.00, '上部胸筋が効いてる！'),
+(1, '下部胸筋を鍛えて厚みを出す', 0, 2, 1, 3, 12, 50.00, '下部胸筋がバキバキ！'),
+(1, '胸の内側を集中的に鍛える', 0, 1, 1, 3, 15, 20.00, '胸の内側が効いてる！'),
 
 -- 背中のワークアウト (muscle_group = 2)
-(3, '背中を広くして逆三角形に！', 0, 3, 2, 3, 8, 80.00, '化け物級の重量に挑戦！🔥'),
-(7, '広い背中で逆三角形を目指す', 0, 2, 2, 3, 12, 45.00, '背中が広がってきた！'),
-(7, '背中の厚みを増す', 0, 2, 2, 4, 10, 40.00, '背中が厚くなってきた！'),
-(7, '背中の中央部を鍛える', 0, 1, 2, 3, 15, 30.00, '背中の中央が効いてる！'),
+(3, '背中を広くして逆三角形に！', 0, 2, 2, 3, 8, 80.00, '化け物級の重量に挑戦！🔥'),
+(7, '広い背中で逆三角形を目指す', 0, 1, 2, 3, 12, 45.00, '背中が広がってきた！'),
+(7, '背中の厚みを増す', 0, 1, 2, 4, 10, 40.00, '背中が厚くなってきた！'),
+(7, '背中の中央部を鍛える', 0, 0, 2, 3, 15, 30.00, '背中の中央が効いてる！'),
 
 -- 脚のワークアウト (muscle_group = 3)
-(2, '下半身を鍛えてモテ男に！', 0, 1, 3, 4, 15, 0.00, '自重でもキツい...😅'),
-(2, '太ももを太くして逞しく', 0, 2, 3, 4, 12, 100.00, '脚が太くなってきた！'),
-(2, '太もも前部を集中的に鍛える', 0, 1, 3, 3, 20, 25.00, '太もも前部が効いてる！'),
-(2, '太もも後部を鍛える', 0, 1, 3, 3, 15, 20.00, '太もも後部が効いてる！'),
+(2, '下半身を鍛えてモテ男に！', 0, 0, 3, 4, 15, 0.00, '自重でもキツい...😅'),
+(2, '太ももを太くして逞しく', 0, 1, 3, 4, 12, 100.00, '脚が太くなってきた！'),
+(2, '太もも前部を集中的に鍛える', 0, 0, 3, 3, 20, 25.00, '太もも前部が効いてる！'),
+(2, '太もも後部を鍛える', 0, 0, 3, 3, 15, 20.00, '太もも後部が効いてる！'),
 
 -- 肩のワークアウト (muscle_group = 4)
-(4, '肩を大きくして逞しく見せる', 0, 2, 4, 3, 10, 30.00, '肩が丸くなってきた💪'),
-(6, '肩の外側を鍛える', 0, 1, 4, 3, 15, 8.00, '肩の外側が効いてる！'),
-(4, '肩の後ろ側を鍛える', 0, 2, 4, 3, 12, 12.00, '肩の後ろ側が効いてる！'),
-(4, '肩の前側を鍛える', 0, 1, 4, 3, 12, 10.00, '肩の前側が効いてる！'),
+(4, '肩を大きくして逞しく見せる', 0, 1, 4, 3, 10, 30.00, '肩が丸くなってきた💪'),
+(6, '肩の外側を鍛える', 0, 0, 4, 3, 15, 8.00, '肩の外側が効いてる！'),
+(4, '肩の後ろ側を鍛える', 0, 1, 4, 3, 12, 12.00, '肩の後ろ側が効いてる！'),
+(4, '肩の前側を鍛える', 0, 0, 4, 3, 12, 10.00, '肩の前側が効いてる！'),
 
 -- 腕のワークアウト (muscle_group = 5)
-(1, '腕を太くして彼女にアピール', 0, 1, 5, 3, 20, 0.00, '筋肉痛で死にそう💀'),
-(5, '腕を太くして逞しく', 0, 1, 5, 3, 15, 15.00, '腕が太くなってきた💪'),
-(5, '腕の後ろ側を鍛える', 0, 2, 5, 3, 12, 35.00, '腕の後ろ側が効いてる！'),
-(5, '腕の外側を鍛える', 0, 2, 5, 3, 12, 18.00, '腕の外側が効いてる！'),
+(1, '腕を太くして彼女にアピール', 0, 0, 5, 3, 20, 0.00, '筋肉痛で死にそう💀'),
+(5, '腕を太くして逞しく', 0, 0, 5, 3, 15, 15.00, '腕が太くなってきた💪'),
+(5, '腕の後ろ側を鍛える', 0, 1, 5, 3, 12, 35.00, '腕の後ろ側が効いてる！'),
+(5, '腕の外側を鍛える', 0, 1, 5, 3, 12, 18.00, '腕の外側が効いてる！'),
 
 -- 腹筋のワークアウト (muscle_group = 6)
-(0, '腹筋を割ってビーチボディに！', 0, 1, 6, 3, 60, 0.00, '1分間が永遠に感じる⏰'),
-(0, '腹筋を割ってモテ男に', 0, 1, 6, 3, 20, 0.00, '腹筋が少し見えてきた😊'),
-(0, '下腹部を集中的に鍛える', 0, 2, 6, 3, 15, 0.00, '下腹部が効いてる！'),
-(0, '腹斜筋を鍛える', 0, 2, 6, 3, 45, 0.00, '腹斜筋が効いてる！'),
+(0, '腹筋を割ってビーチボディに！', 0, 0, 6, 3, 60, 0.00, '1分間が永遠に感じる⏰'),
+(0, '腹筋を割ってモテ男に', 0, 0, 6, 3, 20, 0.00, '腹筋が少し見えてきた😊'),
+(0, '下腹部を集中的に鍛える', 0, 1, 6, 3, 15, 0.00, '下腹部が効いてる！'),
+(0, '腹斜筋を鍛える', 0, 1, 6, 3, 45, 0.00, '腹斜筋が効いてる！'),
 
 -- 完了済みのワークアウト（統計クエリ用）
-(1, '胸をバキバキに鍛えた！', 2, 2, 1, 3, 10, 65.00, '記録更新！💪'),
-(2, '下半身を鍛えた！', 2, 1, 3, 4, 15, 0.00, '筋肉痛で歩けない😅'),
-(3, '背中を鍛えた！', 2, 3, 2, 3, 8, 85.00, '化け物級の重量達成！🔥'),
+(1, '胸をバキバキに鍛えた！', 2, 1, 1, 3, 10, 65.00, '記録更新！💪'),
+(2, '下半身を鍛えた！', 2, 0, 3, 4, 15, 0.00, '筋肉痛で歩けない😅'),
+(3, '背中を鍛えた！', 2, 2, 2, 3, 8, 85.00, '化け物級の重量達成！🔥'),
 
 -- スキップしたワークアウト
-(0, '有酸素運動で脂肪燃焼', 3, 1, 9, 1, 30, 0.00, '雨でスキップ😢'),
-(0, '柔軟性を高める', 3, 1, 7, 1, 60, 0.00, '時間がなくてスキップ😅');
+(0, '有酸素運動で脂肪燃焼', 3, 0, 9, 1, 30, 0.00, '雨でスキップ😢'),
+(0, '柔軟性を高める', 3, 0, 7, 1, 60, 0.00, '時間がなくてスキップ😅');
 
 -- 統計情報の表示
 SELECT 
@@ -0,0 +1,93 @@
+// Package tracing OpenTelemetryのトレースの設定（エクスポーター・サンプリング・伝播形式）
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// エクスポーター（スパンの送信先）
+const (
+	ExporterNone   = "none"   // 送信しない（デフォルト）
+	ExporterStdout = "stdout" // 標準出力にJSONで出力（ローカルでの確認用）
+	ExporterOTLP   = "otlp"   // OTLP/gRPCでコレクター（Jaeger・Tempoなど）に送信
+)
+
+// ServiceName トレースのservice.name
+const ServiceName = "golv2-learning-app"
+
+// Config トレースの設定
+type Config struct {
+	Exporter    string  // none・stdout・otlp（空の場合はnone）
+	Endpoint    string  // OTLPの送信先（例: "localhost:4317"）。空の場合は環境変数OTEL_EXPORTER_OTLP_ENDPOINTまたはlocalhost:4317
+	Insecure    bool    // OTLPをTLSなしで送信する
+	SampleRatio float64 // 記録するトレースの割合（0〜1）。親のスパンがある場合は親に従う
+	Version     string  // service.version
+}
+
+// New 設定に従ってTracerProviderを作成する
+// 戻り値のshutdownは終了時に呼び出し、バッファに残ったスパンを送信する
+func New(ctx context.Context, cfg Config, stdout io.Writer) (provider trace.TracerProvider, shutdown func(context.Context) error, err error) {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, nil, fmt.Errorf("invalid sample ratio: %v (0 to 1)", cfg.SampleRatio)
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch strings.ToLower(cfg.Exporter) {
+	case "", ExporterNone:
+		return Noop(), func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(stdout))
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, nil, fmt.Errorf("unknown tracing exporter: %q (none, stdout or otlp)", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create %s exporter: %w", cfg.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL,
+		semconv.ServiceName(ServiceName),
+		semconv.ServiceVersion(cfg.Version),
+	))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+	)
+	return tp, tp.Shutdown, nil
+}
+
+// Noop スパンを記録しないTracerProvider（トレースを設定しない場合のデフォルト）
+func Noop() trace.TracerProvider {
+	return noop.NewTracerProvider()
+}
+
+// Propagator トレースコンテキストを受け渡す形式（W3C Trace Context・Baggage）
+// gRPCのメタデータ・HTTPヘッダーの traceparent で呼び出し元のトレースを引き継ぐ
+func Propagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+}
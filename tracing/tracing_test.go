@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// TestNew テーブル駆動テストでエクスポーター・サンプリングの設定をテスト
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         Config
+		wantErr     bool
+		wantSDK     bool // スパンを記録するTracerProviderか
+		wantOutput  bool // 標準出力にスパンが出力されるか
+		description string
+	}{
+		{
+			name:        "正常系: 未設定",
+			cfg:         Config{},
+			description: "記録しないTracerProviderを返す",
+		},
+		{
+			name:        "正常系: none",
+			cfg:         Config{Exporter: ExporterNone, SampleRatio: 1},
+			description: "記録しないTracerProviderを返す",
+		},
+		{
+			name:        "正常系: stdout",
+			cfg:         Config{Exporter: "STDOUT", SampleRatio: 1, Version: "1.0.0"},
+			wantSDK:     true,
+			wantOutput:  true,
+			description: "大文字小文字を区別せず、終了時にスパンを出力する",
+		},
+		{
+			name:        "正常系: サンプリングしない",
+			cfg:         Config{Exporter: ExporterStdout, SampleRatio: 0},
+			wantSDK:     true,
+			description: "割合が0の場合はスパンを出力しない",
+		},
+		{
+			name:        "異常系: 不明な出力先",
+			cfg:         Config{Exporter: "zipkin", SampleRatio: 1},
+			wantErr:     true,
+			description: "設定の誤りは起動時に検出する",
+		},
+		{
+			name:        "異常系: 割合が範囲外",
+			cfg:         Config{Exporter: ExporterStdout, SampleRatio: 1.5},
+			wantErr:     true,
+			description: "サンプリングの割合は0〜1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			tp, shutdown, err := New(context.Background(), tt.cfg, &buf)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if _, ok := tp.(*sdktrace.TracerProvider); ok != tt.wantSDK {
+				t.Errorf("Expected SDK provider = %t, got %T", tt.wantSDK, tp)
+			}
+			_, span := tp.Tracer("test").Start(context.Background(), "test-span")
+			span.End()
+			if err := shutdown(context.Background()); err != nil {
+				t.Fatalf("shutdown() error = %v", err)
+			}
+			if got := strings.Contains(buf.String(), `"Name":"test-span"`); got != tt.wantOutput {
+				t.Errorf("Expected output = %t, got %q", tt.wantOutput, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,333 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+// BatchMode 一括処理の結果の扱い
+type BatchMode int
+
+const (
+	BatchAtomic  BatchMode = iota // 1件でも失敗したら何も反映しない
+	BatchPerItem                  // 成功した項目だけ反映し、項目ごとに結果を返す
+)
+
+const (
+	MaxBatchItems   = 1000 // 1回の一括処理で受け付ける最大件数
+	createBatchSize = 100  // 一括作成で1つのINSERT文にまとめる件数
+)
+
+// 一括処理の項目ごとのエラーの種類（errors.Isで判定する）
+var (
+	ErrBatchInvalid  = errors.New("invalid batch item")
+	ErrBatchNotFound = errors.New("workout not found")
+	ErrBatchAborted  = errors.New("aborted because another item in the atomic batch failed")
+)
+
+// BatchItemResult 一括処理の1項目分の結果
+type BatchItemResult struct {
+	Index   int              // リクエスト内の位置（0始まり）
+	ID      domain.WorkoutID // 対象のワークアウトID（作成に失敗した場合は0）
+	Workout *domain.Workout  // 作成・更新後のワークアウト（失敗した場合と削除の場合はnil）
+	Err     error            // nilなら成功
+}
+
+// BatchResult 一括処理の結果
+type BatchResult struct {
+	Items     []*BatchItemResult // リクエストと同じ順番
+	Committed bool               // 1件以上反映したか
+}
+
+// SuccessCount 成功した項目の件数
+func (r *BatchResult) SuccessCount() int {
+	count := 0
+	for _, item := range r.Items {
+		if item.Err == nil {
+			count++
+		}
+	}
+	return count
+}
+
+// batchItemError 項目ごとのエラーに種類を付ける（元のエラーも保持する）
+func batchItemError(kind, err error) error {
+	return fmt.Errorf("%w: %w", kind, err)
+}
+
+// validateBatchSize 一括処理の件数を検証
+func (wm *WorkoutManager) validateBatchSize(op string, count int) error {
+	if count == 0 || count > MaxBatchItems {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      op,
+			Message: fmt.Sprintf("batch must contain 1 to %d items (got: %d)", MaxBatchItems, count),
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+	return nil
+}
+
+// settleBatch 検証に失敗した項目があればアトミックモードでは残りを中断扱いにし、保存する項目を返す
+func settleBatch(items []*BatchItemResult, mode BatchMode) []*BatchItemResult {
+	pending := make([]*BatchItemResult, 0, len(items))
+	failed := false
+	for _, item := range items {
+		if item.Err != nil {
+			failed = true
+			continue
+		}
+		pending = append(pending, item)
+	}
+	if failed && mode == BatchAtomic {
+		for _, item := range pending {
+			item.Err = ErrBatchAborted
+			item.Workout = nil
+		}
+		return nil
+	}
+	return pending
+}
+
+// failBatch 保存に失敗した項目にエラーを設定する
+func failBatch(items []*BatchItemResult, err error) {
+	for _, item := range items {
+		item.Err = err
+		item.Workout = nil
+	}
+}
+
+// BatchCreateWorkouts 複数のワークアウトを一括作成（ビジネスロジック層）
+// 検証はCreateWorkoutと同じ。保存はまとめて1トランザクションで行い、
+// 項目ごとモードで保存に失敗した場合は1件ずつ作成して失敗した項目を特定する
+func (wm *WorkoutManager) BatchCreateWorkouts(reqs []CreateWorkoutRequest, mode BatchMode) (*BatchResult, error) {
+	wm, span := wm.startSpan("BatchCreateWorkouts")
+	defer span.End()
+
+	if err := wm.validateBatchSize("BatchCreateWorkouts", len(reqs)); err != nil {
+		return nil, err
+	}
+	wm.logger.Debug("ワークアウトを一括作成中", slog.String(logging.KeyOp, "BatchCreateWorkouts"), slog.Int("items", len(reqs)))
+
+	result := &BatchResult{Items: make([]*BatchItemResult, 0, len(reqs))}
+	for i, req := range reqs {
+		workout, err := wm.newWorkout("BatchCreateWorkouts", req)
+		if err != nil {
+			err = batchItemError(ErrBatchInvalid, err)
+		}
+		result.Items = append(result.Items, &BatchItemResult{Index: i, Workout: workout, Err: err})
+	}
+
+	pending := settleBatch(result.Items, mode)
+	if len(pending) == 0 {
+		return result, nil
+	}
+	workouts := make([]*domain.Workout, 0, len(pending))
+	for _, item := range pending {
+		workouts = append(workouts, item.Workout)
+	}
+
+	if err := wm.repo.BatchCreateWorkouts(workouts, createBatchSize); err != nil {
+		wm.logger.Error("一括作成に失敗しました", slog.String(logging.KeyOp, "BatchCreateWorkouts"), slog.String(logging.KeyError, err.Error()))
+		if mode == BatchAtomic {
+			failBatch(pending, err)
+			return result, nil
+		}
+		// ロールバックされたため、採番されたIDを戻して1件ずつ作成する
+		for _, item := range pending {
+			item.Workout.ID = 0
+			if err := wm.repo.CreateWorkout(item.Workout); err != nil {
+				failBatch([]*BatchItemResult{item}, err)
+			}
+		}
+	}
+
+	for _, item := range pending {
+		if item.Err == nil {
+			item.ID = item.Workout.ID
+			result.Committed = true
+			wm.publishEvent(domain.WorkoutEventCreated, item.Workout)
+		}
+	}
+	wm.logger.Info("ワークアウトを一括作成しました",
+		slog.String(logging.KeyOp, "BatchCreateWorkouts"), slog.Int("succeeded", result.SuccessCount()), slog.Int("items", len(reqs)))
+	return result, nil
+}
+
+// BatchUpdateWorkouts 複数のワークアウトを一括更新（ビジネスロジック層）
+// 検証・値の反映はUpdateWorkoutと同じ。同じIDを複数回指定することはできない
+func (wm *WorkoutManager) BatchUpdateWorkouts(reqs []UpdateWorkoutRequest, mode BatchMode) (*BatchResult, error) {
+	wm, span := wm.startSpan("BatchUpdateWorkouts")
+	defer span.End()
+
+	if err := wm.validateBatchSize("BatchUpdateWorkouts", len(reqs)); err != nil {
+		return nil, err
+	}
+	wm.logger.Debug("ワークアウトを一括更新中", slog.String(logging.KeyOp, "BatchUpdateWorkouts"), slog.Int("items", len(reqs)))
+
+	ids := make([]domain.WorkoutID, 0, len(reqs))
+	for _, req := range reqs {
+		ids = append(ids, req.ID)
+	}
+	existing, err := wm.getWorkoutsByID("BatchUpdateWorkouts", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Items: make([]*BatchItemResult, 0, len(reqs))}
+	justCompleted := make(map[domain.WorkoutID]bool, len(reqs))
+	previousStatus := make(map[domain.WorkoutID]domain.WorkoutStatus, len(reqs))
+	seen := make(map[domain.WorkoutID]bool, len(reqs))
+	for i, req := range reqs {
+		item := &BatchItemResult{Index: i, ID: req.ID}
+		result.Items = append(result.Items, item)
+
+		if err := wm.validateUpdateInputWithErrValidator(req.ID, req.ExerciseType, req.Sets, req.Reps, req.weightInKilograms()); err != nil {
+			item.Err = batchItemError(ErrBatchInvalid, err)
+			continue
+		}
+		if seen[req.ID] {
+			item.Err = fmt.Errorf("%w: duplicate workout ID in batch: %d", ErrBatchInvalid, req.ID)
+			continue
+		}
+		seen[req.ID] = true
+		workout, ok := existing[req.ID]
+		if !ok {
+			item.Err = fmt.Errorf("%w (ID: %d)", ErrBatchNotFound, req.ID)
+			continue
+		}
+		previousStatus[req.ID] = workout.Status
+		justCompleted[req.ID] = wm.applyUpdate(workout, req)
+		item.Workout = workout
+	}
+
+	pending := settleBatch(result.Items, mode)
+	if len(pending) == 0 {
+		return result, nil
+	}
+	workouts := make([]*domain.Workout, 0, len(pending))
+	for _, item := range pending {
+		workouts = append(workouts, item.Workout)
+	}
+
+	if err := wm.repo.BatchUpdateWorkouts(workouts); err != nil {
+		wm.logger.Error("一括更新に失敗しました", slog.String(logging.KeyOp, "BatchUpdateWorkouts"), slog.String(logging.KeyError, err.Error()))
+		if mode == BatchAtomic {
+			failBatch(pending, err)
+			return result, nil
+		}
+		for _, item := range pending {
+			if err := wm.repo.UpdateWorkout(item.Workout); err != nil {
+				failBatch([]*BatchItemResult{item}, err)
+			}
+		}
+	}
+
+	for _, item := range pending {
+		if item.Err != nil {
+			continue
+		}
+		result.Committed = true
+		wm.publishUpdate(item.Workout, previousStatus[item.ID])
+		// ビジネスロジック: 完了したら次回の予定を作成（失敗しても更新自体は成功扱い）
+		if justCompleted[item.ID] {
+			if _, err := wm.scheduleNextWorkout(item.Workout); err != nil {
+				wm.logger.Warn("次回のワークアウトの自動作成に失敗しました",
+					slog.String(logging.KeyOp, "BatchUpdateWorkouts"), slog.Int64(logging.KeyWorkoutID, int64(item.ID)),
+					slog.String(logging.KeyError, err.Error()))
+			}
+		}
+	}
+	wm.logger.Info("ワークアウトを一括更新しました",
+		slog.String(logging.KeyOp, "BatchUpdateWorkouts"), slog.Int("succeeded", result.SuccessCount()), slog.Int("items", len(reqs)))
+	return result, nil
+}
+
+// BatchDeleteWorkouts 複数のワークアウトを一括削除（ビジネスロジック層）
+func (wm *WorkoutManager) BatchDeleteWorkouts(ids []domain.WorkoutID, mode BatchMode) (*BatchResult, error) {
+	wm, span := wm.startSpan("BatchDeleteWorkouts")
+	defer span.End()
+
+	if err := wm.validateBatchSize("BatchDeleteWorkouts", len(ids)); err != nil {
+		return nil, err
+	}
+	wm.logger.Debug("ワークアウトを一括削除中", slog.String(logging.KeyOp, "BatchDeleteWorkouts"), slog.Int("items", len(ids)))
+
+	existing, err := wm.getWorkoutsByID("BatchDeleteWorkouts", ids)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BatchResult{Items: make([]*BatchItemResult, 0, len(ids))}
+	seen := make(map[domain.WorkoutID]bool, len(ids))
+	for i, id := range ids {
+		item := &BatchItemResult{Index: i, ID: id}
+		result.Items = append(result.Items, item)
+
+		switch {
+		case id <= 0:
+			item.Err = fmt.Errorf("%w: workout ID must be positive (got: %d)", ErrBatchInvalid, id)
+		case seen[id]:
+			item.Err = fmt.Errorf("%w: duplicate workout ID in batch: %d", ErrBatchInvalid, id)
+		case existing[id] == nil:
+			item.Err = fmt.Errorf("%w (ID: %d)", ErrBatchNotFound, id)
+		}
+		seen[id] = true
+	}
+
+	pending := settleBatch(result.Items, mode)
+	if len(pending) == 0 {
+		return result, nil
+	}
+	pendingIDs := make([]domain.WorkoutID, 0, len(pending))
+	for _, item := range pending {
+		pendingIDs = append(pendingIDs, item.ID)
+	}
+
+	if _, err := wm.repo.BatchDeleteWorkouts(pendingIDs); err != nil {
+		wm.logger.Error("一括削除に失敗しました", slog.String(logging.KeyOp, "BatchDeleteWorkouts"), slog.String(logging.KeyError, err.Error()))
+		if mode == BatchAtomic {
+			failBatch(pending, err)
+			return result, nil
+		}
+		for _, item := range pending {
+			if err := wm.repo.DeleteWorkout(item.ID); err != nil {
+				failBatch([]*BatchItemResult{item}, err)
+			}
+		}
+	}
+
+	for _, item := range pending {
+		if item.Err == nil {
+			result.Committed = true
+			wm.publishEvent(domain.WorkoutEventDeleted, existing[item.ID])
+		}
+	}
+	wm.logger.Info("ワークアウトを一括削除しました",
+		slog.String(logging.KeyOp, "BatchDeleteWorkouts"), slog.Int("succeeded", result.SuccessCount()), slog.Int("items", len(ids)))
+	return result, nil
+}
+
+// getWorkoutsByID 指定したIDのワークアウトをIDごとのマップで取得（存在しないIDは含めない）
+func (wm *WorkoutManager) getWorkoutsByID(op string, ids []domain.WorkoutID) (map[domain.WorkoutID]*domain.Workout, error) {
+	workouts, err := wm.repo.GetWorkouts(ids)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      op,
+			Message: fmt.Sprintf("failed to get workouts (count=%d)", len(ids)),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	byID := make(map[domain.WorkoutID]*domain.Workout, len(workouts))
+	for _, workout := range workouts {
+		byID[workout.ID] = workout
+	}
+	return byID, nil
+}
@@ -0,0 +1,237 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// failingBatchRepository 一括保存だけ失敗するテスト用のリポジトリ
+type failingBatchRepository struct {
+	*repository.MockWorkoutRepository
+}
+
+func (r *failingBatchRepository) BatchCreateWorkouts(workouts []*domain.Workout, batchSize int) error {
+	for i, workout := range workouts {
+		workout.ID = domain.WorkoutID(100 + i) // ロールバック前に採番されたIDが残る状況を再現
+	}
+	return errors.New("deadlock found when trying to get lock")
+}
+
+// TestBatchCreateWorkouts テーブル駆動テストでアトミック・項目ごとの一括作成をテスト
+func TestBatchCreateWorkouts(t *testing.T) {
+	valid := CreateWorkoutRequest{ExerciseType: domain.BenchPress, Sets: 3, Reps: 10, Weight: 60}
+	invalid := CreateWorkoutRequest{ExerciseType: domain.ExerciseUnspecified}
+
+	tests := []struct {
+		name          string
+		reqs          []CreateWorkoutRequest
+		mode          BatchMode
+		failBatch     bool
+		wantErrs      []error // 項目ごとに期待するエラーの種類（nilは成功）
+		wantSaved     int
+		wantCommitted bool
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: アトミック",
+			reqs:          []CreateWorkoutRequest{valid, valid, valid},
+			mode:          BatchAtomic,
+			wantErrs:      []error{nil, nil, nil},
+			wantSaved:     3,
+			wantCommitted: true,
+			description:   "全件を作成",
+		},
+		{
+			name:        "異常系: アトミックで1件が不正",
+			reqs:        []CreateWorkoutRequest{valid, invalid, valid},
+			mode:        BatchAtomic,
+			wantErrs:    []error{ErrBatchAborted, ErrBatchInvalid, ErrBatchAborted},
+			description: "不正な項目以外は中断扱いで、何も作成しない",
+		},
+		{
+			name:          "正常系: 項目ごとで1件が不正",
+			reqs:          []CreateWorkoutRequest{valid, invalid, valid},
+			mode:          BatchPerItem,
+			wantErrs:      []error{nil, ErrBatchInvalid, nil},
+			wantSaved:     2,
+			wantCommitted: true,
+			description:   "不正な項目以外は作成",
+		},
+		{
+			name:          "正常系: 項目ごとで一括保存に失敗",
+			reqs:          []CreateWorkoutRequest{valid, valid},
+			mode:          BatchPerItem,
+			failBatch:     true,
+			wantErrs:      []error{nil, nil},
+			wantSaved:     2,
+			wantCommitted: true,
+			description:   "1件ずつ作成し直す（採番済みのIDは使わない）",
+		},
+		{
+			name:        "異常系: 件数が0",
+			mode:        BatchAtomic,
+			wantErr:     true,
+			description: "1件以上必要",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			var repo domain.WorkoutRepository = mockRepo
+			if tt.failBatch {
+				repo = &failingBatchRepository{mockRepo}
+			}
+			manager := NewWorkoutManagerWithRepository(repo)
+
+			result, err := manager.BatchCreateWorkouts(tt.reqs, tt.mode)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("BatchCreateWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			assertBatchItems(t, result, tt.wantErrs)
+			if result.Committed != tt.wantCommitted {
+				t.Errorf("Expected committed=%t, got %t", tt.wantCommitted, result.Committed)
+			}
+			if count, _ := mockRepo.GetWorkoutCount(); count != tt.wantSaved {
+				t.Errorf("Expected %d saved workouts, got %d", tt.wantSaved, count)
+			}
+			for _, item := range result.Items {
+				if item.Err == nil && (item.ID == 0 || item.ID >= 100) {
+					t.Errorf("Item %d: expected a newly assigned ID, got %d", item.Index, item.ID)
+				}
+			}
+		})
+	}
+}
+
+// TestBatchUpdateAndDeleteWorkouts テーブル駆動テストで一括更新・一括削除をテスト
+func TestBatchUpdateAndDeleteWorkouts(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	completed := domain.WorkoutStatusCompleted
+
+	tests := []struct {
+		name        string
+		update      []UpdateWorkoutRequest // nilの場合は削除
+		deleteIDs   []domain.WorkoutID
+		mode        BatchMode
+		wantErrs    []error
+		wantReps    map[domain.WorkoutID]int // 処理後の回数（削除の場合は残っているか）
+		wantRemain  int
+		description string
+	}{
+		{
+			name: "正常系: アトミックで更新",
+			update: []UpdateWorkoutRequest{
+				{ID: 1, ExerciseType: domain.Squat, Reps: intPtr(5), Status: &completed},
+				{ID: 2, ExerciseType: domain.Squat, Reps: intPtr(6)},
+			},
+			mode:        BatchAtomic,
+			wantErrs:    []error{nil, nil},
+			wantReps:    map[domain.WorkoutID]int{1: 5, 2: 6},
+			wantRemain:  3,
+			description: "全件を1トランザクションで更新",
+		},
+		{
+			name: "異常系: アトミックで存在しないID",
+			update: []UpdateWorkoutRequest{
+				{ID: 1, ExerciseType: domain.Squat, Reps: intPtr(5)},
+				{ID: 99, ExerciseType: domain.Squat, Reps: intPtr(5)},
+			},
+			mode:        BatchAtomic,
+			wantErrs:    []error{ErrBatchAborted, ErrBatchNotFound},
+			wantReps:    map[domain.WorkoutID]int{1: 10},
+			wantRemain:  3,
+			description: "取得した値を変更していても保存しない",
+		},
+		{
+			name: "正常系: 項目ごとで重複したID",
+			update: []UpdateWorkoutRequest{
+				{ID: 1, ExerciseType: domain.Squat, Reps: intPtr(5)},
+				{ID: 1, ExerciseType: domain.Squat, Reps: intPtr(8)},
+				{ID: 2, ExerciseType: domain.Squat, Reps: intPtr(-1)},
+			},
+			mode:        BatchPerItem,
+			wantErrs:    []error{nil, ErrBatchInvalid, ErrBatchInvalid},
+			wantReps:    map[domain.WorkoutID]int{1: 5, 2: 10},
+			wantRemain:  3,
+			description: "最初の指定だけ反映し、不正な項目はスキップ",
+		},
+		{
+			name:        "正常系: 項目ごとで削除",
+			deleteIDs:   []domain.WorkoutID{1, 99, 3, 0},
+			mode:        BatchPerItem,
+			wantErrs:    []error{nil, ErrBatchNotFound, nil, ErrBatchInvalid},
+			wantRemain:  1,
+			description: "存在するIDだけ削除",
+		},
+		{
+			name:        "異常系: アトミックで削除",
+			deleteIDs:   []domain.WorkoutID{1, 99},
+			mode:        BatchAtomic,
+			wantErrs:    []error{ErrBatchAborted, ErrBatchNotFound},
+			wantRemain:  3,
+			description: "1件でも存在しなければ何も削除しない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			for i := 0; i < 3; i++ {
+				if _, err := manager.CreateWorkout(CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 10, Weight: 100}); err != nil {
+					t.Fatalf("Failed to create workout: %v", err)
+				}
+			}
+
+			var (
+				result *BatchResult
+				err    error
+			)
+			if tt.update != nil {
+				result, err = manager.BatchUpdateWorkouts(tt.update, tt.mode)
+			} else {
+				result, err = manager.BatchDeleteWorkouts(tt.deleteIDs, tt.mode)
+			}
+			if err != nil {
+				t.Fatalf("Batch error = %v", err)
+			}
+
+			assertBatchItems(t, result, tt.wantErrs)
+			for id, reps := range tt.wantReps {
+				workout, err := mockRepo.GetWorkout(id)
+				if err != nil || workout.Reps != reps {
+					t.Errorf("Workout %d: expected reps %d, got %+v (err=%v)", id, reps, workout, err)
+				}
+			}
+			if count, _ := mockRepo.GetWorkoutCount(); count != tt.wantRemain {
+				t.Errorf("Expected %d workouts, got %d", tt.wantRemain, count)
+			}
+		})
+	}
+}
+
+// assertBatchItems 項目ごとのエラーの種類を確認する
+func assertBatchItems(t *testing.T, result *BatchResult, wantErrs []error) {
+	t.Helper()
+	if len(result.Items) != len(wantErrs) {
+		t.Fatalf("Expected %d items, got %d", len(wantErrs), len(result.Items))
+	}
+	for i, want := range wantErrs {
+		got := result.Items[i]
+		if got.Index != i {
+			t.Errorf("Item %d: expected index %d, got %d", i, i, got.Index)
+		}
+		if (want == nil) != (got.Err == nil) || (want != nil && !errors.Is(got.Err, want)) {
+			t.Errorf("Item %d: expected error %v, got %v", i, want, got.Err)
+		}
+	}
+}
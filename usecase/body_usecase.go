@@ -0,0 +1,517 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/strength"
+)
+
+const (
+	defaultTrendDays         = 90 // 体重の推移・体重比の期間を指定しない場合の日数
+	defaultMovingAverageDays = 7  // 移動平均の日数を指定しない場合の日数
+	maxMovingAverageDays     = 90 // 移動平均の日数の上限
+)
+
+// SetBodyMeasurementRepository 体重・身体測定の記録の永続化に使用するリポジトリを設定
+func (wm *WorkoutManager) SetBodyMeasurementRepository(repo domain.BodyMeasurementRepository) {
+	wm.bodyRepo = repo
+}
+
+// CreateBodyMeasurementRequest 体重・身体測定の記録作成リクエスト
+// 測定しなかった項目は0（少なくとも1項目は必須）
+type CreateBodyMeasurementRequest struct {
+	Date           *time.Time // オプション: 測定日（ユーザーのタイムゾーンで日付を決める）。nilなら今日
+	Bodyweight     float64    // 体重(kg)
+	BodyFatPercent float64    // 体脂肪率(%)
+	Waist          float64    // ウエスト(cm)
+	Chest          float64    // 胸囲(cm)
+	Arm            float64    // 上腕囲(cm)
+	Notes          string
+}
+
+// CreateBodyMeasurement 体重・身体測定の記録を作成（ビジネスロジック層）
+func (wm *WorkoutManager) CreateBodyMeasurement(req CreateBodyMeasurementRequest) (*domain.BodyMeasurement, error) {
+	wm, span := wm.startSpan("CreateBodyMeasurement")
+	defer span.End()
+
+	date := time.Now()
+	if req.Date != nil {
+		date = *req.Date
+	}
+	measurement := &domain.BodyMeasurement{
+		Date:           domain.StatsPeriodDay.BucketStart(date.In(wm.location)),
+		Bodyweight:     req.Bodyweight,
+		BodyFatPercent: req.BodyFatPercent,
+		Waist:          req.Waist,
+		Chest:          req.Chest,
+		Arm:            req.Arm,
+		Notes:          req.Notes,
+	}
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateBodyMeasurement(measurement, wm.today())
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CreateBodyMeasurement",
+			Message: "body measurement input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	if err := wm.bodyRepo.CreateBodyMeasurement(measurement); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CreateBodyMeasurement",
+			Message: "failed to create body measurement in repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Info("体重・身体測定を記録しました",
+		slog.String(logging.KeyOp, "CreateBodyMeasurement"), slog.Int64("measurement_id", int64(measurement.ID)),
+		slog.String("date", measurement.Date.Format(dateKeyLayout)))
+	return measurement, nil
+}
+
+// GetBodyMeasurement 体重・身体測定の記録をIDで取得
+func (wm *WorkoutManager) GetBodyMeasurement(id domain.BodyMeasurementID) (*domain.BodyMeasurement, error) {
+	wm, span := wm.startSpan("GetBodyMeasurement")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateBodyMeasurementID(id)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetBodyMeasurement",
+			Message: "body measurement input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	measurement, err := wm.bodyRepo.GetBodyMeasurement(id)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetBodyMeasurement",
+			Message: fmt.Sprintf("failed to retrieve body measurement from repository (ID: %d)", id),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	localizeMeasurementDate(measurement, wm.location)
+	return measurement, nil
+}
+
+// ListBodyMeasurementsRequest 体重・身体測定の記録一覧の取得リクエスト
+type ListBodyMeasurementsRequest struct {
+	DateFrom *time.Time // オプション: 開始日時（この日を含む）。nilなら下限なし
+	DateTo   *time.Time // オプション: 終了日時（含まない）。nilなら上限なし
+}
+
+// ListBodyMeasurements 測定日が期間内の記録を取得（測定日の昇順）
+func (wm *WorkoutManager) ListBodyMeasurements(req ListBodyMeasurementsRequest) ([]*domain.BodyMeasurement, error) {
+	wm, span := wm.startSpan("ListBodyMeasurements")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateDateRange(req.DateFrom, req.DateTo)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListBodyMeasurements",
+			Message: "body measurement list input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	measurements, err := wm.listBodyMeasurements(req.DateFrom, req.DateTo, wm.location)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListBodyMeasurements",
+			Message: "failed to list body measurements from repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	return measurements, nil
+}
+
+// UpdateBodyMeasurementRequest 体重・身体測定の記録の更新リクエスト
+// ポインタ型を使用して「更新しない」と「明示的な値（0なら未測定）」を区別
+type UpdateBodyMeasurementRequest struct {
+	ID             domain.BodyMeasurementID // 必須
+	Date           *time.Time               // オプション: nilなら更新しない
+	Bodyweight     *float64                 // オプション: nilなら更新しない
+	BodyFatPercent *float64                 // オプション: nilなら更新しない
+	Waist          *float64                 // オプション: nilなら更新しない
+	Chest          *float64                 // オプション: nilなら更新しない
+	Arm            *float64                 // オプション: nilなら更新しない
+	Notes          *string                  // オプション: nilなら更新しない
+}
+
+// UpdateBodyMeasurement 体重・身体測定の記録を更新
+func (wm *WorkoutManager) UpdateBodyMeasurement(req UpdateBodyMeasurementRequest) (*domain.BodyMeasurement, error) {
+	wm, span := wm.startSpan("UpdateBodyMeasurement")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateBodyMeasurementID(req.ID)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateBodyMeasurement",
+			Message: fmt.Sprintf("body measurement update input validation failed (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	measurement, err := wm.bodyRepo.GetBodyMeasurement(req.ID)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateBodyMeasurement",
+			Message: fmt.Sprintf("failed to get body measurement for update (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	localizeMeasurementDate(measurement, wm.location)
+	if req.Date != nil {
+		measurement.Date = domain.StatsPeriodDay.BucketStart(req.Date.In(wm.location))
+	}
+	if req.Bodyweight != nil {
+		measurement.Bodyweight = *req.Bodyweight
+	}
+	if req.BodyFatPercent != nil {
+		measurement.BodyFatPercent = *req.BodyFatPercent
+	}
+	if req.Waist != nil {
+		measurement.Waist = *req.Waist
+	}
+	if req.Chest != nil {
+		measurement.Chest = *req.Chest
+	}
+	if req.Arm != nil {
+		measurement.Arm = *req.Arm
+	}
+	if req.Notes != nil {
+		measurement.Notes = *req.Notes
+	}
+
+	validator.validateBodyMeasurement(measurement, wm.today())
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateBodyMeasurement",
+			Message: fmt.Sprintf("updated body measurement validation failed (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	if err := wm.bodyRepo.UpdateBodyMeasurement(measurement); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateBodyMeasurement",
+			Message: fmt.Sprintf("failed to persist body measurement update (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Info("体重・身体測定の記録を更新しました",
+		slog.String(logging.KeyOp, "UpdateBodyMeasurement"), slog.Int64("measurement_id", int64(measurement.ID)))
+	return measurement, nil
+}
+
+// DeleteBodyMeasurement 体重・身体測定の記録を削除
+func (wm *WorkoutManager) DeleteBodyMeasurement(id domain.BodyMeasurementID) error {
+	wm, span := wm.startSpan("DeleteBodyMeasurement")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateBodyMeasurementID(id)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "DeleteBodyMeasurement",
+			Message: "body measurement delete input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+
+	if err := wm.bodyRepo.DeleteBodyMeasurement(id); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "DeleteBodyMeasurement",
+			Message: fmt.Sprintf("failed to delete body measurement from repository (ID: %d)", id),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+
+	wm.logger.Info("体重・身体測定の記録を削除しました",
+		slog.String(logging.KeyOp, "DeleteBodyMeasurement"), slog.Int64("measurement_id", int64(id)))
+	return nil
+}
+
+// GetBodyweightTrendRequest 体重の推移の取得リクエスト
+type GetBodyweightTrendRequest struct {
+	DateFrom   *time.Time     // オプション: 開始日時（この日を含む）。nilならDateToの90日前
+	DateTo     *time.Time     // オプション: 終了日時（含まない）。nilなら明日の0時（今日を含む）
+	WindowDays int            // オプション: 移動平均の日数（1〜90）。0なら7日
+	Location   *time.Location // オプション: 日付の区切りに使用するタイムゾーン。nilならデフォルトのタイムゾーン
+}
+
+// GetBodyweightTrend 体重を測定した日ごとの体重と移動平均を取得（ビジネスロジック層）
+// 期間の初日の移動平均にも期間前の測定を使用する
+func (wm *WorkoutManager) GetBodyweightTrend(req GetBodyweightTrendRequest) ([]*domain.BodyweightTrendPoint, error) {
+	wm, span := wm.startSpan("GetBodyweightTrend")
+	defer span.End()
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+	dateFrom, dateTo := trendRange(req.DateFrom, req.DateTo, loc)
+	windowDays := defaultMovingAverageDays
+	if req.WindowDays != 0 {
+		windowDays = req.WindowDays
+	}
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateDateRange(&dateFrom, &dateTo)
+	validator.validate(func() error {
+		if windowDays < 1 || windowDays > maxMovingAverageDays {
+			return fmt.Errorf("moving average window must be between 1 and %d days: %d", maxMovingAverageDays, windowDays)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetBodyweightTrend",
+			Message: "bodyweight trend input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	windowFrom := dateFrom.AddDate(0, 0, -(windowDays - 1))
+	measurements, err := wm.listBodyMeasurements(&windowFrom, &dateTo, loc)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetBodyweightTrend",
+			Message: "failed to list body measurements for trend",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	trend := domain.BodyweightTrend(domain.DailyBodyweights(measurements, loc), windowDays)
+	points := make([]*domain.BodyweightTrendPoint, 0, len(trend))
+	for _, point := range trend {
+		if !point.Date.Before(dateFrom) {
+			points = append(points, point)
+		}
+	}
+
+	wm.logger.Debug("体重の推移を計算しました",
+		slog.String(logging.KeyOp, "GetBodyweightTrend"), slog.Int("window_days", windowDays), slog.Int("points", len(points)))
+	return points, nil
+}
+
+// GetRelativeStrengthRequest 体重比の取得リクエスト
+type GetRelativeStrengthRequest struct {
+	DateFrom     *time.Time          // オプション: 開始日時（含む）。nilならDateToの90日前
+	DateTo       *time.Time          // オプション: 終了日時（含まない）。nilなら明日の0時（今日を含む）
+	ExerciseType domain.ExerciseType // オプション: 未指定なら全種目
+	Location     *time.Location      // オプション: 日付の区切りに使用するタイムゾーン。nilならデフォルトのタイムゾーン
+}
+
+// GetRelativeStrength 完了したワークアウトの重量・推定1RMを、同じ日に測定した体重で割った比を取得（ビジネスロジック層）
+// 体重を測定していない日のワークアウトと、重量0（自重など）のワークアウトは含めない
+func (wm *WorkoutManager) GetRelativeStrength(req GetRelativeStrengthRequest) ([]*domain.RelativeStrength, error) {
+	wm, span := wm.startSpan("GetRelativeStrength")
+	defer span.End()
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+	dateFrom, dateTo := trendRange(req.DateFrom, req.DateTo, loc)
+
+	validator := &errValidator{}
+	validator.validateBodyMeasurementRepository(wm.bodyRepo)
+	validator.validateDateRange(&dateFrom, &dateTo)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetRelativeStrength",
+			Message: "relative strength input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	measurements, err := wm.listBodyMeasurements(&dateFrom, &dateTo, loc)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetRelativeStrength",
+			Message: "failed to list body measurements for relative strength",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	workouts, err := wm.repo.ListWorkoutsByActivity(&dateFrom, &dateTo)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetRelativeStrength",
+			Message: "failed to list workouts for relative strength",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	bodyweights := make(map[string]float64)
+	for _, day := range domain.DailyBodyweights(measurements, loc) {
+		bodyweights[day.Date.Format(dateKeyLayout)] = day.Bodyweight
+	}
+
+	results := make([]*domain.RelativeStrength, 0, len(workouts))
+	for _, w := range workouts {
+		if w.Status != domain.WorkoutStatusCompleted || w.Weight <= 0 {
+			continue
+		}
+		if req.ExerciseType != domain.ExerciseUnspecified && w.ExerciseType != req.ExerciseType {
+			continue
+		}
+		bodyweight, ok := bodyweights[w.ActivityAt().In(loc).Format(dateKeyLayout)]
+		if !ok {
+			continue
+		}
+		rs := &domain.RelativeStrength{
+			Workout:     w,
+			Bodyweight:  bodyweight,
+			WeightRatio: w.Weight / bodyweight,
+		}
+		if oneRepMax, err := strength.EstimateOneRepMax(strength.DefaultFormula, w.Weight, w.Reps, 0); err == nil {
+			rs.OneRepMax = oneRepMax
+			rs.OneRepMaxRatio = oneRepMax / bodyweight
+		}
+		results = append(results, rs)
+	}
+
+	wm.logger.Debug("体重比を計算しました",
+		slog.String(logging.KeyOp, "GetRelativeStrength"), slog.Int("workouts", len(workouts)), slog.Int("results", len(results)))
+	return results, nil
+}
+
+// listBodyMeasurements 測定日が期間内の記録を取得し、測定日をlocの0時に合わせる
+func (wm *WorkoutManager) listBodyMeasurements(dateFrom, dateTo *time.Time, loc *time.Location) ([]*domain.BodyMeasurement, error) {
+	measurements, err := wm.bodyRepo.ListBodyMeasurements(dateFrom, dateTo)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range measurements {
+		localizeMeasurementDate(m, loc)
+	}
+	return measurements, nil
+}
+
+// localizeMeasurementDate リポジトリから取得した測定日（年月日）をlocの0時にする
+func localizeMeasurementDate(m *domain.BodyMeasurement, loc *time.Location) {
+	m.Date = time.Date(m.Date.Year(), m.Date.Month(), m.Date.Day(), 0, 0, 0, 0, loc)
+}
+
+// trendRange 体重の推移・体重比の期間（デフォルトは今日までの90日間）
+func trendRange(from, to *time.Time, loc *time.Location) (time.Time, time.Time) {
+	dateTo := domain.StatsPeriodDay.BucketStart(time.Now().In(loc)).AddDate(0, 0, 1)
+	if to != nil {
+		dateTo = to.In(loc)
+	}
+	dateFrom := dateTo.AddDate(0, 0, -defaultTrendDays)
+	if from != nil {
+		dateFrom = from.In(loc)
+	}
+	return dateFrom, dateTo
+}
+
+// today ユーザーのタイムゾーンの今日の0時
+func (wm *WorkoutManager) today() time.Time {
+	return domain.StatsPeriodDay.BucketStart(time.Now().In(wm.location))
+}
+
+// validateBodyMeasurementRepository 体重・身体測定リポジトリが設定されていることを検証
+func (ev *errValidator) validateBodyMeasurementRepository(repo domain.BodyMeasurementRepository) {
+	ev.validate(func() error {
+		if repo == nil {
+			return fmt.Errorf("body measurement repository is not configured")
+		}
+		return nil
+	})
+}
+
+// validateBodyMeasurementID 記録IDの値を検証
+func (ev *errValidator) validateBodyMeasurementID(id domain.BodyMeasurementID) {
+	ev.validate(func() error {
+		if id <= 0 {
+			return fmt.Errorf("invalid body measurement ID: %d", id)
+		}
+		return nil
+	})
+}
+
+// validateBodyMeasurement 測定値を検証（body_measurementsテーブルの制約と同じ範囲）
+func (ev *errValidator) validateBodyMeasurement(m *domain.BodyMeasurement, today time.Time) {
+	ev.validate(func() error {
+		if m.Date.After(today) {
+			return fmt.Errorf("measurement date cannot be in the future: %s", m.Date.Format(dateKeyLayout))
+		}
+		return nil
+	})
+	ev.validate(func() error {
+		if !m.HasValues() {
+			return fmt.Errorf("at least one measurement is required")
+		}
+		return nil
+	})
+	ev.validateMeasurementValue("bodyweight", m.Bodyweight, domain.MaxBodyweight)
+	ev.validateMeasurementValue("body fat percent", m.BodyFatPercent, domain.MaxBodyFatPercent)
+	ev.validateMeasurementValue("waist", m.Waist, domain.MaxCircumference)
+	ev.validateMeasurementValue("chest", m.Chest, domain.MaxCircumference)
+	ev.validateMeasurementValue("arm", m.Arm, domain.MaxCircumference)
+}
+
+// validateMeasurementValue 測定値が0（未測定）〜上限の範囲であることを検証
+func (ev *errValidator) validateMeasurementValue(name string, value, max float64) {
+	ev.validate(func() error {
+		if value < 0 || value > max {
+			return fmt.Errorf("%s must be between 0 and %.1f: %.2f", name, max, value)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// newBodyTestManager 体重・身体測定のモックリポジトリを設定したWorkoutManager（タイムゾーンはJST固定）
+func newBodyTestManager() (*WorkoutManager, *repository.MockWorkoutRepository) {
+	workoutRepo := repository.NewMockWorkoutRepository()
+	manager := NewWorkoutManagerWithRepository(workoutRepo)
+	manager.SetLocation(time.FixedZone("JST", 9*60*60))
+	manager.SetBodyMeasurementRepository(repository.NewMockBodyMeasurementRepository())
+	return manager, workoutRepo
+}
+
+// TestCreateBodyMeasurement テーブル駆動テストで体重・身体測定の記録作成をテスト
+func TestCreateBodyMeasurement(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	yesterday := time.Now().In(jst).AddDate(0, 0, -1)
+	tomorrow := time.Now().In(jst).AddDate(0, 0, 1)
+
+	tests := []struct {
+		name        string
+		request     CreateBodyMeasurementRequest
+		wantErr     bool
+		wantDate    string
+		description string
+	}{
+		{
+			name:        "正常系: 体重のみ",
+			request:     CreateBodyMeasurementRequest{Date: &yesterday, Bodyweight: 72.5},
+			wantDate:    yesterday.Format(dateKeyLayout),
+			description: "測定しなかった項目は0のまま保存する",
+		},
+		{
+			name:        "正常系: 測定日の省略",
+			request:     CreateBodyMeasurementRequest{Waist: 80, Chest: 100, Arm: 35},
+			wantDate:    time.Now().In(jst).Format(dateKeyLayout),
+			description: "測定日を省略した場合は今日（ユーザーのタイムゾーン）",
+		},
+		{
+			name:        "異常系: 測定値なし",
+			request:     CreateBodyMeasurementRequest{Notes: "メモだけ"},
+			wantErr:     true,
+			description: "少なくとも1項目は必須",
+		},
+		{
+			name:        "異常系: 範囲外の値",
+			request:     CreateBodyMeasurementRequest{Bodyweight: 600, BodyFatPercent: -1},
+			wantErr:     true,
+			description: "body_measurementsテーブルの制約と同じ範囲",
+		},
+		{
+			name:        "異常系: 未来の測定日",
+			request:     CreateBodyMeasurementRequest{Date: &tomorrow, Bodyweight: 72.5},
+			wantErr:     true,
+			description: "明日以降の測定は記録できない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, _ := newBodyTestManager()
+
+			measurement, err := manager.CreateBodyMeasurement(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateBodyMeasurement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := measurement.Date.Format(dateKeyLayout); got != tt.wantDate {
+				t.Errorf("Expected date %s, got %s", tt.wantDate, got)
+			}
+			if measurement.Date.Hour() != 0 || measurement.Date.Location() != manager.Location() {
+				t.Errorf("Expected midnight in user's location, got %v", measurement.Date)
+			}
+		})
+	}
+}
+
+// TestUpdateBodyMeasurement 指定した項目のみ更新され、0で未測定に戻せることをテスト
+func TestUpdateBodyMeasurement(t *testing.T) {
+	manager, _ := newBodyTestManager()
+	measurement, err := manager.CreateBodyMeasurement(CreateBodyMeasurementRequest{Bodyweight: 72.5, Waist: 80})
+	if err != nil {
+		t.Fatalf("Failed to create measurement: %v", err)
+	}
+
+	zero, fat := 0.0, 15.0
+	updated, err := manager.UpdateBodyMeasurement(UpdateBodyMeasurementRequest{ID: measurement.ID, Waist: &zero, BodyFatPercent: &fat})
+	if err != nil {
+		t.Fatalf("UpdateBodyMeasurement() error = %v", err)
+	}
+	if updated.Bodyweight != 72.5 || updated.Waist != 0 || updated.BodyFatPercent != 15 {
+		t.Errorf("Unexpected measurement after update: %+v", updated)
+	}
+
+	// 全ての項目を未測定にする更新はエラー
+	if _, err := manager.UpdateBodyMeasurement(UpdateBodyMeasurementRequest{ID: measurement.ID, Bodyweight: &zero, BodyFatPercent: &zero}); err == nil {
+		t.Error("Expected error when clearing all measurements")
+	}
+	if _, err := manager.UpdateBodyMeasurement(UpdateBodyMeasurementRequest{ID: 999, Bodyweight: &fat}); err == nil {
+		t.Error("Expected error for non-existent measurement")
+	}
+}
+
+// TestGetBodyweightTrend テーブル駆動テストで体重の移動平均をテスト
+func TestGetBodyweightTrend(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	day := func(d int) time.Time { return time.Date(2024, 5, d, 0, 0, 0, 0, jst) }
+	// 5/1〜5/10の体重（5/4・5/5は未測定、5/6は2回測定）
+	records := []struct {
+		day    int
+		weight float64
+	}{
+		{1, 70}, {2, 71}, {3, 72}, {6, 73}, {6, 75}, {7, 76}, {10, 80},
+	}
+
+	tests := []struct {
+		name        string
+		dateFrom    time.Time
+		windowDays  int
+		wantDates   []string
+		wantAverage []float64
+		wantSamples []int
+		description string
+	}{
+		{
+			name:        "正常系: 3日移動平均",
+			dateFrom:    day(1),
+			windowDays:  3,
+			wantDates:   []string{"2024-05-01", "2024-05-02", "2024-05-03", "2024-05-06", "2024-05-07", "2024-05-10"},
+			wantAverage: []float64{70, 70.5, 71, 74, 75, 80},
+			wantSamples: []int{1, 2, 3, 1, 2, 1},
+			description: "未測定の日は平均に含めず、同じ日の複数回の測定は平均する",
+		},
+		{
+			name:        "正常系: 期間前の測定",
+			dateFrom:    day(3),
+			windowDays:  3,
+			wantDates:   []string{"2024-05-03", "2024-05-06", "2024-05-07", "2024-05-10"},
+			wantAverage: []float64{71, 74, 75, 80},
+			wantSamples: []int{3, 1, 2, 1},
+			description: "期間の初日の移動平均にも期間前の測定を使う",
+		},
+		{
+			name:        "正常系: 移動平均の日数の省略",
+			dateFrom:    day(7),
+			wantDates:   []string{"2024-05-07", "2024-05-10"},
+			wantAverage: []float64{(70 + 71 + 72 + 74 + 76) / 5.0, (74 + 76 + 80) / 3.0},
+			wantSamples: []int{5, 3},
+			description: "省略時は7日移動平均",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, _ := newBodyTestManager()
+			for _, r := range records {
+				date := day(r.day)
+				if _, err := manager.CreateBodyMeasurement(CreateBodyMeasurementRequest{Date: &date, Bodyweight: r.weight}); err != nil {
+					t.Fatalf("Failed to create measurement: %v", err)
+				}
+			}
+
+			dateTo := day(11)
+			points, err := manager.GetBodyweightTrend(GetBodyweightTrendRequest{DateFrom: &tt.dateFrom, DateTo: &dateTo, WindowDays: tt.windowDays})
+			if err != nil {
+				t.Fatalf("GetBodyweightTrend() error = %v", err)
+			}
+			if len(points) != len(tt.wantDates) {
+				t.Fatalf("Expected %d points, got %d", len(tt.wantDates), len(points))
+			}
+			for i, p := range points {
+				if got := p.Date.Format(dateKeyLayout); got != tt.wantDates[i] {
+					t.Errorf("points[%d]: expected date %s, got %s", i, tt.wantDates[i], got)
+				}
+				if math.Abs(p.MovingAverage-tt.wantAverage[i]) > 1e-9 {
+					t.Errorf("points[%d]: expected moving average %.3f, got %.3f", i, tt.wantAverage[i], p.MovingAverage)
+				}
+				if p.Samples != tt.wantSamples[i] {
+					t.Errorf("points[%d]: expected %d samples, got %d", i, tt.wantSamples[i], p.Samples)
+				}
+			}
+		})
+	}
+
+	t.Run("異常系: 移動平均の日数が上限超過", func(t *testing.T) {
+		manager, _ := newBodyTestManager()
+		if _, err := manager.GetBodyweightTrend(GetBodyweightTrendRequest{WindowDays: maxMovingAverageDays + 1}); err == nil {
+			t.Error("Expected error for too long window")
+		}
+	})
+}
+
+// TestGetRelativeStrength 同じ日に測定した体重で体重比を計算することをテスト
+func TestGetRelativeStrength(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	manager, workoutRepo := newBodyTestManager()
+
+	measuredOn := time.Date(2024, 5, 1, 0, 0, 0, 0, jst)
+	for _, weight := range []float64{79, 81} {
+		if _, err := manager.CreateBodyMeasurement(CreateBodyMeasurementRequest{Date: &measuredOn, Bodyweight: weight}); err != nil {
+			t.Fatalf("Failed to create measurement: %v", err)
+		}
+	}
+
+	completed := func(exerciseType domain.ExerciseType, weight float64, at time.Time) *domain.Workout {
+		return &domain.Workout{
+			ExerciseType: exerciseType, Status: domain.WorkoutStatusCompleted,
+			Sets: 3, Reps: 5, Weight: weight, CreatedAt: at, CompletedAt: &at,
+		}
+	}
+	workouts := []*domain.Workout{
+		completed(domain.Squat, 120, time.Date(2024, 5, 1, 23, 30, 0, 0, jst)), // JSTでは5/1（UTCでは5/1 14:30）
+		completed(domain.BenchPress, 80, time.Date(2024, 5, 1, 7, 0, 0, 0, jst)),
+		completed(domain.Squat, 100, time.Date(2024, 5, 2, 7, 0, 0, 0, jst)), // 体重を測定していない日
+		completed(domain.Squat, 0, time.Date(2024, 5, 1, 8, 0, 0, 0, jst)),   // 重量0は含めない
+		{ExerciseType: domain.Squat, Status: domain.WorkoutStatusPlanned, Sets: 3, Reps: 5, Weight: 140, CreatedAt: time.Date(2024, 5, 1, 9, 0, 0, 0, jst)},
+	}
+	for _, w := range workouts {
+		if err := workoutRepo.CreateWorkout(w); err != nil {
+			t.Fatalf("Failed to create workout: %v", err)
+		}
+	}
+
+	tests := []struct {
+		name         string
+		exerciseType domain.ExerciseType
+		wantWeights  []float64
+		description  string
+	}{
+		{
+			name:        "正常系: 全種目",
+			wantWeights: []float64{80, 120},
+			description: "体重を測定した日に完了したワークアウトのみ（実施日時の昇順）",
+		},
+		{
+			name:         "正常系: 種目の指定",
+			exerciseType: domain.Squat,
+			wantWeights:  []float64{120},
+			description:  "指定した種目のみ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dateFrom, dateTo := measuredOn, measuredOn.AddDate(0, 0, 7)
+			results, err := manager.GetRelativeStrength(GetRelativeStrengthRequest{DateFrom: &dateFrom, DateTo: &dateTo, ExerciseType: tt.exerciseType})
+			if err != nil {
+				t.Fatalf("GetRelativeStrength() error = %v", err)
+			}
+			if len(results) != len(tt.wantWeights) {
+				t.Fatalf("Expected %d results, got %d", len(tt.wantWeights), len(results))
+			}
+			for i, r := range results {
+				if r.Workout.Weight != tt.wantWeights[i] {
+					t.Errorf("results[%d]: expected weight %.1f, got %.1f", i, tt.wantWeights[i], r.Workout.Weight)
+				}
+				// 同じ日の2回の測定の平均（80kg）で割る
+				if r.Bodyweight != 80 || math.Abs(r.WeightRatio-r.Workout.Weight/80) > 1e-9 {
+					t.Errorf("results[%d]: unexpected ratio %.3f (bodyweight %.1f)", i, r.WeightRatio, r.Bodyweight)
+				}
+				if r.OneRepMax <= r.Workout.Weight || math.Abs(r.OneRepMaxRatio-r.OneRepMax/80) > 1e-9 {
+					t.Errorf("results[%d]: unexpected 1RM ratio %.3f (1RM %.1f)", i, r.OneRepMaxRatio, r.OneRepMax)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,317 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+const (
+	defaultCalendarDays      = 28             // 期間の指定がない場合に返す日数（4週間）
+	maxCalendarDays          = 366            // カレンダーとして返す最大日数
+	DefaultMissedGracePeriod = 24 * time.Hour // 予定日時からこの時間が過ぎたら未実施とみなす
+)
+
+// scheduleLocation 予定日時のタイムゾーンを解決する（空ならユーザー設定のタイムゾーン）
+func (wm *WorkoutManager) scheduleLocation(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return wm.location, nil
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %w", timezone, err)
+	}
+	return loc, nil
+}
+
+// ListCalendarRequest カレンダー取得リクエスト
+type ListCalendarRequest struct {
+	DateFrom *time.Time     // オプション: 開始日時（含む）。nilなら今週の月曜
+	DateTo   *time.Time     // オプション: 終了日時（含まない）。nilならDateFromの4週間後
+	Location *time.Location // オプション: nilならデフォルトのタイムゾーン
+}
+
+// ListCalendar 期間内のワークアウトを実施日ごとにまとめて取得（ビジネスロジック層）
+// 予定は実施予定日、完了済みは完了日の欄に表示する（Workout.ActivityAt）
+func (wm *WorkoutManager) ListCalendar(req ListCalendarRequest) (*domain.Calendar, error) {
+	wm, span := wm.startSpan("ListCalendar")
+	defer span.End()
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+
+	dateFrom := domain.StatsPeriodWeek.BucketStart(time.Now().In(loc))
+	if req.DateFrom != nil {
+		dateFrom = req.DateFrom.In(loc)
+	}
+	dateTo := dateFrom.AddDate(0, 0, defaultCalendarDays)
+	if req.DateTo != nil {
+		dateTo = req.DateTo.In(loc)
+	}
+
+	validator := &errValidator{}
+	validator.validateDateRange(&dateFrom, &dateTo)
+	validator.validate(func() error {
+		if days := dateTo.Sub(dateFrom).Hours() / 24; days > maxCalendarDays {
+			return fmt.Errorf("date range too long: %.0f days (max %d)", days, maxCalendarDays)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListCalendar",
+			Message: "calendar input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	workouts, err := wm.repo.ListWorkoutsByActivity(&dateFrom, &dateTo)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListCalendar",
+			Message: "failed to list workouts for calendar",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	days := make([]*domain.CalendarDay, 0, int(dateTo.Sub(dateFrom).Hours()/24)+1)
+	byDay := make(map[string]*domain.CalendarDay, cap(days))
+	for day := domain.StatsPeriodDay.BucketStart(dateFrom); day.Before(dateTo); day = day.AddDate(0, 0, 1) {
+		d := &domain.CalendarDay{Date: day, Workouts: []*domain.Workout{}}
+		days = append(days, d)
+		byDay[day.Format(dateKeyLayout)] = d
+	}
+	for _, w := range workouts {
+		if d, ok := byDay[w.ActivityAt().In(loc).Format(dateKeyLayout)]; ok {
+			d.Workouts = append(d.Workouts, w)
+		}
+	}
+
+	wm.logger.Debug("カレンダーを作成しました",
+		slog.String(logging.KeyOp, "ListCalendar"), slog.String("date_from", dateFrom.Format(dateKeyLayout)),
+		slog.String("date_to", dateTo.AddDate(0, 0, -1).Format(dateKeyLayout)), slog.Int("days", len(days)), slog.Int("workouts", len(workouts)))
+	return &domain.Calendar{Location: loc, Days: days}, nil
+}
+
+// ListScheduledWorkoutsRequest 実施予定日時のあるワークアウトの取得リクエスト
+type ListScheduledWorkoutsRequest struct {
+	DateFrom    *time.Time // オプション: 開始日時（含む）。nilなら今日の0時
+	DateTo      *time.Time // オプション: 終了日時（含まない）。nilならDateFromの4週間後
+	IncludeDone bool       // trueなら完了・スキップ済みの予定も含める
+}
+
+// ListScheduledWorkouts 実施予定日時が期間内のワークアウトを予定日時の昇順で取得（ビジネスロジック層）
+// カレンダーアプリへの出力用のため、予定日時のない（その場で記録した）ワークアウトは含めない
+func (wm *WorkoutManager) ListScheduledWorkouts(req ListScheduledWorkoutsRequest) ([]*domain.Workout, error) {
+	wm, span := wm.startSpan("ListScheduledWorkouts")
+	defer span.End()
+
+	dateFrom := domain.StatsPeriodDay.BucketStart(time.Now().In(wm.location))
+	if req.DateFrom != nil {
+		dateFrom = *req.DateFrom
+	}
+	dateTo := dateFrom.AddDate(0, 0, defaultCalendarDays)
+	if req.DateTo != nil {
+		dateTo = *req.DateTo
+	}
+
+	validator := &errValidator{}
+	validator.validateDateRange(&dateFrom, &dateTo)
+	validator.validate(func() error {
+		if days := dateTo.Sub(dateFrom).Hours() / 24; days > maxCalendarDays {
+			return fmt.Errorf("date range too long: %.0f days (max %d)", days, maxCalendarDays)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListScheduledWorkouts",
+			Message: "scheduled workouts input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	statuses := []domain.WorkoutStatus{domain.WorkoutStatusPlanned, domain.WorkoutStatusInProgress}
+	if req.IncludeDone {
+		statuses = nil
+	}
+	workouts, err := wm.repo.ListScheduledWorkouts(dateFrom, dateTo, statuses)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListScheduledWorkouts",
+			Message: "failed to list scheduled workouts",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Debug("予定を取得しました",
+		slog.String(logging.KeyOp, "ListScheduledWorkouts"), slog.String("date_from", dateFrom.Format(dateKeyLayout)),
+		slog.String("date_to", dateTo.AddDate(0, 0, -1).Format(dateKeyLayout)), slog.Int("workouts", len(workouts)))
+	return workouts, nil
+}
+
+// RescheduleWorkoutRequest 予定変更リクエスト
+type RescheduleWorkoutRequest struct {
+	ID           domain.WorkoutID // 必須: 対象のID
+	ScheduledFor time.Time        // 必須: 新しい実施予定日時
+	Timezone     string           // オプション: 空なら元の予定のタイムゾーン（なければユーザー設定）
+}
+
+// RescheduleWorkout 予定・スキップ済みのワークアウトの実施予定日時を変更（ビジネスロジック層）
+// スキップ済みのワークアウトは予定に戻す
+func (wm *WorkoutManager) RescheduleWorkout(req RescheduleWorkoutRequest) (*domain.Workout, error) {
+	wm, span := wm.startSpan("RescheduleWorkout")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateID(req.ID)
+	validator.validate(func() error {
+		if req.ScheduledFor.IsZero() {
+			return fmt.Errorf("scheduled_for must be specified")
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "RescheduleWorkout",
+			Message: fmt.Sprintf("reschedule input validation failed (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	workout, err := wm.repo.GetWorkout(req.ID)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "RescheduleWorkout",
+			Message: fmt.Sprintf("failed to get workout for reschedule (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = workout.ScheduledTimezone
+	}
+	loc, err := wm.scheduleLocation(timezone)
+	if err == nil {
+		err = validateReschedule(workout, req.ScheduledFor.In(loc))
+	}
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "RescheduleWorkout",
+			ExerciseType: workout.ExerciseType,
+			Message:      fmt.Sprintf("workout cannot be rescheduled (ID: %d)", req.ID),
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	previousStatus := workout.Status
+	scheduledFor := req.ScheduledFor.In(loc)
+	workout.ScheduledFor = &scheduledFor
+	workout.ScheduledTimezone = loc.String()
+	if workout.Status == domain.WorkoutStatusSkipped {
+		workout.Status = domain.WorkoutStatusPlanned
+		workout.SkipReason = domain.SkipReasonUnspecified
+	}
+	workout.UpdatedAt = time.Now()
+
+	if err := wm.repo.UpdateWorkout(workout); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "RescheduleWorkout",
+			ExerciseType: workout.ExerciseType,
+			Message:      fmt.Sprintf("failed to persist reschedule (ID: %d)", req.ID),
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.publishUpdate(workout, previousStatus)
+	wm.logger.Info("ワークアウトの予定日時を変更しました",
+		slog.String(logging.KeyOp, "RescheduleWorkout"), slog.Int64(logging.KeyWorkoutID, int64(workout.ID)),
+		slog.Time("scheduled_for", scheduledFor))
+	return workout, nil
+}
+
+// validateReschedule 予定変更できるステータス・日時か検証する
+// 過去の日付に移すとすぐに未実施として扱われるため、今日以降のみ許可する
+func validateReschedule(workout *domain.Workout, scheduledFor time.Time) error {
+	if workout.Status != domain.WorkoutStatusPlanned && workout.Status != domain.WorkoutStatusSkipped {
+		return fmt.Errorf("only planned or skipped workouts can be rescheduled: status=%d", workout.Status)
+	}
+	today := domain.StatsPeriodDay.BucketStart(time.Now().In(scheduledFor.Location()))
+	if scheduledFor.Before(today) {
+		return fmt.Errorf("cannot reschedule to a past date: %s", scheduledFor.Format(dateKeyLayout))
+	}
+	return nil
+}
+
+// SetMissedGracePeriod 予定日時を過ぎてから未実施とみなすまでの猶予を設定
+func (wm *WorkoutManager) SetMissedGracePeriod(grace time.Duration) {
+	wm.missedGracePeriod = grace
+}
+
+// MarkMissedWorkouts 予定日時から猶予を過ぎても実施されていない予定をスキップ（未実施）にする
+// 通知するのはリポジトリが実際に変更したワークアウトのみ（同時に完了された予定は通知しない）
+func (wm *WorkoutManager) MarkMissedWorkouts(now time.Time) (int, error) {
+	wm, span := wm.startSpan("MarkMissedWorkouts")
+	defer span.End()
+
+	cutoff := now.Add(-wm.missedGracePeriod)
+	missed, err := wm.repo.MarkMissedWorkouts(cutoff)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "MarkMissedWorkouts",
+			Message: "failed to mark missed workouts",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return 0, workoutErr
+	}
+	for _, workout := range missed {
+		wm.publishUpdate(workout, domain.WorkoutStatusPlanned)
+	}
+	count := len(missed)
+	if count > 0 {
+		wm.logger.Info("予定日を過ぎたワークアウトをスキップにしました", slog.String(logging.KeyOp, "MarkMissedWorkouts"), slog.Int("workouts", count))
+	}
+	return count, nil
+}
+
+// RunMissedWorkoutJob 未実施の予定の検出を起動時とinterval毎に実行する（ctxがキャンセルされるまでブロック）
+func (wm *WorkoutManager) RunMissedWorkoutJob(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// エラーはMarkMissedWorkouts内でログ出力済みのため、次回の実行で再試行する
+		_, _ = wm.MarkMissedWorkouts(time.Now())
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,399 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestCreateWorkout_ScheduledFor 予定日時・タイムゾーン付きのワークアウト作成をテスト
+func TestCreateWorkout_ScheduledFor(t *testing.T) {
+	scheduledFor := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		timezone     string
+		wantTimezone string
+		wantHour     int
+		wantErr      bool
+		description  string
+	}{
+		{
+			name:         "正常系: タイムゾーン指定",
+			timezone:     "Asia/Tokyo",
+			wantTimezone: "Asia/Tokyo",
+			wantHour:     18,
+			description:  "UTC 9時は東京の18時",
+		},
+		{
+			name:         "正常系: タイムゾーン省略",
+			wantTimezone: "UTC",
+			wantHour:     9,
+			description:  "ユーザー設定のタイムゾーンを使用",
+		},
+		{
+			name:        "異常系: 不正なタイムゾーン",
+			timezone:    "Mars/Olympus",
+			wantErr:     true,
+			description: "IANA名として解決できない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			manager.SetLocation(time.UTC)
+
+			workout, err := manager.CreateWorkout(CreateWorkoutRequest{
+				ExerciseType: domain.Squat,
+				ScheduledFor: &scheduledFor,
+				Timezone:     tt.timezone,
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateWorkout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if workout.ScheduledTimezone != tt.wantTimezone {
+				t.Errorf("Expected timezone %s, got %s", tt.wantTimezone, workout.ScheduledTimezone)
+			}
+			if !workout.ScheduledFor.Equal(scheduledFor) || workout.ScheduledFor.Hour() != tt.wantHour {
+				t.Errorf("Expected %v at hour %d, got %v", scheduledFor, tt.wantHour, workout.ScheduledFor)
+			}
+		})
+	}
+}
+
+// TestListCalendar テーブル駆動テストでカレンダーの日ごとのまとめをテスト
+func TestListCalendar(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	monday := time.Date(2024, 1, 1, 0, 0, 0, 0, tokyo)
+
+	tests := []struct {
+		name        string
+		dateFrom    time.Time
+		dateTo      time.Time
+		wantDays    int
+		wantCounts  map[string]int // 日付 → ワークアウト数
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 1週間",
+			dateFrom:    monday,
+			dateTo:      monday.AddDate(0, 0, 7),
+			wantDays:    7,
+			wantCounts:  map[string]int{"2024-01-01": 1, "2024-01-02": 2, "2024-01-03": 0, "2024-01-05": 1},
+			description: "東京の日付で区切る（UTC 15時以降は翌日）",
+		},
+		{
+			name:        "異常系: 期間が長すぎる",
+			dateFrom:    monday,
+			dateTo:      monday.AddDate(2, 0, 0),
+			wantErr:     true,
+			description: "366日まで",
+		},
+		{
+			name:        "異常系: 開始日が終了日より後",
+			dateFrom:    monday.AddDate(0, 0, 7),
+			dateTo:      monday,
+			wantErr:     true,
+			description: "date_from < date_to",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			manager.SetLocation(tokyo)
+
+			at := func(day, hour int) *time.Time {
+				v := time.Date(2024, 1, day, hour, 0, 0, 0, time.UTC)
+				return &v
+			}
+			workouts := []*domain.Workout{
+				{ExerciseType: domain.Squat, Status: domain.WorkoutStatusCompleted, CompletedAt: at(1, 3)},  // 東京 1/1 12時
+				{ExerciseType: domain.Squat, Status: domain.WorkoutStatusPlanned, ScheduledFor: at(1, 16)},  // 東京 1/2 1時
+				{ExerciseType: domain.PullUp, Status: domain.WorkoutStatusSkipped, ScheduledFor: at(2, 10)}, // 東京 1/2 19時
+				{ExerciseType: domain.Deadlift, Status: domain.WorkoutStatusPlanned, ScheduledFor: at(5, 0)},
+				{ExerciseType: domain.Deadlift, Status: domain.WorkoutStatusPlanned, ScheduledFor: at(20, 0)}, // 期間外
+			}
+			for _, w := range workouts {
+				w.CreatedAt = time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)
+				if err := mockRepo.CreateWorkout(w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			calendar, err := manager.ListCalendar(ListCalendarRequest{DateFrom: &tt.dateFrom, DateTo: &tt.dateTo})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListCalendar() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if len(calendar.Days) != tt.wantDays {
+				t.Fatalf("Expected %d days, got %d", tt.wantDays, len(calendar.Days))
+			}
+			for _, day := range calendar.Days {
+				want, ok := tt.wantCounts[day.Date.Format(dateKeyLayout)]
+				if ok && len(day.Workouts) != want {
+					t.Errorf("Expected %d workouts on %s, got %d", want, day.Date.Format(dateKeyLayout), len(day.Workouts))
+				}
+			}
+			if second := calendar.Days[1]; second.CountByStatus(domain.WorkoutStatusPlanned) != 1 || second.CountByStatus(domain.WorkoutStatusSkipped) != 1 {
+				t.Errorf("Expected 1 planned and 1 skipped on 2024-01-02, got %+v", second.Workouts)
+			}
+		})
+	}
+}
+
+// TestRescheduleWorkout テーブル駆動テストで予定変更をテスト
+func TestRescheduleWorkout(t *testing.T) {
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	tests := []struct {
+		name         string
+		status       domain.WorkoutStatus
+		skipReason   domain.SkipReason
+		scheduledFor time.Time
+		timezone     string
+		wantTimezone string
+		wantErr      bool
+		description  string
+	}{
+		{
+			name:         "正常系: 予定を翌日に変更",
+			status:       domain.WorkoutStatusPlanned,
+			scheduledFor: tomorrow,
+			wantTimezone: "Asia/Tokyo",
+			description:  "元の予定のタイムゾーンを引き継ぐ",
+		},
+		{
+			name:         "正常系: 未実施の予定を再予定",
+			status:       domain.WorkoutStatusSkipped,
+			skipReason:   domain.SkipReasonMissed,
+			scheduledFor: tomorrow,
+			timezone:     "America/New_York",
+			wantTimezone: "America/New_York",
+			description:  "スキップから予定に戻し、スキップ理由を消去",
+		},
+		{
+			name:         "異常系: 完了済み",
+			status:       domain.WorkoutStatusCompleted,
+			scheduledFor: tomorrow,
+			wantErr:      true,
+			description:  "完了済みのワークアウトは変更できない",
+		},
+		{
+			name:         "異常系: 過去の日付",
+			status:       domain.WorkoutStatusPlanned,
+			scheduledFor: time.Now().AddDate(0, 0, -3),
+			wantErr:      true,
+			description:  "過去の日付に移すことはできない",
+		},
+		{
+			name:        "異常系: 日時の指定なし",
+			status:      domain.WorkoutStatusPlanned,
+			wantErr:     true,
+			description: "scheduled_forは必須",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+
+			original := time.Now().AddDate(0, 0, -2)
+			workout := &domain.Workout{
+				ExerciseType:      domain.BenchPress,
+				Status:            tt.status,
+				SkipReason:        tt.skipReason,
+				Sets:              3,
+				Reps:              10,
+				ScheduledFor:      &original,
+				ScheduledTimezone: "Asia/Tokyo",
+			}
+			if err := mockRepo.CreateWorkout(workout); err != nil {
+				t.Fatalf("Failed to setup workout: %v", err)
+			}
+
+			updated, err := manager.RescheduleWorkout(RescheduleWorkoutRequest{
+				ID:           workout.ID,
+				ScheduledFor: tt.scheduledFor,
+				Timezone:     tt.timezone,
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RescheduleWorkout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if updated.Status != domain.WorkoutStatusPlanned || updated.SkipReason != domain.SkipReasonUnspecified {
+				t.Errorf("Expected planned without skip reason, got status=%d reason=%s", updated.Status, updated.SkipReason.Japanese())
+			}
+			if !updated.ScheduledFor.Equal(tt.scheduledFor) || updated.ScheduledTimezone != tt.wantTimezone {
+				t.Errorf("Expected %v (%s), got %v (%s)", tt.scheduledFor, tt.wantTimezone, updated.ScheduledFor, updated.ScheduledTimezone)
+			}
+		})
+	}
+}
+
+// TestMarkMissedWorkouts 猶予を過ぎた予定のみが未実施としてスキップされることをテスト
+func TestMarkMissedWorkouts(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		v := now.Add(d)
+		return &v
+	}
+
+	mockRepo := repository.NewMockWorkoutRepository()
+	manager := NewWorkoutManagerWithRepository(mockRepo)
+	manager.SetMissedGracePeriod(24 * time.Hour)
+
+	workouts := []*domain.Workout{
+		{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(-48 * time.Hour)},   // 未実施
+		{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(-12 * time.Hour)},   // 猶予内
+		{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(24 * time.Hour)},    // 未来
+		{Status: domain.WorkoutStatusPlanned},                                      // 予定日なし
+		{Status: domain.WorkoutStatusCompleted, ScheduledFor: at(-48 * time.Hour)}, // 完了済み
+	}
+	for _, w := range workouts {
+		w.ExerciseType = domain.Squat
+		if err := mockRepo.CreateWorkout(w); err != nil {
+			t.Fatalf("Failed to setup workout: %v", err)
+		}
+	}
+	sub, err := manager.WatchWorkouts(WatchWorkoutsRequest{})
+	if err != nil {
+		t.Fatalf("WatchWorkouts() error = %v", err)
+	}
+	defer sub.Close()
+
+	count, err := manager.MarkMissedWorkouts(now)
+	if err != nil {
+		t.Fatalf("MarkMissedWorkouts() error = %v", err)
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 missed workout, got %d", count)
+	}
+	if workouts[0].Status != domain.WorkoutStatusSkipped || workouts[0].SkipReason != domain.SkipReasonMissed {
+		t.Errorf("Expected first workout to be skipped as missed, got status=%d reason=%s", workouts[0].Status, workouts[0].SkipReason.Japanese())
+	}
+	for _, w := range workouts[1:4] {
+		if w.Status != domain.WorkoutStatusPlanned {
+			t.Errorf("Expected workout %d to remain planned, got status=%d", w.ID, w.Status)
+		}
+	}
+	// 実際に変更した予定のみを通知する
+	select {
+	case event := <-sub.Events():
+		if event.Type != domain.WorkoutEventStatusChanged || event.Workout.ID != workouts[0].ID || event.Workout.SkipReason != domain.SkipReasonMissed {
+			t.Errorf("Expected status change of workout %d, got %s of workout %d", workouts[0].ID, event.Type.Japanese(), event.Workout.ID)
+		}
+	default:
+		t.Fatal("Expected a status change event, got none")
+	}
+	select {
+	case event := <-sub.Events():
+		t.Errorf("Expected no more events, got %s of workout %d", event.Type.Japanese(), event.Workout.ID)
+	default:
+	}
+
+	// ジョブはキャンセルされるまで実行を続け、キャンセル後に終了する
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		manager.RunMissedWorkoutJob(ctx, time.Hour)
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunMissedWorkoutJob did not stop after cancel")
+	}
+}
+
+// TestListScheduledWorkouts 予定日時での絞り込みと完了・スキップ済みの扱いをテスト
+func TestListScheduledWorkouts(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	at := func(day int) *time.Time {
+		v := time.Date(2024, 1, day, 9, 0, 0, 0, time.UTC)
+		return &v
+	}
+	completedAt := time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		includeDone bool
+		wantCount   int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 予定のみ",
+			wantCount:   2,
+			description: "完了・スキップ済みと期間外・予定日なしを除く",
+		},
+		{
+			name:        "正常系: 完了・スキップ済みも含める",
+			includeDone: true,
+			wantCount:   4,
+			description: "完了日が期間外でも予定日で判定する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+
+			workouts := []*domain.Workout{
+				{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(5)},
+				{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(2)},
+				{Status: domain.WorkoutStatusSkipped, ScheduledFor: at(3)},
+				{Status: domain.WorkoutStatusCompleted, ScheduledFor: at(4), CompletedAt: &completedAt},
+				{Status: domain.WorkoutStatusPlanned, ScheduledFor: at(10)}, // 期間外
+				{Status: domain.WorkoutStatusPlanned},                       // 予定日なし
+			}
+			for _, w := range workouts {
+				w.ExerciseType = domain.Squat
+				if err := mockRepo.CreateWorkout(w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			got, err := manager.ListScheduledWorkouts(ListScheduledWorkoutsRequest{DateFrom: &from, DateTo: &to, IncludeDone: tt.includeDone})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ListScheduledWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(got) != tt.wantCount {
+				t.Fatalf("Expected %d workouts, got %d", tt.wantCount, len(got))
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].ScheduledFor.Before(*got[i-1].ScheduledFor) {
+					t.Errorf("Expected ascending scheduled_for, got %v before %v", got[i-1].ScheduledFor, got[i].ScheduledFor)
+				}
+			}
+		})
+	}
+}
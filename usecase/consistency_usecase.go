@@ -0,0 +1,214 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+// maxHeatmapDays ヒートマップとして返す最大日数（約3年）
+const maxHeatmapDays = 366 * 3
+
+// dateKeyLayout 日付をマップのキーにする際の形式
+const dateKeyLayout = "2006-01-02"
+
+// SetLocation 日付の区切りに使用するデフォルトのタイムゾーンを設定
+func (wm *WorkoutManager) SetLocation(loc *time.Location) {
+	wm.location = loc
+}
+
+// Location 日付の区切りに使用するデフォルトのタイムゾーン
+func (wm *WorkoutManager) Location() *time.Location {
+	return wm.location
+}
+
+// GetConsistencyRequest 継続状況取得リクエスト
+type GetConsistencyRequest struct {
+	DateFrom         *time.Time         // オプション: 集計開始日時（含む）。nilならDateToの1年前
+	DateTo           *time.Time         // オプション: 集計終了日時（含まない）。nilなら今日の終わり
+	StreakUnit       domain.StatsPeriod // 必須: ストリークの単位（日別・週別のみ）
+	SkipReasonPeriod domain.StatsPeriod // 必須: スキップ理由の集計単位
+	Location         *time.Location     // オプション: nilならデフォルトのタイムゾーン
+}
+
+// GetConsistency ストリーク・予定の実施率・スキップ理由・ヒートマップを集計（ビジネスロジック層）
+// 日付の区切りはすべてユーザーのタイムゾーンで判定する
+func (wm *WorkoutManager) GetConsistency(req GetConsistencyRequest) (*domain.ConsistencyReport, error) {
+	wm, span := wm.startSpan("GetConsistency")
+	defer span.End()
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+	now := time.Now().In(loc)
+	today := domain.StatsPeriodDay.BucketStart(now)
+
+	dateTo := today.AddDate(0, 0, 1)
+	if req.DateTo != nil {
+		dateTo = req.DateTo.In(loc)
+	}
+	dateFrom := dateTo.AddDate(-1, 0, 0)
+	if req.DateFrom != nil {
+		dateFrom = req.DateFrom.In(loc)
+	}
+
+	validator := &errValidator{}
+	validator.validateDateRange(&dateFrom, &dateTo)
+	validator.validate(func() error {
+		if req.StreakUnit != domain.StatsPeriodDay && req.StreakUnit != domain.StatsPeriodWeek {
+			return fmt.Errorf("streak unit must be day or week: %d", req.StreakUnit)
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		if days := dateTo.Sub(dateFrom).Hours() / 24; days > maxHeatmapDays {
+			return fmt.Errorf("date range too long: %.0f days (max %d)", days, maxHeatmapDays)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetConsistency",
+			Message: "consistency input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	// ストリークは集計期間に関係なく全期間の完了日時から求める
+	completionTimes, err := wm.repo.GetCompletionTimes(nil, nil)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetConsistency",
+			Message: "failed to get completion times from repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	workouts, err := wm.repo.ListWorkoutsByActivity(&dateFrom, &dateTo)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetConsistency",
+			Message: "failed to list workouts from repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	report := &domain.ConsistencyReport{
+		Location:   loc,
+		StreakUnit: req.StreakUnit,
+	}
+	report.CurrentStreak, report.LongestStreak = calculateStreaks(completionTimes, req.StreakUnit, now)
+
+	heatmap, heatmapByDay := newHeatmap(dateFrom, dateTo)
+	skipBuckets := make(map[time.Time]*domain.SkipReasonBucket)
+	for _, workout := range workouts {
+		activityAt := workout.ActivityAt().In(loc)
+		switch workout.Status {
+		case domain.WorkoutStatusCompleted:
+			report.CompletedCount++
+			if day, ok := heatmapByDay[domain.StatsPeriodDay.BucketStart(activityAt).Format(dateKeyLayout)]; ok {
+				day.CompletedCount++
+				day.TotalVolume += workout.Volume()
+			}
+		case domain.WorkoutStatusSkipped:
+			report.SkippedCount++
+			start := req.SkipReasonPeriod.BucketStart(activityAt)
+			bucket, exists := skipBuckets[start]
+			if !exists {
+				bucket = &domain.SkipReasonBucket{PeriodStart: start, Counts: make(map[domain.SkipReason]int)}
+				skipBuckets[start] = bucket
+			}
+			bucket.Counts[workout.SkipReason]++
+		case domain.WorkoutStatusPlanned:
+			// 予定のまま実施日を過ぎたものは未実施として扱う（今日の予定はまだ数えない）
+			if activityAt.Before(today) {
+				report.MissedCount++
+			}
+		}
+	}
+	report.DueCount = report.CompletedCount + report.SkippedCount + report.MissedCount
+	report.Heatmap = heatmap
+
+	report.SkipReasons = make([]*domain.SkipReasonBucket, 0, len(skipBuckets))
+	for _, bucket := range skipBuckets {
+		report.SkipReasons = append(report.SkipReasons, bucket)
+	}
+	sort.Slice(report.SkipReasons, func(i, j int) bool {
+		return report.SkipReasons[i].PeriodStart.Before(report.SkipReasons[j].PeriodStart)
+	})
+
+	wm.logger.Debug("継続状況を集計しました",
+		slog.String(logging.KeyOp, "GetConsistency"), slog.Int("current_streak", report.CurrentStreak.Length),
+		slog.Int("longest_streak", report.LongestStreak.Length), slog.Float64("adherence_rate", report.AdherenceRate()),
+		slog.String("timezone", loc.String()))
+	return report, nil
+}
+
+// calculateStreaks 完了日時（古い順）から現在と最長のストリークを求める
+// 現在の期間にまだ完了がなくても、直前の期間まで続いていればストリークは継続中とみなす
+func calculateStreaks(completionTimes []time.Time, unit domain.StatsPeriod, now time.Time) (current, longest domain.Streak) {
+	loc := now.Location()
+	active := make(map[string]bool, len(completionTimes))
+	starts := make([]time.Time, 0, len(completionTimes))
+	for _, t := range completionTimes {
+		start := unit.BucketStart(t.In(loc))
+		key := start.Format(dateKeyLayout)
+		if active[key] {
+			continue
+		}
+		active[key] = true
+		starts = append(starts, start)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	run := domain.Streak{}
+	for _, start := range starts {
+		if run.Length > 0 && unit.Next(run.End).Equal(start) {
+			run.Length++
+			run.End = start
+		} else {
+			run = domain.Streak{Length: 1, Start: start, End: start}
+		}
+		if run.Length > longest.Length {
+			longest = run
+		}
+	}
+
+	cursor := unit.BucketStart(now)
+	if !active[cursor.Format(dateKeyLayout)] {
+		cursor = unit.BucketStart(cursor.AddDate(0, 0, -1))
+	}
+	for active[cursor.Format(dateKeyLayout)] {
+		if current.Length == 0 {
+			current.End = cursor
+		}
+		current.Length++
+		current.Start = cursor
+		cursor = unit.BucketStart(cursor.AddDate(0, 0, -1))
+	}
+	return current, longest
+}
+
+// newHeatmap 期間内の全日分のヒートマップを用意する（dateFromのタイムゾーンで日付を区切る）
+func newHeatmap(dateFrom, dateTo time.Time) ([]*domain.HeatmapDay, map[string]*domain.HeatmapDay) {
+	days := make([]*domain.HeatmapDay, 0, int(dateTo.Sub(dateFrom).Hours()/24)+1)
+	byDay := make(map[string]*domain.HeatmapDay, cap(days))
+	for day := domain.StatsPeriodDay.BucketStart(dateFrom); day.Before(dateTo); day = day.AddDate(0, 0, 1) {
+		d := &domain.HeatmapDay{Date: day}
+		days = append(days, d)
+		byDay[day.Format(dateKeyLayout)] = d
+	}
+	return days, byDay
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestCalculateStreaks テーブル駆動テストでストリーク計算をテスト
+func TestCalculateStreaks(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	// 2024-01-10（水）12:00 JST を現在時刻とする
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, jst)
+	at := func(month time.Month, day, hour int) time.Time {
+		return time.Date(2024, month, day, hour, 0, 0, 0, jst)
+	}
+
+	tests := []struct {
+		name        string
+		times       []time.Time
+		unit        domain.StatsPeriod
+		wantCurrent int
+		wantLongest int
+		description string
+	}{
+		{
+			name:        "正常系: 今日まで3日連続",
+			times:       []time.Time{at(1, 8, 9), at(1, 9, 9), at(1, 10, 9)},
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 3,
+			wantLongest: 3,
+			description: "今日を含む連続日数",
+		},
+		{
+			name:        "正常系: 今日は未実施でも昨日まで続いていれば継続中",
+			times:       []time.Time{at(1, 8, 9), at(1, 9, 9)},
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 2,
+			wantLongest: 2,
+			description: "当日分はまだ途切れていない扱い",
+		},
+		{
+			name:        "正常系: 途切れたストリークと最長記録",
+			times:       []time.Time{at(1, 1, 9), at(1, 2, 9), at(1, 3, 9), at(1, 3, 20), at(1, 4, 9), at(1, 10, 9)},
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 1,
+			wantLongest: 4,
+			description: "同じ日の複数回は1日として数える",
+		},
+		{
+			name:        "正常系: 2日前で途切れている",
+			times:       []time.Time{at(1, 7, 9), at(1, 8, 9)},
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 0,
+			wantLongest: 2,
+			description: "昨日も今日も未実施なら現在のストリークは0",
+		},
+		{
+			name:        "正常系: 週単位",
+			times:       []time.Time{at(1, 1, 9), at(1, 3, 9), at(1, 8, 9)},
+			unit:        domain.StatsPeriodWeek,
+			wantCurrent: 2,
+			wantLongest: 2,
+			description: "月曜始まりの週で1回以上",
+		},
+		{
+			name:        "正常系: タイムゾーンで日付を区切る",
+			times:       []time.Time{time.Date(2024, 1, 8, 16, 0, 0, 0, time.UTC), at(1, 10, 9)},
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 2,
+			wantLongest: 2,
+			description: "UTC 1/8 16:00 は JST 1/9 1:00",
+		},
+		{
+			name:        "境界値: 完了なし",
+			times:       nil,
+			unit:        domain.StatsPeriodDay,
+			wantCurrent: 0,
+			wantLongest: 0,
+			description: "ストリークは0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := calculateStreaks(tt.times, tt.unit, now)
+
+			if current.Length != tt.wantCurrent {
+				t.Errorf("Expected current streak %d, got %d", tt.wantCurrent, current.Length)
+			}
+			if longest.Length != tt.wantLongest {
+				t.Errorf("Expected longest streak %d, got %d", tt.wantLongest, longest.Length)
+			}
+		})
+	}
+}
+
+// TestGetConsistency テーブル駆動テストで予定の実施率・スキップ理由・ヒートマップをテスト
+func TestGetConsistency(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	today := domain.StatsPeriodDay.BucketStart(time.Now().In(jst))
+	daysAgo := func(n, hour int) time.Time { return today.AddDate(0, 0, -n).Add(time.Duration(hour) * time.Hour) }
+	completedAt := func(t time.Time) *time.Time { return &t }
+
+	setupWorkouts := []*domain.Workout{
+		{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, Sets: 3, Reps: 10, Weight: 60.0, CreatedAt: daysAgo(2, 8), CompletedAt: completedAt(daysAgo(2, 9))},
+		{ExerciseType: domain.Squat, Status: domain.WorkoutStatusCompleted, Sets: 5, Reps: 5, Weight: 100.0, CreatedAt: daysAgo(1, 8), CompletedAt: completedAt(daysAgo(1, 9))},
+		{ExerciseType: domain.Deadlift, Status: domain.WorkoutStatusCompleted, Sets: 3, Reps: 5, Weight: 120.0, CreatedAt: daysAgo(1, 8), CompletedAt: completedAt(daysAgo(1, 10))},
+		{ExerciseType: domain.PullUp, Status: domain.WorkoutStatusSkipped, SkipReason: domain.SkipReasonSore, Sets: 3, Reps: 8, CreatedAt: daysAgo(3, 9)},
+		{ExerciseType: domain.SideRaise, Status: domain.WorkoutStatusSkipped, SkipReason: domain.SkipReasonNoTime, Sets: 3, Reps: 15, CreatedAt: daysAgo(4, 9)},
+		{ExerciseType: domain.OneHandRow, Status: domain.WorkoutStatusPlanned, Sets: 3, Reps: 10, CreatedAt: daysAgo(5, 9)},
+		{ExerciseType: domain.HighPull, Status: domain.WorkoutStatusPlanned, Sets: 3, Reps: 10, CreatedAt: daysAgo(0, 9)},
+	}
+
+	weekAgo := today.AddDate(0, 0, -7)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name          string
+		request       GetConsistencyRequest
+		wantDue       int
+		wantMissed    int
+		wantAdherence float64
+		wantSkips     int // スキップ理由の件数の合計
+		wantDays      int // ヒートマップの日数
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: 直近1週間",
+			request:       GetConsistencyRequest{DateFrom: &weekAgo, DateTo: &tomorrow, StreakUnit: domain.StatsPeriodDay, SkipReasonPeriod: domain.StatsPeriodWeek, Location: jst},
+			wantDue:       6,
+			wantMissed:    1,
+			wantAdherence: 3.0 / 6.0,
+			wantSkips:     2,
+			wantDays:      8,
+			description:   "今日の予定は未実施に数えない",
+		},
+		{
+			name:          "正常系: デフォルト期間",
+			request:       GetConsistencyRequest{StreakUnit: domain.StatsPeriodWeek, SkipReasonPeriod: domain.StatsPeriodMonth, Location: jst},
+			wantDue:       6,
+			wantMissed:    1,
+			wantAdherence: 3.0 / 6.0,
+			wantSkips:     2,
+			wantDays:      int(tomorrow.Sub(tomorrow.AddDate(-1, 0, 0)).Hours() / 24),
+			description:   "今日までの1年間",
+		},
+		{
+			name:        "異常系: 月単位のストリーク",
+			request:     GetConsistencyRequest{StreakUnit: domain.StatsPeriodMonth, Location: jst},
+			wantErr:     true,
+			description: "ストリークは日別・週別のみ",
+		},
+		{
+			name:        "異常系: 期間が長すぎる",
+			request:     GetConsistencyRequest{DateFrom: completedAt(today.AddDate(-5, 0, 0)), DateTo: &tomorrow, StreakUnit: domain.StatsPeriodDay, Location: jst},
+			wantErr:     true,
+			description: "ヒートマップは約3年分まで",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			for _, workout := range setupWorkouts {
+				w := *workout
+				if err := mockRepo.CreateWorkout(&w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			report, err := manager.GetConsistency(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetConsistency() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if report.DueCount != tt.wantDue {
+				t.Errorf("Expected DueCount=%d, got %d", tt.wantDue, report.DueCount)
+			}
+			if report.MissedCount != tt.wantMissed {
+				t.Errorf("Expected MissedCount=%d, got %d", tt.wantMissed, report.MissedCount)
+			}
+			if report.AdherenceRate() != tt.wantAdherence {
+				t.Errorf("Expected AdherenceRate=%.2f, got %.2f", tt.wantAdherence, report.AdherenceRate())
+			}
+			skips := 0
+			for _, bucket := range report.SkipReasons {
+				for _, count := range bucket.Counts {
+					skips += count
+				}
+			}
+			if skips != tt.wantSkips {
+				t.Errorf("Expected %d skip reasons, got %d", tt.wantSkips, skips)
+			}
+			if len(report.Heatmap) != tt.wantDays {
+				t.Fatalf("Expected %d heatmap days, got %d", tt.wantDays, len(report.Heatmap))
+			}
+
+			// 昨日は2件完了（スクワット + デッドリフト）
+			yesterday := report.Heatmap[len(report.Heatmap)-2]
+			if !yesterday.Date.Equal(today.AddDate(0, 0, -1)) || yesterday.CompletedCount != 2 {
+				t.Errorf("Expected 2 completions yesterday, got %d on %s", yesterday.CompletedCount, yesterday.Date.Format(dateKeyLayout))
+			}
+			if report.CurrentStreak.Length == 0 {
+				t.Errorf("Expected current streak to continue from yesterday")
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package eventbus
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+const (
+	DefaultHistorySize = 1024 // 再開用に保持する直近のイベント数
+	DefaultBufferSize  = 64   // 購読者ごとの未送信イベントの上限
+)
+
+// 購読の終了理由・購読できない理由（errors.Isで判定する）
+var (
+	ErrSlowConsumer       = errors.New("subscriber fell behind and its event buffer overflowed")
+	ErrResumeExpired      = errors.New("resume token is too old; events since then are no longer retained")
+	ErrInvalidToken       = errors.New("invalid resume token")
+	ErrSubscriptionClosed = errors.New("subscription closed")
+)
+
+// Bus プロセス内のワークアウト変更イベントを購読者に配信する
+// 発行側はブロックしない。購読者のバッファがあふれた場合はその購読を打ち切り、
+// 購読者は最後に受け取ったイベントの再開トークンで取りこぼしなく購読し直せる
+type Bus struct {
+	mu          sync.Mutex
+	epoch       string                // プロセスごとの識別子（再起動前のトークンを見分ける）
+	seq         uint64                // 最後に採番した通し番号
+	history     []domain.WorkoutEvent // 直近のイベント（古い順、最大historySize件）
+	historySize int
+	subs        map[*Subscription]struct{}
+}
+
+// New 直近historySize件のイベントから再開できるBusを作成
+func New(historySize int) *Bus {
+	if historySize <= 0 {
+		historySize = DefaultHistorySize
+	}
+	return &Bus{
+		epoch:       strconv.FormatInt(time.Now().UnixNano(), 36),
+		history:     make([]domain.WorkoutEvent, 0, historySize),
+		historySize: historySize,
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish イベントに通し番号を付けて購読者に配信し、採番後のイベントを返す
+func (b *Bus) Publish(event domain.WorkoutEvent) domain.WorkoutEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event.Sequence = b.seq
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	if len(b.history) == b.historySize {
+		copy(b.history, b.history[1:])
+		b.history = b.history[:len(b.history)-1]
+	}
+	b.history = append(b.history, event)
+
+	for sub := range b.subs {
+		if !sub.filter.Matches(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// 遅い購読者のために発行側を待たせない
+			b.unsubscribe(sub, ErrSlowConsumer)
+		}
+	}
+	return event
+}
+
+// SubscribeOptions 購読の条件
+type SubscribeOptions struct {
+	Filter      domain.WorkoutEventFilter
+	ResumeToken string // 空なら購読開始以降のイベントのみ。指定した場合はそのイベントの次から
+	BufferSize  int    // 0以下ならDefaultBufferSize
+}
+
+// Subscribe イベントを購読する。再開トークンを指定した場合は保持しているイベントを先に配信する
+func (b *Bus) Subscribe(opts SubscribeOptions) (*Subscription, error) {
+	bufferSize := opts.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultBufferSize
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []domain.WorkoutEvent
+	if opts.ResumeToken != "" {
+		after, err := b.parseToken(opts.ResumeToken)
+		if err != nil {
+			return nil, err
+		}
+		// after+1以降がすべて残っていなければ取りこぼしが出る
+		if after < b.seq && (len(b.history) == 0 || b.history[0].Sequence > after+1) {
+			return nil, fmt.Errorf("%w (sequence %d)", ErrResumeExpired, after)
+		}
+		for _, event := range b.history {
+			if event.Sequence > after && opts.Filter.Matches(event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub := &Subscription{
+		bus:    b,
+		filter: opts.Filter,
+		events: make(chan domain.WorkoutEvent, bufferSize+len(replay)),
+		done:   make(chan struct{}),
+	}
+	for _, event := range replay {
+		sub.events <- event
+	}
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+// Token 通し番号から再開トークンを作成
+func (b *Bus) Token(seq uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(b.epoch + ":" + strconv.FormatUint(seq, 10)))
+}
+
+// parseToken 再開トークンから通し番号を取り出す（b.muを保持して呼び出す）
+func (b *Bus) parseToken(token string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	epoch, seqText, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return 0, ErrInvalidToken
+	}
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if epoch != b.epoch {
+		// サーバーの再起動前に発行されたトークン。その間のイベントは残っていない
+		return 0, fmt.Errorf("%w (issued before restart)", ErrResumeExpired)
+	}
+	if seq > b.seq {
+		return 0, fmt.Errorf("%w: sequence %d has not been issued", ErrInvalidToken, seq)
+	}
+	return seq, nil
+}
+
+// SubscriberCount 購読中の数
+func (b *Bus) SubscriberCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// unsubscribe 購読を終了する（b.muを保持して呼び出す）
+func (b *Bus) unsubscribe(sub *Subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+	delete(b.subs, sub)
+	sub.err = err
+	close(sub.done)
+}
+
+// Subscription イベントの購読
+type Subscription struct {
+	bus    *Bus
+	filter domain.WorkoutEventFilter
+	events chan domain.WorkoutEvent
+	done   chan struct{}
+	err    error // doneが閉じられた後に参照する
+}
+
+// Events 配信されたイベント（Doneが閉じられた後は新しいイベントは届かない）
+func (s *Subscription) Events() <-chan domain.WorkoutEvent {
+	return s.events
+}
+
+// Done 購読が終了すると閉じられる
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err 購読が終了した理由（終了していなければnil）
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close 購読を終了する（複数回呼び出してもよい）
+func (s *Subscription) Close() {
+	s.bus.mu.Lock()
+	defer s.bus.mu.Unlock()
+	s.bus.unsubscribe(s, ErrSubscriptionClosed)
+}
@@ -0,0 +1,172 @@
+package eventbus
+
+import (
+	"errors"
+	"testing"
+
+	"golv2-learning-app/domain"
+)
+
+// publishN ワークアウトID 1..nの作成イベントを発行する
+func publishN(bus *Bus, n int) []domain.WorkoutEvent {
+	events := make([]domain.WorkoutEvent, 0, n)
+	for i := 1; i <= n; i++ {
+		workout := &domain.Workout{ID: domain.WorkoutID(i), ExerciseType: domain.Squat}
+		events = append(events, bus.Publish(domain.WorkoutEvent{Type: domain.WorkoutEventCreated, Workout: workout}))
+	}
+	return events
+}
+
+// receiveAll 購読中のイベントを受け取れるだけ受け取る
+func receiveAll(sub *Subscription) []domain.WorkoutID {
+	var ids []domain.WorkoutID
+	for {
+		select {
+		case event := <-sub.Events():
+			ids = append(ids, event.Workout.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+// TestSubscribe テーブル駆動テストで再開トークン・フィルタ・遅い購読者の扱いをテスト
+func TestSubscribe(t *testing.T) {
+	tests := []struct {
+		name        string
+		historySize int
+		published   int // 購読前に発行する件数
+		resumeAfter int // 0より大きければこの件数目のイベントから再開
+		badToken    string
+		filter      domain.WorkoutEventFilter
+		bufferSize  int
+		publishMore int // 購読後に受け取らずに発行する件数
+		wantIDs     []domain.WorkoutID
+		wantDoneErr error
+		wantErr     error
+		description string
+	}{
+		{
+			name:        "正常系: トークンなし",
+			historySize: 10,
+			published:   3,
+			publishMore: 2,
+			wantIDs:     []domain.WorkoutID{4, 5},
+			description: "購読開始以降のイベントのみ受け取る",
+		},
+		{
+			name:        "正常系: 再開トークン",
+			historySize: 10,
+			published:   5,
+			resumeAfter: 2,
+			publishMore: 1,
+			wantIDs:     []domain.WorkoutID{3, 4, 5, 6},
+			description: "トークンの次のイベントから取りこぼさず受け取る",
+		},
+		{
+			name:        "正常系: フィルタ",
+			historySize: 10,
+			published:   4,
+			resumeAfter: 1,
+			filter:      domain.WorkoutEventFilter{WorkoutIDs: []domain.WorkoutID{2, 4}},
+			wantIDs:     []domain.WorkoutID{2, 4},
+			description: "再送するイベントにもフィルタを適用する",
+		},
+		{
+			name:        "正常系: 遅い購読者",
+			historySize: 10,
+			bufferSize:  2,
+			publishMore: 3,
+			wantIDs:     []domain.WorkoutID{1, 2},
+			wantDoneErr: ErrSlowConsumer,
+			description: "バッファがあふれたら購読を打ち切り、発行側は待たない",
+		},
+		{
+			name:        "異常系: 保持期間を過ぎたトークン",
+			historySize: 3,
+			published:   6,
+			resumeAfter: 2,
+			wantErr:     ErrResumeExpired,
+			description: "3件目が残っていないため再開できない",
+		},
+		{
+			name:        "異常系: 不正なトークン",
+			historySize: 10,
+			badToken:    "not a token",
+			wantErr:     ErrInvalidToken,
+			description: "デコードできないトークン",
+		},
+		{
+			name:        "異常系: 別のプロセスのトークン",
+			historySize: 10,
+			published:   1,
+			badToken:    New(10).Token(1),
+			wantErr:     ErrResumeExpired,
+			description: "再起動前のトークンは再開できない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bus := New(tt.historySize)
+			published := publishN(bus, tt.published)
+
+			opts := SubscribeOptions{Filter: tt.filter, BufferSize: tt.bufferSize, ResumeToken: tt.badToken}
+			if tt.resumeAfter > 0 {
+				opts.ResumeToken = bus.Token(published[tt.resumeAfter-1].Sequence)
+			}
+			sub, err := bus.Subscribe(opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Subscribe() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Subscribe() error = %v", err)
+			}
+			defer sub.Close()
+
+			for i := 0; i < tt.publishMore; i++ {
+				workout := &domain.Workout{ID: domain.WorkoutID(tt.published + i + 1)}
+				bus.Publish(domain.WorkoutEvent{Type: domain.WorkoutEventCreated, Workout: workout})
+			}
+
+			ids := receiveAll(sub)
+			if len(ids) != len(tt.wantIDs) {
+				t.Fatalf("Expected IDs %v, got %v", tt.wantIDs, ids)
+			}
+			for i := range ids {
+				if ids[i] != tt.wantIDs[i] {
+					t.Errorf("Expected IDs %v, got %v", tt.wantIDs, ids)
+					break
+				}
+			}
+			if !errors.Is(sub.Err(), tt.wantDoneErr) {
+				t.Errorf("Expected done error %v, got %v", tt.wantDoneErr, sub.Err())
+			}
+			if tt.wantDoneErr != nil && bus.SubscriberCount() != 0 {
+				t.Errorf("Expected the slow subscriber to be removed, got %d subscribers", bus.SubscriberCount())
+			}
+		})
+	}
+}
+
+// TestSubscriptionClose 終了した購読にはイベントを配信しない
+func TestSubscriptionClose(t *testing.T) {
+	bus := New(10)
+	sub, err := bus.Subscribe(SubscribeOptions{})
+	if err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	sub.Close()
+	sub.Close()
+	publishN(bus, 1)
+
+	if !errors.Is(sub.Err(), ErrSubscriptionClosed) {
+		t.Errorf("Expected %v, got %v", ErrSubscriptionClosed, sub.Err())
+	}
+	if ids := receiveAll(sub); len(ids) != 0 {
+		t.Errorf("Expected no events after close, got %v", ids)
+	}
+}
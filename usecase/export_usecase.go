@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"fmt"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+)
+
+const (
+	DefaultExportBatchSize = 500  // バッチサイズの指定がない場合に1回で取得する件数
+	maxExportBatchSize     = 5000 // 1回で取得する最大件数
+)
+
+// ExportWorkouts フィルタに一致するワークアウトをID順にbatchSize件ずつfnに渡す（ビジネスロジック層）
+// 全件をメモリに載せずに書き出すため、fnは受け取ったバッチを送信・書き込みしてすぐに返すこと
+func (wm *WorkoutManager) ExportWorkouts(filter domain.WorkoutFilter, batchSize int, fn func(batch []*domain.Workout) error) (int, error) {
+	wm, span := wm.startSpan("ExportWorkouts")
+	defer span.End()
+
+	if batchSize == 0 {
+		batchSize = DefaultExportBatchSize
+	}
+
+	validator := &errValidator{}
+	validator.validate(func() error {
+		if batchSize < 0 || batchSize > maxExportBatchSize {
+			return fmt.Errorf("batch size must be between 1 and %d: %d", maxExportBatchSize, batchSize)
+		}
+		return nil
+	})
+	if filter.MinWeight != nil {
+		validator.validateWeight(*filter.MinWeight)
+	}
+	if filter.MaxWeight != nil {
+		validator.validateWeight(*filter.MaxWeight)
+	}
+	validator.validate(func() error {
+		if filter.MinWeight != nil && filter.MaxWeight != nil && *filter.MinWeight > *filter.MaxWeight {
+			return fmt.Errorf("min weight must not exceed max weight: %.2f > %.2f", *filter.MinWeight, *filter.MaxWeight)
+		}
+		return nil
+	})
+	validator.validateDateRange(filter.DateFrom, filter.DateTo)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ExportWorkouts",
+			Message: "export input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return 0, workoutErr
+	}
+
+	count := 0
+	err := wm.repo.StreamWorkouts(filter, batchSize, func(batch []*domain.Workout) error {
+		if err := fn(batch); err != nil {
+			return err
+		}
+		count += len(batch)
+		return nil
+	})
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ExportWorkouts",
+			Message: fmt.Sprintf("failed to export workouts after %d", count),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return count, workoutErr
+	}
+	return count, nil
+}
@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestExportWorkouts テーブル駆動テストでフィルタ・バッチ分割・入力検証をテスト
+func TestExportWorkouts(t *testing.T) {
+	floatPtr := func(v float64) *float64 { return &v }
+	completed := domain.WorkoutStatusCompleted
+	base := time.Date(2024, 1, 1, 7, 0, 0, 0, time.UTC)
+	dateFrom, dateTo := base.AddDate(0, 0, 1), base.AddDate(0, 0, 3)
+
+	tests := []struct {
+		name        string
+		filter      domain.WorkoutFilter
+		batchSize   int
+		wantBatches []int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 全件",
+			batchSize:   2,
+			wantBatches: []int{2, 2, 1},
+			description: "batchSize件ずつID順に渡す",
+		},
+		{
+			name:        "正常系: フィルタ",
+			filter:      domain.WorkoutFilter{Status: &completed, MinWeight: floatPtr(55), DateFrom: &dateFrom, DateTo: &dateTo},
+			wantBatches: []int{2},
+			description: "ステータス・重量・実施日時（終了日時を含まない）で絞り込む",
+		},
+		{
+			name:        "異常系: 重量の範囲",
+			filter:      domain.WorkoutFilter{MinWeight: floatPtr(100), MaxWeight: floatPtr(50)},
+			wantErr:     true,
+			description: "下限が上限を超える",
+		},
+		{
+			name:        "異常系: バッチサイズ",
+			batchSize:   maxExportBatchSize + 1,
+			wantErr:     true,
+			description: "1回で取得できる件数を超える",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			for i := 0; i < 5; i++ {
+				completedAt := base.AddDate(0, 0, i)
+				_, err := manager.CreateWorkout(CreateWorkoutRequest{
+					ExerciseType: domain.BenchPress,
+					Sets:         3,
+					Reps:         10,
+					Weight:       50 + float64(i)*5,
+				})
+				if err != nil {
+					t.Fatalf("Failed to create workout: %v", err)
+				}
+				workout, _ := manager.GetWorkout(domain.WorkoutID(i + 1))
+				workout.Status = domain.WorkoutStatusCompleted
+				workout.CompletedAt = &completedAt
+			}
+
+			var batches []int
+			var lastID domain.WorkoutID
+			count, err := manager.ExportWorkouts(tt.filter, tt.batchSize, func(batch []*domain.Workout) error {
+				batches = append(batches, len(batch))
+				for _, w := range batch {
+					if w.ID <= lastID {
+						t.Errorf("Expected ascending IDs, got %d after %d", w.ID, lastID)
+					}
+					lastID = w.ID
+				}
+				return nil
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExportWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			want := 0
+			for _, n := range tt.wantBatches {
+				want += n
+			}
+			if count != want || len(batches) != len(tt.wantBatches) {
+				t.Errorf("Expected batches %v (count=%d), got %v (count=%d)", tt.wantBatches, want, batches, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/usecase/importer"
+)
+
+// Format エクスポートするファイルの形式
+type Format int
+
+const (
+	FormatJSONL Format = iota // JSON Lines（1行に1つの domain.Workout）
+	FormatCSV                 // CSV（列は id と importer.Fields。そのままインポートできる）
+)
+
+// FieldID CSVの先頭に出力するワークアウトIDの列（インポート時は無視される）
+const FieldID = "id"
+
+// Writer ワークアウトをバッチごとに書き出す
+// 書き込んだバッチは保持しないため、件数が多くてもメモリ使用量は一定
+type Writer struct {
+	format      Format
+	csv         *csv.Writer
+	json        *json.Encoder
+	wroteHeader bool
+}
+
+// NewWriter 指定した形式で書き出すWriterを作成
+func NewWriter(w io.Writer, format Format) (*Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return &Writer{format: format, json: json.NewEncoder(w)}, nil
+	case FormatCSV:
+		return &Writer{format: format, csv: csv.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported export format: %d", format)
+	}
+}
+
+// Write ワークアウトを書き出す（CSVの場合は最初の呼び出しでヘッダーも書き出す）
+func (w *Writer) Write(workouts []*domain.Workout) error {
+	if w.format == FormatJSONL {
+		for _, workout := range workouts {
+			if err := w.json.Encode(workout); err != nil {
+				return fmt.Errorf("failed to encode workout (id=%d): %w", workout.ID, err)
+			}
+		}
+		return nil
+	}
+
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	for _, workout := range workouts {
+		if err := w.csv.Write(csvRecord(workout)); err != nil {
+			return fmt.Errorf("failed to write workout (id=%d): %w", workout.ID, err)
+		}
+	}
+	return nil
+}
+
+// Flush バッファに残っている内容を書き出す（0件でもCSVのヘッダーは書き出す）
+func (w *Writer) Flush() error {
+	if w.format != FormatCSV {
+		return nil
+	}
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+// writeHeader CSVのヘッダーを1回だけ書き出す
+func (w *Writer) writeHeader() error {
+	if w.wroteHeader {
+		return nil
+	}
+	w.wroteHeader = true
+	if err := w.csv.Write(append([]string{FieldID}, importer.Fields...)); err != nil {
+		return fmt.Errorf("failed to write csv header: %w", err)
+	}
+	return nil
+}
+
+// csvRecord ワークアウトをCSVの1行にする（列の順番は FieldID, importer.Fields）
+// 列挙値はキー、日時はRFC3339（予定日時は予定のタイムゾーン）で出力する
+func csvRecord(w *domain.Workout) []string {
+	values := map[string]string{
+		importer.FieldExerciseType: w.ExerciseType.Key(),
+		importer.FieldDescription:  w.Description,
+		importer.FieldStatus:       w.Status.Key(),
+		importer.FieldDifficulty:   w.Difficulty.Key(),
+		importer.FieldMuscleGroup:  w.MuscleGroup.Key(),
+		importer.FieldSets:         strconv.Itoa(w.Sets),
+		importer.FieldReps:         strconv.Itoa(w.Reps),
+		importer.FieldWeight:       strconv.FormatFloat(w.Weight, 'f', -1, 64),
+		importer.FieldNotes:        w.Notes,
+		importer.FieldTimezone:     w.ScheduledTimezone,
+		importer.FieldSkipReason:   w.SkipReason.Key(),
+	}
+	if w.CompletedAt != nil {
+		values[importer.FieldCompletedAt] = w.CompletedAt.Format(time.RFC3339)
+	}
+	if w.ScheduledFor != nil {
+		scheduledFor := *w.ScheduledFor
+		if loc, err := time.LoadLocation(w.ScheduledTimezone); err == nil && w.ScheduledTimezone != "" {
+			scheduledFor = scheduledFor.In(loc)
+		}
+		values[importer.FieldScheduledFor] = scheduledFor.Format(time.RFC3339)
+	}
+
+	record := make([]string, 0, len(importer.Fields)+1)
+	record = append(record, strconv.FormatInt(int64(w.ID), 10))
+	for _, field := range importer.Fields {
+		record = append(record, values[field])
+	}
+	return record
+}
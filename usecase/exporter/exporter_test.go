@@ -0,0 +1,114 @@
+package exporter
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/usecase/importer"
+)
+
+// TestWriter テーブル駆動テストでエクスポートしたファイルをそのままインポートできることをテスト
+func TestWriter(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+	completedAt := time.Date(2024, 1, 2, 7, 30, 0, 0, tokyo)
+	scheduledFor := time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC)
+
+	workouts := []*domain.Workout{
+		{
+			ID: 1, ExerciseType: domain.BenchPress, Description: "胸の日", Status: domain.WorkoutStatusCompleted,
+			Difficulty: domain.DifficultyAdvanced, MuscleGroup: domain.Chest, Sets: 3, Reps: 8, Weight: 62.5,
+			Notes: "調子よし, 次回65", CompletedAt: &completedAt,
+		},
+		{
+			ID: 2, ExerciseType: domain.Squat, Status: domain.WorkoutStatusPlanned, Sets: 5, Reps: 5, Weight: 100,
+			ScheduledFor: &scheduledFor, ScheduledTimezone: "Asia/Tokyo",
+		},
+	}
+
+	tests := []struct {
+		name         string
+		format       Format
+		importFormat importer.Format
+		batches      [][]*domain.Workout
+		wantLines    int
+		description  string
+	}{
+		{
+			name:         "正常系: CSV",
+			format:       FormatCSV,
+			importFormat: importer.FormatCSV,
+			batches:      [][]*domain.Workout{workouts[:1], workouts[1:]},
+			wantLines:    3,
+			description:  "ヘッダーは最初のバッチの前に1回だけ出力",
+		},
+		{
+			name:         "正常系: JSON Lines",
+			format:       FormatJSONL,
+			importFormat: importer.FormatJSONL,
+			batches:      [][]*domain.Workout{workouts[:1], workouts[1:]},
+			wantLines:    2,
+			description:  "1行に1つのワークアウト",
+		},
+		{
+			name:         "正常系: CSV（0件）",
+			format:       FormatCSV,
+			importFormat: importer.FormatCSV,
+			wantLines:    1,
+			description:  "0件でもヘッダーは出力",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			writer, err := NewWriter(&buf, tt.format)
+			if err != nil {
+				t.Fatalf("NewWriter() error = %v", err)
+			}
+			want := make([]*domain.Workout, 0, len(workouts))
+			for _, batch := range tt.batches {
+				if err := writer.Write(batch); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+				want = append(want, batch...)
+			}
+			if err := writer.Flush(); err != nil {
+				t.Fatalf("Flush() error = %v", err)
+			}
+
+			if lines := strings.Count(buf.String(), "\n"); lines != tt.wantLines {
+				t.Errorf("Expected %d lines, got %d:\n%s", tt.wantLines, lines, buf.String())
+			}
+
+			result, err := importer.Parse(&buf, importer.Options{Format: tt.importFormat})
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(result.Errors) > 0 || len(result.Rows) != len(want) {
+				t.Fatalf("Expected %d rows without errors, got %d rows (errors: %v)", len(want), len(result.Rows), result.Errors)
+			}
+			for i, row := range result.Rows {
+				got, w := row.Workout, want[i]
+				if got.ExerciseType != w.ExerciseType || got.Status != w.Status || got.Difficulty != w.Difficulty || got.MuscleGroup != w.MuscleGroup ||
+					got.Sets != w.Sets || got.Reps != w.Reps || got.Weight != w.Weight || got.Notes != w.Notes || got.Description != w.Description {
+					t.Errorf("Row %d: expected %+v, got %+v", i, w, got)
+				}
+				if (got.CompletedAt == nil) != (w.CompletedAt == nil) || (got.CompletedAt != nil && !got.CompletedAt.Equal(*w.CompletedAt)) {
+					t.Errorf("Row %d: expected completed_at %v, got %v", i, w.CompletedAt, got.CompletedAt)
+				}
+				if (got.ScheduledFor == nil) != (w.ScheduledFor == nil) || (got.ScheduledFor != nil && !got.ScheduledFor.Equal(*w.ScheduledFor)) {
+					t.Errorf("Row %d: expected scheduled_for %v, got %v", i, w.ScheduledFor, got.ScheduledFor)
+				}
+				if got.ScheduledTimezone != w.ScheduledTimezone {
+					t.Errorf("Row %d: expected timezone %q, got %q", i, w.ScheduledTimezone, got.ScheduledTimezone)
+				}
+			}
+		})
+	}
+}
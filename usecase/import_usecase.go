@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sort"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/importer"
+)
+
+// ImportWorkoutsRequest ワークアウト一括インポートリクエスト
+type ImportWorkoutsRequest struct {
+	Data          io.Reader         // 必須: インポートするファイルの内容
+	Format        importer.Format   // ファイル形式
+	ColumnMapping map[string]string // オプション: CSVのフィールド名 → 列名
+	Location      *time.Location    // オプション: タイムゾーンのない日時の解釈に使用（nilならユーザー設定）
+	DryRun        bool              // trueなら検証のみ行い保存しない
+}
+
+// ImportWorkoutsResult ワークアウト一括インポートの結果
+type ImportWorkoutsResult struct {
+	TotalRows  int                  // 解析した行数（エラーの行を含む）
+	Workouts   []*domain.Workout    // 保存した（DryRunの場合は保存できる）ワークアウト
+	Errors     []*importer.RowError // 行ごとの解析・検証エラー
+	Skipped    []*importer.RowError // 対応する種目がないため取り込まなかった行
+	Duplicates int                  // 取り込み済みのため取り込まなかった件数
+	DryRun     bool
+	Committed  bool // 保存した場合true（1行でもエラーがあれば何も保存しない）
+}
+
+// SetImportAliases 他のアプリの種目名 → 種目の別名を設定（importer.DefaultAliasesに追加・上書き）
+func (wm *WorkoutManager) SetImportAliases(aliases map[string]domain.ExerciseType) {
+	wm.importAliases = aliases
+}
+
+// ImportWorkouts ファイルからワークアウトを一括でインポート（ビジネスロジック層）
+// 全ての行を解析・検証してエラーを行ごとに返し、エラーが1件もない場合のみ1トランザクションで保存する
+func (wm *WorkoutManager) ImportWorkouts(req ImportWorkoutsRequest) (*ImportWorkoutsResult, error) {
+	wm, span := wm.startSpan("ImportWorkouts")
+	defer span.End()
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+
+	parsed, err := importer.Parse(req.Data, importer.Options{
+		Format:        req.Format,
+		ColumnMapping: req.ColumnMapping,
+		Location:      loc,
+		Aliases:       wm.importAliases,
+	})
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ImportWorkouts",
+			Message: fmt.Sprintf("failed to parse %s file", req.Format),
+			Err:     &ValidationErrors{Errors: []error{err}}, // ファイルの内容の誤りは入力値のエラー
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	rows, rowErrors := parsed.Rows, parsed.Errors
+	now := time.Now()
+	workouts := make([]*domain.Workout, 0, len(rows))
+	for _, row := range rows {
+		prepareImportedWorkout(row.Workout, now)
+		if err := validateImportedWorkout(row.Workout, now); err != nil {
+			rowErrors = append(rowErrors, &importer.RowError{Line: row.Line, Err: err})
+			continue
+		}
+		workouts = append(workouts, row.Workout)
+	}
+	sortRowErrors(rowErrors)
+
+	// 取り込み済みの記録（同じファイルの再インポートなど）は除く
+	workouts, duplicates, err := wm.excludeImported(workouts)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ImportWorkouts",
+			Message: "failed to check previously imported workouts",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	result := &ImportWorkoutsResult{
+		TotalRows:  len(rows) + len(parsed.Skipped) + countParseErrors(rows, rowErrors),
+		Workouts:   workouts,
+		Errors:     rowErrors,
+		Skipped:    parsed.Skipped,
+		Duplicates: duplicates,
+		DryRun:     req.DryRun,
+	}
+	if len(rowErrors) > 0 {
+		wm.logger.Warn("インポートを中止しました",
+			slog.String(logging.KeyOp, "ImportWorkouts"), slog.Int("total_rows", result.TotalRows), slog.Int("error_rows", len(rowErrors)))
+		return result, nil
+	}
+	if req.DryRun {
+		wm.logger.Info("インポートの検証のみ実行しました",
+			slog.String(logging.KeyOp, "ImportWorkouts"), slog.Int("workouts", len(workouts)), slog.Bool("dry_run", true))
+		return result, nil
+	}
+
+	err = wm.repo.CreateWorkouts(workouts)
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		// 確認してから保存するまでに同じ記録が取り込まれた（同時のインポート）場合は、除外し直して1回だけ再試行する
+		var more int
+		workouts, more, err = wm.excludeImported(workouts)
+		if err == nil {
+			result.Workouts = workouts
+			result.Duplicates += more
+			err = wm.repo.CreateWorkouts(workouts)
+		}
+	}
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ImportWorkouts",
+			Message: fmt.Sprintf("failed to save imported workouts (count=%d)", len(workouts)),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	result.Committed = true
+	for _, workout := range workouts {
+		wm.publishEvent(domain.WorkoutEventCreated, workout)
+	}
+
+	wm.logger.Info("ワークアウトをインポートしました",
+		slog.String(logging.KeyOp, "ImportWorkouts"), slog.Int("workouts", len(workouts)),
+		slog.Int("duplicates", result.Duplicates), slog.Int("skipped", len(parsed.Skipped)))
+	return result, nil
+}
+
+// excludeImported 取り込み識別子が保存済み、またはファイル内で重複するワークアウトを除く
+func (wm *WorkoutManager) excludeImported(workouts []*domain.Workout) ([]*domain.Workout, int, error) {
+	keys := make([]string, 0, len(workouts))
+	for _, w := range workouts {
+		if w.ImportKey != "" {
+			keys = append(keys, w.ImportKey)
+		}
+	}
+	if len(keys) == 0 {
+		return workouts, 0, nil
+	}
+
+	existing, err := wm.repo.FindImportKeys(keys)
+	if err != nil {
+		return nil, 0, err
+	}
+	seen := make(map[string]bool, len(keys))
+	for _, key := range existing {
+		seen[key] = true
+	}
+
+	fresh := make([]*domain.Workout, 0, len(workouts))
+	for _, w := range workouts {
+		if w.ImportKey != "" {
+			if seen[w.ImportKey] {
+				continue
+			}
+			seen[w.ImportKey] = true
+		}
+		fresh = append(fresh, w)
+	}
+	return fresh, len(workouts) - len(fresh), nil
+}
+
+// prepareImportedWorkout 取り込む行に作成日時などのデフォルト値を設定する
+// 過去の記録として扱えるよう、作成日時は完了日時（なければ予定日時）に合わせる
+func prepareImportedWorkout(workout *domain.Workout, now time.Time) {
+	workout.ID = 0
+	if workout.CreatedAt.IsZero() {
+		workout.CreatedAt = now
+		if at := workout.ActivityAt(); !at.IsZero() && at.Before(now) {
+			workout.CreatedAt = at
+		}
+	}
+	if workout.UpdatedAt.IsZero() {
+		workout.UpdatedAt = now
+	}
+	if workout.ScheduledFor != nil && workout.ScheduledTimezone == "" {
+		workout.ScheduledTimezone = workout.ScheduledFor.Location().String()
+	}
+}
+
+// validateImportedWorkout インポートする1行を検証する（全てのエラーを収集）
+func validateImportedWorkout(workout *domain.Workout, now time.Time) error {
+	validator := &errValidator{}
+	validator.validateExerciseType(workout.ExerciseType)
+	validator.validateSets(workout.Sets)
+	validator.validateReps(workout.Reps)
+	validator.validateWeight(workout.Weight)
+	validator.validate(func() error {
+		if workout.Status < domain.WorkoutStatusPlanned || workout.Status > domain.WorkoutStatusSkipped {
+			return fmt.Errorf("invalid status: %d", workout.Status)
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		switch {
+		case workout.Status == domain.WorkoutStatusCompleted && workout.CompletedAt == nil:
+			return fmt.Errorf("completed workout requires completed_at")
+		case workout.Status != domain.WorkoutStatusCompleted && workout.CompletedAt != nil:
+			return fmt.Errorf("completed_at is only allowed for completed workouts")
+		case workout.CompletedAt != nil && workout.CompletedAt.After(now):
+			return fmt.Errorf("completed_at cannot be in the future: %s", workout.CompletedAt.Format(time.RFC3339))
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		if workout.SkipReason != domain.SkipReasonUnspecified && workout.Status != domain.WorkoutStatusSkipped {
+			return fmt.Errorf("skip_reason is only allowed for skipped workouts")
+		}
+		return nil
+	})
+	return validator.error()
+}
+
+// countParseErrors 解析できずに行として返らなかった件数
+func countParseErrors(rows []*importer.Row, rowErrors []*importer.RowError) int {
+	parsed := make(map[int]bool, len(rows))
+	for _, row := range rows {
+		parsed[row.Line] = true
+	}
+	count := 0
+	for _, e := range rowErrors {
+		if !parsed[e.Line] {
+			count++
+		}
+	}
+	return count
+}
+
+// sortRowErrors 行番号の昇順に並べる（解析エラーと検証エラーが混在するため）
+func sortRowErrors(rowErrors []*importer.RowError) {
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		return rowErrors[i].Line < rowErrors[j].Line
+	})
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+	"golv2-learning-app/usecase/importer"
+)
+
+// TestImportWorkouts テーブル駆動テストで一括インポートの検証・dry-run・全件保存をテスト
+func TestImportWorkouts(t *testing.T) {
+	future := time.Now().AddDate(0, 0, 3).Format("2006-01-02")
+
+	tests := []struct {
+		name          string
+		input         string
+		dryRun        bool
+		wantTotal     int
+		wantErrLines  []int
+		wantCommitted bool
+		wantSaved     int
+		wantErr       bool
+		description   string
+	}{
+		{
+			name: "正常系: 全件保存",
+			input: "exercise_type,sets,reps,weight,completed_at,scheduled_for\n" +
+				"bench_press,3,10,60,2024-01-02,\n" +
+				"squat,5,5,100,,\n" +
+				"deadlift,3,5,120,," + future + "\n",
+			wantTotal:     3,
+			wantCommitted: true,
+			wantSaved:     3,
+			description:   "エラーがなければ1回で全件保存",
+		},
+		{
+			name: "正常系: dry-run",
+			input: "exercise_type,sets,reps\n" +
+				"bench_press,3,10\n",
+			dryRun:      true,
+			wantTotal:   1,
+			wantSaved:   0,
+			description: "検証のみで保存しない",
+		},
+		{
+			name: "異常系: 1行でもエラーがあれば保存しない",
+			input: "exercise_type,sets,reps,weight,status,completed_at,skip_reason\n" +
+				"bench_press,3,10,60,completed,2024-01-02,\n" +
+				"squat,-1,5,-10,,,\n" +
+				"deadlift,3,5,120,completed,,\n" +
+				"pull_up,3,8,0,planned,,sore\n" +
+				"unknown,3,8,0,,,\n",
+			wantTotal:    5,
+			wantErrLines: []int{3, 4, 5, 6},
+			wantSaved:    0,
+			description:  "負の値・完了日時なしの完了・予定のスキップ理由・不明な種目を行番号付きで返す",
+		},
+		{
+			name:        "異常系: ヘッダーの不備",
+			input:       "sets,reps\n3,10\n",
+			wantErr:     true,
+			description: "ファイル全体を解析できない場合はエラー",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+
+			result, err := manager.ImportWorkouts(ImportWorkoutsRequest{
+				Data:   strings.NewReader(tt.input),
+				Format: importer.FormatCSV,
+				DryRun: tt.dryRun,
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ImportWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if result.TotalRows != tt.wantTotal {
+				t.Errorf("Expected %d total rows, got %d", tt.wantTotal, result.TotalRows)
+			}
+			if len(result.Errors) != len(tt.wantErrLines) {
+				t.Fatalf("Expected %d row errors, got %v", len(tt.wantErrLines), result.Errors)
+			}
+			for i, line := range tt.wantErrLines {
+				if result.Errors[i].Line != line {
+					t.Errorf("Expected error on line %d, got %v", line, result.Errors[i])
+				}
+			}
+			if result.Committed != tt.wantCommitted {
+				t.Errorf("Expected committed=%t, got %t", tt.wantCommitted, result.Committed)
+			}
+			if count, _ := mockRepo.GetWorkoutCount(); count != tt.wantSaved {
+				t.Errorf("Expected %d saved workouts, got %d", tt.wantSaved, count)
+			}
+		})
+	}
+}
+
+// TestImportWorkouts_Reimport 他のアプリのエクスポートを再インポートしても重複しないことをテスト
+func TestImportWorkouts_Reimport(t *testing.T) {
+	mockRepo := repository.NewMockWorkoutRepository()
+	manager := NewWorkoutManagerWithRepository(mockRepo)
+	manager.SetImportAliases(map[string]domain.ExerciseType{"Face Pull (Cable)": domain.HighPull})
+
+	importFixture := func() *ImportWorkoutsResult {
+		t.Helper()
+		file, err := os.Open(filepath.Join("importer", "testdata", "hevy.csv"))
+		if err != nil {
+			t.Fatalf("Failed to open fixture: %v", err)
+		}
+		defer file.Close()
+
+		result, err := manager.ImportWorkouts(ImportWorkoutsRequest{Data: file, Format: importer.FormatHevy})
+		if err != nil {
+			t.Fatalf("ImportWorkouts() error = %v", err)
+		}
+		return result
+	}
+
+	first := importFixture()
+	if len(first.Workouts) != 3 || first.Duplicates != 0 || len(first.Skipped) != 0 || !first.Committed {
+		t.Fatalf("Expected 3 imported workouts on first import, got %d (duplicates=%d, skipped=%v)", len(first.Workouts), first.Duplicates, first.Skipped)
+	}
+
+	second := importFixture()
+	if len(second.Workouts) != 0 || second.Duplicates != 3 {
+		t.Errorf("Expected all 3 workouts to be duplicates on re-import, got %d imported (duplicates=%d)", len(second.Workouts), second.Duplicates)
+	}
+	if count, _ := mockRepo.GetWorkoutCount(); count != 3 {
+		t.Errorf("Expected 3 saved workouts, got %d", count)
+	}
+}
+
+// racingImportRepository 取り込み済みの確認の後、保存の前に別のインポートが同じ記録を保存した状況を再現するリポジトリ
+type racingImportRepository struct {
+	*repository.MockWorkoutRepository
+	raced bool
+}
+
+// FindImportKeys 最初の確認の直後に、確認した先頭の識別子の記録を保存する
+func (r *racingImportRepository) FindImportKeys(keys []string) ([]string, error) {
+	existing, err := r.MockWorkoutRepository.FindImportKeys(keys)
+	if err != nil || r.raced {
+		return existing, err
+	}
+	r.raced = true
+	racing := &domain.Workout{ExerciseType: domain.PullUp, Status: domain.WorkoutStatusCompleted, Sets: 1, Reps: 8, ImportKey: keys[0]}
+	return existing, r.MockWorkoutRepository.CreateWorkout(racing)
+}
+
+// TestImportWorkouts_ConcurrentImport 同時のインポートで取り込み識別子が重複した場合に除外し直して保存することをテスト
+func TestImportWorkouts_ConcurrentImport(t *testing.T) {
+	repo := &racingImportRepository{MockWorkoutRepository: repository.NewMockWorkoutRepository()}
+	manager := NewWorkoutManagerWithRepository(repo)
+	manager.SetImportAliases(map[string]domain.ExerciseType{"Face Pull (Cable)": domain.HighPull})
+
+	file, err := os.Open(filepath.Join("importer", "testdata", "hevy.csv"))
+	if err != nil {
+		t.Fatalf("Failed to open fixture: %v", err)
+	}
+	defer file.Close()
+
+	result, err := manager.ImportWorkouts(ImportWorkoutsRequest{Data: file, Format: importer.FormatHevy})
+	if err != nil {
+		t.Fatalf("ImportWorkouts() error = %v", err)
+	}
+	if !result.Committed || len(result.Workouts) != 2 || result.Duplicates != 1 {
+		t.Errorf("Expected 2 imported and 1 duplicate, got %d imported (duplicates=%d, committed=%v)", len(result.Workouts), result.Duplicates, result.Committed)
+	}
+	if count, _ := repo.GetWorkoutCount(); count != 3 {
+		t.Errorf("Expected 3 saved workouts (1 by the concurrent import), got %d", count)
+	}
+}
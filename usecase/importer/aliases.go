@@ -0,0 +1,64 @@
+package importer
+
+import (
+	"strings"
+
+	"golv2-learning-app/domain"
+)
+
+// DefaultAliases Strong・Hevy・FitNotesの標準の種目名 → 種目
+// ここにない種目名は設定ファイル（import.aliases）で追加する
+var DefaultAliases = map[string]domain.ExerciseType{
+	// Strong / Hevy
+	"Bench Press (Barbell)":            domain.BenchPress,
+	"Squat (Barbell)":                  domain.Squat,
+	"Deadlift (Barbell)":               domain.Deadlift,
+	"Shoulder Press (Dumbbell)":        domain.DumbbellShoulder,
+	"Seated Shoulder Press (Dumbbell)": domain.DumbbellShoulder,
+	"Pull Up":                          domain.PullUp,
+	"Pull Up (Weighted)":               domain.PullUp,
+	"Lateral Raise (Dumbbell)":         domain.SideRaise,
+	"Bent Over One Arm Row (Dumbbell)": domain.OneHandRow,
+	"Dumbbell Row":                     domain.OneHandRow,
+	"High Pull (Barbell)":              domain.HighPull,
+	// FitNotes
+	"Flat Barbell Bench Press": domain.BenchPress,
+	"Barbell Squat":            domain.Squat,
+	"Deadlift":                 domain.Deadlift,
+	"Seated Dumbbell Press":    domain.DumbbellShoulder,
+	"Pull Up Bar":              domain.PullUp,
+	"Lateral Dumbbell Raise":   domain.SideRaise,
+	"One-Arm Dumbbell Row":     domain.OneHandRow,
+	"Barbell High Pull":        domain.HighPull,
+}
+
+// aliasTable 正規化した種目名で検索する別名の表
+type aliasTable map[string]domain.ExerciseType
+
+// newAliasTable DefaultAliasesにextraを追加・上書きした表を作る
+func newAliasTable(extra map[string]domain.ExerciseType) aliasTable {
+	table := make(aliasTable, len(DefaultAliases)+len(extra))
+	for name, et := range DefaultAliases {
+		table[normalizeName(name)] = et
+	}
+	for name, et := range extra {
+		table[normalizeName(name)] = et
+	}
+	return table
+}
+
+// lookup 種目名から種目を取得（別名になければ種目キー・日本語名・英語名でも探す）
+func (t aliasTable) lookup(name string) (domain.ExerciseType, bool) {
+	if et, ok := t[normalizeName(name)]; ok {
+		return et, true
+	}
+	if et, err := parseExerciseType(name); err == nil {
+		return et, true
+	}
+	return domain.ExerciseUnspecified, false
+}
+
+// normalizeName 大文字小文字・前後と連続する空白の違いを無視するための正規化
+func normalizeName(name string) string {
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
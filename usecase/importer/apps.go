@@ -0,0 +1,306 @@
+package importer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// kilogramsPerPound ポンドからキログラムへの換算
+const kilogramsPerPound = 0.45359237
+
+// appSpec 他のアプリのCSVエクスポートの列の定義（1行が1セット）
+type appSpec struct {
+	source         string             // 取り込み識別子の接頭辞（例: "strong"）
+	timeColumn     string             // セッションの開始日時
+	timeLayouts    []string           // 開始日時の形式（タイムゾーンなし）
+	nameColumn     string             // セッション名（ない場合は空）
+	exerciseColumn string             // 種目名
+	weightColumns  map[string]float64 // 重量の列名 → kgへの換算係数（いずれか1列）
+	unitColumn     string             // 重量の単位の列（"kg" / "lbs"、ない場合は空）
+	repsColumn     string
+	warmupColumn   string   // ウォームアップかどうかを判定する列（ない場合は空）
+	warmupValues   []string // ウォームアップを表す値
+	notesColumns   []string // メモとして取り込む列
+}
+
+var strongSpec = appSpec{
+	source:         "strong",
+	timeColumn:     "Date",
+	timeLayouts:    []string{"2006-01-02 15:04:05", "2006-01-02 15:04"},
+	nameColumn:     "Workout Name",
+	exerciseColumn: "Exercise Name",
+	weightColumns:  map[string]float64{"Weight": 1},
+	unitColumn:     "Weight Unit",
+	repsColumn:     "Reps",
+	warmupColumn:   "Set Order",
+	warmupValues:   []string{"W"},
+	notesColumns:   []string{"Notes"},
+}
+
+var hevySpec = appSpec{
+	source:         "hevy",
+	timeColumn:     "start_time",
+	timeLayouts:    []string{"2 Jan 2006, 15:04", "2006-01-02 15:04:05", "2006-01-02 15:04"},
+	nameColumn:     "title",
+	exerciseColumn: "exercise_title",
+	weightColumns:  map[string]float64{"weight_kg": 1, "weight_lbs": kilogramsPerPound},
+	repsColumn:     "reps",
+	warmupColumn:   "set_type",
+	warmupValues:   []string{"warmup"},
+	notesColumns:   []string{"exercise_notes"},
+}
+
+var fitNotesSpec = appSpec{
+	source:         "fitnotes",
+	timeColumn:     "Date",
+	timeLayouts:    []string{"2006-01-02"},
+	exerciseColumn: "Exercise",
+	weightColumns:  map[string]float64{"Weight (kgs)": 1, "Weight (kg)": 1, "Weight (lbs)": kilogramsPerPound},
+	repsColumn:     "Reps",
+	notesColumns:   []string{"Comment"},
+}
+
+// appSet 1セット分
+type appSet struct {
+	weight float64 // kg
+	reps   int
+}
+
+// appGroup 同じセッション・種目のセットをまとめたもの（1つのワークアウトになる）
+type appGroup struct {
+	line     int // 最初のセットの行番号
+	start    time.Time
+	session  string
+	exercise string
+	sets     []appSet
+	notes    []string
+}
+
+// parseApp 他のアプリのCSVを解析し、セッション・種目ごとにセットをまとめてワークアウトにする
+func parseApp(r io.Reader, spec appSpec, opts Options) (*Result, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s csv: %w", spec.source, err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("empty %s csv: header row is required", spec.source)
+		}
+		return nil, fmt.Errorf("failed to read %s csv header: %w", spec.source, err)
+	}
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.TrimSpace(name)] = i
+	}
+	for _, column := range []string{spec.timeColumn, spec.exerciseColumn, spec.repsColumn} {
+		if _, ok := positions[column]; !ok {
+			return nil, fmt.Errorf("column %q not found in %s csv header", column, spec.source)
+		}
+	}
+	weightColumn, weightFactor := "", 0.0
+	for column, factor := range spec.weightColumns {
+		if _, ok := positions[column]; ok {
+			weightColumn, weightFactor = column, factor
+			break
+		}
+	}
+	if weightColumn == "" {
+		return nil, fmt.Errorf("weight column not found in %s csv header", spec.source)
+	}
+
+	value := func(record []string, column string) string {
+		if i, ok := positions[column]; ok && column != "" && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	result := &Result{Rows: make([]*Row, 0, 64)}
+	groups := make([]*appGroup, 0, 64)
+	byKey := make(map[string]*appGroup, 64)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.Errors = append(result.Errors, &RowError{Line: parseErr.Line, Err: parseErr.Err})
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s csv: %w", spec.source, err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if isWarmup(value(record, spec.warmupColumn), spec.warmupValues) {
+			continue
+		}
+		set, start, err := parseAppSet(record, value, spec, weightColumn, weightFactor, opts.Location)
+		if err != nil {
+			result.Errors = append(result.Errors, &RowError{Line: line, Err: err})
+			continue
+		}
+		if set.reps <= 0 {
+			continue // 時間・距離のみのセット（有酸素など）は取り込まない
+		}
+
+		exercise := value(record, spec.exerciseColumn)
+		session := value(record, spec.nameColumn)
+		key := fmt.Sprintf("%d|%s|%s", start.Unix(), session, normalizeName(exercise))
+		group, ok := byKey[key]
+		if !ok {
+			group = &appGroup{line: line, start: start, session: session, exercise: exercise}
+			byKey[key] = group
+			groups = append(groups, group)
+		}
+		group.sets = append(group.sets, set)
+		for _, column := range spec.notesColumns {
+			if note := value(record, column); note != "" && !containsString(group.notes, note) {
+				group.notes = append(group.notes, note)
+			}
+		}
+	}
+
+	aliases := newAliasTable(opts.Aliases)
+	for _, group := range groups {
+		exerciseType, ok := aliases.lookup(group.exercise)
+		if !ok {
+			result.Skipped = append(result.Skipped, &RowError{
+				Line: group.line,
+				Err:  fmt.Errorf("unknown exercise %q (add it to import aliases)", group.exercise),
+			})
+			continue
+		}
+		result.Rows = append(result.Rows, &Row{Line: group.line, Workout: group.workout(spec.source, exerciseType)})
+	}
+	return result, nil
+}
+
+// parseAppSet 1行分のセッション開始日時・重量・回数を解析する
+func parseAppSet(record []string, value func([]string, string) string, spec appSpec, weightColumn string, weightFactor float64, loc *time.Location) (appSet, time.Time, error) {
+	var errs []error
+	start, err := parseTimeLayouts(value(record, spec.timeColumn), spec.timeLayouts, loc)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("%s: %w", spec.timeColumn, err))
+	}
+	if value(record, spec.exerciseColumn) == "" {
+		errs = append(errs, fmt.Errorf("%s: exercise name must be specified", spec.exerciseColumn))
+	}
+
+	set := appSet{}
+	if v := value(record, spec.repsColumn); v != "" {
+		reps, err := strconv.ParseFloat(v, 64) // "10.0" のように出力するアプリがある
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", spec.repsColumn, err))
+		}
+		set.reps = int(reps)
+	}
+	if v := value(record, weightColumn); v != "" {
+		weight, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", weightColumn, err))
+		}
+		factor := weightFactor
+		if strings.EqualFold(value(record, spec.unitColumn), "lbs") {
+			factor = kilogramsPerPound
+		}
+		set.weight = math.Round(weight*factor*100) / 100
+	}
+
+	if len(errs) > 0 {
+		return appSet{}, time.Time{}, errors.Join(errs...)
+	}
+	return set, start, nil
+}
+
+// workout まとめたセットからワークアウトを作る
+// 1つのワークアウトには重量・回数を1つしか持てないため、最も重いセット（同じ重量なら回数の多いセット）を代表にし、
+// 全セットの内訳はメモに残す
+func (g *appGroup) workout(source string, exerciseType domain.ExerciseType) *domain.Workout {
+	top := g.sets[0]
+	breakdown := make([]string, 0, len(g.sets))
+	for _, set := range g.sets {
+		if set.weight > top.weight || (set.weight == top.weight && set.reps > top.reps) {
+			top = set
+		}
+		breakdown = append(breakdown, fmt.Sprintf("%s×%d", strconv.FormatFloat(set.weight, 'f', -1, 64), set.reps))
+	}
+
+	completedAt := g.start
+	return &domain.Workout{
+		ExerciseType: exerciseType,
+		Description:  g.session,
+		Status:       domain.WorkoutStatusCompleted,
+		Sets:         len(g.sets),
+		Reps:         top.reps,
+		Weight:       top.weight,
+		Notes:        strings.Join(append([]string{strings.Join(breakdown, ", ")}, g.notes...), "\n"),
+		CompletedAt:  &completedAt,
+		ImportKey:    importKey(source, g.start, g.session, g.exercise),
+	}
+}
+
+// importKey セッションの開始日時・セッション名・種目名から取り込み識別子を作る（同じファイルを再インポートしても同じ値）
+func importKey(source string, start time.Time, session, exercise string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s", start.UTC().Format(time.RFC3339), session, normalizeName(exercise))))
+	return source + ":" + hex.EncodeToString(sum[:16])
+}
+
+// detectDelimiter ヘッダー行の区切り文字を判定する（地域設定によってはセミコロン区切りで出力される）
+func detectDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// isWarmup ウォームアップのセットか判定する
+func isWarmup(value string, warmupValues []string) bool {
+	for _, w := range warmupValues {
+		if strings.EqualFold(value, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTimeLayouts 指定した形式のいずれかで日時を解析する
+func parseTimeLayouts(value string, layouts []string, loc *time.Location) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q (expected %s)", value, layouts[0])
+}
+
+// containsString スライスに含まれるか
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
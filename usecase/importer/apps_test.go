@@ -0,0 +1,215 @@
+package importer
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// TestParseApp テーブル駆動テストでStrong・Hevy・FitNotesのエクスポートの取り込みをテスト
+func TestParseApp(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	type wantWorkout struct {
+		exerciseType domain.ExerciseType
+		sets         int
+		reps         int
+		weight       float64
+		completedAt  time.Time
+	}
+
+	tests := []struct {
+		name         string
+		fixture      string
+		format       Format
+		aliases      map[string]domain.ExerciseType
+		want         []wantWorkout
+		wantSkipped  []int
+		wantNotes    string
+		wantKeyStart string
+		description  string
+	}{
+		{
+			name:    "正常系: Strong",
+			fixture: "strong.csv",
+			format:  FormatStrong,
+			want: []wantWorkout{
+				{domain.BenchPress, 3, 8, 62.5, time.Date(2024, 1, 2, 7, 30, 0, 0, tokyo)},
+				{domain.SideRaise, 2, 15, 8, time.Date(2024, 1, 2, 7, 30, 0, 0, tokyo)},
+				{domain.Squat, 3, 5, 100, time.Date(2024, 1, 4, 18, 0, 0, 0, tokyo)},
+			},
+			wantSkipped:  []int{8},
+			wantNotes:    "60×10, 62.5×8, 62.5×7\nFelt strong",
+			wantKeyStart: "strong:",
+			description:  "ウォームアップ（W）と回数のない有酸素は除き、未対応の種目はスキップとして返す",
+		},
+		{
+			name:    "正常系: Hevy",
+			fixture: "hevy.csv",
+			format:  FormatHevy,
+			want: []wantWorkout{
+				{domain.PullUp, 2, 8, 10, time.Date(2024, 1, 3, 19, 0, 0, 0, tokyo)},
+				{domain.Deadlift, 2, 5, 140, time.Date(2024, 1, 3, 19, 0, 0, 0, tokyo)},
+			},
+			wantSkipped:  []int{7},
+			wantNotes:    "10×8, 10×6\nStrict form",
+			wantKeyStart: "hevy:",
+			description:  "set_typeがwarmupのセットは除き、failureは通常のセットとして数える",
+		},
+		{
+			name:    "正常系: Hevy（別名を追加）",
+			fixture: "hevy.csv",
+			format:  FormatHevy,
+			aliases: map[string]domain.ExerciseType{"face pull  (CABLE)": domain.HighPull},
+			want: []wantWorkout{
+				{domain.PullUp, 2, 8, 10, time.Date(2024, 1, 3, 19, 0, 0, 0, tokyo)},
+				{domain.Deadlift, 2, 5, 140, time.Date(2024, 1, 3, 19, 0, 0, 0, tokyo)},
+				{domain.HighPull, 1, 15, 20, time.Date(2024, 1, 3, 19, 0, 0, 0, tokyo)},
+			},
+			wantNotes:    "10×8, 10×6\nStrict form",
+			wantKeyStart: "hevy:",
+			description:  "別名は大文字小文字・空白の違いを無視して一致させる",
+		},
+		{
+			name:    "正常系: FitNotes（ポンド）",
+			fixture: "fitnotes.csv",
+			format:  FormatFitNotes,
+			want: []wantWorkout{
+				{domain.BenchPress, 2, 8, 70.31, time.Date(2024, 1, 5, 0, 0, 0, 0, tokyo)},
+				{domain.OneHandRow, 1, 12, 22.68, time.Date(2024, 1, 5, 0, 0, 0, 0, tokyo)},
+			},
+			wantNotes:    "61.23×10, 70.31×8\nPaused reps",
+			wantKeyStart: "fitnotes:",
+			description:  "Weight (lbs) の列はkgに換算し、日付ごとにまとめる",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := os.Open(filepath.Join("testdata", tt.fixture))
+			if err != nil {
+				t.Fatalf("Failed to open fixture: %v", err)
+			}
+			defer file.Close()
+
+			result, err := Parse(file, Options{Format: tt.format, Location: tokyo, Aliases: tt.aliases})
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if len(result.Errors) > 0 {
+				t.Fatalf("Unexpected row errors: %v", result.Errors)
+			}
+			if len(result.Rows) != len(tt.want) {
+				t.Fatalf("Expected %d workouts, got %d", len(tt.want), len(result.Rows))
+			}
+			for i, want := range tt.want {
+				got := result.Rows[i].Workout
+				if got.ExerciseType != want.exerciseType || got.Sets != want.sets || got.Reps != want.reps || got.Weight != want.weight {
+					t.Errorf("Workout %d: expected %+v, got type=%d sets=%d reps=%d weight=%.2f", i, want, got.ExerciseType, got.Sets, got.Reps, got.Weight)
+				}
+				if got.Status != domain.WorkoutStatusCompleted || got.CompletedAt == nil || !got.CompletedAt.Equal(want.completedAt) {
+					t.Errorf("Workout %d: expected completed at %v, got status=%d %v", i, want.completedAt, got.Status, got.CompletedAt)
+				}
+				if !strings.HasPrefix(got.ImportKey, tt.wantKeyStart) {
+					t.Errorf("Workout %d: expected import key with prefix %q, got %q", i, tt.wantKeyStart, got.ImportKey)
+				}
+			}
+			if notes := result.Rows[0].Workout.Notes; notes != tt.wantNotes {
+				t.Errorf("Expected notes %q, got %q", tt.wantNotes, notes)
+			}
+			if len(result.Skipped) != len(tt.wantSkipped) {
+				t.Fatalf("Expected %d skipped, got %v", len(tt.wantSkipped), result.Skipped)
+			}
+			for i, line := range tt.wantSkipped {
+				if result.Skipped[i].Line != line {
+					t.Errorf("Expected skipped line %d, got %v", line, result.Skipped[i])
+				}
+			}
+
+			// 同じファイルを再度解析しても取り込み識別子は変わらない
+			if _, err := file.Seek(0, 0); err != nil {
+				t.Fatalf("Failed to rewind fixture: %v", err)
+			}
+			again, err := Parse(file, Options{Format: tt.format, Location: tokyo, Aliases: tt.aliases})
+			if err != nil {
+				t.Fatalf("Parse() again error = %v", err)
+			}
+			for i, row := range again.Rows {
+				if row.Workout.ImportKey != result.Rows[i].Workout.ImportKey {
+					t.Errorf("Import key changed on re-parse: %q → %q", result.Rows[i].Workout.ImportKey, row.Workout.ImportKey)
+				}
+			}
+		})
+	}
+}
+
+// TestParseApp_Errors ヘッダーの不備・行ごとのエラー・セミコロン区切りをテスト
+func TestParseApp_Errors(t *testing.T) {
+	tests := []struct {
+		name         string
+		input        string
+		format       Format
+		wantFatal    bool
+		wantRows     int
+		wantErrLines []int
+		description  string
+	}{
+		{
+			name:        "異常系: 必須の列がない",
+			input:       "Date,Exercise Name,Reps\n2024-01-02 07:30:00,Squat (Barbell),5\n",
+			format:      FormatStrong,
+			wantFatal:   true,
+			description: "重量の列がない",
+		},
+		{
+			name: "異常系: 日時・数値の不正",
+			input: "Date,Workout Name,Exercise Name,Set Order,Weight,Reps\n" +
+				"2024-01-02 07:30:00,Legs,Squat (Barbell),1,100,5\n" +
+				"yesterday,Legs,Squat (Barbell),2,100,5\n" +
+				"2024-01-02 07:30:00,Legs,Squat (Barbell),3,heavy,5\n",
+			format:       FormatStrong,
+			wantRows:     1,
+			wantErrLines: []int{3, 4},
+			description:  "解析できない行は行番号付きのエラー",
+		},
+		{
+			name: "正常系: セミコロン区切り",
+			input: "Date;Workout Name;Exercise Name;Set Order;Weight;Reps\n" +
+				"2024-01-02 07:30:00;Legs;Squat (Barbell);1;100;5\n",
+			format:      FormatStrong,
+			wantRows:    1,
+			description: "地域設定によるセミコロン区切りを判定",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tt.input), Options{Format: tt.format})
+
+			if (err != nil) != tt.wantFatal {
+				t.Fatalf("Parse() error = %v, wantFatal %v", err, tt.wantFatal)
+			}
+			if tt.wantFatal {
+				return
+			}
+			if len(result.Rows) != tt.wantRows {
+				t.Errorf("Expected %d rows, got %d", tt.wantRows, len(result.Rows))
+			}
+			if len(result.Errors) != len(tt.wantErrLines) {
+				t.Fatalf("Expected %d row errors, got %v", len(tt.wantErrLines), result.Errors)
+			}
+			for i, line := range tt.wantErrLines {
+				if result.Errors[i].Line != line {
+					t.Errorf("Expected error on line %d, got %v", line, result.Errors[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package importer
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// Format インポートするファイルの形式
+type Format int
+
+const (
+	FormatCSV      Format = iota // CSV（1行目はヘッダー、列名は ColumnMapping で指定）
+	FormatJSONL                  // JSON Lines（1行に1つの domain.Workout）
+	FormatStrong                 // StrongアプリのCSVエクスポート
+	FormatHevy                   // HevyアプリのCSVエクスポート
+	FormatFitNotes               // FitNotesアプリのCSVエクスポート
+)
+
+// formatKeys コマンドライン等で使用する形式キー
+var formatKeys = map[string]Format{
+	"csv":      FormatCSV,
+	"jsonl":    FormatJSONL,
+	"strong":   FormatStrong,
+	"hevy":     FormatHevy,
+	"fitnotes": FormatFitNotes,
+}
+
+// ParseFormat 形式キー（例: "csv"）からFormatを取得
+func ParseFormat(key string) (Format, error) {
+	f, ok := formatKeys[strings.ToLower(key)]
+	if !ok {
+		return FormatCSV, fmt.Errorf("unknown import format: %q", key)
+	}
+	return f, nil
+}
+
+// String 形式のキーを返す
+func (f Format) String() string {
+	switch f {
+	case FormatCSV:
+		return "csv"
+	case FormatJSONL:
+		return "jsonl"
+	case FormatStrong:
+		return "strong"
+	case FormatHevy:
+		return "hevy"
+	case FormatFitNotes:
+		return "fitnotes"
+	default:
+		return "unknown"
+	}
+}
+
+// CSVの列に対応するワークアウトのフィールド名
+const (
+	FieldExerciseType = "exercise_type" // 必須: 種目キー・日本語名・英語名（例: "bench_press", "ベンチプレス"）
+	FieldDescription  = "description"
+	FieldStatus       = "status" // 省略時は completed_at があれば完了、なければ予定
+	FieldDifficulty   = "difficulty"
+	FieldMuscleGroup  = "muscle_group"
+	FieldSets         = "sets"
+	FieldReps         = "reps"
+	FieldWeight       = "weight"
+	FieldNotes        = "notes"
+	FieldCompletedAt  = "completed_at"
+	FieldScheduledFor = "scheduled_for"
+	FieldTimezone     = "timezone"
+	FieldSkipReason   = "skip_reason"
+)
+
+// Fields CSVで指定できる全てのフィールド
+var Fields = []string{
+	FieldExerciseType, FieldDescription, FieldStatus, FieldDifficulty, FieldMuscleGroup,
+	FieldSets, FieldReps, FieldWeight, FieldNotes,
+	FieldCompletedAt, FieldScheduledFor, FieldTimezone, FieldSkipReason,
+}
+
+// timeLayouts 日時の列で受け付ける形式（タイムゾーンのない形式は Options.Location で解釈）
+var timeLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+
+// Options 解析のオプション
+type Options struct {
+	Format        Format
+	ColumnMapping map[string]string // フィールド名 → CSVの列名（指定のないフィールドはフィールド名と同じ列名）
+	Location      *time.Location    // タイムゾーンのない日時の解釈に使用（nilならUTC）
+	// 他のアプリの種目名 → 種目（DefaultAliasesに追加・上書きする。大文字小文字・空白の違いは無視）
+	Aliases map[string]domain.ExerciseType
+}
+
+// Row 解析済みの1行
+type Row struct {
+	Line    int // 元ファイルの行番号（1始まり）
+	Workout *domain.Workout
+}
+
+// Result 解析結果
+type Result struct {
+	Rows    []*Row
+	Errors  []*RowError // 解析できなかった行（1件でもあればインポートしない）
+	Skipped []*RowError // 対応する種目がないため取り込まない行（インポートは続ける）
+}
+
+// RowError 1行分のエラー
+type RowError struct {
+	Line int
+	Err  error
+}
+
+// Error 行番号付きのエラーメッセージ
+func (e *RowError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+// Unwrap 元のエラーを返す
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Parse ファイルを解析してワークアウトの行を返す
+// 解析できない行はRowErrorとして収集し、残りの行の解析を続ける
+// ヘッダーの不備など、ファイル全体を解析できない場合のみerrorを返す
+func Parse(r io.Reader, opts Options) (*Result, error) {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	switch opts.Format {
+	case FormatCSV:
+		return parseCSV(r, opts)
+	case FormatJSONL:
+		return parseJSONL(r)
+	case FormatStrong:
+		return parseApp(r, strongSpec, opts)
+	case FormatHevy:
+		return parseApp(r, hevySpec, opts)
+	case FormatFitNotes:
+		return parseApp(r, fitNotesSpec, opts)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %d", opts.Format)
+	}
+}
+
+// parseCSV ヘッダー行の列名とColumnMappingから列の位置を決めて解析する
+func parseCSV(r io.Reader, opts Options) (*Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1 // 列数の不一致は行ごとのエラーにする
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("empty csv: header row is required")
+		}
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+	columns, err := resolveColumns(header, opts.ColumnMapping)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Rows: make([]*Row, 0, 64)}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var parseErr *csv.ParseError
+			if errors.As(err, &parseErr) {
+				result.Errors = append(result.Errors, &RowError{Line: parseErr.Line, Err: parseErr.Err})
+				continue
+			}
+			return nil, fmt.Errorf("failed to read csv: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+		if len(record) != len(header) {
+			result.Errors = append(result.Errors, &RowError{Line: line, Err: fmt.Errorf("expected %d columns, got %d", len(header), len(record))})
+			continue
+		}
+
+		values := make(map[string]string, len(columns))
+		for field, index := range columns {
+			values[field] = strings.TrimSpace(record[index])
+		}
+		workout, err := workoutFromValues(values, opts.Location)
+		if err != nil {
+			result.Errors = append(result.Errors, &RowError{Line: line, Err: err})
+			continue
+		}
+		result.Rows = append(result.Rows, &Row{Line: line, Workout: workout})
+	}
+	return result, nil
+}
+
+// resolveColumns フィールド名 → 列の位置を決める
+func resolveColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	known := make(map[string]bool, len(Fields))
+	for _, field := range Fields {
+		known[field] = true
+	}
+	for field := range mapping {
+		if !known[field] {
+			return nil, fmt.Errorf("unknown field in column mapping: %q", field)
+		}
+	}
+
+	positions := make(map[string]int, len(header))
+	for i, name := range header {
+		positions[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = i
+	}
+
+	columns := make(map[string]int, len(Fields))
+	for _, field := range Fields {
+		name := field
+		if mapped, ok := mapping[field]; ok {
+			name = mapped
+		}
+		index, ok := positions[name]
+		if !ok {
+			if _, mapped := mapping[field]; mapped {
+				return nil, fmt.Errorf("column %q mapped to %s not found in header", name, field)
+			}
+			continue
+		}
+		columns[field] = index
+	}
+	if _, ok := columns[FieldExerciseType]; !ok {
+		return nil, fmt.Errorf("required column for %s not found in header", FieldExerciseType)
+	}
+	return columns, nil
+}
+
+// workoutFromValues CSVの1行分の値からワークアウトを組み立てる
+func workoutFromValues(values map[string]string, loc *time.Location) (*domain.Workout, error) {
+	workout := &domain.Workout{
+		Description: values[FieldDescription],
+		Notes:       values[FieldNotes],
+	}
+	var errs []error
+	collect := func(field string, err error) {
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", field, err))
+		}
+	}
+
+	var err error
+	workout.ExerciseType, err = parseExerciseType(values[FieldExerciseType])
+	collect(FieldExerciseType, err)
+	if v := values[FieldDifficulty]; v != "" {
+		workout.Difficulty, err = domain.ParseDifficulty(v)
+		collect(FieldDifficulty, err)
+	}
+	if v := values[FieldMuscleGroup]; v != "" {
+		workout.MuscleGroup, err = parseMuscleGroup(v)
+		collect(FieldMuscleGroup, err)
+	}
+	if v := values[FieldSkipReason]; v != "" {
+		workout.SkipReason, err = domain.ParseSkipReason(v)
+		collect(FieldSkipReason, err)
+	}
+	workout.Sets, err = parseInt(values[FieldSets])
+	collect(FieldSets, err)
+	workout.Reps, err = parseInt(values[FieldReps])
+	collect(FieldReps, err)
+	if v := values[FieldWeight]; v != "" {
+		workout.Weight, err = strconv.ParseFloat(v, 64)
+		collect(FieldWeight, err)
+	}
+
+	// 予定日時はtimezone列のタイムゾーンで解釈する
+	scheduleLoc := loc
+	if tz := values[FieldTimezone]; tz != "" {
+		scheduleLoc, err = time.LoadLocation(tz)
+		collect(FieldTimezone, err)
+		if err != nil {
+			scheduleLoc = loc
+		}
+	}
+	workout.CompletedAt, err = parseTime(values[FieldCompletedAt], loc)
+	collect(FieldCompletedAt, err)
+	workout.ScheduledFor, err = parseTime(values[FieldScheduledFor], scheduleLoc)
+	collect(FieldScheduledFor, err)
+	if workout.ScheduledFor != nil {
+		workout.ScheduledTimezone = scheduleLoc.String()
+	}
+
+	switch v := values[FieldStatus]; {
+	case v != "":
+		workout.Status, err = domain.ParseWorkoutStatus(v)
+		collect(FieldStatus, err)
+	case workout.CompletedAt != nil:
+		workout.Status = domain.WorkoutStatusCompleted
+	default:
+		workout.Status = domain.WorkoutStatusPlanned
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return workout, nil
+}
+
+// parseJSONL 1行に1つの domain.Workout（JSON）を解析する（空行は無視）
+func parseJSONL(r io.Reader) (*Result, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	result := &Result{Rows: make([]*Row, 0, 64)}
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		workout := &domain.Workout{}
+		decoder := json.NewDecoder(strings.NewReader(text))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(workout); err != nil {
+			result.Errors = append(result.Errors, &RowError{Line: line, Err: fmt.Errorf("invalid json: %w", err)})
+			continue
+		}
+		// IDはインポート先で採番し直す
+		workout.ID = 0
+		result.Rows = append(result.Rows, &Row{Line: line, Workout: workout})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read jsonl: %w", err)
+	}
+	return result, nil
+}
+
+// exerciseTypes 名前で検索できる全ての種目
+var exerciseTypes = []domain.ExerciseType{
+	domain.BenchPress, domain.Squat, domain.Deadlift, domain.DumbbellShoulder,
+	domain.PullUp, domain.SideRaise, domain.OneHandRow, domain.HighPull,
+}
+
+// parseExerciseType 種目キー・日本語名・英語名（大文字小文字を区別しない）から種目を取得
+func parseExerciseType(value string) (domain.ExerciseType, error) {
+	if value == "" {
+		return domain.ExerciseUnspecified, fmt.Errorf("exercise type must be specified")
+	}
+	if et, err := domain.ParseExerciseType(value); err == nil {
+		return et, nil
+	}
+	for _, et := range exerciseTypes {
+		if value == et.Japanese() || strings.EqualFold(value, et.English()) {
+			return et, nil
+		}
+	}
+	return domain.ExerciseUnspecified, fmt.Errorf("unknown exercise type: %q", value)
+}
+
+// muscleGroups 名前で検索できる全ての筋肉群
+var muscleGroups = []domain.MuscleGroup{
+	domain.Chest, domain.Back, domain.Legs, domain.Shoulders, domain.Arms,
+	domain.Abs, domain.Core, domain.Glutes, domain.Cardio, domain.FullBody,
+}
+
+// parseMuscleGroup 筋肉群キー・日本語名から筋肉群を取得
+func parseMuscleGroup(value string) (domain.MuscleGroup, error) {
+	if mg, err := domain.ParseMuscleGroup(value); err == nil {
+		return mg, nil
+	}
+	for _, mg := range muscleGroups {
+		if value == mg.Japanese() {
+			return mg, nil
+		}
+	}
+	return domain.Unspecified, fmt.Errorf("unknown muscle group: %q", value)
+}
+
+// parseInt 空の場合は0
+func parseInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(value)
+}
+
+// parseTime 空の場合はnil
+func parseTime(value string, loc *time.Location) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid time %q (expected RFC3339 or YYYY-MM-DD[ HH:MM[:SS]])", value)
+}
@@ -0,0 +1,152 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+)
+
+// TestParseCSV テーブル駆動テストでCSVの解析と列の対応をテスト
+func TestParseCSV(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Fatalf("Failed to load location: %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		input         string
+		mapping       map[string]string
+		wantRows      int
+		wantErrLines  []int
+		wantFatal     bool
+		wantFirst     *domain.Workout
+		wantCompleted time.Time
+		description   string
+	}{
+		{
+			name: "正常系: フィールド名と同じ列名",
+			input: "exercise_type,sets,reps,weight,completed_at,notes\n" +
+				"bench_press,3,10,60,2024-01-02 07:30,\"調子よし, 次回62.5\"\n" +
+				"squat,5,5,100,2024-01-03,\n",
+			wantRows:      2,
+			wantFirst:     &domain.Workout{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, Sets: 3, Reps: 10, Weight: 60, Notes: "調子よし, 次回62.5"},
+			wantCompleted: time.Date(2024, 1, 2, 7, 30, 0, 0, tokyo),
+			description:   "completed_atがあれば完了として扱い、タイムゾーンなしの日時はLocationで解釈",
+		},
+		{
+			name: "正常系: 列の対応を指定",
+			input: "日付,種目,セット,回数,重量(kg)\n" +
+				"2024-01-02,ベンチプレス,3,10,60\n",
+			mapping: map[string]string{
+				FieldCompletedAt: "日付", FieldExerciseType: "種目", FieldSets: "セット", FieldReps: "回数", FieldWeight: "重量(kg)",
+			},
+			wantRows:      1,
+			wantFirst:     &domain.Workout{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, Sets: 3, Reps: 10, Weight: 60},
+			wantCompleted: time.Date(2024, 1, 2, 0, 0, 0, 0, tokyo),
+			description:   "日本語の列名・種目名に対応",
+		},
+		{
+			name: "異常系: 行ごとのエラー",
+			input: "exercise_type,sets,status\n" +
+				"Deadlift,3,planned\n" +
+				"unknown,3,planned\n" +
+				"squat,three,done\n" +
+				"squat,3\n",
+			wantRows:     1,
+			wantErrLines: []int{3, 4, 5},
+			description:  "不明な種目・数値でない値・列数の不一致は行番号付きで返し、他の行は解析を続ける",
+		},
+		{
+			name:        "異常系: 必須の列がない",
+			input:       "sets,reps\n3,10\n",
+			wantFatal:   true,
+			description: "exercise_typeの列は必須",
+		},
+		{
+			name:        "異常系: 対応先の列がない",
+			input:       "exercise_type,sets\nsquat,3\n",
+			mapping:     map[string]string{FieldWeight: "重量"},
+			wantFatal:   true,
+			description: "指定した列名がヘッダーにない",
+		},
+		{
+			name:        "異常系: 不明なフィールド",
+			input:       "exercise_type\nsquat\n",
+			mapping:     map[string]string{"rpe": "RPE"},
+			wantFatal:   true,
+			description: "対応できるのはFieldsのみ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Parse(strings.NewReader(tt.input), Options{
+				Format:        FormatCSV,
+				ColumnMapping: tt.mapping,
+				Location:      tokyo,
+			})
+
+			if (err != nil) != tt.wantFatal {
+				t.Fatalf("Parse() error = %v, wantFatal %v", err, tt.wantFatal)
+			}
+			if tt.wantFatal {
+				return
+			}
+			rows, rowErrors := result.Rows, result.Errors
+			if len(rows) != tt.wantRows {
+				t.Errorf("Expected %d rows, got %d (errors: %v)", tt.wantRows, len(rows), rowErrors)
+			}
+			if len(rowErrors) != len(tt.wantErrLines) {
+				t.Fatalf("Expected %d row errors, got %v", len(tt.wantErrLines), rowErrors)
+			}
+			for i, line := range tt.wantErrLines {
+				if rowErrors[i].Line != line {
+					t.Errorf("Expected error on line %d, got %v", line, rowErrors[i])
+				}
+			}
+			if tt.wantFirst != nil {
+				got := rows[0].Workout
+				if got.ExerciseType != tt.wantFirst.ExerciseType || got.Status != tt.wantFirst.Status ||
+					got.Sets != tt.wantFirst.Sets || got.Reps != tt.wantFirst.Reps || got.Weight != tt.wantFirst.Weight || got.Notes != tt.wantFirst.Notes {
+					t.Errorf("Expected %+v, got %+v", tt.wantFirst, got)
+				}
+				if got.CompletedAt == nil || !got.CompletedAt.Equal(tt.wantCompleted) {
+					t.Errorf("Expected completed_at %v, got %v", tt.wantCompleted, got.CompletedAt)
+				}
+				if rows[0].Line != 2 {
+					t.Errorf("Expected first row on line 2, got %d", rows[0].Line)
+				}
+			}
+		})
+	}
+}
+
+// TestParseJSONL JSON Linesの解析をテスト
+func TestParseJSONL(t *testing.T) {
+	input := `{"id":99,"exercise_type":2,"status":2,"sets":5,"reps":5,"weight":100,"completed_at":"2024-01-02T07:30:00+09:00"}
+
+{"exercise_type":1,"status":0,"sets":3,"reps":10,"scheduled_for":"2024-02-01T09:00:00Z"}
+{"exercise_type":1,"rpe":8}
+not json
+`
+	result, err := Parse(strings.NewReader(input), Options{Format: FormatJSONL})
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	rows, rowErrors := result.Rows, result.Errors
+	if len(rows) != 2 {
+		t.Fatalf("Expected 2 rows, got %d", len(rows))
+	}
+	if rows[0].Workout.ID != 0 || rows[0].Workout.ExerciseType != domain.Squat || rows[0].Workout.Status != domain.WorkoutStatusCompleted {
+		t.Errorf("Expected squat completed without ID, got %+v", rows[0].Workout)
+	}
+	if rows[1].Line != 3 || rows[1].Workout.ScheduledFor == nil {
+		t.Errorf("Expected scheduled workout on line 3, got line %d %+v", rows[1].Line, rows[1].Workout)
+	}
+	if len(rowErrors) != 2 || rowErrors[0].Line != 4 || rowErrors[1].Line != 5 {
+		t.Errorf("Expected errors on lines 4 (unknown field) and 5 (invalid json), got %v", rowErrors)
+	}
+}
@@ -0,0 +1,235 @@
+package plates
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"golv2-learning-app/domain"
+)
+
+// scale 重量を0.01単位の整数で扱う（1.25kg・2.5lbなどの端数を誤差なく足し合わせるため）
+const scale = 100
+
+// unreachable 組めない重量を表す枚数
+const unreachable = math.MaxInt
+
+// MaxStates 1回の計算で扱う片側の重量の数の上限（最小の刻み0.25で片側5000まで計算できる）
+const MaxStates = 1 << 15
+
+// DefaultWarmupPercents 一般的なウォームアップの割合（メインセットの40%・60%・80%）
+var DefaultWarmupPercents = []float64{0.4, 0.6, 0.8}
+
+// ValidateInventory バーとプレートの在庫を検証する
+func ValidateInventory(inventory domain.PlateInventory) error {
+	if inventory.BarWeight < 0 || inventory.BarWeight > domain.MaxBarWeight {
+		return fmt.Errorf("bar weight must be between 0 and %.1f: %.2f", domain.MaxBarWeight, inventory.BarWeight)
+	}
+	if len(inventory.Plates) > domain.MaxPlateTypes {
+		return fmt.Errorf("too many plate types: %d (max %d)", len(inventory.Plates), domain.MaxPlateTypes)
+	}
+	for _, p := range inventory.Plates {
+		if p.Weight <= 0 || p.Weight > domain.MaxPlateWeight {
+			return fmt.Errorf("plate weight must be between 0 and %.1f: %.2f", domain.MaxPlateWeight, p.Weight)
+		}
+		if toUnits(p.Weight) == 0 || math.Abs(float64(toUnits(p.Weight))/scale-p.Weight) > 1e-9 {
+			return fmt.Errorf("plate weight must be a multiple of 0.01: %v", p.Weight)
+		}
+		if p.Count < 0 || p.Count > domain.MaxPlateCount {
+			return fmt.Errorf("plate count must be between 0 and %d: %d", domain.MaxPlateCount, p.Count)
+		}
+	}
+	return nil
+}
+
+// Load 目標重量に最も近い重量になる片側のプレートの組み合わせを求める
+// 左右同じ組み合わせを付けるため、各プレートは片側にCount/2枚まで使える
+// 同じ重量を組める組み合わせが複数ある場合は枚数が最も少なく、その中で重いプレートを多く使うものを選ぶ
+// 目標との差が同じ場合は軽い方（目標を超えない方）を選ぶ。目標がバーより軽い場合はバーのみ
+// 計算量は片側の重量の数（ValidateLoadでMaxStates以下に制限する）× プレートの種類 × log(枚数)
+func Load(target float64, inventory domain.PlateInventory) domain.PlateLoading {
+	loading := domain.PlateLoading{Target: target, Achieved: inventory.BarWeight, PerSide: []domain.PlateLoad{}}
+	load := toUnits(target) - toUnits(inventory.BarWeight) // 両側のプレートの合計の目標
+	available := sidePlates(inventory.Plates)
+	if load <= 0 || len(available) == 0 {
+		return loading
+	}
+	step, limit := searchRange(load, available)
+
+	// 各プレートの枚数を1, 2, 4, ...枚の束に分け、束ごとに使う・使わないを決める（枚数の上限のあるナップサック問題）
+	// 軽いプレートの束から順に加え、枚数が同じ場合は後から加える重いプレートの束を使う方を選ぶ
+	bundles := splitBundles(available, step)
+
+	// fewest[s] 片側 s×step を組むのに必要な最少枚数、taken[i][s] その時にi番目の束を使うか
+	fewest := make([]int, limit+1)
+	for s := range fewest {
+		fewest[s] = unreachable
+	}
+	fewest[0] = 0
+	taken := make([]bitset, len(bundles))
+	for i, b := range bundles {
+		taken[i] = newBitset(limit + 1)
+		for s := limit; s >= b.size; s-- {
+			prev := fewest[s-b.size]
+			if prev != unreachable && prev+b.count <= fewest[s] {
+				fewest[s] = prev + b.count
+				taken[i].set(s)
+			}
+		}
+	}
+
+	best := 0
+	for s := range fewest {
+		if fewest[s] == unreachable {
+			continue
+		}
+		if abs(2*s*step-load) < abs(2*best*step-load) {
+			best = s
+		}
+	}
+
+	// 重いプレートの束から順に枚数を復元する
+	counts := make([]int, len(available))
+	s := best
+	for i := len(bundles) - 1; i >= 0; i-- {
+		if taken[i].get(s) {
+			counts[bundles[i].plate] += bundles[i].count
+			s -= bundles[i].size
+		}
+	}
+	for i := len(available) - 1; i >= 0; i-- {
+		if counts[i] > 0 {
+			loading.PerSide = append(loading.PerSide, domain.PlateLoad{Weight: available[i].weight, Count: counts[i]})
+		}
+	}
+
+	loading.Achieved = inventory.BarWeight + 2*float64(best*step)/scale
+	return loading
+}
+
+// ValidateLoad 目標重量の組み合わせを計算できるか検証する
+// プレートの重量の最大公約数が小さいほど計算する重量の数が増えるため、MaxStatesを超える場合はエラー
+func ValidateLoad(target float64, inventory domain.PlateInventory) error {
+	load := toUnits(target) - toUnits(inventory.BarWeight)
+	available := sidePlates(inventory.Plates)
+	if load <= 0 || len(available) == 0 {
+		return nil
+	}
+	step, limit := searchRange(load, available)
+	if limit > MaxStates {
+		return fmt.Errorf("target weight %.2f is too heavy for plate increments of %.2f (max %.2f per side)",
+			target, float64(step)/scale, float64(MaxStates*step)/scale)
+	}
+	return nil
+}
+
+// searchRange 計算する片側の重量の刻み（0.01単位）と、刻みで数えた片側の重量の上限
+func searchRange(load int, available []sidePlate) (step, limit int) {
+	// 全てのプレートの重量の最大公約数を単位にして、計算する重量の数を減らす
+	total, heaviest := 0, 0
+	for _, p := range available {
+		step = gcd(step, p.units)
+		total += p.units * p.perSide
+		heaviest = max(heaviest, p.units)
+	}
+	// 目標を超える組み合わせはプレート1枚分まで考えれば十分
+	return step, min(total, (load+1)/2+heaviest) / step
+}
+
+// Calculate ウォームアップセット（指定した割合の軽い順）とメインセットのプレートの組み合わせを求める
+func Calculate(target float64, warmupPercents []float64, inventory domain.PlateInventory) *domain.PlateCalculation {
+	percents := append([]float64(nil), warmupPercents...)
+	sort.Float64s(percents)
+
+	calculation := &domain.PlateCalculation{
+		Unit:      inventory.Unit,
+		BarWeight: inventory.BarWeight,
+		Sets:      make([]*domain.PlateLoading, 0, len(percents)+1),
+	}
+	for _, percent := range append(percents, 1.0) {
+		loading := Load(math.Round(target*percent*scale)/scale, inventory)
+		loading.Percent = percent
+		calculation.Sets = append(calculation.Sets, &loading)
+	}
+	return calculation
+}
+
+// sidePlate 片側に付けられるプレート
+type sidePlate struct {
+	weight  float64
+	units   int // 0.01単位の重量
+	perSide int // 片側に付けられる枚数
+}
+
+// sidePlates 在庫を軽い順の片側に付けられるプレートにする（同じ重量はまとめ、片側に1枚も付けられないものは除く）
+func sidePlates(plates []domain.Plate) []sidePlate {
+	counts := make(map[int]int, len(plates))
+	for _, p := range plates {
+		counts[toUnits(p.Weight)] += p.Count
+	}
+	result := make([]sidePlate, 0, len(counts))
+	for units, count := range counts {
+		if units <= 0 || count < 2 {
+			continue
+		}
+		result = append(result, sidePlate{weight: float64(units) / scale, units: units, perSide: count / 2})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].units < result[j].units })
+	return result
+}
+
+// bundle 同じプレートをまとめて使う・使わないを決める束
+type bundle struct {
+	plate int // sidePlatesの何番目のプレートか
+	count int // 束の枚数
+	size  int // 束の重量（刻みで数えた値）
+}
+
+// splitBundles 各プレートの片側の枚数を1, 2, 4, ...枚（最後は残り）の束に分ける（軽いプレートから順）
+// 束の組み合わせで0〜perSide枚の全ての枚数を表せる
+func splitBundles(available []sidePlate, step int) []bundle {
+	var bundles []bundle
+	for i, p := range available {
+		w := p.units / step
+		for count, rest := 1, p.perSide; rest > 0; count *= 2 {
+			c := min(count, rest)
+			bundles = append(bundles, bundle{plate: i, count: c, size: c * w})
+			rest -= c
+		}
+	}
+	return bundles
+}
+
+// bitset 束を使うかどうかを重量ごとに記録するビット列
+type bitset []uint64
+
+func newBitset(n int) bitset {
+	return make(bitset, (n+63)/64)
+}
+
+func (b bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+func (b bitset) get(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// toUnits 重量を0.01単位の整数に変換する
+func toUnits(weight float64) int {
+	return int(math.Round(weight * scale))
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
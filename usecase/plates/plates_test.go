@@ -0,0 +1,239 @@
+package plates
+
+import (
+	"reflect"
+	"testing"
+
+	"golv2-learning-app/domain"
+)
+
+// TestLoad テーブル駆動テストでプレートの組み合わせ計算をテスト
+func TestLoad(t *testing.T) {
+	kg := domain.DefaultPlateInventory(domain.WeightUnitKilogram)
+	lb := domain.DefaultPlateInventory(domain.WeightUnitPound)
+
+	tests := []struct {
+		name         string
+		target       float64
+		inventory    domain.PlateInventory
+		wantAchieved float64
+		wantPerSide  []domain.PlateLoad
+		description  string
+	}{
+		{
+			name:         "正常系: 100kg",
+			target:       100,
+			inventory:    kg,
+			wantAchieved: 100,
+			wantPerSide:  []domain.PlateLoad{{Weight: 25, Count: 1}, {Weight: 15, Count: 1}},
+			description:  "片側40kgを最少の枚数で組む",
+		},
+		{
+			name:         "正常系: 225lb",
+			target:       225,
+			inventory:    lb,
+			wantAchieved: 225,
+			wantPerSide:  []domain.PlateLoad{{Weight: 45, Count: 2}},
+			description:  "45lbバー + 片側45lb × 2",
+		},
+		{
+			name:         "正常系: ちょうど組めない場合は最も近い重量",
+			target:       101,
+			inventory:    kg,
+			wantAchieved: 100,
+			wantPerSide:  []domain.PlateLoad{{Weight: 25, Count: 1}, {Weight: 15, Count: 1}},
+			description:  "101kgは100kg（差1kg）と102.5kg（差1.5kg）のうち近い方",
+		},
+		{
+			name:         "正常系: 差が同じ場合は軽い方",
+			target:       101.25,
+			inventory:    kg,
+			wantAchieved: 100,
+			wantPerSide:  []domain.PlateLoad{{Weight: 25, Count: 1}, {Weight: 15, Count: 1}},
+			description:  "100kgと102.5kgの中間は目標を超えない方",
+		},
+		{
+			name:   "正常系: 貪欲法では組めない在庫",
+			target: 60,
+			inventory: domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{
+				{Weight: 15, Count: 2},
+				{Weight: 10, Count: 4},
+			}},
+			wantAchieved: 60,
+			wantPerSide:  []domain.PlateLoad{{Weight: 10, Count: 2}},
+			description:  "15kgを先に付けると片側20kgを組めない",
+		},
+		{
+			name:   "正常系: 在庫が足りない場合は組める最大の重量",
+			target: 200,
+			inventory: domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{
+				{Weight: 20, Count: 4},
+				{Weight: 5, Count: 3},
+			}},
+			wantAchieved: 110,
+			wantPerSide:  []domain.PlateLoad{{Weight: 20, Count: 2}, {Weight: 5, Count: 1}},
+			description:  "奇数枚のプレートは片側に付けられる枚数だけ使う",
+		},
+		{
+			name:         "正常系: バーより軽い目標",
+			target:       15,
+			inventory:    kg,
+			wantAchieved: 20,
+			wantPerSide:  []domain.PlateLoad{},
+			description:  "バーのみ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Load(tt.target, tt.inventory)
+
+			if got.Achieved != tt.wantAchieved {
+				t.Errorf("Expected Achieved=%.2f, got %.2f", tt.wantAchieved, got.Achieved)
+			}
+			if !reflect.DeepEqual(got.PerSide, tt.wantPerSide) {
+				t.Errorf("Expected PerSide=%v, got %v", tt.wantPerSide, got.PerSide)
+			}
+			if got.Exact() != (tt.target == tt.wantAchieved) {
+				t.Errorf("Expected Exact()=%v", tt.target == tt.wantAchieved)
+			}
+		})
+	}
+}
+
+// TestCalculate ウォームアップセットを軽い順に並べ、最後にメインセットを返すことをテスト
+func TestCalculate(t *testing.T) {
+	calculation := Calculate(140, []float64{0.8, 0.4, 0.6}, domain.DefaultPlateInventory(domain.WeightUnitKilogram))
+
+	wantTargets := []float64{56, 84, 112, 140}
+	wantAchieved := []float64{55, 85, 112.5, 140}
+	if len(calculation.Sets) != len(wantTargets) {
+		t.Fatalf("Expected %d sets, got %d", len(wantTargets), len(calculation.Sets))
+	}
+	for i, set := range calculation.Sets {
+		if set.Target != wantTargets[i] || set.Achieved != wantAchieved[i] {
+			t.Errorf("Set %d: expected target=%.1f achieved=%.1f, got target=%.1f achieved=%.1f",
+				i, wantTargets[i], wantAchieved[i], set.Target, set.Achieved)
+		}
+	}
+	if last := calculation.Sets[len(calculation.Sets)-1]; last.Percent != 1.0 {
+		t.Errorf("Expected main set percent=1.0, got %.2f", last.Percent)
+	}
+}
+
+// TestValidateInventory テーブル駆動テストで在庫の検証をテスト
+func TestValidateInventory(t *testing.T) {
+	tests := []struct {
+		name        string
+		inventory   domain.PlateInventory
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: デフォルトの在庫",
+			inventory:   domain.DefaultPlateInventory(domain.WeightUnitPound),
+			description: "標準のlbプレート",
+		},
+		{
+			name:        "異常系: 重量が0のプレート",
+			inventory:   domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{{Weight: 0, Count: 2}}},
+			wantErr:     true,
+			description: "プレートの重量は正の値",
+		},
+		{
+			name:        "異常系: 0.01単位でない重量",
+			inventory:   domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{{Weight: 1.125, Count: 2}}},
+			wantErr:     true,
+			description: "0.01単位で計算するため",
+		},
+		{
+			name:        "異常系: バーの重量が負",
+			inventory:   domain.PlateInventory{BarWeight: -1},
+			wantErr:     true,
+			description: "バーの重量は0以上",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateInventory(tt.inventory)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateInventory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestValidateLoad テーブル駆動テストで計算量の上限の検証をテスト
+func TestValidateLoad(t *testing.T) {
+	tests := []struct {
+		name        string
+		target      float64
+		inventory   domain.PlateInventory
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: デフォルトの在庫で上限の重量",
+			target:      9999.99,
+			inventory:   domain.DefaultPlateInventory(domain.WeightUnitKilogram),
+			description: "1.25kg刻みなら片側の重量の数は上限以下",
+		},
+		{
+			name:   "異常系: 刻みが0.01で重い目標",
+			target: 9999.99,
+			inventory: domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{
+				{Weight: 0.01, Count: 100},
+				{Weight: 100, Count: 100},
+			}},
+			wantErr:     true,
+			description: "0.01刻みで片側約5000を計算すると上限を超える",
+		},
+		{
+			name:   "正常系: 刻みが0.01でも軽い目標",
+			target: 200,
+			inventory: domain.PlateInventory{BarWeight: 20, Plates: []domain.Plate{
+				{Weight: 0.01, Count: 100},
+				{Weight: 20, Count: 100},
+			}},
+			description: "計算する範囲は目標の重量で決まる",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLoad(tt.target, tt.inventory)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateLoad() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// worstCaseInventory 検証を通る在庫のうち計算量が最大になるもの
+// 0.25刻みのプレート20種類を100枚ずつ、上限の目標重量で片側の重量の数がMaxStatesに近くなる
+func worstCaseInventory() domain.PlateInventory {
+	plates := []domain.Plate{{Weight: 0.25, Count: domain.MaxPlateCount}}
+	for i := 0; len(plates) < domain.MaxPlateTypes; i++ {
+		plates = append(plates, domain.Plate{Weight: domain.MaxPlateWeight - float64(i)*5.25, Count: domain.MaxPlateCount})
+	}
+	return domain.PlateInventory{BarWeight: 0, Plates: plates}
+}
+
+// BenchmarkCalculate_WorstCase 検証を通る最大の入力（上限の目標重量・ウォームアップ10セット）の計算時間
+func BenchmarkCalculate_WorstCase(b *testing.B) {
+	inventory := worstCaseInventory()
+	target := 9999.99
+	if err := ValidateInventory(inventory); err != nil {
+		b.Fatalf("ValidateInventory() error = %v", err)
+	}
+	if err := ValidateLoad(target, inventory); err != nil {
+		b.Fatalf("ValidateLoad() error = %v", err)
+	}
+	warmups := []float64{0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 0.95}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Calculate(target, warmups, inventory)
+	}
+}
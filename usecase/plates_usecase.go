@@ -0,0 +1,118 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/plates"
+)
+
+// SetPlateInventory プレートの組み合わせ計算に使用するバーとプレートの在庫を設定
+func (wm *WorkoutManager) SetPlateInventory(inventory domain.PlateInventory) {
+	wm.plateInventory = &inventory
+}
+
+// PlateInventory ユーザーのバーとプレートの在庫（未設定の場合はユーザーの単位の一般的なジムの在庫）
+func (wm *WorkoutManager) PlateInventory() domain.PlateInventory {
+	if wm.plateInventory == nil {
+		return domain.DefaultPlateInventory(wm.weightUnit)
+	}
+	return *wm.plateInventory
+}
+
+// CalculatePlatesRequest プレートの組み合わせ計算リクエスト
+// 重量はすべてWeightUnitの単位。在庫の単位と異なる場合は在庫の単位に換算して計算する
+type CalculatePlatesRequest struct {
+	ExerciseType   domain.ExerciseType // オプション: 指定した場合はバーベル種目のみ
+	TargetWeight   float64             // 必須: メインセットの目標重量
+	WeightUnit     domain.WeightUnit   // TargetWeight・BarWeight・Platesの単位（ゼロ値はkg）
+	BarWeight      *float64            // オプション: nilなら在庫のバー
+	Plates         []domain.Plate      // オプション: 空ならユーザー設定の在庫
+	WarmupPercents []float64           // オプション: ウォームアップセットの目標重量の割合（0.0〜1.0）
+}
+
+// CalculatePlates ウォームアップセットとメインセットの片側に付けるプレートを計算（ビジネスロジック層）
+// 結果の重量は在庫の単位で返す（実際に付けるプレートの単位）
+func (wm *WorkoutManager) CalculatePlates(req CalculatePlatesRequest) (*domain.PlateCalculation, error) {
+	wm, span := wm.startSpan("CalculatePlates")
+	defer span.End()
+
+	inventory := wm.PlateInventory()
+	if len(req.Plates) > 0 {
+		// 設定と異なる単位のプレートを指定した場合、バーもその単位の一般的なバー（20kg・45lb）とみなす
+		barWeight := inventory.BarWeight
+		if inventory.Unit != req.WeightUnit {
+			barWeight = domain.DefaultPlateInventory(req.WeightUnit).BarWeight
+		}
+		inventory = domain.PlateInventory{Unit: req.WeightUnit, BarWeight: barWeight, Plates: req.Plates}
+	}
+	if req.BarWeight != nil {
+		inventory.BarWeight = convertWeight(*req.BarWeight, req.WeightUnit, inventory.Unit)
+	}
+	target := convertWeight(req.TargetWeight, req.WeightUnit, inventory.Unit)
+
+	validator := &errValidator{}
+	validator.validate(func() error {
+		if req.ExerciseType == domain.ExerciseUnspecified {
+			return nil
+		}
+		if info, ok := domain.LookupExercise(req.ExerciseType); !ok || !info.Barbell {
+			return fmt.Errorf("exercise type must be a barbell exercise: %s", req.ExerciseType.Key())
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		if req.TargetWeight <= 0 {
+			return fmt.Errorf("target weight must be positive: %.2f", req.TargetWeight)
+		}
+		return nil
+	})
+	validator.validateWeight(req.WeightUnit.ToKilograms(req.TargetWeight))
+	validator.validate(func() error {
+		if len(req.WarmupPercents) > domain.MaxWarmupSets {
+			return fmt.Errorf("too many warm-up sets: %d (max %d)", len(req.WarmupPercents), domain.MaxWarmupSets)
+		}
+		for _, percent := range req.WarmupPercents {
+			if percent <= 0 || percent >= 1 {
+				return fmt.Errorf("warm-up percent must be between 0 and 1: %.2f", percent)
+			}
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		if err := plates.ValidateInventory(inventory); err != nil {
+			return err
+		}
+		// ウォームアップセットはメインセットより軽いため、メインセットが計算できれば全て計算できる
+		return plates.ValidateLoad(target, inventory)
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "CalculatePlates",
+			ExerciseType: req.ExerciseType,
+			Message:      "plate calculation input validation failed",
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	calculation := plates.Calculate(target, req.WarmupPercents, inventory)
+	mainSet := calculation.Sets[len(calculation.Sets)-1]
+	wm.logger.Debug("プレートの組み合わせを計算しました",
+		slog.String(logging.KeyOp, "CalculatePlates"), slog.String("weight_unit", inventory.Unit.Key()),
+		slog.Float64("target", mainSet.Target), slog.Float64("achieved", mainSet.Achieved), slog.Int("sets", len(calculation.Sets)))
+	return calculation, nil
+}
+
+// convertWeight 重量を単位fromから単位toに換算する（0.01単位に丸める）
+func convertWeight(weight float64, from, to domain.WeightUnit) float64 {
+	if from == to {
+		return weight
+	}
+	return math.Round(to.FromKilograms(from.ToKilograms(weight))*100) / 100
+}
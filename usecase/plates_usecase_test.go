@@ -0,0 +1,105 @@
+package usecase
+
+import (
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestCalculatePlates テーブル駆動テストでプレートの組み合わせ計算をテスト
+func TestCalculatePlates(t *testing.T) {
+	barWeight := 15.0
+
+	tests := []struct {
+		name         string
+		req          CalculatePlatesRequest
+		wantUnit     domain.WeightUnit
+		wantBar      float64
+		wantAchieved []float64 // ウォームアップセット → メインセット
+		wantErr      bool
+		description  string
+	}{
+		{
+			name:         "正常系: 設定の在庫（kg）",
+			req:          CalculatePlatesRequest{ExerciseType: domain.Squat, TargetWeight: 100},
+			wantUnit:     domain.WeightUnitKilogram,
+			wantBar:      20,
+			wantAchieved: []float64{100},
+			description:  "在庫の指定がなければユーザー設定の在庫",
+		},
+		{
+			name:         "正常系: lbの目標をkgの在庫で計算",
+			req:          CalculatePlatesRequest{TargetWeight: 225, WeightUnit: domain.WeightUnitPound},
+			wantUnit:     domain.WeightUnitKilogram,
+			wantBar:      20,
+			wantAchieved: []float64{102.5},
+			description:  "225lb = 102.06kg を在庫の単位に換算し、最も近い102.5kgを返す",
+		},
+		{
+			name: "正常系: lbの在庫とウォームアップ",
+			req: CalculatePlatesRequest{
+				ExerciseType:   domain.BenchPress,
+				TargetWeight:   225,
+				WeightUnit:     domain.WeightUnitPound,
+				Plates:         []domain.Plate{{Weight: 45, Count: 4}, {Weight: 25, Count: 2}, {Weight: 10, Count: 2}},
+				WarmupPercents: []float64{0.6},
+			},
+			wantUnit:     domain.WeightUnitPound,
+			wantBar:      45,
+			wantAchieved: []float64{135, 225},
+			description:  "指定した在庫はリクエストの単位。設定（kg）と単位が異なるためバーは45lb",
+		},
+		{
+			name:         "正常系: バーの重量を指定",
+			req:          CalculatePlatesRequest{ExerciseType: domain.Deadlift, TargetWeight: 60, BarWeight: &barWeight},
+			wantUnit:     domain.WeightUnitKilogram,
+			wantBar:      15,
+			wantAchieved: []float64{60},
+			description:  "女子用・トレーニング用のバー",
+		},
+		{
+			name:        "異常系: バーベル種目以外",
+			req:         CalculatePlatesRequest{ExerciseType: domain.PullUp, TargetWeight: 20},
+			wantErr:     true,
+			description: "プレートを付けない種目は計算しない",
+		},
+		{
+			name:        "異常系: ウォームアップの割合が1以上",
+			req:         CalculatePlatesRequest{TargetWeight: 100, WarmupPercents: []float64{1.2}},
+			wantErr:     true,
+			description: "ウォームアップはメインセットより軽い",
+		},
+		{
+			name:        "異常系: 目標重量が0",
+			req:         CalculatePlatesRequest{TargetWeight: 0},
+			wantErr:     true,
+			description: "目標重量は必須",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			calculation, err := manager.CalculatePlates(tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CalculatePlates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if calculation.Unit != tt.wantUnit || calculation.BarWeight != tt.wantBar {
+				t.Errorf("Expected unit=%s bar=%.2f, got unit=%s bar=%.2f", tt.wantUnit.Key(), tt.wantBar, calculation.Unit.Key(), calculation.BarWeight)
+			}
+			if len(calculation.Sets) != len(tt.wantAchieved) {
+				t.Fatalf("Expected %d sets, got %d", len(tt.wantAchieved), len(calculation.Sets))
+			}
+			for i, set := range calculation.Sets {
+				if diff := set.Achieved - tt.wantAchieved[i]; diff > 1e-9 || diff < -1e-9 {
+					t.Errorf("Set %d: expected achieved=%.2f, got %.2f", i, tt.wantAchieved[i], set.Achieved)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,516 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+const (
+	maxProgramWeeks         = 52  // ApplyProgramで一度に生成できる最大週数
+	maxPercentOfTrainingMax = 1.5 // トレーニングマックスに対する割合の上限
+)
+
+// SetProgramRepository プログラムの永続化に使用するリポジトリを設定
+func (wm *WorkoutManager) SetProgramRepository(repo domain.ProgramRepository) {
+	wm.programRepo = repo
+}
+
+// CreateProgramRequest プログラム作成リクエスト
+type CreateProgramRequest struct {
+	Name        string                    // 必須
+	Description string                    // オプション
+	Templates   []*domain.WorkoutTemplate // 必須: 1日分以上
+}
+
+// CreateProgram プログラムを作成（ビジネスロジック層）
+func (wm *WorkoutManager) CreateProgram(req CreateProgramRequest) (*domain.Program, error) {
+	wm, span := wm.startSpan("CreateProgram")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramName(req.Name)
+	validator.validateTemplates(req.Templates)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CreateProgram",
+			Message: "program input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	program := &domain.Program{
+		Name:        req.Name,
+		Description: req.Description,
+		Templates:   req.Templates,
+	}
+	if err := wm.programRepo.CreateProgram(program); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CreateProgram",
+			Message: "failed to create program in repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Info("プログラムを作成しました",
+		slog.String(logging.KeyOp, "CreateProgram"), slog.Int64("program_id", int64(program.ID)), slog.Int("templates", len(program.Templates)))
+	return program, nil
+}
+
+// GetProgram 指定バージョンのプログラムを取得（version が0の場合は最新）
+func (wm *WorkoutManager) GetProgram(id domain.ProgramID, version int) (*domain.Program, error) {
+	wm, span := wm.startSpan("GetProgram")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramID(id)
+	validator.validate(func() error {
+		if version < 0 {
+			return fmt.Errorf("invalid program version: %d", version)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetProgram",
+			Message: "program input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	program, err := wm.programRepo.GetProgram(id, version)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetProgram",
+			Message: fmt.Sprintf("failed to retrieve program from repository (ID: %d, version: %d)", id, version),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	return program, nil
+}
+
+// ListPrograms プログラム一覧を取得（テンプレートは含めない）
+func (wm *WorkoutManager) ListPrograms() ([]*domain.Program, error) {
+	wm, span := wm.startSpan("ListPrograms")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListPrograms",
+			Message: "program repository unavailable",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	programs, err := wm.programRepo.ListPrograms()
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ListPrograms",
+			Message: "failed to list programs from repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	return programs, nil
+}
+
+// UpdateProgramRequest プログラムの名前・説明の更新リクエスト
+type UpdateProgramRequest struct {
+	ID          domain.ProgramID // 必須
+	Name        *string          // オプション: nilなら更新しない
+	Description *string          // オプション: nilなら更新しない
+}
+
+// UpdateProgram プログラムの名前・説明を更新（バージョンは変えない）
+func (wm *WorkoutManager) UpdateProgram(req UpdateProgramRequest) (*domain.Program, error) {
+	wm, span := wm.startSpan("UpdateProgram")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramID(req.ID)
+	if req.Name != nil {
+		validator.validateProgramName(*req.Name)
+	}
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateProgram",
+			Message: fmt.Sprintf("program update input validation failed (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	program, err := wm.programRepo.GetProgram(req.ID, 0)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateProgram",
+			Message: fmt.Sprintf("failed to get program for update (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	if req.Name != nil {
+		program.Name = *req.Name
+	}
+	if req.Description != nil {
+		program.Description = *req.Description
+	}
+
+	if err := wm.programRepo.UpdateProgram(program); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateProgram",
+			Message: fmt.Sprintf("failed to persist program update (ID: %d)", req.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Info("プログラムを更新しました", slog.String(logging.KeyOp, "UpdateProgram"), slog.Int64("program_id", int64(program.ID)))
+	return program, nil
+}
+
+// UpdateProgramTemplates テンプレートを編集し、新しいバージョンとして保存する
+// 過去のバージョンと生成済みのワークアウトは変更しない
+func (wm *WorkoutManager) UpdateProgramTemplates(id domain.ProgramID, templates []*domain.WorkoutTemplate) (*domain.Program, error) {
+	wm, span := wm.startSpan("UpdateProgramTemplates")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramID(id)
+	validator.validateTemplates(templates)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateProgramTemplates",
+			Message: fmt.Sprintf("program template input validation failed (ID: %d)", id),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	program, err := wm.programRepo.CreateProgramVersion(id, templates)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "UpdateProgramTemplates",
+			Message: fmt.Sprintf("failed to create program version (ID: %d)", id),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Info("プログラムのテンプレートを更新しました",
+		slog.String(logging.KeyOp, "UpdateProgramTemplates"), slog.Int64("program_id", int64(program.ID)), slog.Int("version", program.Version))
+	return program, nil
+}
+
+// DeleteProgram プログラムを削除（生成済みのワークアウトは残す）
+func (wm *WorkoutManager) DeleteProgram(id domain.ProgramID) error {
+	wm, span := wm.startSpan("DeleteProgram")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramID(id)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "DeleteProgram",
+			Message: "program delete input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+
+	if err := wm.programRepo.DeleteProgram(id); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "DeleteProgram",
+			Message: fmt.Sprintf("failed to delete program from repository (ID: %d)", id),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+
+	wm.logger.Info("プログラムを削除しました", slog.String(logging.KeyOp, "DeleteProgram"), slog.Int64("program_id", int64(id)))
+	return nil
+}
+
+// ApplyProgramRequest プログラムから予定のワークアウトを生成するリクエスト
+type ApplyProgramRequest struct {
+	ProgramID     domain.ProgramID                // 必須
+	Version       int                             // オプション: 0なら最新バージョン
+	StartDate     time.Time                       // 必須: この日以降のテンプレートの曜日に予定を作成
+	Weeks         int                             // 必須: 生成する週数（1〜52）
+	TrainingMaxes map[domain.ExerciseType]float64 // 割合で指定したセット構成に必要なトレーニングマックス（kg）
+	WeightUnit    domain.WeightUnit               // 生成するワークアウトの単位（割合から求めた重量はこの単位のプレートで組める重量に丸める）
+}
+
+// ApplyProgram プログラムのテンプレートから今後N週間分の予定（Planned）のワークアウトを生成する
+// 週は StartDate を含む週（月曜始まり）から数え、StartDate より前の曜日は生成しない
+// 生成はすべて成功するか、すべて失敗する
+func (wm *WorkoutManager) ApplyProgram(req ApplyProgramRequest) ([]*domain.Workout, error) {
+	wm, span := wm.startSpan("ApplyProgram")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateProgramRepository(wm.programRepo)
+	validator.validateProgramID(req.ProgramID)
+	validator.validate(func() error {
+		if req.Weeks < 1 || req.Weeks > maxProgramWeeks {
+			return fmt.Errorf("weeks must be between 1 and %d: %d", maxProgramWeeks, req.Weeks)
+		}
+		return nil
+	})
+	validator.validate(func() error {
+		if req.StartDate.IsZero() {
+			return fmt.Errorf("start date must be specified")
+		}
+		return nil
+	})
+	for exerciseType, trainingMax := range req.TrainingMaxes {
+		validator.validate(func() error {
+			if trainingMax <= 0 {
+				return fmt.Errorf("training max for %s must be positive: %.2f", exerciseType.Japanese(), trainingMax)
+			}
+			return nil
+		})
+	}
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ApplyProgram",
+			Message: "apply program input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	program, err := wm.programRepo.GetProgram(req.ProgramID, req.Version)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ApplyProgram",
+			Message: fmt.Sprintf("failed to retrieve program from repository (ID: %d, version: %d)", req.ProgramID, req.Version),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	workouts, err := wm.materializeProgram(program, req)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ApplyProgram",
+			Message: fmt.Sprintf("failed to build workouts from program (ID: %d)", program.ID),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	if err := wm.repo.CreateWorkouts(workouts); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "ApplyProgram",
+			Message: fmt.Sprintf("failed to create scheduled workouts in repository (count: %d)", len(workouts)),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	for _, workout := range workouts {
+		wm.publishEvent(domain.WorkoutEventCreated, workout)
+	}
+	wm.logger.Info("プログラムから予定を作成しました",
+		slog.String(logging.KeyOp, "ApplyProgram"), slog.Int64("program_id", int64(program.ID)), slog.Int("version", program.Version),
+		slog.Int("weeks", req.Weeks), slog.Int("workouts", len(workouts)))
+	return workouts, nil
+}
+
+// materializeProgram テンプレートを日付つきのワークアウトに展開する（日付・テンプレート順）
+// 説明はユーザーが入力する欄のため空のままにする（生成元はProgramID・ProgramVersionで参照できる）
+func (wm *WorkoutManager) materializeProgram(program *domain.Program, req ApplyProgramRequest) ([]*domain.Workout, error) {
+	loc := wm.location
+	startDay := domain.StatsPeriodDay.BucketStart(req.StartDate.In(loc))
+	firstWeek := domain.StatsPeriodWeek.BucketStart(startDay)
+	now := time.Now()
+	programID := program.ID
+
+	workouts := make([]*domain.Workout, 0, req.Weeks*len(program.Templates)*4)
+	for week := 0; week < req.Weeks; week++ {
+		weekStart := firstWeek.AddDate(0, 0, week*7)
+		for _, template := range sortedByDay(program.Templates) {
+			// 月曜=0になるように補正
+			scheduledFor := weekStart.AddDate(0, 0, (int(template.DayOfWeek)+6)%7)
+			if scheduledFor.Before(startDay) {
+				continue
+			}
+			for _, exercise := range template.Exercises {
+				muscleGroup := exercise.MuscleGroup
+				if info, ok := domain.LookupExercise(exercise.ExerciseType); ok && muscleGroup == domain.Unspecified {
+					muscleGroup = info.Primary
+				}
+				for _, scheme := range exercise.SchemeForWeek(week) {
+					weight := scheme.Weight
+					if scheme.PercentOfTrainingMax > 0 {
+						trainingMax, ok := req.TrainingMaxes[exercise.ExerciseType]
+						if !ok {
+							return nil, fmt.Errorf("training max required for %s (template %s)", exercise.ExerciseType.Japanese(), template.Name)
+						}
+						unit := req.WeightUnit
+						weight = unit.ToKilograms(unit.RoundToPlates(unit.FromKilograms(trainingMax * scheme.PercentOfTrainingMax)))
+					}
+					date := scheduledFor
+					workouts = append(workouts, &domain.Workout{
+						ExerciseType:      exercise.ExerciseType,
+						Status:            domain.WorkoutStatusPlanned,
+						Difficulty:        exercise.Difficulty,
+						MuscleGroup:       muscleGroup,
+						Sets:              scheme.Sets,
+						Reps:              scheme.Reps,
+						Weight:            weight,
+						WeightUnit:        req.WeightUnit,
+						Notes:             exercise.Notes,
+						CreatedAt:         now,
+						UpdatedAt:         now,
+						ScheduledFor:      &date,
+						ScheduledTimezone: wm.location.String(),
+						ProgramID:         &programID,
+						ProgramVersion:    program.Version,
+					})
+				}
+			}
+		}
+	}
+	return workouts, nil
+}
+
+// sortedByDay テンプレートを曜日順（月曜始まり）に並べる（同じ曜日は並び順を保つ）
+func sortedByDay(templates []*domain.WorkoutTemplate) []*domain.WorkoutTemplate {
+	sorted := make([]*domain.WorkoutTemplate, 0, len(templates))
+	for offset := 0; offset < 7; offset++ {
+		for _, t := range templates {
+			if (int(t.DayOfWeek)+6)%7 == offset {
+				sorted = append(sorted, t)
+			}
+		}
+	}
+	return sorted
+}
+
+// validateProgramRepository プログラムリポジトリが設定されていることを検証
+func (ev *errValidator) validateProgramRepository(repo domain.ProgramRepository) {
+	ev.validate(func() error {
+		if repo == nil {
+			return fmt.Errorf("program repository is not configured")
+		}
+		return nil
+	})
+}
+
+// validateProgramID プログラムIDの値を検証
+func (ev *errValidator) validateProgramID(id domain.ProgramID) {
+	ev.validate(func() error {
+		if id <= 0 {
+			return fmt.Errorf("invalid program ID: %d", id)
+		}
+		return nil
+	})
+}
+
+// validateProgramName プログラム名を検証
+func (ev *errValidator) validateProgramName(name string) {
+	ev.validate(func() error {
+		if name == "" {
+			return fmt.Errorf("program name must be specified")
+		}
+		if len([]rune(name)) > 100 {
+			return fmt.Errorf("program name too long: %d characters (max 100)", len([]rune(name)))
+		}
+		return nil
+	})
+}
+
+// validateTemplates テンプレート・種目・セット構成を検証
+func (ev *errValidator) validateTemplates(templates []*domain.WorkoutTemplate) {
+	ev.validate(func() error {
+		if len(templates) == 0 {
+			return fmt.Errorf("program requires at least one template")
+		}
+		return nil
+	})
+	for _, t := range templates {
+		ev.validate(func() error {
+			if t.Name == "" {
+				return fmt.Errorf("template name must be specified")
+			}
+			if t.DayOfWeek < time.Sunday || t.DayOfWeek > time.Saturday {
+				return fmt.Errorf("invalid day of week for template %s: %d", t.Name, t.DayOfWeek)
+			}
+			if len(t.Exercises) == 0 {
+				return fmt.Errorf("template %s requires at least one exercise", t.Name)
+			}
+			return nil
+		})
+		for _, exercise := range t.Exercises {
+			ev.validateExerciseType(exercise.ExerciseType)
+			ev.validate(func() error {
+				if len(exercise.Weeks) == 0 {
+					return fmt.Errorf("%s in template %s requires at least one week of sets", exercise.ExerciseType.Japanese(), t.Name)
+				}
+				for week, schemes := range exercise.Weeks {
+					if len(schemes) == 0 {
+						return fmt.Errorf("%s in template %s has no sets in week %d", exercise.ExerciseType.Japanese(), t.Name, week+1)
+					}
+					for _, scheme := range schemes {
+						if err := validateSetScheme(scheme); err != nil {
+							return fmt.Errorf("%s in template %s (week %d): %w", exercise.ExerciseType.Japanese(), t.Name, week+1, err)
+						}
+					}
+				}
+				return nil
+			})
+		}
+	}
+}
+
+// validateSetScheme セット構成の値を検証（workoutsテーブルの制約と同じ範囲）
+func validateSetScheme(scheme domain.SetScheme) error {
+	if scheme.Sets < 1 || scheme.Sets > domain.MaxSets {
+		return fmt.Errorf("sets must be between 1 and %d: %d", domain.MaxSets, scheme.Sets)
+	}
+	if scheme.Reps < 1 || scheme.Reps > domain.MaxReps {
+		return fmt.Errorf("reps must be between 1 and %d: %d", domain.MaxReps, scheme.Reps)
+	}
+	if scheme.Weight < 0 || scheme.Weight > domain.MaxWeight {
+		return fmt.Errorf("weight must be between 0 and %.2f: %.2f", domain.MaxWeight, scheme.Weight)
+	}
+	if scheme.PercentOfTrainingMax < 0 || scheme.PercentOfTrainingMax > maxPercentOfTrainingMax {
+		return fmt.Errorf("percent of training max must be between 0 and %.1f: %.2f", maxPercentOfTrainingMax, scheme.PercentOfTrainingMax)
+	}
+	return nil
+}
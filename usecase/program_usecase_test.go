@@ -0,0 +1,265 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// pplTemplates テスト用のPPL（月・水・金）テンプレート
+func pplTemplates() []*domain.WorkoutTemplate {
+	fixed := func(sets, reps int, weight float64) [][]domain.SetScheme {
+		return [][]domain.SetScheme{{{Sets: sets, Reps: reps, Weight: weight}}}
+	}
+	return []*domain.WorkoutTemplate{
+		{Name: "Push", DayOfWeek: time.Monday, Exercises: []domain.TemplateExercise{
+			{ExerciseType: domain.BenchPress, Difficulty: domain.DifficultyIntermediate, Weeks: fixed(3, 8, 70)},
+			{ExerciseType: domain.SideRaise, Weeks: fixed(3, 15, 8)},
+		}},
+		{Name: "Pull", DayOfWeek: time.Wednesday, Exercises: []domain.TemplateExercise{
+			{ExerciseType: domain.PullUp, Weeks: fixed(4, 8, 0)},
+		}},
+		{Name: "Legs", DayOfWeek: time.Friday, Exercises: []domain.TemplateExercise{
+			{ExerciseType: domain.Squat, Weeks: fixed(5, 5, 100)},
+		}},
+	}
+}
+
+// fiveThreeOneTemplates テスト用の5/3/1（スクワットのみ、週ごとに割合が変わる）テンプレート
+func fiveThreeOneTemplates() []*domain.WorkoutTemplate {
+	week := func(reps int, percents ...float64) []domain.SetScheme {
+		schemes := make([]domain.SetScheme, 0, len(percents))
+		for _, p := range percents {
+			schemes = append(schemes, domain.SetScheme{Sets: 1, Reps: reps, PercentOfTrainingMax: p})
+		}
+		return schemes
+	}
+	return []*domain.WorkoutTemplate{
+		{Name: "Squat Day", DayOfWeek: time.Tuesday, Exercises: []domain.TemplateExercise{
+			{ExerciseType: domain.Squat, Difficulty: domain.DifficultyAdvanced, Weeks: [][]domain.SetScheme{
+				week(5, 0.65, 0.75, 0.85),
+				week(3, 0.70, 0.80, 0.90),
+				week(1, 0.75, 0.85, 0.95),
+				week(5, 0.40, 0.50, 0.60),
+			}},
+		}},
+	}
+}
+
+// TestCreateProgram テーブル駆動テストでプログラム作成のバリデーションをテスト
+func TestCreateProgram(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     CreateProgramRequest
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: PPL",
+			request:     CreateProgramRequest{Name: "PPL", Templates: pplTemplates()},
+			description: "バージョン1として作成",
+		},
+		{
+			name:        "異常系: 名前なし",
+			request:     CreateProgramRequest{Templates: pplTemplates()},
+			wantErr:     true,
+			description: "プログラム名は必須",
+		},
+		{
+			name:        "異常系: テンプレートなし",
+			request:     CreateProgramRequest{Name: "空のプログラム"},
+			wantErr:     true,
+			description: "1日分以上のテンプレートが必要",
+		},
+		{
+			name: "異常系: セット数が上限を超える",
+			request: CreateProgramRequest{Name: "Volume", Templates: []*domain.WorkoutTemplate{
+				{Name: "Day 1", DayOfWeek: time.Monday, Exercises: []domain.TemplateExercise{
+					{ExerciseType: domain.BenchPress, Weeks: [][]domain.SetScheme{{{Sets: 101, Reps: 10}}}},
+				}},
+			}},
+			wantErr:     true,
+			description: "workoutsテーブルの制約（sets <= 100）と同じ範囲",
+		},
+		{
+			name: "異常系: 種目未指定",
+			request: CreateProgramRequest{Name: "Unknown", Templates: []*domain.WorkoutTemplate{
+				{Name: "Day 1", DayOfWeek: time.Monday, Exercises: []domain.TemplateExercise{
+					{Weeks: [][]domain.SetScheme{{{Sets: 3, Reps: 10}}}},
+				}},
+			}},
+			wantErr:     true,
+			description: "種目は必須",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			manager.SetProgramRepository(repository.NewMockProgramRepository())
+
+			program, err := manager.CreateProgram(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CreateProgram() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && program.Version != 1 {
+				t.Errorf("Expected version 1, got %d", program.Version)
+			}
+		})
+	}
+}
+
+// TestUpdateProgramTemplates テンプレート編集で新しいバージョンが作成され、過去のバージョンが残ることをテスト
+func TestUpdateProgramTemplates(t *testing.T) {
+	manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	manager.SetProgramRepository(repository.NewMockProgramRepository())
+
+	program, err := manager.CreateProgram(CreateProgramRequest{Name: "PPL", Templates: pplTemplates()})
+	if err != nil {
+		t.Fatalf("Failed to create program: %v", err)
+	}
+
+	// Legs日を削除した2日分のテンプレートに編集
+	updated, err := manager.UpdateProgramTemplates(program.ID, pplTemplates()[:2])
+	if err != nil {
+		t.Fatalf("UpdateProgramTemplates() error = %v", err)
+	}
+	if updated.Version != 2 {
+		t.Errorf("Expected version 2, got %d", updated.Version)
+	}
+
+	tests := []struct {
+		name          string
+		version       int
+		wantVersion   int
+		wantTemplates int
+	}{
+		{name: "正常系: 最新バージョン", version: 0, wantVersion: 2, wantTemplates: 2},
+		{name: "正常系: 編集前のバージョン", version: 1, wantVersion: 1, wantTemplates: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := manager.GetProgram(program.ID, tt.version)
+			if err != nil {
+				t.Fatalf("GetProgram() error = %v", err)
+			}
+			if got.Version != tt.wantVersion || len(got.Templates) != tt.wantTemplates {
+				t.Errorf("Expected v%d with %d templates, got v%d with %d templates", tt.wantVersion, tt.wantTemplates, got.Version, len(got.Templates))
+			}
+		})
+	}
+}
+
+// TestApplyProgram テーブル駆動テストでプログラムからの予定生成をテスト
+func TestApplyProgram(t *testing.T) {
+	// 2024-01-03は水曜日
+	wednesday := time.Date(2024, 1, 3, 0, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name          string
+		templates     []*domain.WorkoutTemplate
+		request       ApplyProgramRequest
+		wantWorkouts  int
+		wantFirstDate time.Time
+		wantWeights   []float64 // 先頭から順に確認する重量
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: PPLを水曜から2週間",
+			templates:     pplTemplates(),
+			request:       ApplyProgramRequest{StartDate: wednesday, Weeks: 2},
+			wantWorkouts:  1 + 1 + 2 + 1 + 1, // 1週目: 水・金、2週目: 月（2種目）・水・金
+			wantFirstDate: wednesday,
+			wantWeights:   []float64{0, 100, 70, 8},
+			description:   "開始日より前の曜日（1週目の月曜）は生成しない",
+		},
+		{
+			name:          "正常系: 5/3/1をトレーニングマックスから計算",
+			templates:     fiveThreeOneTemplates(),
+			request:       ApplyProgramRequest{StartDate: wednesday, Weeks: 3, TrainingMaxes: map[domain.ExerciseType]float64{domain.Squat: 140}},
+			wantWorkouts:  3 * 2, // 1週目の火曜は開始日より前
+			wantFirstDate: wednesday.AddDate(0, 0, 6),
+			// 2週目（70/80/90%）: 98 → 97.5、112 → 112.5、126 → 125
+			wantWeights: []float64{97.5, 112.5, 125, 105, 120, 132.5},
+			description: "週番号に応じたセット構成を2.5kg刻みで丸める",
+		},
+		{
+			name:        "異常系: トレーニングマックスなし",
+			templates:   fiveThreeOneTemplates(),
+			request:     ApplyProgramRequest{StartDate: wednesday, Weeks: 2},
+			wantErr:     true,
+			description: "割合で指定した種目にはトレーニングマックスが必要",
+		},
+		{
+			name:        "異常系: 週数が範囲外",
+			templates:   pplTemplates(),
+			request:     ApplyProgramRequest{StartDate: wednesday, Weeks: 0},
+			wantErr:     true,
+			description: "週数は1〜52",
+		},
+		{
+			name:        "異常系: 存在しないバージョン",
+			templates:   pplTemplates(),
+			request:     ApplyProgramRequest{StartDate: wednesday, Weeks: 1, Version: 5},
+			wantErr:     true,
+			description: "未作成のバージョンは適用できない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workoutRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(workoutRepo)
+			manager.SetProgramRepository(repository.NewMockProgramRepository())
+			manager.SetLocation(time.Local)
+
+			program, err := manager.CreateProgram(CreateProgramRequest{Name: "Test", Templates: tt.templates})
+			if err != nil {
+				t.Fatalf("Failed to create program: %v", err)
+			}
+			tt.request.ProgramID = program.ID
+
+			workouts, err := manager.ApplyProgram(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ApplyProgram() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				// すべて失敗した場合は1件も作成されない
+				if count, _ := workoutRepo.GetWorkoutCount(); count != 0 {
+					t.Errorf("Expected no workouts on failure, got %d", count)
+				}
+				return
+			}
+
+			if len(workouts) != tt.wantWorkouts {
+				t.Fatalf("Expected %d workouts, got %d", tt.wantWorkouts, len(workouts))
+			}
+			first := workouts[0]
+			if first.ScheduledFor == nil || !first.ScheduledFor.Equal(tt.wantFirstDate) {
+				t.Errorf("Expected first workout on %v, got %v", tt.wantFirstDate, first.ScheduledFor)
+			}
+			if first.Status != domain.WorkoutStatusPlanned || first.ProgramID == nil || *first.ProgramID != program.ID || first.ProgramVersion != 1 {
+				t.Errorf("Expected planned workout from program %d v1, got %+v", program.ID, first)
+			}
+			// 説明はユーザーが入力する欄のため、特定の言語の文を保存しない
+			if first.Description != "" {
+				t.Errorf("Expected empty description, got %q", first.Description)
+			}
+			for i, want := range tt.wantWeights {
+				if workouts[i].Weight != want {
+					t.Errorf("Expected workouts[%d].Weight=%.1f, got %.1f", i, want, workouts[i].Weight)
+				}
+			}
+			if count, _ := workoutRepo.GetWorkoutCount(); count != tt.wantWorkouts {
+				t.Errorf("Expected %d workouts in repository, got %d", tt.wantWorkouts, count)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package progression
+
+import (
+	"fmt"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/usecase/strength"
+)
+
+const (
+	maxReps          = 1000    // workoutsテーブルの制約（reps <= 1000）と同じ
+	maxWeight        = 9999.99 // workoutsテーブルの制約（weight <= 9999.99）と同じ
+	maxIncrement     = 50.0    // 1回に上げる重量の上限(kg)
+	maxDeloadPercent = 0.5     // ディロードで下げる割合の上限
+)
+
+// DefaultRule ルールが設定されていない種目に適用するデフォルトのルール
+// 目標回数を達成したら+2.5kg、3回連続で失敗したら10%ディロード
+func DefaultRule(exerciseType domain.ExerciseType) domain.ProgressionRule {
+	return domain.ProgressionRule{
+		ExerciseType:  exerciseType,
+		Scheme:        domain.ProgressionLinear,
+		Increment:     domain.DefaultProgressionIncrement,
+		DeloadAfter:   domain.DefaultProgressionDeloadAfter,
+		DeloadPercent: domain.DefaultProgressionDeloadPercent,
+	}
+}
+
+// ValidateRule ルールの設定値を検証する
+func ValidateRule(rule domain.ProgressionRule) error {
+	if rule.ExerciseType == domain.ExerciseUnspecified {
+		return fmt.Errorf("exercise type must be specified")
+	}
+	if rule.Increment <= 0 || rule.Increment > maxIncrement {
+		return fmt.Errorf("increment must be between 0 and %.1f: %.2f", maxIncrement, rule.Increment)
+	}
+	if rule.DeloadAfter < 0 {
+		return fmt.Errorf("deload_after cannot be negative: %d", rule.DeloadAfter)
+	}
+	if rule.DeloadPercent < 0 || rule.DeloadPercent > maxDeloadPercent {
+		return fmt.Errorf("deload_percent must be between 0 and %.1f: %.2f", maxDeloadPercent, rule.DeloadPercent)
+	}
+
+	switch rule.Scheme {
+	case domain.ProgressionLinear:
+		if rule.TargetReps < 0 || rule.TargetReps > maxReps {
+			return fmt.Errorf("target_reps must be between 0 and %d: %d", maxReps, rule.TargetReps)
+		}
+	case domain.ProgressionDouble:
+		if rule.MinReps <= 0 || rule.MaxReps < rule.MinReps || rule.MaxReps > maxReps {
+			return fmt.Errorf("invalid rep range for double progression: %d-%d", rule.MinReps, rule.MaxReps)
+		}
+	default:
+		return fmt.Errorf("unknown progression scheme: %d", rule.Scheme)
+	}
+	return nil
+}
+
+// target 各セッションの目標（直前の提案内容）
+type target struct {
+	action domain.ProgressionAction
+	sets   int
+	reps   int
+	weight float64
+}
+
+// Suggest 完了したワークアウトの履歴（古い順）にルールを順に適用し、次回の内容を提案する
+// 各セッションは直前の提案を目標として評価し、重量・回数の両方を達成していれば成功とみなす
+// （最初のセッションは基準とするため成功扱い）
+func Suggest(rule domain.ProgressionRule, history []*domain.Workout) *domain.ProgressionSuggestion {
+	suggestion := &domain.ProgressionSuggestion{
+		ExerciseType: rule.ExerciseType,
+		ProgramID:    rule.ProgramID,
+		Rule:         rule,
+		Action:       domain.ProgressionActionBaseline,
+		Reps:         initialReps(rule, 0),
+	}
+	if len(history) == 0 {
+		return suggestion
+	}
+
+	var next *target
+	failures := 0
+	for _, w := range history {
+		success := next == nil || (w.Weight >= next.weight && w.Reps >= next.reps)
+		if success {
+			failures = 0
+			next = advance(rule, w)
+			continue
+		}
+
+		failures++
+		if rule.DeloadAfter > 0 && failures >= rule.DeloadAfter {
+			failures = 0
+			next = &target{
+				action: domain.ProgressionActionDeload,
+				sets:   w.Sets,
+				reps:   initialReps(rule, next.reps),
+				weight: strength.RoundToIncrement(next.weight*(1-rule.DeloadPercent), rule.Increment),
+			}
+			continue
+		}
+		next = &target{
+			action: domain.ProgressionActionRepeat,
+			sets:   w.Sets,
+			reps:   next.reps,
+			weight: next.weight,
+		}
+	}
+
+	suggestion.Action = next.action
+	suggestion.Sets = next.sets
+	suggestion.Reps = next.reps
+	suggestion.Weight = next.weight
+	suggestion.ConsecutiveFailures = failures
+	suggestion.LastWorkout = history[len(history)-1]
+	return suggestion
+}
+
+// advance 成功したセッションから次回の目標を求める
+func advance(rule domain.ProgressionRule, w *domain.Workout) *target {
+	increased := &target{
+		action: domain.ProgressionActionIncreaseWeight,
+		sets:   w.Sets,
+		reps:   initialReps(rule, w.Reps),
+		weight: min(w.Weight+rule.Increment, maxWeight),
+	}
+
+	switch rule.Scheme {
+	case domain.ProgressionDouble:
+		if w.Reps >= rule.MaxReps {
+			return increased
+		}
+		return &target{
+			action: domain.ProgressionActionIncreaseReps,
+			sets:   w.Sets,
+			reps:   max(w.Reps+1, rule.MinReps),
+			weight: w.Weight,
+		}
+	default:
+		if rule.TargetReps > 0 && w.Reps < rule.TargetReps {
+			// 最初のセッションで目標回数に届いていない場合は同じ重量で目標回数を目指す
+			return &target{
+				action: domain.ProgressionActionRepeat,
+				sets:   w.Sets,
+				reps:   rule.TargetReps,
+				weight: w.Weight,
+			}
+		}
+		return increased
+	}
+}
+
+// initialReps 重量を上げた後（またはディロード後）の回数
+// ルールで指定がない場合はfallbackの回数を使う
+func initialReps(rule domain.ProgressionRule, fallback int) int {
+	switch rule.Scheme {
+	case domain.ProgressionDouble:
+		return rule.MinReps
+	default:
+		if rule.TargetReps > 0 {
+			return rule.TargetReps
+		}
+		return fallback
+	}
+}
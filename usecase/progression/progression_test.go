@@ -0,0 +1,174 @@
+package progression
+
+import (
+	"testing"
+
+	"golv2-learning-app/domain"
+)
+
+// session テスト用の完了ワークアウト
+func session(weight float64, reps int) *domain.Workout {
+	return &domain.Workout{
+		ExerciseType: domain.Squat,
+		Status:       domain.WorkoutStatusCompleted,
+		Sets:         3,
+		Reps:         reps,
+		Weight:       weight,
+	}
+}
+
+// TestSuggest テーブル駆動テストでルールごとの次回の提案をテスト
+func TestSuggest(t *testing.T) {
+	linear := domain.ProgressionRule{
+		ExerciseType:  domain.Squat,
+		Scheme:        domain.ProgressionLinear,
+		Increment:     2.5,
+		TargetReps:    5,
+		DeloadAfter:   3,
+		DeloadPercent: 0.1,
+	}
+	double := domain.ProgressionRule{
+		ExerciseType: domain.Squat,
+		Scheme:       domain.ProgressionDouble,
+		Increment:    2.5,
+		MinReps:      8,
+		MaxReps:      12,
+	}
+
+	tests := []struct {
+		name         string
+		rule         domain.ProgressionRule
+		history      []*domain.Workout
+		wantAction   domain.ProgressionAction
+		wantWeight   float64
+		wantReps     int
+		wantFailures int
+		description  string
+	}{
+		{
+			name:        "正常系: 履歴なし",
+			rule:        linear,
+			wantAction:  domain.ProgressionActionBaseline,
+			wantReps:    5,
+			description: "履歴がない場合は目標回数のみ提案",
+		},
+		{
+			name:        "正常系: リニア（成功が続く）",
+			rule:        linear,
+			history:     []*domain.Workout{session(100, 5), session(102.5, 5), session(105, 5)},
+			wantAction:  domain.ProgressionActionIncreaseWeight,
+			wantWeight:  107.5,
+			wantReps:    5,
+			description: "目標回数を達成するたびに+2.5kg",
+		},
+		{
+			name:         "正常系: リニア（失敗）",
+			rule:         linear,
+			history:      []*domain.Workout{session(100, 5), session(102.5, 3)},
+			wantAction:   domain.ProgressionActionRepeat,
+			wantWeight:   102.5,
+			wantReps:     5,
+			wantFailures: 1,
+			description:  "目標回数に届かなければ同じ重量で再挑戦",
+		},
+		{
+			name:        "正常系: リニア（3回失敗でディロード）",
+			rule:        linear,
+			history:     []*domain.Workout{session(100, 5), session(102.5, 4), session(102.5, 4), session(102.5, 3)},
+			wantAction:  domain.ProgressionActionDeload,
+			wantWeight:  92.5,
+			wantReps:    5,
+			description: "102.5kgの10%減 → 92.25 → 2.5kg刻みで92.5kg",
+		},
+		{
+			name:        "正常系: リニア（ディロード後に成功）",
+			rule:        linear,
+			history:     []*domain.Workout{session(100, 5), session(102.5, 4), session(102.5, 4), session(102.5, 3), session(92.5, 5)},
+			wantAction:  domain.ProgressionActionIncreaseWeight,
+			wantWeight:  95,
+			wantReps:    5,
+			description: "ディロード後は連続失敗回数がリセットされる",
+		},
+		{
+			name:        "正常系: リニア（初回が目標回数未満）",
+			rule:        linear,
+			history:     []*domain.Workout{session(100, 3)},
+			wantAction:  domain.ProgressionActionRepeat,
+			wantWeight:  100,
+			wantReps:    5,
+			description: "初回は失敗扱いにせず、同じ重量で目標回数を目指す",
+		},
+		{
+			name:        "正常系: ダブルプログレッション（回数アップ）",
+			rule:        double,
+			history:     []*domain.Workout{session(60, 8), session(60, 9)},
+			wantAction:  domain.ProgressionActionIncreaseReps,
+			wantWeight:  60,
+			wantReps:    10,
+			description: "上限回数に届くまで同じ重量で回数を伸ばす",
+		},
+		{
+			name:        "正常系: ダブルプログレッション（重量アップ）",
+			rule:        double,
+			history:     []*domain.Workout{session(60, 11), session(60, 12)},
+			wantAction:  domain.ProgressionActionIncreaseWeight,
+			wantWeight:  62.5,
+			wantReps:    8,
+			description: "上限回数を達成したら重量を上げて下限回数に戻す",
+		},
+		{
+			name:         "正常系: ダブルプログレッション（失敗してもディロードしない）",
+			rule:         double,
+			history:      []*domain.Workout{session(60, 10), session(60, 9), session(60, 9), session(60, 9)},
+			wantAction:   domain.ProgressionActionRepeat,
+			wantWeight:   60,
+			wantReps:     11,
+			wantFailures: 3,
+			description:  "deload_after=0 の場合は再挑戦を続ける",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Suggest(tt.rule, tt.history)
+
+			if got.Action != tt.wantAction {
+				t.Errorf("Expected action %s, got %s", tt.wantAction.Japanese(), got.Action.Japanese())
+			}
+			if got.Weight != tt.wantWeight || got.Reps != tt.wantReps {
+				t.Errorf("Expected %.1fkg × %d, got %.1fkg × %d", tt.wantWeight, tt.wantReps, got.Weight, got.Reps)
+			}
+			if got.ConsecutiveFailures != tt.wantFailures {
+				t.Errorf("Expected %d consecutive failures, got %d", tt.wantFailures, got.ConsecutiveFailures)
+			}
+			if len(tt.history) > 0 && got.LastWorkout != tt.history[len(tt.history)-1] {
+				t.Errorf("Expected last workout to be the newest session")
+			}
+		})
+	}
+}
+
+// TestValidateRule テーブル駆動テストでルールの設定値の検証をテスト
+func TestValidateRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    domain.ProgressionRule
+		wantErr bool
+	}{
+		{name: "正常系: デフォルトルール", rule: DefaultRule(domain.BenchPress)},
+		{name: "正常系: ダブルプログレッション", rule: domain.ProgressionRule{ExerciseType: domain.SideRaise, Scheme: domain.ProgressionDouble, Increment: 1, MinReps: 10, MaxReps: 15}},
+		{name: "異常系: 種目未指定", rule: domain.ProgressionRule{Increment: 2.5}, wantErr: true},
+		{name: "異常系: 増加量が0", rule: domain.ProgressionRule{ExerciseType: domain.Squat}, wantErr: true},
+		{name: "異常系: ダブルプログレッションの回数範囲が不正", rule: domain.ProgressionRule{ExerciseType: domain.Squat, Scheme: domain.ProgressionDouble, Increment: 2.5, MinReps: 12, MaxReps: 8}, wantErr: true},
+		{name: "異常系: ディロード割合が大きすぎる", rule: domain.ProgressionRule{ExerciseType: domain.Squat, Increment: 2.5, DeloadPercent: 0.8}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRule(tt.rule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
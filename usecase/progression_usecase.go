@@ -0,0 +1,149 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/progression"
+)
+
+// progressionHistoryLimit 提案の計算に使用する直近の完了ワークアウト数
+const progressionHistoryLimit = 20
+
+// SetProgressionRules 種目ごとの漸進的過負荷ルールと、完了時に次回の予定を自動作成するかを設定
+func (wm *WorkoutManager) SetProgressionRules(rules []domain.ProgressionRule, autoCreateNext bool) {
+	wm.progressionRules = rules
+	wm.autoCreateNextWorkout = autoCreateNext
+}
+
+// progressionRuleFor 種目・プログラムに適用するルールを返す
+// プログラム指定のルール → 種目のみのルール → デフォルトルールの順に探す
+func (wm *WorkoutManager) progressionRuleFor(exerciseType domain.ExerciseType, programID *domain.ProgramID) domain.ProgressionRule {
+	var exerciseRule *domain.ProgressionRule
+	for i, rule := range wm.progressionRules {
+		if !rule.AppliesTo(exerciseType, programID) {
+			continue
+		}
+		if rule.ProgramID != nil {
+			return rule
+		}
+		if exerciseRule == nil {
+			exerciseRule = &wm.progressionRules[i]
+		}
+	}
+	if exerciseRule != nil {
+		return *exerciseRule
+	}
+	return progression.DefaultRule(exerciseType)
+}
+
+// SuggestNextWorkoutRequest 次回のワークアウト提案リクエスト
+type SuggestNextWorkoutRequest struct {
+	ExerciseType domain.ExerciseType // 必須: 種目
+	ProgramID    *domain.ProgramID   // オプション: 指定した場合はプログラム内の履歴とルールを使用
+}
+
+// SuggestNextWorkout 完了履歴にルールを適用し、次回の重量・回数を提案する（ビジネスロジック層）
+func (wm *WorkoutManager) SuggestNextWorkout(req SuggestNextWorkoutRequest) (*domain.ProgressionSuggestion, error) {
+	wm, span := wm.startSpan("SuggestNextWorkout")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateExerciseType(req.ExerciseType)
+	validator.validate(func() error {
+		if req.ProgramID != nil && *req.ProgramID <= 0 {
+			return fmt.Errorf("invalid program ID: %d", *req.ProgramID)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "SuggestNextWorkout",
+			ExerciseType: req.ExerciseType,
+			Message:      "suggest next workout input validation failed",
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	suggestion, err := wm.suggestNextWorkout(req.ExerciseType, req.ProgramID)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "SuggestNextWorkout",
+			ExerciseType: req.ExerciseType,
+			Message:      "failed to suggest next workout",
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Debug("次回のワークアウトを提案しました",
+		slog.String(logging.KeyOp, "SuggestNextWorkout"), slog.String("exercise_type", req.ExerciseType.Key()),
+		slog.String("action", suggestion.Action.Japanese()), slog.Float64("weight", suggestion.Weight),
+		slog.Int("reps", suggestion.Reps), slog.Int("sets", suggestion.Sets))
+	return suggestion, nil
+}
+
+// suggestNextWorkout 履歴を取得してルールを適用する
+func (wm *WorkoutManager) suggestNextWorkout(exerciseType domain.ExerciseType, programID *domain.ProgramID) (*domain.ProgressionSuggestion, error) {
+	rule := wm.progressionRuleFor(exerciseType, programID)
+	if err := progression.ValidateRule(rule); err != nil {
+		return nil, fmt.Errorf("invalid progression rule: %w", err)
+	}
+
+	history, err := wm.repo.GetExerciseHistory(exerciseType, programID, progressionHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestion := progression.Suggest(rule, history)
+	suggestion.ProgramID = programID
+	return suggestion, nil
+}
+
+// scheduleNextWorkout 完了したワークアウトの次回分を提案内容で予定として作成する
+// プログラムから生成したワークアウトは予定が作成済みのため対象外
+func (wm *WorkoutManager) scheduleNextWorkout(completed *domain.Workout) (*domain.Workout, error) {
+	if !wm.autoCreateNextWorkout || completed.ProgramID != nil {
+		return nil, nil
+	}
+
+	suggestion, err := wm.suggestNextWorkout(completed.ExerciseType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// 完了したワークアウトと同じ単位で記録し、その単位のプレートで組める重量に丸める
+	unit := completed.WeightUnit
+	weight := unit.ToKilograms(unit.RoundToPlates(unit.FromKilograms(suggestion.Weight)))
+
+	now := time.Now()
+	next := &domain.Workout{
+		// 説明は空のままにする（種目名は表示時にリクエストの言語で display_name に入る）
+		ExerciseType: completed.ExerciseType,
+		Status:       domain.WorkoutStatusPlanned,
+		Difficulty:   completed.Difficulty,
+		MuscleGroup:  completed.MuscleGroup,
+		Sets:         suggestion.Sets,
+		Reps:         suggestion.Reps,
+		Weight:       weight,
+		WeightUnit:   unit,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := wm.repo.CreateWorkout(next); err != nil {
+		return nil, err
+	}
+	wm.publishEvent(domain.WorkoutEventCreated, next)
+
+	wm.logger.Info("次回のワークアウトを予定に追加しました",
+		slog.Int64(logging.KeyWorkoutID, int64(next.ID)), slog.String("exercise_type", next.ExerciseType.Key()),
+		slog.Float64("weight", next.Weight), slog.Int("reps", next.Reps), slog.Int("sets", next.Sets))
+	return next, nil
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestSuggestNextWorkout テーブル駆動テストで種目・プログラムごとのルール選択をテスト
+func TestSuggestNextWorkout(t *testing.T) {
+	programID := domain.ProgramID(1)
+	otherProgramID := domain.ProgramID(2)
+	base := time.Date(2024, 1, 1, 18, 0, 0, 0, time.Local)
+
+	tests := []struct {
+		name        string
+		programID   *domain.ProgramID
+		exercise    domain.ExerciseType
+		wantScheme  domain.ProgressionScheme
+		wantWeight  float64
+		wantReps    int
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: 種目のルール（+5kg）",
+			exercise:    domain.Squat,
+			wantScheme:  domain.ProgressionLinear,
+			wantWeight:  105,
+			wantReps:    5,
+			description: "プログラムを問わず全履歴の最新（100kg × 5回）から+5kg",
+		},
+		{
+			name:        "正常系: プログラム指定のルールを優先",
+			programID:   &programID,
+			exercise:    domain.Squat,
+			wantScheme:  domain.ProgressionDouble,
+			wantWeight:  80,
+			wantReps:    9,
+			description: "プログラム1の履歴（80kg × 8回）にダブルプログレッションを適用",
+		},
+		{
+			name:        "正常系: 他のプログラムは種目のルールで計算",
+			programID:   &otherProgramID,
+			exercise:    domain.Squat,
+			wantScheme:  domain.ProgressionLinear,
+			wantWeight:  0,
+			wantReps:    5,
+			description: "プログラム2の履歴はないため履歴なしの提案",
+		},
+		{
+			name:        "正常系: ルール未設定の種目はデフォルトルール",
+			exercise:    domain.BenchPress,
+			wantScheme:  domain.ProgressionLinear,
+			wantWeight:  62.5,
+			wantReps:    10,
+			description: "デフォルトルールは前回の回数を維持して+2.5kg",
+		},
+		{
+			name:        "異常系: 種目未指定",
+			exercise:    domain.ExerciseUnspecified,
+			wantErr:     true,
+			description: "種目は必須",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			manager.SetProgressionRules([]domain.ProgressionRule{
+				{ExerciseType: domain.Squat, ProgramID: &programID, Scheme: domain.ProgressionDouble, Increment: 2.5, MinReps: 6, MaxReps: 10},
+				{ExerciseType: domain.Squat, Scheme: domain.ProgressionLinear, Increment: 5, TargetReps: 5},
+			}, false)
+
+			history := []*domain.Workout{
+				{ExerciseType: domain.Squat, ProgramID: &programID, Sets: 3, Reps: 8, Weight: 80},
+				{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100},
+				{ExerciseType: domain.BenchPress, Sets: 3, Reps: 10, Weight: 60},
+			}
+			for i, w := range history {
+				completedAt := base.AddDate(0, 0, i)
+				w.Status = domain.WorkoutStatusCompleted
+				w.CompletedAt = &completedAt
+				if err := mockRepo.CreateWorkout(w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			suggestion, err := manager.SuggestNextWorkout(SuggestNextWorkoutRequest{
+				ExerciseType: tt.exercise,
+				ProgramID:    tt.programID,
+			})
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SuggestNextWorkout() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+			if suggestion.Rule.Scheme != tt.wantScheme {
+				t.Errorf("Expected scheme %s, got %s", tt.wantScheme.Japanese(), suggestion.Rule.Scheme.Japanese())
+			}
+			if suggestion.Weight != tt.wantWeight || suggestion.Reps != tt.wantReps {
+				t.Errorf("Expected %.1fkg × %d, got %.1fkg × %d", tt.wantWeight, tt.wantReps, suggestion.Weight, suggestion.Reps)
+			}
+		})
+	}
+}
+
+// TestUpdateWorkout_AutoCreateNext 完了時の次回ワークアウト自動作成をテスト
+func TestUpdateWorkout_AutoCreateNext(t *testing.T) {
+	programID := domain.ProgramID(1)
+
+	tests := []struct {
+		name        string
+		autoCreate  bool
+		programID   *domain.ProgramID
+		status      domain.WorkoutStatus
+		weightUnit  domain.WeightUnit
+		weight      float64 // 完了したワークアウトの重量（kg）
+		wantCreated bool
+		wantWeight  float64 // 作成した予定の重量（kg）
+		description string
+	}{
+		{
+			name:        "正常系: 完了で次回を作成",
+			autoCreate:  true,
+			status:      domain.WorkoutStatusCompleted,
+			weight:      140,
+			wantCreated: true,
+			wantWeight:  142.5,
+			description: "提案内容（+2.5kg）で予定を作成",
+		},
+		{
+			name:        "正常系: lbで記録したワークアウト",
+			autoCreate:  true,
+			status:      domain.WorkoutStatusCompleted,
+			weightUnit:  domain.WeightUnitPound,
+			weight:      domain.WeightUnitPound.ToKilograms(225),
+			wantCreated: true,
+			wantWeight:  domain.WeightUnitPound.ToKilograms(230),
+			description: "225lb + 2.5kg = 230.51lb → lbのプレート（5lb刻み）で組める230lbで、lbとして予定を作成",
+		},
+		{
+			name:        "正常系: 自動作成が無効",
+			autoCreate:  false,
+			status:      domain.WorkoutStatusCompleted,
+			weight:      140,
+			description: "設定で無効にした場合は作成しない",
+		},
+		{
+			name:        "正常系: スキップでは作成しない",
+			autoCreate:  true,
+			status:      domain.WorkoutStatusSkipped,
+			weight:      140,
+			description: "完了以外のステータス変更は対象外",
+		},
+		{
+			name:        "正常系: プログラムのワークアウトは対象外",
+			autoCreate:  true,
+			programID:   &programID,
+			status:      domain.WorkoutStatusCompleted,
+			weight:      140,
+			description: "プログラムの予定は適用時に作成済み",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			manager.SetProgressionRules(nil, tt.autoCreate)
+
+			workout := &domain.Workout{
+				ExerciseType: domain.Deadlift,
+				Status:       domain.WorkoutStatusPlanned,
+				Difficulty:   domain.DifficultyAdvanced,
+				MuscleGroup:  domain.Back,
+				Sets:         3,
+				Reps:         5,
+				Weight:       tt.weight,
+				WeightUnit:   tt.weightUnit,
+				ProgramID:    tt.programID,
+			}
+			if err := mockRepo.CreateWorkout(workout); err != nil {
+				t.Fatalf("Failed to setup workout: %v", err)
+			}
+
+			reps := 5
+			err := manager.UpdateWorkout(UpdateWorkoutRequest{
+				ID:           workout.ID,
+				ExerciseType: domain.Deadlift,
+				Status:       &tt.status,
+				Reps:         &reps,
+			})
+			if err != nil {
+				t.Fatalf("UpdateWorkout() error = %v", err)
+			}
+
+			count, _ := mockRepo.GetWorkoutCount()
+			if (count == 2) != tt.wantCreated {
+				t.Fatalf("Expected created=%v, got %d workouts", tt.wantCreated, count)
+			}
+			if !tt.wantCreated {
+				return
+			}
+
+			next, err := mockRepo.GetWorkout(workout.ID + 1)
+			if err != nil {
+				t.Fatalf("Failed to get next workout: %v", err)
+			}
+			if next.Status != domain.WorkoutStatusPlanned || next.Weight != tt.wantWeight || next.Reps != 5 || next.Sets != 3 {
+				t.Errorf("Expected planned %.2fkg × 5 × 3, got %+v", tt.wantWeight, next)
+			}
+			if next.WeightUnit != tt.weightUnit {
+				t.Errorf("Expected weight unit %s, got %s", tt.weightUnit.Key(), next.WeightUnit.Key())
+			}
+			if next.Difficulty != domain.DifficultyAdvanced || next.MuscleGroup != domain.Back {
+				t.Errorf("Expected difficulty and muscle group to be copied, got %+v", next)
+			}
+			if next.Description != "" {
+				t.Errorf("Expected empty description (localized at display time), got %q", next.Description)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+// GetTrainingStatsRequest トレーニング統計取得リクエスト
+type GetTrainingStatsRequest struct {
+	Period   domain.StatsPeriod // 集計単位（日・週・月）
+	DateFrom *time.Time         // オプション: nilなら下限なし（この日時を含む）
+	DateTo   *time.Time         // オプション: nilなら上限なし（この日時を含まない）
+	Location *time.Location     // オプション: 集計期間の区切りに使用するタイムゾーン。nilならデフォルトのタイムゾーン
+}
+
+// GetTrainingStats 集計期間ごとのトレーニング統計を取得（ビジネスロジック層）
+func (wm *WorkoutManager) GetTrainingStats(req GetTrainingStatsRequest) ([]*domain.TrainingStatsBucket, error) {
+	wm, span := wm.startSpan("GetTrainingStats")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validate(func() error {
+		if req.Period < domain.StatsPeriodDay || req.Period > domain.StatsPeriodMonth {
+			return fmt.Errorf("invalid stats period: %d", req.Period)
+		}
+		return nil
+	})
+	validator.validateDateRange(req.DateFrom, req.DateTo)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetTrainingStats",
+			Message: "training stats input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	loc := wm.location
+	if req.Location != nil {
+		loc = req.Location
+	}
+	buckets, err := wm.repo.GetTrainingStats(domain.TrainingStatsQuery{
+		Period:   req.Period,
+		DateFrom: req.DateFrom,
+		DateTo:   req.DateTo,
+		Location: loc,
+	})
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetTrainingStats",
+			Message: "failed to aggregate training stats in repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	wm.logger.Debug("トレーニング統計を集計しました", slog.String(logging.KeyOp, "GetTrainingStats"), slog.Int("buckets", len(buckets)))
+	return buckets, nil
+}
+
+// validateDateRange 期間の妥当性を検証（開始が終了より後でないこと）
+func (ev *errValidator) validateDateRange(from, to *time.Time) {
+	ev.validate(func() error {
+		if from != nil && to != nil && !from.Before(*to) {
+			return fmt.Errorf("date_from must be before date_to: %s >= %s", from.Format(time.RFC3339), to.Format(time.RFC3339))
+		}
+		return nil
+	})
+}
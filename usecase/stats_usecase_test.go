@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestGetTrainingStats テーブル駆動テストで期間別のトレーニング統計をテスト
+func TestGetTrainingStats(t *testing.T) {
+	// 2024-01-01は月曜日
+	monday := time.Date(2024, 1, 1, 9, 0, 0, 0, time.Local)
+	completedAt := func(t time.Time) *time.Time { return &t }
+
+	setupWorkouts := []*domain.Workout{
+		{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, Difficulty: domain.DifficultyAdvanced, MuscleGroup: domain.Chest, Sets: 3, Reps: 10, Weight: 60.0, CreatedAt: monday, CompletedAt: completedAt(monday)},
+		{ExerciseType: domain.Squat, Status: domain.WorkoutStatusCompleted, Difficulty: domain.DifficultyIntermediate, MuscleGroup: domain.Legs, Sets: 5, Reps: 5, Weight: 100.0, CreatedAt: monday, CompletedAt: completedAt(monday.AddDate(0, 0, 2))},
+		{ExerciseType: domain.PullUp, Status: domain.WorkoutStatusSkipped, Difficulty: domain.DifficultyBeginner, MuscleGroup: domain.Back, Sets: 3, Reps: 8, CreatedAt: monday.AddDate(0, 0, 3)},
+		{ExerciseType: domain.Deadlift, Status: domain.WorkoutStatusCompleted, Difficulty: domain.DifficultyBeast, MuscleGroup: domain.Back, Sets: 3, Reps: 5, Weight: 140.0, CreatedAt: monday.AddDate(0, 0, 7), CompletedAt: completedAt(monday.AddDate(0, 0, 8))},
+	}
+
+	from := monday
+	to := monday.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name          string
+		request       GetTrainingStatsRequest
+		wantBuckets   int
+		wantVolume    float64 // 最初の期間のボリューム
+		wantCompleted int     // 最初の期間の完了数
+		wantSkipped   int     // 最初の期間のスキップ数
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: 週別",
+			request:       GetTrainingStatsRequest{Period: domain.StatsPeriodWeek},
+			wantBuckets:   2,
+			wantVolume:    3*10*60.0 + 5*5*100.0,
+			wantCompleted: 2,
+			wantSkipped:   1,
+			description:   "月曜始まりで2週分に集計",
+		},
+		{
+			name:          "正常系: 日別",
+			request:       GetTrainingStatsRequest{Period: domain.StatsPeriodDay},
+			wantBuckets:   4,
+			wantVolume:    3 * 10 * 60.0,
+			wantCompleted: 1,
+			description:   "完了日（未完了なら作成日）で日別に集計",
+		},
+		{
+			name:          "正常系: 月別・期間指定",
+			request:       GetTrainingStatsRequest{Period: domain.StatsPeriodMonth, DateFrom: &from, DateTo: &to},
+			wantBuckets:   1,
+			wantVolume:    3*10*60.0 + 5*5*100.0,
+			wantCompleted: 2,
+			wantSkipped:   1,
+			description:   "期間外（翌週）のワークアウトは除外",
+		},
+		{
+			name:        "異常系: 期間が逆転",
+			request:     GetTrainingStatsRequest{Period: domain.StatsPeriodWeek, DateFrom: &to, DateTo: &from},
+			wantErr:     true,
+			description: "開始日が終了日より後の場合はエラー",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			for _, workout := range setupWorkouts {
+				w := *workout
+				if err := mockRepo.CreateWorkout(&w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			buckets, err := manager.GetTrainingStats(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetTrainingStats() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(buckets) != tt.wantBuckets {
+				t.Fatalf("Expected %d buckets, got %d", tt.wantBuckets, len(buckets))
+			}
+			first := buckets[0]
+			if !first.PeriodStart.Equal(tt.request.Period.BucketStart(monday)) {
+				t.Errorf("Expected first period %v, got %v", tt.request.Period.BucketStart(monday), first.PeriodStart)
+			}
+			if first.TotalVolume != tt.wantVolume {
+				t.Errorf("Expected TotalVolume=%.1f, got %.1f", tt.wantVolume, first.TotalVolume)
+			}
+			if first.CompletedCount != tt.wantCompleted {
+				t.Errorf("Expected CompletedCount=%d, got %d", tt.wantCompleted, first.CompletedCount)
+			}
+			if first.SkippedCount != tt.wantSkipped {
+				t.Errorf("Expected SkippedCount=%d, got %d", tt.wantSkipped, first.SkippedCount)
+			}
+		})
+	}
+}
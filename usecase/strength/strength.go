@@ -0,0 +1,146 @@
+package strength
+
+import (
+	"fmt"
+	"math"
+
+	"golv2-learning-app/domain"
+)
+
+// Formula 1RM（最大挙上重量）の推定式
+type Formula int
+
+const (
+	FormulaEpley    Formula = iota // Epley式: weight × (1 + reps / 30)
+	FormulaBrzycki                 // Brzycki式: weight × 36 / (37 - reps)
+	FormulaLombardi                // Lombardi式: weight × reps^0.10
+	FormulaRPE                     // RPEチャート（RTS）による推定
+)
+
+// DefaultFormula ワークアウト単位のe1RM計算で使用するデフォルトの推定式（domain.EstimateOneRepMaxと同じ）
+const DefaultFormula = FormulaEpley
+
+// Formulas 利用可能な全ての推定式
+var Formulas = []Formula{FormulaEpley, FormulaBrzycki, FormulaLombardi, FormulaRPE}
+
+// String 推定式の名前を返す
+func (f Formula) String() string {
+	switch f {
+	case FormulaEpley:
+		return "Epley"
+	case FormulaBrzycki:
+		return "Brzycki"
+	case FormulaLombardi:
+		return "Lombardi"
+	case FormulaRPE:
+		return "RPE"
+	default:
+		return "Unknown"
+	}
+}
+
+const (
+	maxBrzyckiReps = 36   // Brzycki式は37回以上で発散する
+	minRPE         = 6.0  // RPEチャートの下限
+	maxRPE         = 10.0 // RPEチャートの上限
+	maxRPEReps     = 12   // RPEチャートのレップ数上限
+)
+
+// rpeChart RPEチャートの%1RM（RPE10・1回=100%から0.5RPE刻みで並べたもの）
+// 0.5RPE下がる毎に1段、1レップ増える毎に2段ずれる
+var rpeChart = []float64{
+	100.0, 97.8, 95.5, 93.9, 92.2, 90.7, 89.2, 87.8, 86.3, 85.0,
+	83.7, 82.4, 81.1, 79.9, 78.6, 77.4, 76.2, 75.1, 73.9, 72.3,
+	70.7, 69.4, 68.0, 66.7, 65.3, 64.0, 62.6, 61.3, 59.9, 58.6,
+	57.4,
+}
+
+// Estimate 推定結果
+type Estimate struct {
+	Formula   Formula
+	OneRepMax float64
+}
+
+// EstimateOneRepMax 指定した推定式で1RMを計算する
+// rpeはFormulaRPEの場合のみ使用する（6.0〜10.0、0.5刻み）
+func EstimateOneRepMax(formula Formula, weight float64, reps int, rpe float64) (float64, error) {
+	if weight < 0 {
+		return 0, fmt.Errorf("weight cannot be negative: %.2f", weight)
+	}
+	if reps <= 0 {
+		return 0, fmt.Errorf("reps must be positive: %d", reps)
+	}
+	if weight == 0 {
+		return 0, nil
+	}
+
+	switch formula {
+	case FormulaEpley:
+		return domain.EstimateOneRepMax(weight, reps), nil
+	case FormulaBrzycki:
+		if reps > maxBrzyckiReps {
+			return 0, fmt.Errorf("brzycki formula supports up to %d reps: %d", maxBrzyckiReps, reps)
+		}
+		return weight * 36 / float64(37-reps), nil
+	case FormulaLombardi:
+		return weight * math.Pow(float64(reps), 0.10), nil
+	case FormulaRPE:
+		percent, err := PercentFromRPE(reps, rpe)
+		if err != nil {
+			return 0, err
+		}
+		return weight / (percent / 100), nil
+	default:
+		return 0, fmt.Errorf("unknown formula: %d", formula)
+	}
+}
+
+// EstimateAll 適用可能な全ての推定式で1RMを計算する
+// 入力値がその推定式の適用範囲外の場合は結果から除外する
+func EstimateAll(weight float64, reps int, rpe float64) []Estimate {
+	estimates := make([]Estimate, 0, len(Formulas))
+	for _, formula := range Formulas {
+		if formula == FormulaRPE && rpe == 0 {
+			continue
+		}
+		oneRepMax, err := EstimateOneRepMax(formula, weight, reps, rpe)
+		if err != nil {
+			continue
+		}
+		estimates = append(estimates, Estimate{Formula: formula, OneRepMax: oneRepMax})
+	}
+	return estimates
+}
+
+// PercentFromRPE RPEチャートからレップ数とRPEに対応する%1RMを返す
+func PercentFromRPE(reps int, rpe float64) (float64, error) {
+	if reps <= 0 || reps > maxRPEReps {
+		return 0, fmt.Errorf("rpe chart supports 1 to %d reps: %d", maxRPEReps, reps)
+	}
+	if rpe < minRPE || rpe > maxRPE {
+		return 0, fmt.Errorf("rpe must be between %.1f and %.1f: %.1f", minRPE, maxRPE, rpe)
+	}
+	halfSteps := (maxRPE - rpe) * 2
+	if halfSteps != math.Trunc(halfSteps) {
+		return 0, fmt.Errorf("rpe must be in 0.5 increments: %.2f", rpe)
+	}
+	return rpeChart[(reps-1)*2+int(halfSteps)], nil
+}
+
+// PercentOfOneRepMax 重量が1RMの何%にあたるかを返す（0.0〜1.0）
+// 1RMが0以下の場合は0を返す
+func PercentOfOneRepMax(weight, oneRepMax float64) float64 {
+	if oneRepMax <= 0 {
+		return 0
+	}
+	return weight / oneRepMax
+}
+
+// RoundToIncrement 重量を実際に組める刻み（例: 2.5kg）の最も近い値に丸める
+// 刻みが0以下の場合はそのまま返す
+func RoundToIncrement(weight, increment float64) float64 {
+	if increment <= 0 {
+		return weight
+	}
+	return math.Round(weight/increment) * increment
+}
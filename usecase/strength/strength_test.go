@@ -0,0 +1,163 @@
+package strength
+
+import (
+	"math"
+	"testing"
+)
+
+// TestEstimateOneRepMax テーブル駆動テストで各推定式の1RM計算をテスト
+func TestEstimateOneRepMax(t *testing.T) {
+	tests := []struct {
+		name        string
+		formula     Formula
+		weight      float64
+		reps        int
+		rpe         float64
+		want        float64
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: Epley式",
+			formula:     FormulaEpley,
+			weight:      100.0,
+			reps:        5,
+			want:        116.67,
+			description: "100kg × 5回 → 100 × (1 + 5/30)",
+		},
+		{
+			name:        "正常系: Epley式（1回は重量そのまま）",
+			formula:     FormulaEpley,
+			weight:      140.0,
+			reps:        1,
+			want:        140.0,
+			description: "1回挙上の場合は使用重量が1RM",
+		},
+		{
+			name:        "正常系: Brzycki式",
+			formula:     FormulaBrzycki,
+			weight:      100.0,
+			reps:        10,
+			want:        133.33,
+			description: "100kg × 10回 → 100 × 36 / 27",
+		},
+		{
+			name:        "正常系: Lombardi式",
+			formula:     FormulaLombardi,
+			weight:      100.0,
+			reps:        10,
+			want:        125.89,
+			description: "100kg × 10回 → 100 × 10^0.10",
+		},
+		{
+			name:        "正常系: RPEチャート",
+			formula:     FormulaRPE,
+			weight:      81.1,
+			reps:        5,
+			rpe:         8.0,
+			want:        100.0,
+			description: "5回 @RPE8 は81.1%",
+		},
+		{
+			name:        "正常系: 重量0は0を返す",
+			formula:     FormulaEpley,
+			weight:      0,
+			reps:        15,
+			want:        0,
+			description: "自重種目など重量なしの場合",
+		},
+		{
+			name:        "異常系: 回数0",
+			formula:     FormulaEpley,
+			weight:      100.0,
+			reps:        0,
+			wantErr:     true,
+			description: "回数は1以上が必要",
+		},
+		{
+			name:        "異常系: Brzycki式の適用範囲外",
+			formula:     FormulaBrzycki,
+			weight:      50.0,
+			reps:        40,
+			wantErr:     true,
+			description: "37回以上は計算できない",
+		},
+		{
+			name:        "異常系: RPEが範囲外",
+			formula:     FormulaRPE,
+			weight:      100.0,
+			reps:        3,
+			rpe:         5.0,
+			wantErr:     true,
+			description: "RPEチャートは6.0〜10.0",
+		},
+		{
+			name:        "異常系: RPEが0.5刻みでない",
+			formula:     FormulaRPE,
+			weight:      100.0,
+			reps:        3,
+			rpe:         8.3,
+			wantErr:     true,
+			description: "RPEは0.5刻みのみ対応",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EstimateOneRepMax(tt.formula, tt.weight, tt.reps, tt.rpe)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EstimateOneRepMax() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if math.Abs(got-tt.want) > 0.01 {
+				t.Errorf("Expected 1RM=%.2f, got %.2f", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestEstimateAll RPE未指定時はRPEチャートを除外することを確認
+func TestEstimateAll(t *testing.T) {
+	estimates := EstimateAll(100.0, 5, 0)
+	if len(estimates) != 3 {
+		t.Fatalf("Expected 3 estimates, got %d", len(estimates))
+	}
+	for _, estimate := range estimates {
+		if estimate.Formula == FormulaRPE {
+			t.Error("RPE formula should be skipped when rpe is not specified")
+		}
+	}
+
+	estimates = EstimateAll(100.0, 5, 9.0)
+	if len(estimates) != 4 {
+		t.Errorf("Expected 4 estimates with rpe, got %d", len(estimates))
+	}
+}
+
+// TestRoundToIncrement テーブル駆動テストで重量の丸めをテスト
+func TestRoundToIncrement(t *testing.T) {
+	tests := []struct {
+		name      string
+		weight    float64
+		increment float64
+		want      float64
+	}{
+		{name: "正常系: 2.5kg刻みに切り上げ", weight: 86.3, increment: 2.5, want: 87.5},
+		{name: "正常系: 2.5kg刻みに切り捨て", weight: 83.7, increment: 2.5, want: 82.5},
+		{name: "正常系: ちょうど刻みの値", weight: 100, increment: 2.5, want: 100},
+		{name: "境界値: 刻みが0ならそのまま", weight: 86.3, increment: 0, want: 86.3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RoundToIncrement(tt.weight, tt.increment); got != tt.want {
+				t.Errorf("RoundToIncrement(%.1f, %.1f) = %.2f, want %.2f", tt.weight, tt.increment, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,407 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/strength"
+)
+
+// DefaultRestDuration セット間の休憩時間のデフォルト
+const DefaultRestDuration = 90 * time.Second
+
+// 記録できない理由（errors.Isで判定する）
+var (
+	ErrWorkoutNotInProgress = errors.New("workout is not in progress")
+	ErrInvalidTrackingInput = errors.New("invalid tracking input") // セット・メモの内容が不正（記録は継続できる）
+)
+
+// StartTrackingRequest 実施中のワークアウトの記録開始リクエスト
+type StartTrackingRequest struct {
+	WorkoutID    domain.WorkoutID // 必須: 実施中のワークアウト
+	RestDuration time.Duration    // オプション: セット間の休憩時間（0ならDefaultRestDuration）
+}
+
+// CompleteSetRequest セット完了リクエスト
+type CompleteSetRequest struct {
+	Reps       int               // 必須: 反復回数
+	Weight     float64           // 使用重量（自重なら0）
+	WeightUnit domain.WeightUnit // Weightの単位（ゼロ値はkg）
+	RPE        float64           // オプション: 0なら未記録
+}
+
+// SetResult セットの記録結果
+type SetResult struct {
+	Set             *domain.WorkoutSet
+	PersonalRecords []*domain.PersonalRecord // このセットで更新した自己ベスト
+	RestUntil       *time.Time               // 休憩の終了予定（一時停止中はnil）
+}
+
+// RestTimerExpiry 休憩時間の終了通知
+type RestTimerExpiry struct {
+	SetNumber int           // 直前に完了したセット
+	Rest      time.Duration // 休憩時間
+}
+
+// TrackingSession 実施中のワークアウトの記録
+// セットは完了するたびに保存するため、途中で切断されても記録済みのセットは失われない
+// RestTimerExpired以外のメソッドは1つのゴルーチンから呼び出すこと
+type TrackingSession struct {
+	wm           *WorkoutManager
+	workout      *domain.Workout
+	sets         []*domain.WorkoutSet
+	best         domain.ExerciseBest
+	restDuration time.Duration
+	restExpired  chan RestTimerExpiry
+
+	mu            sync.Mutex // 以下の休憩タイマーの状態を保護（タイマーは別のゴルーチンで発火する）
+	paused        bool
+	restTimer     *time.Timer
+	restSetNumber int           // 休憩の対象のセット（休憩中でなければ0）
+	restUntil     time.Time     // 休憩の終了予定（タイマー作動中のみ）
+	restRemaining time.Duration // 一時停止した時点の休憩の残り時間
+	timerGen      int           // 止めたタイマーが遅れて発火しても通知しないための世代番号
+	closed        bool
+}
+
+// StartTracking 実施中のワークアウトの記録を開始する（ビジネスロジック層）
+// 記録済みのセットがあれば続きから記録する。呼び出し元は使い終わったらFinishかCloseを呼ぶこと
+func (wm *WorkoutManager) StartTracking(req StartTrackingRequest) (*TrackingSession, error) {
+	sessionManager := wm // セッションはスパンの終了後も使うため、スパンの外のWorkoutManagerを渡す
+	wm, span := wm.startSpan("StartTracking")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateID(req.WorkoutID)
+	validator.validate(func() error {
+		if req.RestDuration < 0 {
+			return fmt.Errorf("rest duration cannot be negative: %v", req.RestDuration)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		return nil, wm.trackingError("StartTracking", domain.ExerciseUnspecified, "tracking input validation failed",
+			fmt.Errorf("%w: %v", ErrInvalidTrackingInput, err))
+	}
+
+	workout, err := wm.repo.GetWorkout(req.WorkoutID)
+	if err != nil {
+		return nil, wm.trackingError("StartTracking", domain.ExerciseUnspecified,
+			fmt.Sprintf("failed to get workout for tracking (ID: %d)", req.WorkoutID), err)
+	}
+	if workout.Status != domain.WorkoutStatusInProgress {
+		return nil, wm.trackingError("StartTracking", workout.ExerciseType,
+			fmt.Sprintf("only in-progress workouts can be tracked (ID: %d)", req.WorkoutID), ErrWorkoutNotInProgress)
+	}
+
+	sets, err := wm.repo.ListWorkoutSets(workout.ID)
+	if err != nil {
+		return nil, wm.trackingError("StartTracking", workout.ExerciseType,
+			fmt.Sprintf("failed to list recorded sets (ID: %d)", workout.ID), err)
+	}
+	best, err := wm.repo.GetExerciseBest(workout.ExerciseType)
+	if err != nil {
+		return nil, wm.trackingError("StartTracking", workout.ExerciseType, "failed to get exercise best", err)
+	}
+
+	restDuration := req.RestDuration
+	if restDuration == 0 {
+		restDuration = DefaultRestDuration
+	}
+	wm.logger.Info("ワークアウトの記録を開始しました",
+		slog.String(logging.KeyOp, "StartTracking"), slog.Int64(logging.KeyWorkoutID, int64(workout.ID)), slog.Int("recorded_sets", len(sets)))
+	return &TrackingSession{
+		wm:           sessionManager,
+		workout:      workout,
+		sets:         sets,
+		best:         *best,
+		restDuration: restDuration,
+		restExpired:  make(chan RestTimerExpiry, 1),
+	}, nil
+}
+
+// trackingError エラーをWorkoutErrorにまとめてログに出力する
+func (wm *WorkoutManager) trackingError(op string, exerciseType domain.ExerciseType, message string, err error) error {
+	workoutErr := &appErrors.WorkoutError{
+		Op:           op,
+		ExerciseType: exerciseType,
+		Message:      message,
+		Err:          err,
+	}
+	wm.logError(workoutErr)
+	return workoutErr
+}
+
+// Workout 記録中のワークアウト
+func (s *TrackingSession) Workout() *domain.Workout {
+	return s.workout
+}
+
+// Sets 記録済みのセット（セット番号の昇順）
+func (s *TrackingSession) Sets() []*domain.WorkoutSet {
+	return s.sets
+}
+
+// RestDuration セット間の休憩時間
+func (s *TrackingSession) RestDuration() time.Duration {
+	return s.restDuration
+}
+
+// RestTimerExpired 休憩時間が終わると通知される
+func (s *TrackingSession) RestTimerExpired() <-chan RestTimerExpiry {
+	return s.restExpired
+}
+
+// CompleteSet セットを保存し、自己ベストの更新を判定して休憩タイマーを開始する
+func (s *TrackingSession) CompleteSet(req CompleteSetRequest) (*SetResult, error) {
+	weight := req.WeightUnit.ToKilograms(req.Weight)
+	validator := &errValidator{}
+	validator.validateReps(req.Reps)
+	validator.validateWeight(weight)
+	validator.validate(func() error {
+		if req.RPE < 0 || req.RPE > domain.MaxRPE {
+			return fmt.Errorf("rpe must be between 0 and %.0f: %.1f", domain.MaxRPE, req.RPE)
+		}
+		return nil
+	})
+	if err := validator.error(); err != nil {
+		return nil, s.wm.trackingError("CompleteSet", s.workout.ExerciseType, "set input validation failed",
+			fmt.Errorf("%w: %v", ErrInvalidTrackingInput, err))
+	}
+
+	set := &domain.WorkoutSet{
+		WorkoutID:   s.workout.ID,
+		SetNumber:   len(s.sets) + 1,
+		Reps:        req.Reps,
+		Weight:      weight,
+		RPE:         req.RPE,
+		CompletedAt: time.Now(),
+	}
+	if err := s.wm.repo.CreateWorkoutSet(set); err != nil {
+		return nil, s.wm.trackingError("CompleteSet", s.workout.ExerciseType,
+			fmt.Sprintf("failed to save set %d (ID: %d)", set.SetNumber, s.workout.ID), err)
+	}
+	s.sets = append(s.sets, set)
+	s.wm.logger.Debug("セットを記録しました",
+		slog.String(logging.KeyOp, "CompleteSet"), slog.Int64(logging.KeyWorkoutID, int64(s.workout.ID)),
+		slog.Int("set_number", set.SetNumber), slog.Float64("weight", set.Weight), slog.Int("reps", set.Reps))
+
+	result := &SetResult{Set: set, PersonalRecords: s.checkPersonalRecords(set)}
+	for _, record := range result.PersonalRecords {
+		s.wm.logger.Info("自己ベストを更新しました",
+			slog.String(logging.KeyOp, "CompleteSet"), slog.Int64(logging.KeyWorkoutID, int64(s.workout.ID)),
+			slog.String("record_type", record.Type.Japanese()), slog.Float64("previous", record.Previous), slog.Float64("value", record.Value))
+	}
+	result.RestUntil = s.startRest(set.SetNumber)
+	return result, nil
+}
+
+// checkPersonalRecords セットが自己ベストを更新したか判定し、更新した場合は以降の基準にする
+// 重量0（自重）のセットは対象外
+func (s *TrackingSession) checkPersonalRecords(set *domain.WorkoutSet) []*domain.PersonalRecord {
+	if set.Weight <= 0 {
+		return nil
+	}
+	records := make([]*domain.PersonalRecord, 0, 2)
+	newRecord := func(recordType domain.PersonalRecordType, value, previous float64) *domain.PersonalRecord {
+		return &domain.PersonalRecord{
+			Type:         recordType,
+			ExerciseType: s.workout.ExerciseType,
+			Value:        value,
+			Previous:     previous,
+			Set:          set,
+		}
+	}
+	if set.Weight > s.best.MaxWeight {
+		records = append(records, newRecord(domain.PersonalRecordWeight, set.Weight, s.best.MaxWeight))
+		s.best.MaxWeight = set.Weight
+	}
+	oneRepMax, err := strength.EstimateOneRepMax(strength.DefaultFormula, set.Weight, set.Reps, 0)
+	if err == nil && oneRepMax > s.best.OneRepMax {
+		records = append(records, newRecord(domain.PersonalRecordOneRepMax, oneRepMax, s.best.OneRepMax))
+		s.best.OneRepMax = oneRepMax
+	}
+	return records
+}
+
+// AddNote ワークアウトのメモに1行追記して保存する
+func (s *TrackingSession) AddNote(text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return s.wm.trackingError("AddNote", s.workout.ExerciseType, "note input validation failed",
+			fmt.Errorf("%w: note must not be empty", ErrInvalidTrackingInput))
+	}
+
+	notes := text
+	if s.workout.Notes != "" {
+		notes = s.workout.Notes + "\n" + text
+	}
+	updated := *s.workout
+	updated.Notes = notes
+	updated.UpdatedAt = time.Now()
+	if err := s.wm.repo.UpdateWorkout(&updated); err != nil {
+		return s.wm.trackingError("AddNote", s.workout.ExerciseType,
+			fmt.Sprintf("failed to save note (ID: %d)", s.workout.ID), err)
+	}
+	*s.workout = updated
+	s.wm.publishEvent(domain.WorkoutEventUpdated, s.workout)
+	return nil
+}
+
+// Pause 一時停止する（休憩中なら残り時間を保持してタイマーを止める）
+// 休憩の残り時間を返す（休憩中でなければ0）
+func (s *TrackingSession) Pause() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.paused || s.closed {
+		return s.restRemaining
+	}
+	s.paused = true
+	if s.restTimer != nil {
+		s.stopTimerLocked()
+		s.restRemaining = max(time.Until(s.restUntil), 0)
+	}
+	s.wm.logger.Debug("ワークアウトの記録を一時停止しました", slog.String(logging.KeyOp, "Pause"), slog.Int64(logging.KeyWorkoutID, int64(s.workout.ID)))
+	return s.restRemaining
+}
+
+// Resume 再開する（一時停止前に休憩中だった場合は残り時間からタイマーを再開する）
+// 休憩の残り時間を返す（休憩中でなければ0）
+func (s *TrackingSession) Resume() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.paused || s.closed {
+		return 0
+	}
+	s.paused = false
+	remaining := s.restRemaining
+	s.restRemaining = 0
+	if s.restSetNumber > 0 {
+		s.startTimerLocked(remaining)
+	}
+	s.wm.logger.Debug("ワークアウトの記録を再開しました", slog.String(logging.KeyOp, "Resume"), slog.Int64(logging.KeyWorkoutID, int64(s.workout.ID)))
+	return remaining
+}
+
+// startRest セットの完了後に休憩を開始し、終了予定日時を返す（一時停止中は再開後に開始するためnil）
+func (s *TrackingSession) startRest(setNumber int) *time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.stopTimerLocked()
+	s.restSetNumber = setNumber
+	if s.paused {
+		s.restRemaining = s.restDuration
+		return nil
+	}
+	s.startTimerLocked(s.restDuration)
+	restUntil := s.restUntil
+	return &restUntil
+}
+
+// startTimerLocked 休憩タイマーを開始する（s.muを保持して呼び出す）
+func (s *TrackingSession) startTimerLocked(d time.Duration) {
+	s.timerGen++
+	gen := s.timerGen
+	setNumber := s.restSetNumber
+	s.restUntil = time.Now().Add(d)
+	s.restTimer = time.AfterFunc(d, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if gen != s.timerGen || s.closed {
+			return
+		}
+		s.restTimer = nil
+		s.restSetNumber = 0
+		select {
+		case s.restExpired <- RestTimerExpiry{SetNumber: setNumber, Rest: s.restDuration}:
+		default:
+			// 未受信の通知があれば、より新しい休憩の通知は不要
+		}
+	})
+}
+
+// stopTimerLocked 休憩タイマーを止める（s.muを保持して呼び出す）
+func (s *TrackingSession) stopTimerLocked() {
+	s.timerGen++
+	if s.restTimer != nil {
+		s.restTimer.Stop()
+		s.restTimer = nil
+	}
+}
+
+// Finish 記録を終了してワークアウトを完了にする
+// 記録したセットがあれば、セット数と最も重いセットの重量・回数をワークアウトに反映する
+// 重量はワークアウトを記録した単位のまま更新する（lbで記録したワークアウトはlbのまま）
+func (s *TrackingSession) Finish() (*domain.Workout, error) {
+	s.Close()
+
+	completed := domain.WorkoutStatusCompleted
+	req := UpdateWorkoutRequest{
+		ID:           s.workout.ID,
+		ExerciseType: s.workout.ExerciseType,
+		Status:       &completed,
+	}
+	if top := topSet(s.sets); top != nil {
+		setCount := len(s.sets)
+		req.Sets = &setCount
+		req.Reps = &top.Reps
+		weight := s.workout.WeightUnit.FromKilogramsRounded(top.Weight)
+		req.Weight = &weight
+		req.WeightUnit = s.workout.WeightUnit
+	}
+	if err := s.wm.UpdateWorkout(req); err != nil {
+		return nil, err
+	}
+
+	workout, err := s.wm.repo.GetWorkout(s.workout.ID)
+	if err != nil {
+		return nil, s.wm.trackingError("Finish", s.workout.ExerciseType,
+			fmt.Sprintf("failed to get completed workout (ID: %d)", s.workout.ID), err)
+	}
+	s.workout = workout
+	s.wm.logger.Info("ワークアウトの記録を終了しました",
+		slog.String(logging.KeyOp, "Finish"), slog.Int64(logging.KeyWorkoutID, int64(workout.ID)),
+		slog.Int("sets", len(s.sets)), slog.Float64("volume", s.TotalVolume()))
+	return workout, nil
+}
+
+// TotalVolume 記録したセットの重量 × 回数の合計
+func (s *TrackingSession) TotalVolume() float64 {
+	total := 0.0
+	for _, set := range s.sets {
+		total += set.Weight * float64(set.Reps)
+	}
+	return total
+}
+
+// Close 休憩タイマーを止めて記録を終了する（ワークアウトは実施中のまま。複数回呼び出してもよい）
+func (s *TrackingSession) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopTimerLocked()
+	s.closed = true
+}
+
+// topSet 最も重いセット（同じ重量なら回数の多いセット）。セットがなければnil
+func topSet(sets []*domain.WorkoutSet) *domain.WorkoutSet {
+	var top *domain.WorkoutSet
+	for _, set := range sets {
+		if top == nil || set.Weight > top.Weight || (set.Weight == top.Weight && set.Reps > top.Reps) {
+			top = set
+		}
+	}
+	return top
+}
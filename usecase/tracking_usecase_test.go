@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// newTrackingManager 完了済みのベンチプレス（100kg × 5回）と実施中のワークアウトを用意する
+func newTrackingManager(t *testing.T) (*WorkoutManager, domain.WorkoutID) {
+	t.Helper()
+	manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	inProgress := domain.WorkoutStatusInProgress
+	completed := domain.WorkoutStatusCompleted
+	for _, status := range []*domain.WorkoutStatus{&completed, &inProgress} {
+		workout, err := manager.CreateWorkout(CreateWorkoutRequest{ExerciseType: domain.BenchPress, Sets: 3, Reps: 5, Weight: 100})
+		if err != nil {
+			t.Fatalf("CreateWorkout() error = %v", err)
+		}
+		if err := manager.UpdateWorkout(UpdateWorkoutRequest{ID: workout.ID, ExerciseType: domain.BenchPress, Status: status}); err != nil {
+			t.Fatalf("UpdateWorkout() error = %v", err)
+		}
+	}
+	return manager, 2
+}
+
+// TestTrackingSession_CompleteSet テーブル駆動テストでセットの保存と自己ベストの判定をテスト
+func TestTrackingSession_CompleteSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		sets        []CompleteSetRequest
+		wantRecords [][]domain.PersonalRecordType // セットごとに期待する自己ベストの種類
+		wantErr     error
+		description string
+	}{
+		{
+			name:        "正常系: 自己ベスト未満",
+			sets:        []CompleteSetRequest{{Reps: 5, Weight: 90}},
+			wantRecords: [][]domain.PersonalRecordType{nil},
+			description: "100kg × 5回を超えないため通知しない",
+		},
+		{
+			name: "正常系: 重量と推定1RMを更新",
+			sets: []CompleteSetRequest{{Reps: 5, Weight: 105}, {Reps: 5, Weight: 105}},
+			wantRecords: [][]domain.PersonalRecordType{
+				{domain.PersonalRecordWeight, domain.PersonalRecordOneRepMax},
+				nil,
+			},
+			description: "更新した記録が以降の基準になるため、同じ内容のセットでは通知しない",
+		},
+		{
+			name:        "正常系: 推定1RMのみ更新",
+			sets:        []CompleteSetRequest{{Reps: 10, Weight: 95, RPE: 9}},
+			wantRecords: [][]domain.PersonalRecordType{{domain.PersonalRecordOneRepMax}},
+			description: "95kg × 10回の推定1RMは100kg × 5回を上回る",
+		},
+		{
+			name:        "正常系: 自重",
+			sets:        []CompleteSetRequest{{Reps: 20}},
+			wantRecords: [][]domain.PersonalRecordType{nil},
+			description: "重量0のセットは自己ベストの対象外",
+		},
+		{
+			name:        "異常系: 回数が0",
+			sets:        []CompleteSetRequest{{Reps: 0, Weight: 60}},
+			wantErr:     ErrInvalidTrackingInput,
+			description: "不正なセットは保存しない",
+		},
+		{
+			name:        "異常系: RPEが範囲外",
+			sets:        []CompleteSetRequest{{Reps: 5, Weight: 60, RPE: 11}},
+			wantErr:     ErrInvalidTrackingInput,
+			description: "RPEは0〜10",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager, id := newTrackingManager(t)
+			session, err := manager.StartTracking(StartTrackingRequest{WorkoutID: id, RestDuration: time.Hour})
+			if err != nil {
+				t.Fatalf("StartTracking() error = %v", err)
+			}
+			defer session.Close()
+
+			for i, req := range tt.sets {
+				result, err := session.CompleteSet(req)
+				if tt.wantErr != nil {
+					if !errors.Is(err, tt.wantErr) {
+						t.Fatalf("CompleteSet() error = %v, want %v", err, tt.wantErr)
+					}
+					if len(session.Sets()) != 0 {
+						t.Errorf("Expected no sets to be saved, got %d", len(session.Sets()))
+					}
+					return
+				}
+				if err != nil {
+					t.Fatalf("CompleteSet() error = %v", err)
+				}
+				if result.Set.SetNumber != i+1 || result.Set.ID == 0 {
+					t.Errorf("Expected saved set %d, got number %d (ID %d)", i+1, result.Set.SetNumber, result.Set.ID)
+				}
+				if result.RestUntil == nil {
+					t.Error("Expected the rest timer to start")
+				}
+				if len(result.PersonalRecords) != len(tt.wantRecords[i]) {
+					t.Fatalf("Set %d: expected records %v, got %d", i+1, tt.wantRecords[i], len(result.PersonalRecords))
+				}
+				for j, record := range result.PersonalRecords {
+					if record.Type != tt.wantRecords[i][j] {
+						t.Errorf("Set %d: expected %s, got %s", i+1, tt.wantRecords[i][j].Japanese(), record.Type.Japanese())
+					}
+				}
+			}
+
+			saved, err := manager.repo.ListWorkoutSets(id)
+			if err != nil {
+				t.Fatalf("ListWorkoutSets() error = %v", err)
+			}
+			if len(saved) != len(tt.sets) {
+				t.Errorf("Expected %d saved sets, got %d", len(tt.sets), len(saved))
+			}
+		})
+	}
+}
+
+// TestStartTracking 実施中でないワークアウトは記録できない
+func TestStartTracking(t *testing.T) {
+	manager, _ := newTrackingManager(t)
+
+	_, err := manager.StartTracking(StartTrackingRequest{WorkoutID: 1})
+	if !errors.Is(err, ErrWorkoutNotInProgress) {
+		t.Errorf("Expected %v for a completed workout, got %v", ErrWorkoutNotInProgress, err)
+	}
+	_, err = manager.StartTracking(StartTrackingRequest{WorkoutID: 2, RestDuration: -time.Second})
+	if !errors.Is(err, ErrInvalidTrackingInput) {
+		t.Errorf("Expected %v for a negative rest duration, got %v", ErrInvalidTrackingInput, err)
+	}
+}
+
+// TestTrackingSession_RestTimer 休憩タイマーの通知と一時停止中の扱いをテスト
+func TestTrackingSession_RestTimer(t *testing.T) {
+	manager, id := newTrackingManager(t)
+	session, err := manager.StartTracking(StartTrackingRequest{WorkoutID: id, RestDuration: 20 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("StartTracking() error = %v", err)
+	}
+	defer session.Close()
+
+	if _, err := session.CompleteSet(CompleteSetRequest{Reps: 5, Weight: 80}); err != nil {
+		t.Fatalf("CompleteSet() error = %v", err)
+	}
+	select {
+	case expiry := <-session.RestTimerExpired():
+		if expiry.SetNumber != 1 {
+			t.Errorf("Expected rest after set 1, got set %d", expiry.SetNumber)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the rest timer to expire")
+	}
+
+	// 一時停止中は休憩タイマーを開始しない
+	session.Pause()
+	result, err := session.CompleteSet(CompleteSetRequest{Reps: 5, Weight: 80})
+	if err != nil {
+		t.Fatalf("CompleteSet() error = %v", err)
+	}
+	if result.RestUntil != nil {
+		t.Errorf("Expected no rest timer while paused, got %v", result.RestUntil)
+	}
+	select {
+	case expiry := <-session.RestTimerExpired():
+		t.Fatalf("Expected no expiry while paused, got set %d", expiry.SetNumber)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// 再開すると残り時間から休憩タイマーを再開する
+	if remaining := session.Resume(); remaining != 20*time.Millisecond {
+		t.Errorf("Expected the full rest to remain, got %v", remaining)
+	}
+	select {
+	case expiry := <-session.RestTimerExpired():
+		if expiry.SetNumber != 2 {
+			t.Errorf("Expected rest after set 2, got set %d", expiry.SetNumber)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the rest timer to expire after resuming")
+	}
+}
+
+// TestTrackingSession_Finish 記録したセットをワークアウトに反映して完了にする
+func TestTrackingSession_Finish(t *testing.T) {
+	manager, id := newTrackingManager(t)
+	session, err := manager.StartTracking(StartTrackingRequest{WorkoutID: id, RestDuration: time.Hour})
+	if err != nil {
+		t.Fatalf("StartTracking() error = %v", err)
+	}
+	for _, req := range []CompleteSetRequest{{Reps: 8, Weight: 80}, {Reps: 6, Weight: 90}, {Reps: 4, Weight: 90}} {
+		if _, err := session.CompleteSet(req); err != nil {
+			t.Fatalf("CompleteSet() error = %v", err)
+		}
+	}
+	if err := session.AddNote("  2セット目で肩に違和感  "); err != nil {
+		t.Fatalf("AddNote() error = %v", err)
+	}
+	if err := session.AddNote(" "); !errors.Is(err, ErrInvalidTrackingInput) {
+		t.Errorf("Expected %v for an empty note, got %v", ErrInvalidTrackingInput, err)
+	}
+
+	workout, err := session.Finish()
+	if err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+	if workout.Status != domain.WorkoutStatusCompleted || workout.CompletedAt == nil {
+		t.Errorf("Expected a completed workout, got status %v", workout.Status)
+	}
+	if workout.Sets != 3 || workout.Weight != 90 || workout.Reps != 6 {
+		t.Errorf("Expected 3 sets of 90kg × 6, got %d sets of %.1fkg × %d", workout.Sets, workout.Weight, workout.Reps)
+	}
+	if workout.Notes != "2セット目で肩に違和感" {
+		t.Errorf("Expected the note to be saved, got %q", workout.Notes)
+	}
+	if want := 8*80.0 + 6*90.0 + 4*90.0; session.TotalVolume() != want {
+		t.Errorf("Expected total volume %.1f, got %.1f", want, session.TotalVolume())
+	}
+
+	// 再接続して記録を続けることはできない
+	if _, err := manager.StartTracking(StartTrackingRequest{WorkoutID: id}); !errors.Is(err, ErrWorkoutNotInProgress) {
+		t.Errorf("Expected %v after finishing, got %v", ErrWorkoutNotInProgress, err)
+	}
+}
+
+// TestTrackingSession_Finish_KeepsWeightUnit lbで記録したワークアウトは完了後もlbのまま、記録した重量で表示されることをテスト
+func TestTrackingSession_Finish_KeepsWeightUnit(t *testing.T) {
+	manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	workout, err := manager.CreateWorkout(CreateWorkoutRequest{
+		ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 225, WeightUnit: domain.WeightUnitPound,
+	})
+	if err != nil {
+		t.Fatalf("CreateWorkout() error = %v", err)
+	}
+	inProgress := domain.WorkoutStatusInProgress
+	if err := manager.UpdateWorkout(UpdateWorkoutRequest{ID: workout.ID, ExerciseType: domain.Squat, Status: &inProgress}); err != nil {
+		t.Fatalf("UpdateWorkout() error = %v", err)
+	}
+
+	session, err := manager.StartTracking(StartTrackingRequest{WorkoutID: workout.ID, RestDuration: time.Hour})
+	if err != nil {
+		t.Fatalf("StartTracking() error = %v", err)
+	}
+	if _, err := session.CompleteSet(CompleteSetRequest{Reps: 5, Weight: 235, WeightUnit: domain.WeightUnitPound}); err != nil {
+		t.Fatalf("CompleteSet() error = %v", err)
+	}
+
+	finished, err := session.Finish()
+	if err != nil {
+		t.Fatalf("Finish() error = %v", err)
+	}
+	if finished.WeightUnit != domain.WeightUnitPound {
+		t.Errorf("Expected WeightUnit=lb, got %s", finished.WeightUnit.Key())
+	}
+	if got := finished.PlateWeightIn(domain.WeightUnitPound); got != 235 {
+		t.Errorf("Expected 235 lb, got %.2f", got)
+	}
+}
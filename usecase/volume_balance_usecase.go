@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+)
+
+// allMuscleGroups レポートに含める筋肉群（定義順）
+var allMuscleGroups = []domain.MuscleGroup{
+	domain.Chest, domain.Back, domain.Legs, domain.Shoulders, domain.Arms,
+	domain.Abs, domain.Core, domain.Glutes, domain.Cardio, domain.FullBody,
+}
+
+// DefaultVolumeTargets 設定ファイルで指定がない場合の週あたり目標セット数
+func DefaultVolumeTargets() map[domain.MuscleGroup]domain.VolumeTarget {
+	return map[domain.MuscleGroup]domain.VolumeTarget{
+		domain.Chest:     {MinWeeklySets: 10, MaxWeeklySets: 20},
+		domain.Back:      {MinWeeklySets: 10, MaxWeeklySets: 20},
+		domain.Legs:      {MinWeeklySets: 10, MaxWeeklySets: 20},
+		domain.Shoulders: {MinWeeklySets: 8, MaxWeeklySets: 20},
+		domain.Arms:      {MinWeeklySets: 6, MaxWeeklySets: 16},
+	}
+}
+
+// SetVolumeTargets リクエストで指定されない場合に使用する目標セット数と協働筋の重みを設定
+func (wm *WorkoutManager) SetVolumeTargets(targets map[domain.MuscleGroup]domain.VolumeTarget, secondaryWeight float64) {
+	wm.volumeTargets = targets
+	wm.secondaryMuscleWeight = secondaryWeight
+}
+
+// GetMuscleBalanceReportRequest ボリュームバランスレポート取得リクエスト
+type GetMuscleBalanceReportRequest struct {
+	DateFrom        *time.Time                                 // オプション: 集計開始日時（含む）。nilならDateToの7日前
+	DateTo          *time.Time                                 // オプション: 集計終了日時（含まない）。nilなら現在時刻
+	SecondaryWeight *float64                                   // オプション: nilなら設定値を使用
+	Targets         map[domain.MuscleGroup]domain.VolumeTarget // オプション: 指定した筋肉群のみ設定値を上書き
+}
+
+// GetMuscleBalanceReport 筋肉群ごとの週あたりセット数を集計し、目標範囲と比較する（ビジネスロジック層）
+func (wm *WorkoutManager) GetMuscleBalanceReport(req GetMuscleBalanceReportRequest) (*domain.MuscleBalanceReport, error) {
+	wm, span := wm.startSpan("GetMuscleBalanceReport")
+	defer span.End()
+
+	dateTo := time.Now()
+	if req.DateTo != nil {
+		dateTo = *req.DateTo
+	}
+	dateFrom := dateTo.AddDate(0, 0, -7)
+	if req.DateFrom != nil {
+		dateFrom = *req.DateFrom
+	}
+
+	secondaryWeight := wm.secondaryMuscleWeight
+	if req.SecondaryWeight != nil {
+		secondaryWeight = *req.SecondaryWeight
+	}
+
+	validator := &errValidator{}
+	validator.validateDateRange(&dateFrom, &dateTo)
+	validator.validate(func() error {
+		if secondaryWeight < 0 || secondaryWeight > 1 {
+			return fmt.Errorf("secondary weight must be between 0 and 1: %.2f", secondaryWeight)
+		}
+		return nil
+	})
+	for mg, target := range req.Targets {
+		validator.validate(func() error {
+			if target.MinWeeklySets < 0 || (target.MaxWeeklySets > 0 && target.MaxWeeklySets < target.MinWeeklySets) {
+				return fmt.Errorf("invalid volume target for %s: %.1f-%.1f", mg.Japanese(), target.MinWeeklySets, target.MaxWeeklySets)
+			}
+			return nil
+		})
+	}
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetMuscleBalanceReport",
+			Message: "muscle balance report input validation failed",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	exerciseSets, err := wm.repo.GetCompletedSetsByExercise(&dateFrom, &dateTo)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetMuscleBalanceReport",
+			Message: "failed to aggregate completed sets in repository",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	// 主働筋はワークアウトに記録された筋肉群を優先し、未指定ならカタログの主働筋を使う
+	primarySets := make(map[domain.MuscleGroup]float64)
+	secondarySets := make(map[domain.MuscleGroup]float64)
+	for _, es := range exerciseSets {
+		info, inCatalog := domain.LookupExercise(es.ExerciseType)
+		primary := es.MuscleGroup
+		if primary == domain.Unspecified && inCatalog {
+			primary = info.Primary
+		}
+		if primary != domain.Unspecified {
+			primarySets[primary] += float64(es.Sets)
+		}
+		if !inCatalog {
+			continue
+		}
+		for _, mg := range info.Secondary {
+			if mg != primary {
+				secondarySets[mg] += float64(es.Sets)
+			}
+		}
+	}
+
+	targets := make(map[domain.MuscleGroup]domain.VolumeTarget, len(wm.volumeTargets)+len(req.Targets))
+	for mg, target := range wm.volumeTargets {
+		targets[mg] = target
+	}
+	for mg, target := range req.Targets {
+		targets[mg] = target
+	}
+
+	weeks := dateTo.Sub(dateFrom).Hours() / (24 * 7)
+	if weeks < 1 {
+		// 1週間未満の期間はそのままの値を週の値として扱う
+		weeks = 1
+	}
+
+	report := &domain.MuscleBalanceReport{
+		DateFrom:        dateFrom,
+		DateTo:          dateTo,
+		Weeks:           weeks,
+		SecondaryWeight: secondaryWeight,
+		Muscles:         make([]*domain.MuscleVolume, 0, len(allMuscleGroups)),
+	}
+	for _, mg := range allMuscleGroups {
+		volume := &domain.MuscleVolume{
+			MuscleGroup:   mg,
+			PrimarySets:   primarySets[mg],
+			SecondarySets: secondarySets[mg],
+			WeightedSets:  primarySets[mg] + secondarySets[mg]*secondaryWeight,
+			Status:        domain.VolumeNoTarget,
+		}
+		volume.WeeklySets = volume.WeightedSets / weeks
+		if target, ok := targets[mg]; ok {
+			volume.Target = &target
+			volume.Status = target.Evaluate(volume.WeeklySets)
+		}
+		// 目標もトレーニング実績もない筋肉群はレポートに含めない
+		if volume.Target == nil && volume.WeightedSets == 0 {
+			continue
+		}
+		report.Muscles = append(report.Muscles, volume)
+	}
+
+	wm.logger.Debug("ボリュームバランスを集計しました",
+		slog.String(logging.KeyOp, "GetMuscleBalanceReport"), slog.Float64("weeks", weeks), slog.Int("muscle_groups", len(report.Muscles)))
+	return report, nil
+}
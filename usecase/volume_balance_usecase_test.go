@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestGetMuscleBalanceReport テーブル駆動テストで筋肉群ごとのボリュームバランスをテスト
+func TestGetMuscleBalanceReport(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	oneWeek := from.AddDate(0, 0, 7)
+	twoWeeks := from.AddDate(0, 0, 14)
+	completedAt := func(t time.Time) *time.Time { return &t }
+
+	setupWorkouts := []*domain.Workout{
+		// ベンチプレス: 胸（主働筋）+ 肩・腕（協働筋）
+		{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, MuscleGroup: domain.Chest, Sets: 12, Reps: 10, Weight: 60.0, CreatedAt: from, CompletedAt: completedAt(from.AddDate(0, 0, 1))},
+		{ExerciseType: domain.BenchPress, Status: domain.WorkoutStatusCompleted, MuscleGroup: domain.Chest, Sets: 12, Reps: 10, Weight: 60.0, CreatedAt: from, CompletedAt: completedAt(from.AddDate(0, 0, 3))},
+		// 懸垂: 筋肉群未指定のためカタログの主働筋（背中）を使う
+		{ExerciseType: domain.PullUp, Status: domain.WorkoutStatusCompleted, Sets: 4, Reps: 8, CreatedAt: from, CompletedAt: completedAt(from.AddDate(0, 0, 2))},
+		// スキップしたワークアウトは集計しない
+		{ExerciseType: domain.OneHandRow, Status: domain.WorkoutStatusSkipped, MuscleGroup: domain.Back, Sets: 10, Reps: 10, Weight: 20.0, CreatedAt: from.AddDate(0, 0, 4)},
+		// 2週目のスクワット
+		{ExerciseType: domain.Squat, Status: domain.WorkoutStatusCompleted, MuscleGroup: domain.Legs, Sets: 10, Reps: 5, Weight: 100.0, CreatedAt: oneWeek, CompletedAt: completedAt(oneWeek.AddDate(0, 0, 1))},
+	}
+
+	half := 0.5
+	zero := 0.0
+	invalidWeight := 1.5
+
+	tests := []struct {
+		name        string
+		request     GetMuscleBalanceReportRequest
+		wantWeeks   float64
+		wantWeekly  map[domain.MuscleGroup]float64
+		wantStatus  map[domain.MuscleGroup]domain.VolumeBalanceStatus
+		wantErr     bool
+		description string
+	}{
+		{
+			name:      "正常系: 1週間・デフォルト目標",
+			request:   GetMuscleBalanceReportRequest{DateFrom: &from, DateTo: &oneWeek},
+			wantWeeks: 1,
+			wantWeekly: map[domain.MuscleGroup]float64{
+				domain.Chest:     24,
+				domain.Back:      4,
+				domain.Shoulders: 24 * half,
+				domain.Arms:      24*half + 4*half,
+			},
+			wantStatus: map[domain.MuscleGroup]domain.VolumeBalanceStatus{
+				domain.Chest:     domain.VolumeOver,
+				domain.Back:      domain.VolumeUnder,
+				domain.Legs:      domain.VolumeUnder,
+				domain.Shoulders: domain.VolumeWithin,
+				domain.Arms:      domain.VolumeWithin,
+			},
+			description: "胸が多すぎ、背中と脚が不足",
+		},
+		{
+			name:      "正常系: 2週間は週あたりに換算",
+			request:   GetMuscleBalanceReportRequest{DateFrom: &from, DateTo: &twoWeeks},
+			wantWeeks: 2,
+			wantWeekly: map[domain.MuscleGroup]float64{
+				domain.Chest:  12,
+				domain.Legs:   5,
+				domain.Glutes: 10 * half / 2,
+			},
+			wantStatus: map[domain.MuscleGroup]domain.VolumeBalanceStatus{
+				domain.Chest:  domain.VolumeWithin,
+				domain.Legs:   domain.VolumeUnder,
+				domain.Glutes: domain.VolumeNoTarget,
+			},
+			description: "合計セット数を週数で割る",
+		},
+		{
+			name: "正常系: 協働筋の重み0と目標の上書き",
+			request: GetMuscleBalanceReportRequest{
+				DateFrom:        &from,
+				DateTo:          &oneWeek,
+				SecondaryWeight: &zero,
+				Targets:         map[domain.MuscleGroup]domain.VolumeTarget{domain.Chest: {MinWeeklySets: 20, MaxWeeklySets: 30}},
+			},
+			wantWeeks: 1,
+			wantWeekly: map[domain.MuscleGroup]float64{
+				domain.Chest:     24,
+				domain.Shoulders: 0,
+			},
+			wantStatus: map[domain.MuscleGroup]domain.VolumeBalanceStatus{
+				domain.Chest:     domain.VolumeWithin,
+				domain.Shoulders: domain.VolumeUnder,
+			},
+			description: "協働筋を数えず、胸の目標をリクエストで上書き",
+		},
+		{
+			name:        "異常系: 協働筋の重みが範囲外",
+			request:     GetMuscleBalanceReportRequest{DateFrom: &from, DateTo: &oneWeek, SecondaryWeight: &invalidWeight},
+			wantErr:     true,
+			description: "重みは0〜1の範囲",
+		},
+		{
+			name: "異常系: 目標の上限が下限より小さい",
+			request: GetMuscleBalanceReportRequest{
+				DateFrom: &from,
+				DateTo:   &oneWeek,
+				Targets:  map[domain.MuscleGroup]domain.VolumeTarget{domain.Back: {MinWeeklySets: 10, MaxWeeklySets: 5}},
+			},
+			wantErr:     true,
+			description: "不正な目標範囲はエラー",
+		},
+		{
+			name:        "異常系: 期間が逆転",
+			request:     GetMuscleBalanceReportRequest{DateFrom: &oneWeek, DateTo: &from},
+			wantErr:     true,
+			description: "開始日が終了日より後の場合はエラー",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			for _, workout := range setupWorkouts {
+				w := *workout
+				if err := mockRepo.CreateWorkout(&w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			report, err := manager.GetMuscleBalanceReport(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetMuscleBalanceReport() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if report.Weeks != tt.wantWeeks {
+				t.Errorf("Expected Weeks=%.1f, got %.1f", tt.wantWeeks, report.Weeks)
+			}
+			volumes := make(map[domain.MuscleGroup]*domain.MuscleVolume, len(report.Muscles))
+			for _, mv := range report.Muscles {
+				volumes[mv.MuscleGroup] = mv
+			}
+			for mg, want := range tt.wantWeekly {
+				mv, ok := volumes[mg]
+				if !ok {
+					t.Errorf("Expected %s in report", mg.Japanese())
+					continue
+				}
+				if mv.WeeklySets != want {
+					t.Errorf("Expected %s WeeklySets=%.2f, got %.2f", mg.Japanese(), want, mv.WeeklySets)
+				}
+			}
+			for mg, want := range tt.wantStatus {
+				mv, ok := volumes[mg]
+				if !ok {
+					t.Errorf("Expected %s in report", mg.Japanese())
+					continue
+				}
+				if mv.Status != want {
+					t.Errorf("Expected %s Status=%d, got %d", mg.Japanese(), want, mv.Status)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"log/slog"
+
+	"golv2-learning-app/domain"
+	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/usecase/eventbus"
+)
+
+// WatchWorkoutsRequest ワークアウトの変更の購読リクエスト
+type WatchWorkoutsRequest struct {
+	Filter      domain.WorkoutEventFilter
+	ResumeToken string // オプション: 最後に受け取ったイベントの再開トークン
+	BufferSize  int    // オプション: 未送信イベントの上限（0ならデフォルト）
+}
+
+// WatchWorkouts ワークアウトの変更イベントを購読する（ビジネスロジック層）
+// 呼び出し元は使い終わったらSubscription.Closeを呼ぶこと
+func (wm *WorkoutManager) WatchWorkouts(req WatchWorkoutsRequest) (*eventbus.Subscription, error) {
+	wm, span := wm.startSpan("WatchWorkouts")
+	defer span.End()
+
+	sub, err := wm.events.Subscribe(eventbus.SubscribeOptions{
+		Filter:      req.Filter,
+		ResumeToken: req.ResumeToken,
+		BufferSize:  req.BufferSize,
+	})
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "WatchWorkouts",
+			Message: "failed to subscribe to workout events",
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+	wm.logger.Info("ワークアウトの変更の購読を開始しました",
+		slog.String(logging.KeyOp, "WatchWorkouts"), slog.Int("subscribers", wm.events.SubscriberCount()))
+	return sub, nil
+}
+
+// EventToken イベントの再開トークン（次回のWatchWorkoutsで指定すると続きから受け取れる）
+func (wm *WorkoutManager) EventToken(event domain.WorkoutEvent) string {
+	return wm.events.Token(event.Sequence)
+}
+
+// publishEvent 保存済みのワークアウトの変更を購読者に通知する
+func (wm *WorkoutManager) publishEvent(eventType domain.WorkoutEventType, workout *domain.Workout) {
+	wm.recordEvent(eventType, workout)
+	wm.events.Publish(newWorkoutEvent(eventType, workout))
+}
+
+// publishUpdate 更新を通知する（ステータスが変わった場合はステータス変更として通知）
+func (wm *WorkoutManager) publishUpdate(workout *domain.Workout, previous domain.WorkoutStatus) {
+	if workout.Status == previous {
+		wm.publishEvent(domain.WorkoutEventUpdated, workout)
+		return
+	}
+	wm.recordEvent(domain.WorkoutEventStatusChanged, workout)
+	event := newWorkoutEvent(domain.WorkoutEventStatusChanged, workout)
+	event.PreviousStatus = &previous
+	wm.events.Publish(event)
+}
+
+// recordEvent 作成・完了・スキップの件数をメトリクスに記録する
+// 完了・スキップは、その状態で作成された場合（インポートなど）とステータスが変わった場合に数える
+func (wm *WorkoutManager) recordEvent(eventType domain.WorkoutEventType, workout *domain.Workout) {
+	if eventType == domain.WorkoutEventCreated {
+		wm.metrics.WorkoutCreated()
+	} else if eventType != domain.WorkoutEventStatusChanged {
+		return
+	}
+	switch workout.Status {
+	case domain.WorkoutStatusCompleted:
+		wm.metrics.WorkoutCompleted()
+	case domain.WorkoutStatusSkipped:
+		reason := workout.SkipReason.Key()
+		if reason == "" {
+			reason = "unspecified"
+		}
+		wm.metrics.WorkoutSkipped(reason)
+	}
+}
+
+// newWorkoutEvent イベントを作成する
+// 購読者が受け取った後に呼び出し元が変更しても影響しないよう、ワークアウトはコピーを渡す
+func newWorkoutEvent(eventType domain.WorkoutEventType, workout *domain.Workout) domain.WorkoutEvent {
+	snapshot := *workout
+	return domain.WorkoutEvent{Type: eventType, Workout: &snapshot}
+}
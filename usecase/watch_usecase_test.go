@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"testing"
+
+	"golv2-learning-app/domain"
+	repository "golv2-learning-app/infra"
+)
+
+// TestWatchWorkouts 変更の種類ごとに発行されるイベントをテスト
+func TestWatchWorkouts(t *testing.T) {
+	manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	sub, err := manager.WatchWorkouts(WatchWorkoutsRequest{})
+	if err != nil {
+		t.Fatalf("WatchWorkouts() error = %v", err)
+	}
+	defer sub.Close()
+
+	workout, err := manager.CreateWorkout(CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 3, Reps: 5, Weight: 100})
+	if err != nil {
+		t.Fatalf("CreateWorkout() error = %v", err)
+	}
+	completed := domain.WorkoutStatusCompleted
+	notes := "フォーム良好"
+	for _, req := range []UpdateWorkoutRequest{
+		{ID: workout.ID, ExerciseType: domain.Squat, Status: &completed},
+		{ID: workout.ID, ExerciseType: domain.Squat, Notes: &notes},
+	} {
+		if err := manager.UpdateWorkout(req); err != nil {
+			t.Fatalf("UpdateWorkout() error = %v", err)
+		}
+	}
+	if err := manager.DeleteWorkout(workout.ID); err != nil {
+		t.Fatalf("DeleteWorkout() error = %v", err)
+	}
+	// 不正なリクエストは保存されないため通知しない
+	if _, err := manager.CreateWorkout(CreateWorkoutRequest{ExerciseType: domain.ExerciseUnspecified}); err == nil {
+		t.Fatal("Expected CreateWorkout() to fail for an unspecified exercise")
+	}
+
+	wantTypes := []domain.WorkoutEventType{
+		domain.WorkoutEventCreated,
+		domain.WorkoutEventStatusChanged,
+		domain.WorkoutEventUpdated,
+		domain.WorkoutEventDeleted,
+	}
+	for i, want := range wantTypes {
+		select {
+		case event := <-sub.Events():
+			if event.Type != want {
+				t.Errorf("Event %d: expected %s, got %s", i, want.Japanese(), event.Type.Japanese())
+			}
+			if event.Workout.ID != workout.ID {
+				t.Errorf("Event %d: expected workout ID %d, got %d", i, workout.ID, event.Workout.ID)
+			}
+			if want == domain.WorkoutEventStatusChanged {
+				if event.PreviousStatus == nil || *event.PreviousStatus != domain.WorkoutStatusPlanned {
+					t.Errorf("Expected previous status %v, got %v", domain.WorkoutStatusPlanned, event.PreviousStatus)
+				}
+				if event.Workout.Status != domain.WorkoutStatusCompleted {
+					t.Errorf("Expected status %v, got %v", domain.WorkoutStatusCompleted, event.Workout.Status)
+				}
+			}
+		default:
+			t.Fatalf("Expected event %d (%s), got none", i, want.Japanese())
+		}
+	}
+	select {
+	case event := <-sub.Events():
+		t.Errorf("Expected no more events, got %s", event.Type.Japanese())
+	default:
+	}
+}
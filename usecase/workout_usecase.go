@@ -1,18 +1,50 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"strings"
 	"time"
 
 	"golv2-learning-app/domain"
 	appErrors "golv2-learning-app/errors"
+	"golv2-learning-app/logging"
+	"golv2-learning-app/metrics"
+	"golv2-learning-app/tracing"
+	"golv2-learning-app/usecase/eventbus"
+	"golv2-learning-app/usecase/strength"
+
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracerName ユースケース層のスパンの計装ライブラリ名
+const tracerName = "golv2-learning-app/usecase"
+
 // WorkoutManager ワークアウトのユースケース層（ビジネスロジック）
 // WorkoutUseCaseインターフェースを実装
 type WorkoutManager struct {
-	repo domain.WorkoutRepository
+	repo                  domain.WorkoutRepository
+	intensityRules        []domain.IntensityRule                     // 高強度判定のデフォルトルール
+	bodyweight            float64                                    // 体重比ルールで使用するデフォルト体重(kg)
+	volumeTargets         map[domain.MuscleGroup]domain.VolumeTarget // 筋肉群ごとの週あたり目標セット数
+	secondaryMuscleWeight float64                                    // 協働筋のセット数に掛ける重み
+	location              *time.Location                             // 日付の区切りに使用するユーザーのタイムゾーン
+	weightUnit            domain.WeightUnit                          // ユーザーが使用する重量の単位（入力・表示のデフォルト）
+	plateInventory        *domain.PlateInventory                     // プレートの組み合わせ計算に使用する在庫（nilならユーザーの単位のデフォルト）
+	programRepo           domain.ProgramRepository                   // トレーニングプログラムの永続化（未設定ならプログラム機能は使用不可）
+	bodyRepo              domain.BodyMeasurementRepository           // 体重・身体測定の記録の永続化（未設定なら体重・身体測定機能は使用不可）
+	progressionRules      []domain.ProgressionRule                   // 種目ごとの漸進的過負荷ルール（一致しない種目はデフォルトルール）
+	autoCreateNextWorkout bool                                       // 完了時に次回のワークアウトを予定として自動作成するか
+	missedGracePeriod     time.Duration                              // 予定日時からこの時間が過ぎたら未実施とみなす
+	importAliases         map[string]domain.ExerciseType             // 他のアプリの種目名 → 種目（インポート用）
+	events                *eventbus.Bus                              // ワークアウトの変更イベントの配信先
+	logger                *slog.Logger                               // ログの出力先（デフォルトは出力しない）
+	metrics               *metrics.Metrics                           // 作成・完了・スキップ件数の記録先（nilの場合は記録しない）
+	tracer                trace.Tracer                               // ユースケースのスパンの作成元（デフォルトは記録しない）
+	ctx                   context.Context                            // リクエストのコンテキスト（スパンの親、nilの場合はBackground）
 }
 
 // CreateWorkoutRequest ワークアウト作成リクエスト
@@ -26,7 +58,10 @@ type CreateWorkoutRequest struct {
 	Sets         int32
 	Reps         int32
 	Weight       float64
+	WeightUnit   domain.WeightUnit // Weightの単位（ゼロ値はkg）
 	Notes        string
+	ScheduledFor *time.Time // オプション: 実施予定日時
+	Timezone     string     // オプション: 予定日時のタイムゾーン（IANA名）。空ならユーザー設定
 }
 
 // UpdateWorkoutRequest ワークアウト更新リクエスト
@@ -41,40 +76,200 @@ type UpdateWorkoutRequest struct {
 	Sets         *int                  // オプション: nilなら更新しない
 	Reps         *int                  // オプション: nilなら更新しない
 	Weight       *float64              // オプション: nilなら更新しない
+	WeightUnit   domain.WeightUnit     // Weightの単位（ゼロ値はkg）
 	Notes        *string               // オプション: nilなら更新しない
+	SkipReason   *domain.SkipReason    // オプション: スキップ状態のときのみ反映
+}
+
+// weightInKilograms 更新する重量をkgに換算して返す（nilなら更新しない）
+func (req UpdateWorkoutRequest) weightInKilograms() *float64 {
+	if req.Weight == nil {
+		return nil
+	}
+	weight := req.WeightUnit.ToKilograms(*req.Weight)
+	return &weight
 }
 
 // ファクトリー関数
 func NewWorkoutManager() *WorkoutManager {
 	return &WorkoutManager{
-		repo: nil, // メモリベース（後方互換性のため）
+		repo:                  nil, // メモリベース（後方互換性のため）
+		intensityRules:        DefaultIntensityRules(),
+		volumeTargets:         DefaultVolumeTargets(),
+		secondaryMuscleWeight: domain.DefaultSecondaryMuscleWeight,
+		location:              time.Local,
+		missedGracePeriod:     DefaultMissedGracePeriod,
+		events:                eventbus.New(eventbus.DefaultHistorySize),
+		logger:                logging.Discard(),
+		tracer:                tracing.Noop().Tracer(tracerName),
 	}
 }
 
 // NewWorkoutManagerWithRepository リポジトリを使用するファクトリー関数
 func NewWorkoutManagerWithRepository(repo domain.WorkoutRepository) *WorkoutManager {
 	return &WorkoutManager{
-		repo: repo,
+		repo:                  repo,
+		intensityRules:        DefaultIntensityRules(),
+		volumeTargets:         DefaultVolumeTargets(),
+		secondaryMuscleWeight: domain.DefaultSecondaryMuscleWeight,
+		location:              time.Local,
+		missedGracePeriod:     DefaultMissedGracePeriod,
+		events:                eventbus.New(eventbus.DefaultHistorySize),
+		logger:                logging.Discard(),
+		tracer:                tracing.Noop().Tracer(tracerName),
+	}
+}
+
+// SetLogger ログの出力先を設定
+func (wm *WorkoutManager) SetLogger(logger *slog.Logger) {
+	wm.logger = logger
+}
+
+// SetMetrics ワークアウトの作成・完了・スキップ件数の記録先を設定
+func (wm *WorkoutManager) SetMetrics(m *metrics.Metrics) {
+	wm.metrics = m
+}
+
+// SetTracerProvider ユースケース層のスパンの記録先を設定
+func (wm *WorkoutManager) SetTracerProvider(tp trace.TracerProvider) {
+	wm.tracer = tp.Tracer(tracerName)
+}
+
+// SetWeightUnit ユーザーが使用する重量の単位を設定
+func (wm *WorkoutManager) SetWeightUnit(unit domain.WeightUnit) {
+	wm.weightUnit = unit
+}
+
+// WeightUnit ユーザーが使用する重量の単位
+func (wm *WorkoutManager) WeightUnit() domain.WeightUnit {
+	return wm.weightUnit
+}
+
+// WithContext リクエストのコンテキストで処理するWorkoutManagerを返す
+// コンテキストのロガー（リクエストIDなどの属性付き）を使い、ユースケースのスパンはコンテキストのスパンの子になる
+// リポジトリ・設定・イベントバスは元のWorkoutManagerと共有する
+func (wm *WorkoutManager) WithContext(ctx context.Context) *WorkoutManager {
+	scoped := *wm
+	scoped.ctx = ctx
+	if logger, ok := logging.FromContext(ctx); ok {
+		scoped.logger = logger
+	}
+	return &scoped
+}
+
+// startSpan ユースケースのメソッドのスパンを開始する
+// 戻り値のWorkoutManagerはスパンのコンテキストでリポジトリを呼び出すため、DBクエリのスパンがこのスパンの子になる
+// 呼び出し元は defer span.End() で終了すること
+func (wm *WorkoutManager) startSpan(method string) (*WorkoutManager, trace.Span) {
+	ctx := wm.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tracer := wm.tracer
+	if tracer == nil {
+		tracer = tracing.Noop().Tracer(tracerName)
+	}
+	ctx, span := tracer.Start(ctx, "WorkoutManager."+method)
+
+	scoped := *wm
+	scoped.ctx = ctx
+	if repo, ok := wm.repo.(interface {
+		WithContext(context.Context) domain.WorkoutRepository
+	}); ok {
+		scoped.repo = repo.WithContext(ctx)
+	}
+	if programRepo, ok := wm.programRepo.(interface {
+		WithContext(context.Context) domain.ProgramRepository
+	}); ok {
+		scoped.programRepo = programRepo.WithContext(ctx)
+	}
+	if bodyRepo, ok := wm.bodyRepo.(interface {
+		WithContext(context.Context) domain.BodyMeasurementRepository
+	}); ok {
+		scoped.bodyRepo = bodyRepo.WithContext(ctx)
+	}
+	return &scoped, span
+}
+
+// WithLogger ログの出力先だけを差し替えたWorkoutManagerを返す
+// リクエストIDなどリクエストごとの属性を付与したロガーで処理する場合に使用する
+// リポジトリ・設定・イベントバスは元のWorkoutManagerと共有する
+func (wm *WorkoutManager) WithLogger(logger *slog.Logger) *WorkoutManager {
+	scoped := *wm
+	scoped.logger = logger
+	return &scoped
+}
+
+// logError WorkoutErrorを操作名・種目の属性付きでログに出力する
+// 入力値の不正や存在しないIDなど呼び出し側の問題はWarn、それ以外はError
+func (wm *WorkoutManager) logError(workoutErr *appErrors.WorkoutError) {
+	level := slog.LevelError
+	if errors.Is(workoutErr, domain.ErrInvalidArgument) || errors.Is(workoutErr, domain.ErrNotFound) {
+		level = slog.LevelWarn
+	}
+	attrs := []slog.Attr{slog.String(logging.KeyOp, workoutErr.Op)}
+	if workoutErr.ExerciseType != domain.ExerciseUnspecified {
+		attrs = append(attrs, slog.String("exercise_type", workoutErr.ExerciseType.Key()))
+	}
+	if workoutErr.Err != nil {
+		attrs = append(attrs, slog.String(logging.KeyError, workoutErr.Err.Error()))
+	}
+	wm.logger.LogAttrs(context.Background(), level, workoutErr.Message, attrs...)
+
+	// 実行中のスパンがあればエラーを記録する（入力値の不正なども含め、失敗した呼び出しを追えるように）
+	if wm.ctx != nil {
+		span := trace.SpanFromContext(wm.ctx)
+		span.RecordError(workoutErr)
+		span.SetStatus(otelcodes.Error, workoutErr.Message)
 	}
 }
 
 func (wm *WorkoutManager) CreateWorkout(req CreateWorkoutRequest) (*domain.Workout, error) {
+	wm, span := wm.startSpan("CreateWorkout")
+	defer span.End()
+
 	// defer でのログ記録とエラーハンドリング
+	logger := wm.logger.With(slog.String(logging.KeyOp, "CreateWorkout"), slog.String("exercise_type", req.ExerciseType.Key()))
 	start := time.Now()
-	fmt.Printf("🏃 ワークアウト作成開始: %s\n", req.ExerciseType.Japanese())
+	logger.Debug("ワークアウト作成開始")
 
 	defer func() {
-		duration := time.Since(start)
-		fmt.Printf("🏁 ワークアウト作成処理終了: %s (実行時間: %v)\n", req.ExerciseType.Japanese(), duration)
+		logger.Debug("ワークアウト作成処理終了", slog.Duration(logging.KeyDuration, time.Since(start)))
 	}()
 
 	// panic recovery
 	defer func() {
 		if r := recover(); r != nil {
-			fmt.Printf("💥 ワークアウト作成中にpanic発生: %s - %v\n", req.ExerciseType.Japanese(), r)
+			logger.Error("ワークアウト作成中にpanic発生", slog.Any("panic", r))
 		}
 	}()
 
+	workout, err := wm.newWorkout("CreateWorkout", req)
+	if err != nil {
+		return nil, err
+	}
+
+	err = wm.repo.CreateWorkout(workout)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "CreateWorkout",
+			ExerciseType: req.ExerciseType,
+			Message:      "failed to create workout in repository",
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
+	}
+
+	// ビジネスロジック: 作成成功ログ
+	wm.logWorkoutCreated(workout)
+	wm.publishEvent(domain.WorkoutEventCreated, workout)
+
+	return workout, nil
+}
+
+// newWorkout リクエストにデフォルト値を補ってワークアウトを組み立て、検証する（保存はしない）
+func (wm *WorkoutManager) newWorkout(op string, req CreateWorkoutRequest) (*domain.Workout, error) {
 	// ビジネスロジック: 入力値のバリデーション
 	if req.ExerciseType == domain.ExerciseUnspecified {
 		return nil, fmt.Errorf("exercise type must be specified")
@@ -107,54 +302,55 @@ func (wm *WorkoutManager) CreateWorkout(req CreateWorkoutRequest) (*domain.Worko
 	if req.Reps > 0 {
 		workout.Reps = int(req.Reps)
 	}
+	workout.WeightUnit = req.WeightUnit
 	if req.Weight > 0 {
-		workout.Weight = req.Weight
+		workout.Weight = req.WeightUnit.ToKilograms(req.Weight)
 	}
 	if req.Notes != "" {
 		workout.Notes = req.Notes
 	}
+	if req.ScheduledFor != nil {
+		loc, err := wm.scheduleLocation(req.Timezone)
+		if err != nil {
+			workoutErr := &appErrors.WorkoutError{
+				Op:           op,
+				ExerciseType: req.ExerciseType,
+				Message:      "invalid schedule timezone",
+				Err:          err,
+			}
+			wm.logError(workoutErr)
+			return nil, workoutErr
+		}
+		scheduledFor := req.ScheduledFor.In(loc)
+		workout.ScheduledFor = &scheduledFor
+		workout.ScheduledTimezone = loc.String()
+	}
 
 	// ビジネスロジック: 最終的なバリデーション
 	// errValidatorを使用したバリデーション（冗長的なエラーチェックをまとめる）
 	if err := wm.validateWorkoutDataWithErrValidator(workout); err != nil {
 		workoutErr := &appErrors.WorkoutError{
-			Op:           "CreateWorkout",
+			Op:           op,
 			ExerciseType: req.ExerciseType,
 			Message:      "workout data validation failed",
 			Err:          err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
-		return nil, workoutErr
-	}
-
-	err := wm.repo.CreateWorkout(workout)
-	if err != nil {
-		workoutErr := &appErrors.WorkoutError{
-			Op:           "CreateWorkout",
-			ExerciseType: req.ExerciseType,
-			Message:      "failed to create workout in repository",
-			Err:          err,
-		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
-
-	// ビジネスロジック: 作成成功ログ
-	wm.logWorkoutCreated(workout)
-
 	return workout, nil
 }
 
 // validateWorkoutData ワークアウトデータの妥当性チェック
 func (wm *WorkoutManager) validateWorkoutData(workout *domain.Workout) error {
-	if workout.Sets < 0 {
-		return fmt.Errorf("sets cannot be negative: %d", workout.Sets)
+	if workout.Sets < 1 || workout.Sets > domain.MaxSets {
+		return fmt.Errorf("sets must be between 1 and %d: %d", domain.MaxSets, workout.Sets)
 	}
-	if workout.Reps < 0 {
-		return fmt.Errorf("reps cannot be negative: %d", workout.Reps)
+	if workout.Reps < 1 || workout.Reps > domain.MaxReps {
+		return fmt.Errorf("reps must be between 1 and %d: %d", domain.MaxReps, workout.Reps)
 	}
-	if workout.Weight < 0 {
-		return fmt.Errorf("weight cannot be negative: %.2f", workout.Weight)
+	if workout.Weight < 0 || workout.Weight > domain.MaxWeight {
+		return fmt.Errorf("weight must be between 0 and %.2f: %.2f", domain.MaxWeight, workout.Weight)
 	}
 	return nil
 }
@@ -190,6 +386,16 @@ func (ve *ValidationErrors) Error() string {
 	return builder.String()
 }
 
+// Is 入力値の検証エラーは全て domain.ErrInvalidArgument として扱う
+func (ve *ValidationErrors) Is(target error) bool {
+	return target == domain.ErrInvalidArgument
+}
+
+// Unwrap 個々のバリデーションエラーを返す（errors.Is・errors.Asで使用）
+func (ve *ValidationErrors) Unwrap() []error {
+	return ve.Errors
+}
+
 // errValidator 冗長的なエラーチェックをまとめるための構造体
 // errWriterパターンを参考に、複数のバリデーション処理を連続して行い、
 // 全てのバリデーションを実行し、全てのエラーを収集して返す
@@ -206,31 +412,31 @@ func (ev *errValidator) validate(fn func() error) {
 	}
 }
 
-// validateSets Setsの値を検証
+// validateSets Setsの値を検証（workoutsテーブルの制約と同じ範囲）
 func (ev *errValidator) validateSets(sets int) {
 	ev.validate(func() error {
-		if sets < 0 {
-			return fmt.Errorf("sets cannot be negative: %d", sets)
+		if sets < 1 || sets > domain.MaxSets {
+			return fmt.Errorf("sets must be between 1 and %d: %d", domain.MaxSets, sets)
 		}
 		return nil
 	})
 }
 
-// validateReps Repsの値を検証
+// validateReps Repsの値を検証（workoutsテーブルの制約と同じ範囲）
 func (ev *errValidator) validateReps(reps int) {
 	ev.validate(func() error {
-		if reps < 0 {
-			return fmt.Errorf("reps cannot be negative: %d", reps)
+		if reps < 1 || reps > domain.MaxReps {
+			return fmt.Errorf("reps must be between 1 and %d: %d", domain.MaxReps, reps)
 		}
 		return nil
 	})
 }
 
-// validateWeight Weightの値を検証
+// validateWeight Weightの値を検証（workoutsテーブルの制約と同じ範囲）
 func (ev *errValidator) validateWeight(weight float64) {
 	ev.validate(func() error {
-		if weight < 0 {
-			return fmt.Errorf("weight cannot be negative: %.2f", weight)
+		if weight < 0 || weight > domain.MaxWeight {
+			return fmt.Errorf("weight must be between 0 and %.2f: %.2f", domain.MaxWeight, weight)
 		}
 		return nil
 	})
@@ -257,15 +463,11 @@ func (ev *errValidator) validateExerciseType(exerciseType domain.ExerciseType) {
 }
 
 // error 保持しているエラーを返す（nilの場合はnilを返す）
-// 複数のエラーがある場合は ValidationErrors として返す
+// 1件の場合も ValidationErrors として返し、errors.Is(err, domain.ErrInvalidArgument) で判定できるようにする
 func (ev *errValidator) error() error {
 	if len(ev.errors) == 0 {
 		return nil
 	}
-	if len(ev.errors) == 1 {
-		return ev.errors[0]
-	}
-	// 複数のエラーがある場合は ValidationErrors として返す
 	return &ValidationErrors{Errors: ev.errors}
 }
 
@@ -287,29 +489,31 @@ func (wm *WorkoutManager) validateWorkoutDataWithErrValidator(workout *domain.Wo
 
 // logWorkoutCreated ワークアウト作成時のログ出力
 func (wm *WorkoutManager) logWorkoutCreated(workout *domain.Workout) {
-	difficultyNames := map[domain.Difficulty]string{
-		domain.DifficultyBeginner:     "初心者",
-		domain.DifficultyIntermediate: "中級者",
-		domain.DifficultyAdvanced:     "上級者",
-		domain.DifficultyBeast:        "お前は化け物だ、キモいです、すごいです",
-	}
-
-	fmt.Printf("💪 新しいワークアウト「%s」を作成しました！難易度: %s\n", workout.ExerciseType.Japanese(), difficultyNames[workout.Difficulty])
-	if workout.Weight > 0 {
-		fmt.Printf("🔥 重量: %.1fkg × %dセット × %d回\n", workout.Weight, workout.Sets, workout.Reps)
-	}
+	wm.logger.Info("ワークアウトを作成しました",
+		slog.String(logging.KeyOp, "CreateWorkout"),
+		slog.Int64(logging.KeyWorkoutID, int64(workout.ID)),
+		slog.String("exercise_type", workout.ExerciseType.Key()),
+		slog.String("difficulty", workout.Difficulty.Key()),
+		slog.Int("sets", workout.Sets),
+		slog.Int("reps", workout.Reps),
+		slog.Float64("weight", workout.WeightIn(workout.WeightUnit)),
+		slog.String("weight_unit", workout.WeightUnit.Key()),
+	)
 }
 
 // GetWorkout ワークアウトを取得（ビジネスロジック層）
 func (wm *WorkoutManager) GetWorkout(id domain.WorkoutID) (*domain.Workout, error) {
+	wm, span := wm.startSpan("GetWorkout")
+	defer span.End()
+
 	// ビジネスロジック: 入力値のバリデーション
 	if id <= 0 {
 		workoutErr := &appErrors.WorkoutError{
 			Op:      "GetWorkout",
 			Message: fmt.Sprintf("workout ID must be positive (got: %d)", id),
-			Err:     nil,
+			Err:     domain.ErrInvalidArgument,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
 
@@ -320,7 +524,7 @@ func (wm *WorkoutManager) GetWorkout(id domain.WorkoutID) (*domain.Workout, erro
 			Message: fmt.Sprintf("failed to retrieve workout from repository (ID: %d)", id),
 			Err:     err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
 
@@ -332,7 +536,7 @@ func (wm *WorkoutManager) GetWorkout(id domain.WorkoutID) (*domain.Workout, erro
 			Message:      fmt.Sprintf("workout data validation failed after retrieval (ID: %d)", id),
 			Err:          nil,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
 
@@ -341,15 +545,18 @@ func (wm *WorkoutManager) GetWorkout(id domain.WorkoutID) (*domain.Workout, erro
 
 // UpdateWorkout ワークアウトを更新（ビジネスロジック層）
 func (wm *WorkoutManager) UpdateWorkout(req UpdateWorkoutRequest) error {
+	wm, span := wm.startSpan("UpdateWorkout")
+	defer span.End()
+
 	// ビジネスロジック: 入力値のバリデーション
-	if err := wm.validateUpdateInput(req.ID, req.ExerciseType, req.Sets, req.Reps, req.Weight); err != nil {
+	if err := wm.validateUpdateInputWithErrValidator(req.ID, req.ExerciseType, req.Sets, req.Reps, req.weightInKilograms()); err != nil {
 		workoutErr := &appErrors.WorkoutError{
 			Op:           "UpdateWorkout",
 			ExerciseType: req.ExerciseType,
 			Message:      fmt.Sprintf("update input validation failed (ID: %d)", req.ID),
 			Err:          err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return workoutErr
 	}
 
@@ -362,20 +569,57 @@ func (wm *WorkoutManager) UpdateWorkout(req UpdateWorkoutRequest) error {
 			Message:      fmt.Sprintf("failed to get workout for update (ID: %d)", req.ID),
 			Err:          err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return workoutErr
 	}
 
-	// ビジネスロジック: 値の更新（nilでないフィールドのみ）
+	previousStatus := workout.Status
+	justCompleted := wm.applyUpdate(workout, req)
+	workout.UpdatedAt = time.Now()
+
+	err = wm.repo.UpdateWorkout(workout)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:           "UpdateWorkout",
+			ExerciseType: req.ExerciseType,
+			Message:      fmt.Sprintf("failed to persist workout update (ID: %d)", req.ID),
+			Err:          err,
+		}
+		wm.logError(workoutErr)
+		return workoutErr
+	}
+
+	wm.logger.Info("ワークアウトを更新しました",
+		slog.String(logging.KeyOp, "UpdateWorkout"), slog.Int64(logging.KeyWorkoutID, int64(req.ID)))
+	wm.publishUpdate(workout, previousStatus)
+
+	// ビジネスロジック: 完了したら次回の予定を作成（失敗しても更新自体は成功扱い）
+	if justCompleted {
+		if _, err := wm.scheduleNextWorkout(workout); err != nil {
+			wm.logger.Warn("次回のワークアウトの自動作成に失敗しました",
+				slog.String(logging.KeyOp, "UpdateWorkout"), slog.Int64(logging.KeyWorkoutID, int64(req.ID)),
+				slog.String(logging.KeyError, err.Error()))
+		}
+	}
+	return nil
+}
+
+// applyUpdate リクエストのnilでないフィールドをワークアウトに反映する（保存はしない）
+// 新たに完了した場合はtrueを返す
+func (wm *WorkoutManager) applyUpdate(workout *domain.Workout, req UpdateWorkoutRequest) bool {
 	workout.ExerciseType = req.ExerciseType
 
 	if req.Description != nil {
 		workout.Description = *req.Description
 	}
+	justCompleted := false
 	if req.Status != nil {
 		workout.Status = *req.Status
 		// ビジネスロジック: ステータス変更時の処理
-		wm.handleStatusChange(workout, *req.Status, req.ExerciseType)
+		justCompleted = wm.handleStatusChange(workout, *req.Status, req.ExerciseType)
+	}
+	if req.SkipReason != nil && workout.Status == domain.WorkoutStatusSkipped {
+		workout.SkipReason = *req.SkipReason
 	}
 	if req.Difficulty != nil {
 		workout.Difficulty = *req.Difficulty
@@ -389,28 +633,15 @@ func (wm *WorkoutManager) UpdateWorkout(req UpdateWorkoutRequest) error {
 	if req.Reps != nil {
 		workout.Reps = *req.Reps
 	}
-	if req.Weight != nil {
-		workout.Weight = *req.Weight
+	// 取得した重量をそのまま送り返した場合（他の項目だけの更新）は、記録した重量・単位を変えない
+	if weight := req.weightInKilograms(); weight != nil && *req.Weight != workout.WeightIn(req.WeightUnit) {
+		workout.Weight = *weight
+		workout.WeightUnit = req.WeightUnit
 	}
 	if req.Notes != nil {
 		workout.Notes = *req.Notes
 	}
-	workout.UpdatedAt = time.Now()
-
-	err = wm.repo.UpdateWorkout(workout)
-	if err != nil {
-		workoutErr := &appErrors.WorkoutError{
-			Op:           "UpdateWorkout",
-			ExerciseType: req.ExerciseType,
-			Message:      fmt.Sprintf("failed to persist workout update (ID: %d)", req.ID),
-			Err:          err,
-		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
-		return workoutErr
-	}
-
-	fmt.Printf("✅ ワークアウト「%s」を更新しました\n", req.ExerciseType.Japanese())
-	return nil
+	return justCompleted
 }
 
 // validateUpdateInput 更新時の入力値バリデーション
@@ -421,14 +652,14 @@ func (wm *WorkoutManager) validateUpdateInput(id domain.WorkoutID, exerciseType
 	if exerciseType == domain.ExerciseUnspecified {
 		return fmt.Errorf("exercise type must be specified")
 	}
-	if sets != nil && *sets < 0 {
-		return fmt.Errorf("sets cannot be negative: %d", *sets)
+	if sets != nil && (*sets < 1 || *sets > domain.MaxSets) {
+		return fmt.Errorf("sets must be between 1 and %d: %d", domain.MaxSets, *sets)
 	}
-	if reps != nil && *reps < 0 {
-		return fmt.Errorf("reps cannot be negative: %d", *reps)
+	if reps != nil && (*reps < 1 || *reps > domain.MaxReps) {
+		return fmt.Errorf("reps must be between 1 and %d: %d", domain.MaxReps, *reps)
 	}
-	if weight != nil && *weight < 0 {
-		return fmt.Errorf("weight cannot be negative: %.2f", *weight)
+	if weight != nil && (*weight < 0 || *weight > domain.MaxWeight) {
+		return fmt.Errorf("weight must be between 0 and %.2f: %.2f", domain.MaxWeight, *weight)
 	}
 	return nil
 }
@@ -458,30 +689,43 @@ func (wm *WorkoutManager) validateUpdateInputWithErrValidator(id domain.WorkoutI
 }
 
 // handleStatusChange ステータス変更時のビジネスロジック
-func (wm *WorkoutManager) handleStatusChange(workout *domain.Workout, newStatus domain.WorkoutStatus, exerciseType domain.ExerciseType) {
+// 新たに完了した場合はtrueを返す（保存後に次回の予定を自動作成するため）
+func (wm *WorkoutManager) handleStatusChange(workout *domain.Workout, newStatus domain.WorkoutStatus, exerciseType domain.ExerciseType) bool {
+	justCompleted := false
+
 	// ステータスが完了に変更された場合
 	if newStatus == domain.WorkoutStatusCompleted && workout.CompletedAt == nil {
 		now := time.Now()
 		workout.CompletedAt = &now
-		fmt.Printf("🎉 ワークアウト「%s」完了！お疲れ様でした！\n", exerciseType.Japanese())
+		justCompleted = true
+		wm.logger.Info("ワークアウトが完了しました",
+			slog.Int64(logging.KeyWorkoutID, int64(workout.ID)), slog.String("exercise_type", exerciseType.Key()))
 	}
 
 	// ステータスがスキップに変更された場合
 	if newStatus == domain.WorkoutStatusSkipped {
-		fmt.Printf("😅 ワークアウト「%s」をスキップしました。筋肉痛ですか？\n", exerciseType.Japanese())
+		wm.logger.Info("ワークアウトをスキップしました",
+			slog.Int64(logging.KeyWorkoutID, int64(workout.ID)), slog.String("exercise_type", exerciseType.Key()))
+	} else {
+		// スキップ以外に戻した場合はスキップ理由を消去
+		workout.SkipReason = domain.SkipReasonUnspecified
 	}
+	return justCompleted
 }
 
 // DeleteWorkout ワークアウトを削除（ビジネスロジック層）
 func (wm *WorkoutManager) DeleteWorkout(id domain.WorkoutID) error {
+	wm, span := wm.startSpan("DeleteWorkout")
+	defer span.End()
+
 	// ビジネスロジック: 入力値のバリデーション
 	if id <= 0 {
 		workoutErr := &appErrors.WorkoutError{
 			Op:      "DeleteWorkout",
 			Message: fmt.Sprintf("workout ID must be positive (got: %d)", id),
-			Err:     nil,
+			Err:     domain.ErrInvalidArgument,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return workoutErr
 	}
 
@@ -493,13 +737,14 @@ func (wm *WorkoutManager) DeleteWorkout(id domain.WorkoutID) error {
 			Message: fmt.Sprintf("failed to get workout before deletion (ID: %d)", id),
 			Err:     err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return workoutErr
 	}
 
 	// ビジネスロジック: 完了済みワークアウトの削除警告
 	if workout.Status == domain.WorkoutStatusCompleted {
-		fmt.Printf("⚠️  完了済みのワークアウトを削除します: 「%s」\n", workout.ExerciseType.Japanese())
+		wm.logger.Warn("完了済みのワークアウトを削除します",
+			slog.String(logging.KeyOp, "DeleteWorkout"), slog.Int64(logging.KeyWorkoutID, int64(id)))
 	}
 
 	err = wm.repo.DeleteWorkout(id)
@@ -510,16 +755,21 @@ func (wm *WorkoutManager) DeleteWorkout(id domain.WorkoutID) error {
 			Message:      fmt.Sprintf("failed to delete workout from repository (ID: %d)", id),
 			Err:          err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return workoutErr
 	}
 
-	fmt.Printf("🗑️ ワークアウト「%s」を削除しました\n", workout.ExerciseType.Japanese())
+	wm.logger.Info("ワークアウトを削除しました",
+		slog.String(logging.KeyOp, "DeleteWorkout"), slog.Int64(logging.KeyWorkoutID, int64(id)))
+	wm.publishEvent(domain.WorkoutEventDeleted, workout)
 	return nil
 }
 
 // ListWorkouts ワークアウト一覧を取得（ビジネスロジック層）
 func (wm *WorkoutManager) ListWorkouts(statusFilter *int, difficultyFilter *int, muscleGroupFilter *int) ([]*domain.Workout, error) {
+	wm, span := wm.startSpan("ListWorkouts")
+	defer span.End()
+
 	// リポジトリから全データを取得
 	workouts, err := wm.repo.ListWorkouts(statusFilter, difficultyFilter, muscleGroupFilter)
 	if err != nil {
@@ -528,7 +778,7 @@ func (wm *WorkoutManager) ListWorkouts(statusFilter *int, difficultyFilter *int,
 			Message: "failed to retrieve workouts from repository",
 			Err:     err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
 
@@ -540,7 +790,8 @@ func (wm *WorkoutManager) ListWorkouts(statusFilter *int, difficultyFilter *int,
 		}
 	}
 
-	fmt.Printf("🔍 フィルタリング結果: 全%d件中、有効なワークアウト%d件を返します\n", len(workouts), len(validWorkouts))
+	wm.logger.Debug("ワークアウト一覧をフィルタリングしました",
+		slog.String(logging.KeyOp, "ListWorkouts"), slog.Int("total", len(workouts)), slog.Int("valid", len(validWorkouts)))
 	return validWorkouts, nil
 }
 
@@ -559,90 +810,170 @@ func (wm *WorkoutManager) isValidWorkout(workout *domain.Workout) bool {
 	return true
 }
 
-// GetHighIntensityWorkouts 高強度ワークアウトのみを取得（Go基礎技術使用例）
-func (wm *WorkoutManager) GetHighIntensityWorkouts() ([]*domain.Workout, error) {
-	// 全ワークアウトを取得
-	allWorkouts, err := wm.repo.ListWorkouts(nil, nil, nil)
-	if err != nil {
+// DefaultIntensityRules 設定ファイルでルールが指定されていない場合の高強度判定ルール
+// 上級者以上の難易度で、種目ごとのベストe1RMの80%以上の重量を扱ったワークアウト
+func DefaultIntensityRules() []domain.IntensityRule {
+	minDifficulty := domain.DifficultyAdvanced
+	minPercent := 0.80
+	return []domain.IntensityRule{
+		{
+			Name:                  "advanced_heavy",
+			MinDifficulty:         &minDifficulty,
+			MinPercentOfOneRepMax: &minPercent,
+		},
+	}
+}
+
+// SetIntensityRules リクエストでルールが指定されない場合に使用する高強度判定ルールを設定
+func (wm *WorkoutManager) SetIntensityRules(rules []domain.IntensityRule, bodyweight float64) {
+	wm.intensityRules = rules
+	wm.bodyweight = bodyweight
+}
+
+// GetHighIntensityWorkoutsRequest 高強度ワークアウト取得リクエスト
+type GetHighIntensityWorkoutsRequest struct {
+	Rules      []domain.IntensityRule // オプション: 空なら設定済みのルールを使用
+	Bodyweight float64                // オプション: 0なら設定済みの体重を使用
+}
+
+// GetHighIntensityWorkouts 高強度ワークアウトのみを取得（ビジネスロジック層）
+// ルールの評価はリポジトリのクエリで行い、全件の読み込みは行わない
+func (wm *WorkoutManager) GetHighIntensityWorkouts(req GetHighIntensityWorkoutsRequest) ([]*domain.IntensityMatch, error) {
+	wm, span := wm.startSpan("GetHighIntensityWorkouts")
+	defer span.End()
+
+	query := domain.IntensityQuery{
+		Rules:      req.Rules,
+		Bodyweight: req.Bodyweight,
+	}
+	if len(query.Rules) == 0 {
+		query.Rules = wm.intensityRules
+	}
+	if query.Bodyweight == 0 {
+		query.Bodyweight = wm.bodyweight
+	}
+
+	if err := validateIntensityQuery(query); err != nil {
 		workoutErr := &appErrors.WorkoutError{
 			Op:      "GetHighIntensityWorkouts",
-			Message: "failed to get all workouts for filtering",
+			Message: "intensity rule validation failed",
 			Err:     err,
 		}
-		fmt.Printf("❌ %s\n", workoutErr.Error())
+		wm.logError(workoutErr)
 		return nil, workoutErr
 	}
 
-	highIntensityWorkouts := make([]*domain.Workout, 0)
-	for _, w := range allWorkouts {
-		isHighDifficulty := w.Difficulty == domain.DifficultyAdvanced || w.Difficulty == domain.DifficultyBeast
-		isHeavyWeight := w.Weight >= 50.0
-		if isHighDifficulty && isHeavyWeight {
-			highIntensityWorkouts = append(highIntensityWorkouts, w)
+	matches, err := wm.repo.FindHighIntensityWorkouts(query)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "GetHighIntensityWorkouts",
+			Message: fmt.Sprintf("failed to find high intensity workouts (rules: %d)", len(query.Rules)),
+			Err:     err,
 		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
 	}
 
-	logMessage := wm.buildHighIntensityLogMessage(len(allWorkouts), len(highIntensityWorkouts))
-	fmt.Print(logMessage)
+	totalCount, err := wm.repo.GetWorkoutCount()
+	if err != nil {
+		totalCount = len(matches)
+	}
+	wm.logger.Debug("高強度ワークアウトを抽出しました",
+		slog.String(logging.KeyOp, "GetHighIntensityWorkouts"), slog.Int("total", totalCount), slog.Int("matched", len(matches)))
 
-	// --- Generics活用例: 任意の数値条件で件数を集計してログに出す ---
-	// Weightが80.5以上の件数
-	heavyCount := countWorkoutsBy[float64](allWorkouts,
-		func(w *domain.Workout) float64 { return w.Weight },
-		func(v float64) bool { return v >= 80.5 },
-	)
-	// Setsが5以上の件数
-	highSetsCount := countWorkoutsBy[int](allWorkouts,
-		func(w *domain.Workout) int { return w.Sets },
-		func(v int) bool { return v >= 5 },
-	)
-	fmt.Printf("🔎 Generics filter summary: weight>=80.5=%d, sets>=5=%d\n", heavyCount, highSetsCount)
+	return matches, nil
+}
 
-	return highIntensityWorkouts, nil
+// validateIntensityQuery 高強度判定ルールの妥当性チェック（全てのエラーを収集）
+func validateIntensityQuery(query domain.IntensityQuery) error {
+	validator := &errValidator{}
+	validator.validate(func() error {
+		if len(query.Rules) == 0 {
+			return fmt.Errorf("at least one intensity rule is required")
+		}
+		return nil
+	})
+	validator.validateWeight(query.Bodyweight)
+
+	for i, rule := range query.Rules {
+		validator.validate(func() error {
+			if rule.Name == "" {
+				return fmt.Errorf("rule[%d]: name must be specified", i)
+			}
+			if !rule.HasCriteria() {
+				return fmt.Errorf("rule %q: at least one criterion is required", rule.Name)
+			}
+			return nil
+		})
+		validator.validate(func() error {
+			if rule.MinBodyweightRatio != nil && query.Bodyweight <= 0 {
+				return fmt.Errorf("rule %q: bodyweight is required for bodyweight ratio", rule.Name)
+			}
+			return nil
+		})
+		validator.validate(func() error {
+			if rule.MinPercentOfOneRepMax != nil && (*rule.MinPercentOfOneRepMax <= 0 || *rule.MinPercentOfOneRepMax > 1) {
+				return fmt.Errorf("rule %q: percent of one rep max must be in (0, 1]: %.2f", rule.Name, *rule.MinPercentOfOneRepMax)
+			}
+			return nil
+		})
+	}
+
+	return validator.error()
 }
 
-// ジェネリクス関数用
-type IntOrFloat interface {
-	int | float64
+// CalculateOneRepMaxRequest 1RM計算リクエスト
+type CalculateOneRepMaxRequest struct {
+	Weight  float64           // 必須: 使用重量
+	Reps    int               // 必須: 反復回数
+	RPE     float64           // オプション: RPEチャートを使う場合のみ必要
+	Formula *strength.Formula // オプション: nilなら適用可能な全ての推定式で計算
 }
 
-func countWorkoutsBy[T IntOrFloat](workouts []*domain.Workout, selector func(*domain.Workout) T, filter func(T) bool) int {
-	if len(workouts) == 0 {
-		return 0
-	}
-	var count int
-	for _, w := range workouts {
-		value := selector(w)
-		if filter(value) {
-			count++
+// CalculateOneRepMax 推定1RMを計算（ビジネスロジック層）
+func (wm *WorkoutManager) CalculateOneRepMax(req CalculateOneRepMaxRequest) ([]strength.Estimate, error) {
+	wm, span := wm.startSpan("CalculateOneRepMax")
+	defer span.End()
+
+	validator := &errValidator{}
+	validator.validateWeight(req.Weight)
+	validator.validateReps(req.Reps)
+	if err := validator.error(); err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CalculateOneRepMax",
+			Message: "one rep max input validation failed",
+			Err:     err,
 		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
 	}
-	return count
-}
 
-func (wm *WorkoutManager) buildHighIntensityLogMessage(totalCount, filteredCount int) string {
-	var builder strings.Builder
-	// 概算容量を事前確保
-	builder.Grow(100)
-
-	builder.WriteString("🔥 高強度ワークアウト: 全")
-	builder.WriteString(fmt.Sprintf("%d", totalCount))
-	builder.WriteString("件中")
-	builder.WriteString(fmt.Sprintf("%d", filteredCount))
-	builder.WriteString("件を抽出しました")
+	if req.Formula == nil {
+		return strength.EstimateAll(req.Weight, req.Reps, req.RPE), nil
+	}
 
-	if filteredCount == 0 {
-		builder.WriteString(" - もっと重いものを持ち上げましょう！💪こんなもんじゃないだろう！")
-	} else if filteredCount > totalCount/2 {
-		builder.WriteString(" - 野獣レベルですね！強すぎ🦍")
+	oneRepMax, err := strength.EstimateOneRepMax(*req.Formula, req.Weight, req.Reps, req.RPE)
+	if err != nil {
+		workoutErr := &appErrors.WorkoutError{
+			Op:      "CalculateOneRepMax",
+			Message: fmt.Sprintf("failed to estimate one rep max (formula: %s)", req.Formula),
+			Err:     err,
+		}
+		wm.logError(workoutErr)
+		return nil, workoutErr
 	}
 
-	builder.WriteString("\n")
-	return builder.String()
+	wm.logger.Debug("推定1RMを計算しました",
+		slog.String(logging.KeyOp, "CalculateOneRepMax"), slog.String("formula", req.Formula.String()),
+		slog.Float64("weight", req.Weight), slog.Int("reps", req.Reps), slog.Float64("one_rep_max", oneRepMax))
+	return []strength.Estimate{{Formula: *req.Formula, OneRepMax: oneRepMax}}, nil
 }
 
 // GetWorkoutCount ワークアウト数を取得
 func (wm *WorkoutManager) GetWorkoutCount() (int, error) {
+	wm, span := wm.startSpan("GetWorkoutCount")
+	defer span.End()
+
 	return wm.repo.GetWorkoutCount()
 }
 
@@ -3,6 +3,8 @@ package usecase
 import (
 	"golv2-learning-app/domain"
 	repository "golv2-learning-app/infra"
+	"golv2-learning-app/usecase/strength"
+	"math"
 	"testing"
 )
 
@@ -93,6 +95,31 @@ func TestCreateWorkout(t *testing.T) {
 			wantReps:    10, // デフォルト値が適用
 			description: "負の値は無視され、デフォルト値が適用されることを確認",
 		},
+		{
+			name: "異常系: 上限を超える値",
+			request: CreateWorkoutRequest{
+				ExerciseType: domain.BenchPress,
+				Sets:         500,
+				Reps:         1001,
+				Weight:       10000,
+			},
+			wantErr:     true,
+			description: "workoutsテーブルのCHECK制約と同じ上限をメモリのリポジトリでも適用する",
+		},
+		{
+			name: "正常系: 上限ちょうど",
+			request: CreateWorkoutRequest{
+				ExerciseType: domain.BenchPress,
+				Sets:         domain.MaxSets,
+				Reps:         domain.MaxReps,
+				Weight:       domain.MaxWeight,
+			},
+			wantID:      1,
+			wantStatus:  domain.WorkoutStatusPlanned,
+			wantSets:    domain.MaxSets,
+			wantReps:    domain.MaxReps,
+			description: "上限の値は作成できる",
+		},
 	}
 
 	for _, tt := range tests {
@@ -216,6 +243,23 @@ func TestUpdateWorkout(t *testing.T) {
 			wantErr:      true,
 			description:  "負のセット数は許可されない",
 		},
+		{
+			name: "異常系: 上限を超えるセット数",
+			setupWorkout: &domain.Workout{
+				ID:           1,
+				ExerciseType: domain.BenchPress,
+				Status:       domain.WorkoutStatusPlanned,
+				Sets:         3,
+				Reps:         10,
+			},
+			updateID:     1,
+			updateType:   domain.BenchPress,
+			updateSets:   domain.MaxSets + 1,
+			updateReps:   10,
+			updateWeight: 60.0,
+			wantErr:      true,
+			description:  "MySQLに保存する前にセット数の上限を検証する",
+		},
 	}
 
 	for _, tt := range tests {
@@ -487,3 +531,350 @@ func TestCreateWorkout_WithMock(t *testing.T) {
 		t.Errorf("Expected ExerciseType %s, got %s", domain.BenchPress.Japanese(), workout.ExerciseType.Japanese())
 	}
 }
+
+// TestGetHighIntensityWorkouts 高強度判定ルールの評価と一致したルール名の報告をテスト
+func TestGetHighIntensityWorkouts(t *testing.T) {
+	deadlift := domain.Deadlift
+	bodyweightRatio := 2.0
+	minSets := 5
+	minVolume := 2000.0
+
+	setupWorkouts := []*domain.Workout{
+		// ベンチプレスのベストe1RMは100kg（1回挙上）→ 100%
+		{ExerciseType: domain.BenchPress, Difficulty: domain.DifficultyBeast, Sets: 1, Reps: 1, Weight: 100.0},
+		// 60kgはベストe1RMの60% → デフォルトルールでは高強度ではない
+		{ExerciseType: domain.BenchPress, Difficulty: domain.DifficultyAdvanced, Sets: 3, Reps: 10, Weight: 60.0},
+		// スクワット80kg×5回のe1RMは約93.3kg → 約86%、ボリューム2000
+		{ExerciseType: domain.Squat, Difficulty: domain.DifficultyAdvanced, Sets: 5, Reps: 5, Weight: 80.0},
+		// 重量は重いが難易度が低い → デフォルトルールでは高強度ではない
+		{ExerciseType: domain.Deadlift, Difficulty: domain.DifficultyBeginner, Sets: 3, Reps: 5, Weight: 150.0},
+	}
+
+	tests := []struct {
+		name        string
+		request     GetHighIntensityWorkoutsRequest
+		wantRules   map[string]int // ルール名ごとの一致件数
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: デフォルトルール",
+			request:     GetHighIntensityWorkoutsRequest{},
+			wantRules:   map[string]int{"advanced_heavy": 2},
+			description: "上級者以上かつベストe1RMの80%以上",
+		},
+		{
+			name: "正常系: 種目別の体重比ルール",
+			request: GetHighIntensityWorkoutsRequest{
+				Rules:      []domain.IntensityRule{{Name: "deadlift_2x", ExerciseType: &deadlift, MinBodyweightRatio: &bodyweightRatio}},
+				Bodyweight: 70.0,
+			},
+			wantRules:   map[string]int{"deadlift_2x": 1},
+			description: "デッドリフトで体重の2倍以上",
+		},
+		{
+			name: "正常系: 最初に一致したルールを報告",
+			request: GetHighIntensityWorkoutsRequest{
+				Rules: []domain.IntensityRule{
+					{Name: "many_sets", MinSets: &minSets},
+					{Name: "high_volume", MinVolume: &minVolume},
+				},
+			},
+			wantRules:   map[string]int{"many_sets": 1, "high_volume": 1},
+			description: "スクワットはmany_sets、デッドリフト（2250）はhigh_volumeに一致",
+		},
+		{
+			name: "異常系: 体重未指定の体重比ルール",
+			request: GetHighIntensityWorkoutsRequest{
+				Rules: []domain.IntensityRule{{Name: "deadlift_2x", MinBodyweightRatio: &bodyweightRatio}},
+			},
+			wantErr:     true,
+			description: "体重比ルールには体重が必要",
+		},
+		{
+			name: "異常系: 条件のないルール",
+			request: GetHighIntensityWorkoutsRequest{
+				Rules: []domain.IntensityRule{{Name: "empty"}},
+			},
+			wantErr:     true,
+			description: "条件が1つもないルールはエラー",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			for _, workout := range setupWorkouts {
+				w := *workout
+				if err := mockRepo.CreateWorkout(&w); err != nil {
+					t.Fatalf("Failed to setup workout: %v", err)
+				}
+			}
+
+			matches, err := manager.GetHighIntensityWorkouts(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetHighIntensityWorkouts() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				return
+			}
+
+			gotRules := make(map[string]int)
+			for _, match := range matches {
+				gotRules[match.MatchedRule]++
+			}
+			if len(gotRules) != len(tt.wantRules) {
+				t.Errorf("Expected matched rules %v, got %v", tt.wantRules, gotRules)
+			}
+			for name, want := range tt.wantRules {
+				if gotRules[name] != want {
+					t.Errorf("Expected %d matches for rule %q, got %d", want, name, gotRules[name])
+				}
+			}
+		})
+	}
+}
+
+// TestCalculateOneRepMax 1RM計算のバリデーションと推定式の選択をテスト
+func TestCalculateOneRepMax(t *testing.T) {
+	brzycki := strength.FormulaBrzycki
+
+	tests := []struct {
+		name          string
+		request       CalculateOneRepMaxRequest
+		wantEstimates int
+		wantErr       bool
+		description   string
+	}{
+		{
+			name:          "正常系: 推定式未指定",
+			request:       CalculateOneRepMaxRequest{Weight: 100.0, Reps: 5},
+			wantEstimates: 3,
+			description:   "RPEなしの場合はRPEチャート以外の全推定式で計算",
+		},
+		{
+			name:          "正常系: 推定式指定",
+			request:       CalculateOneRepMaxRequest{Weight: 100.0, Reps: 5, Formula: &brzycki},
+			wantEstimates: 1,
+			description:   "指定した推定式のみで計算",
+		},
+		{
+			name:        "異常系: 負の重量と0回",
+			request:     CalculateOneRepMaxRequest{Weight: -10.0, Reps: 0},
+			wantErr:     true,
+			description: "複数のバリデーションエラーをまとめて返す",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+
+			estimates, err := manager.CalculateOneRepMax(tt.request)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CalculateOneRepMax() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if len(estimates) != tt.wantEstimates {
+				t.Errorf("Expected %d estimates, got %d", tt.wantEstimates, len(estimates))
+			}
+		})
+	}
+}
+
+// TestUpdateWorkout_SkipReason テーブル駆動テストでスキップ理由の更新をテスト
+func TestUpdateWorkout_SkipReason(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupStatus domain.WorkoutStatus
+		setupReason domain.SkipReason
+		status      domain.WorkoutStatus
+		reason      domain.SkipReason
+		wantReason  domain.SkipReason
+		description string
+	}{
+		{
+			name:        "正常系: スキップ時に理由を記録",
+			setupStatus: domain.WorkoutStatusPlanned,
+			status:      domain.WorkoutStatusSkipped,
+			reason:      domain.SkipReasonSore,
+			wantReason:  domain.SkipReasonSore,
+			description: "スキップ理由が保存される",
+		},
+		{
+			name:        "正常系: スキップ以外では理由を無視",
+			setupStatus: domain.WorkoutStatusPlanned,
+			status:      domain.WorkoutStatusCompleted,
+			reason:      domain.SkipReasonNoTime,
+			wantReason:  domain.SkipReasonUnspecified,
+			description: "完了時はスキップ理由を保存しない",
+		},
+		{
+			name:        "正常系: スキップから予定に戻すと理由を消去",
+			setupStatus: domain.WorkoutStatusSkipped,
+			setupReason: domain.SkipReasonWeather,
+			status:      domain.WorkoutStatusPlanned,
+			reason:      domain.SkipReasonUnspecified,
+			wantReason:  domain.SkipReasonUnspecified,
+			description: "再予定したワークアウトに古い理由を残さない",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := repository.NewMockWorkoutRepository()
+			manager := NewWorkoutManagerWithRepository(mockRepo)
+			workout := &domain.Workout{ExerciseType: domain.PullUp, Status: tt.setupStatus, SkipReason: tt.setupReason, Sets: 3, Reps: 8}
+			if err := mockRepo.CreateWorkout(workout); err != nil {
+				t.Fatalf("Failed to setup workout: %v", err)
+			}
+
+			err := manager.UpdateWorkout(UpdateWorkoutRequest{
+				ID:           workout.ID,
+				ExerciseType: domain.PullUp,
+				Status:       &tt.status,
+				SkipReason:   &tt.reason,
+			})
+			if err != nil {
+				t.Fatalf("UpdateWorkout() error = %v", err)
+			}
+
+			updated, err := mockRepo.GetWorkout(workout.ID)
+			if err != nil {
+				t.Fatalf("Failed to get workout: %v", err)
+			}
+			if updated.SkipReason != tt.wantReason {
+				t.Errorf("Expected SkipReason=%s, got %s", tt.wantReason.Japanese(), updated.SkipReason.Japanese())
+			}
+		})
+	}
+}
+
+// TestCreateWorkout_WeightUnit テーブル駆動テストで重量の単位の換算をテスト
+func TestCreateWorkout_WeightUnit(t *testing.T) {
+	tests := []struct {
+		name        string
+		weight      float64
+		unit        domain.WeightUnit
+		wantKg      float64                       // 保存される重量(kg)
+		wantIn      map[domain.WeightUnit]float64 // WeightIn（0.01単位）
+		wantPlate   map[domain.WeightUnit]float64 // PlateWeightIn（表示用）
+		wantErr     bool
+		description string
+	}{
+		{
+			name:        "正常系: kgで入力",
+			weight:      100,
+			unit:        domain.WeightUnitKilogram,
+			wantKg:      100,
+			wantIn:      map[domain.WeightUnit]float64{domain.WeightUnitKilogram: 100, domain.WeightUnitPound: 220.46},
+			wantPlate:   map[domain.WeightUnit]float64{domain.WeightUnitKilogram: 100, domain.WeightUnitPound: 220},
+			description: "ポンドの表示用の値は5lb刻みに丸める（220.46lb → 220lb）",
+		},
+		{
+			name:        "正常系: lbで入力",
+			weight:      225,
+			unit:        domain.WeightUnitPound,
+			wantKg:      225 * domain.KilogramsPerPound,
+			wantIn:      map[domain.WeightUnit]float64{domain.WeightUnitKilogram: 102.06, domain.WeightUnitPound: 225},
+			wantPlate:   map[domain.WeightUnit]float64{domain.WeightUnitKilogram: 102.5, domain.WeightUnitPound: 225},
+			description: "kgで保存し、入力した単位では入力値に戻る。kgの表示用の値は2.5kg刻みに丸める（102.06kg → 102.5kg）",
+		},
+		{
+			name:        "異常系: 換算後の重量が上限を超える",
+			weight:      30000,
+			unit:        domain.WeightUnitPound,
+			wantErr:     true,
+			description: "上限はkgに換算した重量で判定する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+			workout, err := manager.CreateWorkout(CreateWorkoutRequest{
+				ExerciseType: domain.Squat,
+				Weight:       tt.weight,
+				WeightUnit:   tt.unit,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateWorkout() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if math.Abs(workout.Weight-tt.wantKg) > 1e-9 {
+				t.Errorf("Expected Weight=%f kg, got %f", tt.wantKg, workout.Weight)
+			}
+			if workout.WeightUnit != tt.unit {
+				t.Errorf("Expected WeightUnit=%s, got %s", tt.unit.Key(), workout.WeightUnit.Key())
+			}
+			for unit, want := range tt.wantIn {
+				if got := workout.WeightIn(unit); got != want {
+					t.Errorf("Expected WeightIn(%s)=%.2f, got %.2f", unit.Key(), want, got)
+				}
+			}
+			for unit, want := range tt.wantPlate {
+				if got := workout.PlateWeightIn(unit); got != want {
+					t.Errorf("Expected PlateWeightIn(%s)=%.2f, got %.2f", unit.Key(), want, got)
+				}
+			}
+		})
+	}
+}
+
+// TestUpdateWorkout_WeightRoundTrip 異なる単位で取得した重量を送り返しても、記録した重量・単位が変わらないことをテスト
+func TestUpdateWorkout_WeightRoundTrip(t *testing.T) {
+	manager := NewWorkoutManagerWithRepository(repository.NewMockWorkoutRepository())
+	created, err := manager.CreateWorkout(CreateWorkoutRequest{ExerciseType: domain.Squat, Sets: 5, Reps: 5, Weight: 100})
+	if err != nil {
+		t.Fatalf("CreateWorkout() error = %v", err)
+	}
+
+	// lbのクライアントが取得した重量のまま、メモだけを更新する
+	weight := created.WeightIn(domain.WeightUnitPound)
+	notes := "felt easy"
+	if err := manager.UpdateWorkout(UpdateWorkoutRequest{
+		ID:           created.ID,
+		ExerciseType: domain.Squat,
+		Weight:       &weight,
+		WeightUnit:   domain.WeightUnitPound,
+		Notes:        &notes,
+	}); err != nil {
+		t.Fatalf("UpdateWorkout() error = %v", err)
+	}
+
+	updated, err := manager.GetWorkout(created.ID)
+	if err != nil {
+		t.Fatalf("GetWorkout() error = %v", err)
+	}
+	if updated.Weight != 100 || updated.WeightUnit != domain.WeightUnitKilogram {
+		t.Errorf("Expected 100 kg to be kept, got %f %s", updated.Weight, updated.WeightUnit.Key())
+	}
+	if updated.Notes != notes {
+		t.Errorf("Expected Notes=%q, got %q", notes, updated.Notes)
+	}
+
+	// 重量を変更した場合はリクエストの単位で記録する
+	weight = 225
+	if err := manager.UpdateWorkout(UpdateWorkoutRequest{
+		ID:           created.ID,
+		ExerciseType: domain.Squat,
+		Weight:       &weight,
+		WeightUnit:   domain.WeightUnitPound,
+	}); err != nil {
+		t.Fatalf("UpdateWorkout() error = %v", err)
+	}
+	updated, err = manager.GetWorkout(created.ID)
+	if err != nil {
+		t.Fatalf("GetWorkout() error = %v", err)
+	}
+	if updated.WeightUnit != domain.WeightUnitPound || updated.WeightIn(domain.WeightUnitPound) != 225 {
+		t.Errorf("Expected 225 lb, got %.2f %s", updated.WeightIn(domain.WeightUnitPound), updated.WeightUnit.Key())
+	}
+}
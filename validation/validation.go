@@ -0,0 +1,205 @@
+// Package validation protoのフィールドオプション（proto/validate/validate.proto）で定義した制約でメッセージを検証する
+package validation
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/proto/validate"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Violation 1つのフィールドの制約違反
+type Violation struct {
+	Field       string // フィールドのパス（例: "templates[0].exercises[1].weeks[0].sets[2].reps"）
+	Description string // 違反の内容（例: "value must be greater than or equal to 0 and less than or equal to 100"）
+}
+
+// Error メッセージの全ての制約違反
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	var builder strings.Builder
+	builder.WriteString("validation failed: ")
+	for i, v := range e.Violations {
+		if i > 0 {
+			builder.WriteString("; ")
+		}
+		builder.WriteString(v.Field)
+		builder.WriteString(": ")
+		builder.WriteString(v.Description)
+	}
+	return builder.String()
+}
+
+// Is 制約違反は domain.ErrInvalidArgument として扱う
+func (e *Error) Is(target error) bool {
+	return target == domain.ErrInvalidArgument
+}
+
+// Validate メッセージを検証し、違反があれば全ての違反を *Error で返す
+// 存在を区別できるフィールド（メッセージ型・optional・oneof）は設定されている場合のみ検証し、
+// それ以外のフィールドは0や空文字列も値として検証する。メッセージ型のフィールドは中身も再帰的に検証する
+func Validate(msg proto.Message) error {
+	var violations []Violation
+	validateMessage(msg.ProtoReflect(), "", &violations)
+	if len(violations) == 0 {
+		return nil
+	}
+	return &Error{Violations: violations}
+}
+
+// validateMessage メッセージの全てのフィールドを検証して違反を追加する
+func validateMessage(m protoreflect.Message, prefix string, violations *[]Violation) {
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		rules := fieldRules(fd)
+		path := fd.TextName()
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if fd.HasPresence() && !m.Has(fd) {
+			if rules.GetRequired() {
+				*violations = append(*violations, Violation{Field: path, Description: "value is required"})
+			}
+			continue
+		}
+
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			repeated := rules.GetRepeated()
+			if description := checkItems(list.Len(), repeated); description != "" {
+				*violations = append(*violations, Violation{Field: path, Description: description})
+			}
+			for j := 0; j < list.Len(); j++ {
+				validateValue(fd, list.Get(j), repeated.GetItems(), rules.GetSkipped(), fmt.Sprintf("%s[%d]", path, j), violations)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.MessageKind || rules.GetSkipped() {
+				continue
+			}
+			m.Get(fd).Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+				validateMessage(value.Message(), fmt.Sprintf("%s[%q]", path, key.String()), violations)
+				return true
+			})
+		default:
+			validateValue(fd, m.Get(fd), rules, rules.GetSkipped(), path, violations)
+		}
+	}
+}
+
+// validateValue 1つの値（repeatedの場合は要素）を検証する
+func validateValue(fd protoreflect.FieldDescriptor, value protoreflect.Value, rules *validate.FieldRules, skipped bool, path string, violations *[]Violation) {
+	if fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind {
+		if !skipped {
+			validateMessage(value.Message(), path, violations)
+		}
+		return
+	}
+	if description := checkValue(fd, value, rules); description != "" {
+		*violations = append(*violations, Violation{Field: path, Description: description})
+	}
+}
+
+// fieldRules フィールドオプションの制約（指定がなければnil）
+func fieldRules(fd protoreflect.FieldDescriptor) *validate.FieldRules {
+	opts := fd.Options()
+	if opts == nil || !proto.HasExtension(opts, validate.E_Field) {
+		return nil
+	}
+	rules, _ := proto.GetExtension(opts, validate.E_Field).(*validate.FieldRules)
+	return rules
+}
+
+// checkValue スカラー値を型ごとの制約で検証し、違反の内容を返す（違反がなければ空文字列）
+// フィールドの型と一致しない制約は無視する（TestWorkoutProtoRulesで型の一致を確認している）
+func checkValue(fd protoreflect.FieldDescriptor, value protoreflect.Value, rules *validate.FieldRules) string {
+	switch r := rules.GetType().(type) {
+	case *validate.FieldRules_Int32:
+		if fd.Kind() == protoreflect.Int32Kind || fd.Kind() == protoreflect.Sint32Kind || fd.Kind() == protoreflect.Sfixed32Kind {
+			return checkRange(int32(value.Int()), r.Int32.Gt, r.Int32.Gte, r.Int32.Lt, r.Int32.Lte)
+		}
+	case *validate.FieldRules_Int64:
+		if fd.Kind() == protoreflect.Int64Kind || fd.Kind() == protoreflect.Sint64Kind || fd.Kind() == protoreflect.Sfixed64Kind {
+			return checkRange(value.Int(), r.Int64.Gt, r.Int64.Gte, r.Int64.Lt, r.Int64.Lte)
+		}
+	case *validate.FieldRules_Double:
+		if fd.Kind() == protoreflect.DoubleKind {
+			if math.IsNaN(value.Float()) {
+				return "value must not be NaN"
+			}
+			return checkRange(value.Float(), r.Double.Gt, r.Double.Gte, r.Double.Lt, r.Double.Lte)
+		}
+	case *validate.FieldRules_String_:
+		if fd.Kind() == protoreflect.StringKind {
+			return checkLength(uint64(utf8.RuneCountInString(value.String())), r.String_.MinLen, r.String_.MaxLen, "characters")
+		}
+	case *validate.FieldRules_Enum:
+		if fd.Kind() == protoreflect.EnumKind && r.Enum.GetDefinedOnly() && fd.Enum().Values().ByNumber(value.Enum()) == nil {
+			return "value must be one of the defined enum values"
+		}
+	}
+	return ""
+}
+
+// checkItems repeatedの要素数を検証する
+func checkItems(n int, rules *validate.RepeatedRules) string {
+	if rules == nil {
+		return ""
+	}
+	return checkLength(uint64(n), rules.MinItems, rules.MaxItems, "items")
+}
+
+// checkRange 数値が境界（指定されたもののみ）の範囲内か検証する。違反した場合は範囲全体を説明する
+func checkRange[T int32 | int64 | float64](v T, gt, gte, lt, lte *T) string {
+	ok := (gt == nil || v > *gt) && (gte == nil || v >= *gte) && (lt == nil || v < *lt) && (lte == nil || v <= *lte)
+	if ok {
+		return ""
+	}
+	var conditions []string
+	if gt != nil {
+		conditions = append(conditions, "greater than "+formatNumber(*gt))
+	}
+	if gte != nil {
+		conditions = append(conditions, "greater than or equal to "+formatNumber(*gte))
+	}
+	if lt != nil {
+		conditions = append(conditions, "less than "+formatNumber(*lt))
+	}
+	if lte != nil {
+		conditions = append(conditions, "less than or equal to "+formatNumber(*lte))
+	}
+	return "value must be " + strings.Join(conditions, " and ")
+}
+
+// checkLength 長さ・要素数が範囲内か検証する（unitは "characters" または "items"）
+func checkLength(n uint64, min, max *uint64, unit string) string {
+	switch {
+	case min != nil && n < *min:
+		return fmt.Sprintf("value must have at least %d %s", *min, unit)
+	case max != nil && n > *max:
+		return fmt.Sprintf("value must have at most %d %s", *max, unit)
+	}
+	return ""
+}
+
+// formatNumber 境界値を最短の表記で文字列にする（例: 9999.99、100）
+func formatNumber[T int32 | int64 | float64](v T) string {
+	switch n := any(v).(type) {
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	default:
+		return fmt.Sprint(n)
+	}
+}
@@ -0,0 +1,177 @@
+package validation
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"golv2-learning-app/domain"
+	"golv2-learning-app/proto"
+	"golv2-learning-app/proto/validate"
+
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TestValidate テーブル駆動テストでprotoの制約による検証をテスト
+func TestValidate(t *testing.T) {
+	name := ""
+	tests := []struct {
+		name        string
+		msg         protoreflect.ProtoMessage
+		wantFields  []string // 違反するフィールド（nilの場合は違反なし）
+		description string
+	}{
+		{
+			name:        "正常系: 未指定の値",
+			msg:         &proto.CreateWorkoutRequest{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT},
+			description: "省略したsets・repsは検証しない（デフォルト値）",
+		},
+		{
+			name: "正常系: 上限ちょうど",
+			msg: &proto.CreateWorkoutRequest{
+				ExerciseType: proto.ExerciseType_EXERCISE_SQUAT,
+				Sets:         protobuf.Int32(domain.MaxSets),
+				Reps:         protobuf.Int32(domain.MaxReps),
+				Weight:       domain.MaxWeight,
+			},
+			description: "境界値は許可する",
+		},
+		{
+			name: "異常系: 全ての違反を返す",
+			msg: &proto.CreateWorkoutRequest{
+				ExerciseType: proto.ExerciseType(99),
+				Sets:         protobuf.Int32(500),
+				Reps:         protobuf.Int32(-1),
+				Weight:       10000,
+			},
+			wantFields:  []string{"exercise_type", "sets", "reps", "weight"},
+			description: "最初の違反で止めずに全てのフィールドを検証する",
+		},
+		{
+			name:        "異常系: NaN",
+			msg:         &proto.CompleteSet{Reps: 5, Weight: math.NaN()},
+			wantFields:  []string{"weight"},
+			description: "NaNはどの範囲にも含まれない",
+		},
+		{
+			name: "異常系: ネストしたメッセージ",
+			msg: &proto.CreateProgramRequest{
+				Name: "5/3/1",
+				Templates: []*proto.WorkoutTemplate{{
+					Name: "Day 1",
+					Exercises: []*proto.TemplateExercise{{
+						ExerciseType: proto.ExerciseType_EXERCISE_SQUAT,
+						Weeks:        []*proto.WeekScheme{{Sets: []*proto.SetScheme{{Sets: 5, Reps: 5}, {Sets: 0, Reps: 5}}}},
+					}},
+				}},
+			},
+			wantFields:  []string{"templates[0].exercises[0].weeks[0].sets[1].sets"},
+			description: "repeatedの要素の位置を含むパスで返す",
+		},
+		{
+			name:        "正常系: optionalの未設定",
+			msg:         &proto.UpdateProgramRequest{Id: 1},
+			description: "存在を区別できるフィールドは設定されている場合のみ検証する",
+		},
+		{
+			name:        "異常系: optionalの空文字列",
+			msg:         &proto.UpdateProgramRequest{Id: 1, Name: &name},
+			wantFields:  []string{"name"},
+			description: "設定された空文字列はmin_lenで拒否する",
+		},
+		{
+			name: "正常系: 検証しない項目",
+			msg: &proto.BatchCreateWorkoutsRequest{Workouts: []*proto.CreateWorkoutRequest{
+				{ExerciseType: proto.ExerciseType_EXERCISE_SQUAT, Sets: protobuf.Int32(500)},
+			}},
+			description: "skippedの項目はハンドラーで項目ごとに検証する",
+		},
+		{
+			name: "異常系: oneofのメッセージ",
+			msg: &proto.TrackWorkoutRequest{Event: &proto.TrackWorkoutRequest_SetCompleted{
+				SetCompleted: &proto.CompleteSet{Reps: 0, Rpe: 11},
+			}},
+			wantFields:  []string{"set_completed.reps", "set_completed.rpe"},
+			description: "設定されたoneofのメッセージは中身を検証する",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.msg)
+			if tt.wantFields == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var validationErr *Error
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Validate() error = %v, want *Error", err)
+			}
+			if !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Error("Expected errors.Is(err, domain.ErrInvalidArgument)")
+			}
+			var fields []string
+			for _, v := range validationErr.Violations {
+				fields = append(fields, v.Field)
+				if v.Description == "" {
+					t.Errorf("Expected description for %s", v.Field)
+				}
+			}
+			if !reflect.DeepEqual(fields, tt.wantFields) {
+				t.Errorf("Expected violations %v, got %v", tt.wantFields, fields)
+			}
+		})
+	}
+}
+
+// TestWorkoutProtoRules workout.protoの制約がフィールドの型と一致していることをテスト
+// 型の一致しない制約は検証時に無視されるため、定義の誤りをここで検出する
+func TestWorkoutProtoRules(t *testing.T) {
+	messages := proto.File_proto_workout_proto.Messages()
+	var count int
+	for i := 0; i < messages.Len(); i++ {
+		fields := messages.Get(i).Fields()
+		for j := 0; j < fields.Len(); j++ {
+			fd := fields.Get(j)
+			rules := fieldRules(fd)
+			if rules == nil {
+				continue
+			}
+			count++
+			if repeated := rules.GetRepeated(); repeated != nil {
+				if !fd.IsList() {
+					t.Errorf("%s: repeated rules on a non-repeated field", fd.FullName())
+				}
+				rules = repeated.GetItems()
+			}
+			if want, ok := ruleKinds(rules); ok && !want[fd.Kind()] {
+				t.Errorf("%s: %T rules do not match field kind %s", fd.FullName(), rules.GetType(), fd.Kind())
+			}
+		}
+	}
+	if count == 0 {
+		t.Error("Expected rules in workout.proto")
+	}
+}
+
+// ruleKinds 制約の種類ごとに指定できるフィールドの型
+func ruleKinds(rules *validate.FieldRules) (map[protoreflect.Kind]bool, bool) {
+	switch rules.GetType().(type) {
+	case *validate.FieldRules_Int32:
+		return map[protoreflect.Kind]bool{protoreflect.Int32Kind: true, protoreflect.Sint32Kind: true, protoreflect.Sfixed32Kind: true}, true
+	case *validate.FieldRules_Int64:
+		return map[protoreflect.Kind]bool{protoreflect.Int64Kind: true, protoreflect.Sint64Kind: true, protoreflect.Sfixed64Kind: true}, true
+	case *validate.FieldRules_Double:
+		return map[protoreflect.Kind]bool{protoreflect.DoubleKind: true}, true
+	case *validate.FieldRules_String_:
+		return map[protoreflect.Kind]bool{protoreflect.StringKind: true}, true
+	case *validate.FieldRules_Enum:
+		return map[protoreflect.Kind]bool{protoreflect.EnumKind: true}, true
+	}
+	return nil, false
+}